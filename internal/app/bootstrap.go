@@ -63,6 +63,18 @@ func Start() {
 	// 启动限速器清理任务
 	initializer.StartRateLimitCleanup()
 
+	// 启动插件错误率告警任务
+	initializer.StartPluginAlert()
+
+	// 启动数据保留清理任务
+	initializer.StartRetention()
+
+	// 启动面板健康检查任务
+	initializer.StartPanelHealth()
+
+	// 启动超时卡密预扣退回任务
+	initializer.StartCDKReservation()
+
 	fmt.Println(" ")
 	switch config.Config.App.Mode {
 	case gin.DebugMode:
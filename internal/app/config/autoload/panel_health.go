@@ -0,0 +1,10 @@
+package autoload
+
+type PanelHealth struct {
+	Enable           bool `mapstructure:"enable" json:"enable" yaml:"enable"`
+	Interval         int  `mapstructure:"interval" json:"interval" yaml:"interval"`
+	DegradedLatency  int  `mapstructure:"degraded-latency" json:"degraded-latency" yaml:"degraded-latency"`
+	DownThreshold    int  `mapstructure:"down-threshold" json:"down-threshold" yaml:"down-threshold"`
+	AutoDisable      bool `mapstructure:"auto-disable" json:"auto-disable" yaml:"auto-disable"`
+	AutoDisableAfter int  `mapstructure:"auto-disable-after" json:"auto-disable-after" yaml:"auto-disable-after"`
+}
@@ -0,0 +1,11 @@
+package autoload
+
+type PluginAlert struct {
+	Enable     bool    `mapstructure:"enable" json:"enable" yaml:"enable"`
+	Interval   int     `mapstructure:"interval" json:"interval" yaml:"interval"`
+	Window     int     `mapstructure:"window" json:"window" yaml:"window"`
+	Threshold  float64 `mapstructure:"threshold" json:"threshold" yaml:"threshold"`
+	MinSamples int     `mapstructure:"min-samples" json:"min-samples" yaml:"min-samples"`
+	Cooldown   int     `mapstructure:"cooldown" json:"cooldown" yaml:"cooldown"`
+	Webhook    string  `mapstructure:"webhook" json:"webhook" yaml:"webhook"`
+}
@@ -0,0 +1,9 @@
+package autoload
+
+type Qinglong struct {
+	EnvCacheTTL    int `mapstructure:"env-cache-ttl" json:"env-cache-ttl" yaml:"env-cache-ttl"`
+	RequestTimeout int `mapstructure:"request-timeout" json:"request-timeout" yaml:"request-timeout"`
+	MaxConcurrency int `mapstructure:"max-concurrency" json:"max-concurrency" yaml:"max-concurrency"`
+
+	AllowUnsupportedVersion bool `mapstructure:"allow-unsupported-version" json:"allow-unsupported-version" yaml:"allow-unsupported-version"`
+}
@@ -0,0 +1,14 @@
+package autoload
+
+type Retention struct {
+	Enable         bool                       `mapstructure:"enable" json:"enable" yaml:"enable"`
+	Interval       int                        `mapstructure:"interval" json:"interval" yaml:"interval"`
+	StoreInputData bool                       `mapstructure:"store-input-data" json:"store-input-data" yaml:"store-input-data"`
+	Policies       map[string]RetentionPolicy `mapstructure:"policies" json:"policies" yaml:"policies"`
+}
+
+type RetentionPolicy struct {
+	MaxAge      int `mapstructure:"max-age" json:"max-age" yaml:"max-age"`
+	ErrorMaxAge int `mapstructure:"error-max-age" json:"error-max-age" yaml:"error-max-age"`
+	MaxRows     int `mapstructure:"max-rows" json:"max-rows" yaml:"max-rows"`
+}
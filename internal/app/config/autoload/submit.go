@@ -0,0 +1,8 @@
+package autoload
+
+type Submit struct {
+	LockTTL            int `mapstructure:"lock-ttl" json:"lock-ttl" yaml:"lock-ttl"`
+	LockWait           int `mapstructure:"lock-wait" json:"lock-wait" yaml:"lock-wait"`
+	ReservationTimeout int `mapstructure:"reservation-timeout" json:"reservation-timeout" yaml:"reservation-timeout"`
+	IdempotencyWindow  int `mapstructure:"idempotency-window" json:"idempotency-window" yaml:"idempotency-window"`
+}
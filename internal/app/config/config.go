@@ -10,9 +10,14 @@ import (
 )
 
 type Configuration struct {
-	App   autoload.App   `mapstructure:"app" json:"app" yaml:"app"`
-	DB    autoload.DB    `mapstructure:"db" json:"db" yaml:"db"`
-	Cache autoload.Cache `mapstructure:"cache" json:"cache" yaml:"cache"`
+	App         autoload.App         `mapstructure:"app" json:"app" yaml:"app"`
+	DB          autoload.DB          `mapstructure:"db" json:"db" yaml:"db"`
+	Cache       autoload.Cache       `mapstructure:"cache" json:"cache" yaml:"cache"`
+	PluginAlert autoload.PluginAlert `mapstructure:"plugin-alert" json:"plugin-alert" yaml:"plugin-alert"`
+	Retention   autoload.Retention   `mapstructure:"retention" json:"retention" yaml:"retention"`
+	Qinglong    autoload.Qinglong    `mapstructure:"qinglong" json:"qinglong" yaml:"qinglong"`
+	PanelHealth autoload.PanelHealth `mapstructure:"panel-health" json:"panel-health" yaml:"panel-health"`
+	Submit      autoload.Submit      `mapstructure:"submit" json:"submit" yaml:"submit"`
 }
 
 var (
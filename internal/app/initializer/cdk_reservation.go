@@ -0,0 +1,10 @@
+package initializer
+
+import (
+	"github.com/nuanxinqing123/QLToolsV2/internal/service"
+)
+
+// StartCDKReservation 启动超时卡密预扣退回任务
+func StartCDKReservation() {
+	service.StartCDKReservationTask()
+}
@@ -0,0 +1,10 @@
+package initializer
+
+import (
+	"github.com/nuanxinqing123/QLToolsV2/internal/service"
+)
+
+// StartPanelHealth 启动面板健康检查任务
+func StartPanelHealth() {
+	service.StartPanelHealthTask()
+}
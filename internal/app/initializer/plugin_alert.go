@@ -0,0 +1,10 @@
+package initializer
+
+import (
+	"github.com/nuanxinqing123/QLToolsV2/internal/service"
+)
+
+// StartPluginAlert 启动插件错误率告警任务
+func StartPluginAlert() {
+	service.StartPluginAlertTask()
+}
@@ -0,0 +1,10 @@
+package initializer
+
+import (
+	"github.com/nuanxinqing123/QLToolsV2/internal/service"
+)
+
+// StartRetention 启动数据保留清理任务
+func StartRetention() {
+	service.StartRetentionTask()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
 	"github.com/nuanxinqing123/QLToolsV2/internal/controller"
 	"github.com/nuanxinqing123/QLToolsV2/internal/middleware"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/plugin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	ginprometheus "github.com/zsais/go-gin-prometheus"
@@ -28,7 +29,7 @@ func Routers() *gin.Engine {
 	// Router.Use(adaptive.PlatoMiddlewareGinDefault(0.8))
 
 	// 初始化 Prometheus 中间件
-	p := ginprometheus.NewPrometheus("gin")
+	p := ginprometheus.NewPrometheus("gin", plugin.Metrics)
 	p.Use(Router)
 
 	// 存活检测
@@ -95,6 +96,11 @@ func Routers() *gin.Engine {
 		PluginGroup := authAPI.Group("/plugin")
 		PluginCon := controller.NewPluginController()
 		PluginCon.PluginRouter(PluginGroup)
+
+		// 数据保留
+		RetentionGroup := authAPI.Group("/retention")
+		RetentionCon := controller.NewRetentionController()
+		RetentionCon.RetentionRouter(RetentionGroup)
 	}
 
 	return Router
@@ -7,6 +7,27 @@ const (
 	JWTIssuer = "QLToolsV2"
 
 	// CreateMode 提交类型
-	CreateMode = 1 // 新建模式
-	UpdateMode = 2 // 更新模式
+	CreateMode    = 1 // 新建模式
+	UpdateMode    = 2 // 更新模式
+	ReplicateMode = 3 // 副本模式：同一变量写入多个面板
+
+	// UpdateScopeFirst 更新模式的更新范围
+	UpdateScopeFirst  = "first"  // 只更新第一个匹配的变量
+	UpdateScopeAll    = "all"    // 更新所有面板中的全部匹配变量
+	UpdateScopeDedupe = "dedupe" // 更新第一个匹配的变量并删除其余重复变量
+
+	// DedupByNone 新建模式的重复检测方式
+	DedupByNone  = "none"  // 不检测
+	DedupByValue = "value" // 完整变量值相同视为重复
+	DedupByKey   = "key"   // 更新正则提取的匹配键相同视为重复
+
+	// DedupReject 检测到重复时的处理方式
+	DedupReject = "reject" // 拒绝提交
+	DedupUpdate = "update" // 更新已有变量，不占用新的位置
+	DedupAllow  = "allow"  // 继续新建
+
+	// PluginFailClosed 插件执行失败处理策略
+	PluginFailClosed  = "fail_closed"  // 执行失败时拒绝提交
+	PluginFailOpen    = "fail_open"    // 执行失败时跳过该插件继续提交
+	PluginAutoDisable = "auto_disable" // 连续失败达到阈值后熔断，冷却期内跳过该插件
 )
@@ -27,6 +27,7 @@ func (ctrl *CDKController) CDKRouter(router *gin.RouterGroup) {
 	router.POST("/create/batch", ctrl.AddCDKBatch)      // 批量创建CDK
 	router.PUT("/update", ctrl.UpdateCDK)               // 更新CDK
 	router.DELETE("/:id", ctrl.DeleteCDK)               // 删除CDK
+	router.GET("/:id/ledger", ctrl.GetCDKLedger)        // 获取卡密额度流水
 	router.POST("/toggle-status", ctrl.ToggleCDKStatus) // 切换CDK状态
 }
 
@@ -216,3 +217,41 @@ func (ctrl *CDKController) ToggleCDKStatus(c *gin.Context) {
 
 	response.ResSuccess(c, resp)
 }
+
+// GetCDKLedger 获取卡密额度流水
+// @Summary 获取卡密额度流水
+// @Description 分页获取卡密的预扣、结算与退回流水，按时间倒序
+// @Tags CDK管理
+// @Accept json
+// @Produce json
+// @Param id path int true "CDK ID"
+// @Param page query int false "页码"
+// @Param page_size query int false "每页数量"
+// @Success 200 {object} response.Data{data=schema.GetCDKLedgerResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/cdk/{id}/ledger [get]
+// @Security ApiKeyAuth
+func (ctrl *CDKController) GetCDKLedger(c *gin.Context) {
+	// 解析路径参数
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "CDK ID格式错误")
+		return
+	}
+
+	var req schema.GetCDKLedgerRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.cdkService.GetCDKLedger(id, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
@@ -10,27 +10,37 @@ import (
 )
 
 type EnvController struct {
-	envService *service.EnvService
+	envService  *service.EnvService
+	openService *service.OpenService
 }
 
 // NewEnvController 创建EnvController实例
 func NewEnvController() *EnvController {
 	return &EnvController{
-		envService: service.NewEnvService(),
+		envService:  service.NewEnvService(),
+		openService: service.NewOpenService(),
 	}
 }
 
 // EnvRouter 变量相关路由注册
 func (ctrl *EnvController) EnvRouter(router *gin.RouterGroup) {
-	router.GET("/list", ctrl.GetEnvList)                // 获取变量列表
-	router.GET("/:id", ctrl.GetEnv)                     // 获取单个变量信息
-	router.POST("/create", ctrl.AddEnv)                 // 创建变量
-	router.PUT("/update", ctrl.UpdateEnv)               // 更新变量
-	router.DELETE("/:id", ctrl.DeleteEnv)               // 删除变量
-	router.POST("/toggle-status", ctrl.ToggleEnvStatus) // 切换变量状态
-	router.POST("/panels", ctrl.UpdateEnvPanels)        // 更新环境变量的面板绑定关系
-	router.GET("/panels/:env_id", ctrl.GetEnvPanels)    // 获取变量关联的面板
-	router.GET("/plugins/:env_id", ctrl.GetEnvPlugins)  // 获取变量关联的插件
+	router.GET("/list", ctrl.GetEnvList)                           // 获取变量列表
+	router.GET("/:id", ctrl.GetEnv)                                // 获取单个变量信息
+	router.POST("/create", ctrl.AddEnv)                            // 创建变量
+	router.PUT("/update", ctrl.UpdateEnv)                          // 更新变量
+	router.DELETE("/:id", ctrl.DeleteEnv)                          // 删除变量
+	router.POST("/toggle-status", ctrl.ToggleEnvStatus)            // 切换变量状态
+	router.POST("/panels", ctrl.UpdateEnvPanels)                   // 更新环境变量的面板绑定关系
+	router.GET("/panels/:env_id", ctrl.GetEnvPanels)               // 获取变量关联的面板
+	router.PUT("/panels/binding", ctrl.UpdateEnvPanelBinding)      // 更新变量在面板上的绑定配置
+	router.GET("/plugins/:env_id", ctrl.GetEnvPlugins)             // 获取变量关联的插件
+	router.POST("/dry-run", ctrl.DryRunSubmit)                     // 试运行提交流程
+	router.POST("/extract/test", ctrl.TestEnvExtract)              // 测试变量提取规则
+	router.GET("/cron-triggers/:env_id", ctrl.GetEnvCronTriggers)  // 获取变量的定时任务触发配置
+	router.POST("/cron-triggers/create", ctrl.AddEnvCronTrigger)   // 添加定时任务触发配置
+	router.PUT("/cron-triggers/update", ctrl.UpdateEnvCronTrigger) // 更新定时任务触发配置
+	router.DELETE("/cron-triggers/:id", ctrl.DeleteEnvCronTrigger) // 删除定时任务触发配置
+	router.GET("/cron-trigger-logs", ctrl.GetCronTriggerLogs)      // 获取定时任务触发日志
 }
 
 // AddEnv 添加环境变量
@@ -317,3 +327,33 @@ func (ctrl *EnvController) GetEnvPlugins(c *gin.Context) {
 
 	response.ResSuccess(c, resp)
 }
+
+// DryRunSubmit 试运行提交流程
+// @Summary 试运行提交流程
+// @Description 按真实提交的步骤执行卡密校验、正则提取、插件处理、位置计算与面板选择，返回每一步的追踪结果；不扣减卡密、不写入青龙面板、不记录插件执行日志
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param request body schema.DryRunSubmitRequest true "试运行请求参数"
+// @Success 200 {object} response.Data{data=schema.DryRunSubmitResponse} "试运行完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "试运行失败"
+// @Router /api/env/dry-run [post]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) DryRunSubmit(c *gin.Context) {
+	// 解析请求参数
+	var req schema.DryRunSubmitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层试运行提交流程
+	resp, err := ctrl.openService.DryRunSubmit(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetEnvCronTriggers 获取变量的定时任务触发配置
+// @Summary 获取变量的定时任务触发配置
+// @Description 获取变量提交成功后自动运行的青龙定时任务配置
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param env_id path int true "环境变量ID"
+// @Success 200 {object} response.Data{data=schema.GetEnvCronTriggersResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/env/cron-triggers/{env_id} [get]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) GetEnvCronTriggers(c *gin.Context) {
+	envID, err := strconv.ParseInt(c.Param("env_id"), 10, 64)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "环境变量ID格式错误")
+		return
+	}
+
+	resp, err := ctrl.envService.GetEnvCronTriggers(schema.GetEnvCronTriggersRequest{EnvID: envID})
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// AddEnvCronTrigger 添加变量的定时任务触发配置
+// @Summary 添加变量的定时任务触发配置
+// @Description 配置变量提交成功后在接收变量的面板上运行的定时任务，按定时任务ID或名称正则匹配
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param request body schema.AddEnvCronTriggerRequest true "添加触发配置请求参数"
+// @Success 200 {object} response.Data{data=schema.AddEnvCronTriggerResponse} "添加成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "添加失败"
+// @Router /api/env/cron-triggers/create [post]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) AddEnvCronTrigger(c *gin.Context) {
+	var req schema.AddEnvCronTriggerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.envService.AddEnvCronTrigger(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// UpdateEnvCronTrigger 更新变量的定时任务触发配置
+// @Summary 更新变量的定时任务触发配置
+// @Description 更新变量提交成功后自动运行的定时任务配置
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UpdateEnvCronTriggerRequest true "更新触发配置请求参数"
+// @Success 200 {object} response.Data{data=schema.UpdateEnvCronTriggerResponse} "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "更新失败"
+// @Router /api/env/cron-triggers/update [put]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) UpdateEnvCronTrigger(c *gin.Context) {
+	var req schema.UpdateEnvCronTriggerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.envService.UpdateEnvCronTrigger(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// DeleteEnvCronTrigger 删除变量的定时任务触发配置
+// @Summary 删除变量的定时任务触发配置
+// @Description 根据ID删除定时任务触发配置
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param id path int true "触发配置ID"
+// @Success 200 {object} response.Data{data=schema.DeleteEnvCronTriggerResponse} "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "删除失败"
+// @Router /api/env/cron-triggers/{id} [delete]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) DeleteEnvCronTrigger(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "触发配置ID格式错误")
+		return
+	}
+
+	resp, err := ctrl.envService.DeleteEnvCronTrigger(schema.DeleteEnvCronTriggerRequest{ID: id})
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetCronTriggerLogs 获取定时任务触发日志
+// @Summary 获取定时任务触发日志
+// @Description 分页获取变量提交后自动运行定时任务的结果
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(10)
+// @Param env_id query int false "环境变量ID"
+// @Param panel_id query int false "面板ID"
+// @Param status query string false "触发状态(success,error,skipped)"
+// @Success 200 {object} response.Data{data=schema.GetCronTriggerLogsResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/env/cron-trigger-logs [get]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) GetCronTriggerLogs(c *gin.Context) {
+	var req schema.GetCronTriggerLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.envService.GetCronTriggerLogs(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
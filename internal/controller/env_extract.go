@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// TestEnvExtract 测试变量提取规则
+// @Summary 测试变量提取规则
+// @Description 使用匹配正则、变量值模板、更新正则与匹配键模板处理示例值，返回提取的字段、最终变量值与匹配键
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param request body schema.TestEnvExtractRequest true "测试提取规则请求参数"
+// @Success 200 {object} response.Data{data=schema.TestEnvExtractResponse} "测试完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "提取规则无效"
+// @Router /api/env/extract/test [post]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) TestEnvExtract(c *gin.Context) {
+	var req schema.TestEnvExtractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.envService.TestEnvExtract(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
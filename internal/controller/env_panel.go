@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// UpdateEnvPanelBinding 更新环境变量面板绑定配置
+// @Summary 更新环境变量面板绑定配置
+// @Description 设置变量在某个已绑定面板上的最大数量、权重与启用状态，位置计算与面板选择会遵守这些限制
+// @Tags 环境变量管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UpdateEnvPanelBindingRequest true "更新绑定配置请求参数"
+// @Success 200 {object} response.Data{data=schema.UpdateEnvPanelBindingResponse} "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "更新失败"
+// @Router /api/env/panels/binding [put]
+// @Security ApiKeyAuth
+func (ctrl *EnvController) UpdateEnvPanelBinding(c *gin.Context) {
+	var req schema.UpdateEnvPanelBindingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.envService.UpdateEnvPanelBinding(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
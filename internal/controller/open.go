@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"errors"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -31,6 +33,7 @@ func (c *OpenController) OpenRouter(r *gin.RouterGroup) {
 	submitGroup := r.Group("")
 	submitGroup.Use(middleware.SubmitAPIRateLimit()) // 更严格的限速：每秒2个请求，桶容量5
 	submitGroup.POST("/submit", c.SubmitVariable)    // 提交变量
+	submitGroup.POST("/submit/batch", c.SubmitBatch) // 批量提交变量
 }
 
 // CheckCDK 检查卡密
@@ -119,9 +122,11 @@ func (c *OpenController) CalculateAvailableSlots(ctx *gin.Context) {
 // @Tags 公开接口
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "幂等键，按卡密（未使用卡密时按IP）隔离，窗口内使用相同幂等键重试时返回首次成功的结果，不会重复提交与扣减卡密"
 // @Param request body schema.SubmitVariableRequest true "提交变量请求参数"
 // @Success 200 {object} response.Data{data=schema.SubmitVariableResponse} "提交成功"
 // @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 409 {object} response.Data "相同幂等键的请求正在处理中"
 // @Failure 500 {object} response.Data "提交失败"
 // @Router /api/open/submit [post]
 func (c *OpenController) SubmitVariable(ctx *gin.Context) {
@@ -132,6 +137,26 @@ func (c *OpenController) SubmitVariable(ctx *gin.Context) {
 		return
 	}
 
+	req.ClientIP = ctx.ClientIP()
+
+	// 携带幂等键时，重试请求重放首次结果
+	if key := ctx.GetHeader("Idempotency-Key"); key != "" {
+		resp, replayed, err := c.service.SubmitVariableIdempotent(ctx.Request.Context(), key, req)
+		if err != nil {
+			if errors.Is(err, service.ErrIdempotencyInFlight) {
+				response.ResErrorWithStatus(ctx, http.StatusConflict, response.CodeRequestInFlight, err.Error())
+				return
+			}
+			response.ResErrorWithMsg(ctx, response.CodeGenericError, err.Error())
+			return
+		}
+		if replayed {
+			ctx.Header("Idempotent-Replayed", "true")
+		}
+		response.ResSuccess(ctx, resp)
+		return
+	}
+
 	// 调用服务层提交变量
 	resp, err := c.service.SubmitVariable(req)
 	if err != nil {
@@ -141,3 +166,37 @@ func (c *OpenController) SubmitVariable(ctx *gin.Context) {
 
 	response.ResSuccess(ctx, resp)
 }
+
+// SubmitBatch 批量提交变量
+// @Summary 批量提交变量
+// @Description 一次提交多个变量值，按变量配置的分隔符拆分后逐个执行完整的提交流程，卡密按成功的变量值扣减，位置已满或卡密次数不足时跳过剩余变量值，按实际提交的变量值数量计入限速，返回每个变量值的结果
+// @Tags 公开接口
+// @Accept json
+// @Produce json
+// @Param request body schema.SubmitBatchRequest true "批量提交变量请求参数"
+// @Success 200 {object} response.Data{data=schema.SubmitBatchResponse} "提交完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "提交失败"
+// @Router /api/open/submit/batch [post]
+func (c *OpenController) SubmitBatch(ctx *gin.Context) {
+	// 解析请求参数
+	var req schema.SubmitBatchRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(ctx, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	req.ClientIP = ctx.ClientIP()
+
+	// 调用服务层批量提交变量
+	resp, err := c.service.SubmitBatch(req)
+	if err != nil {
+		response.ResErrorWithMsg(ctx, response.CodeGenericError, err.Error())
+		return
+	}
+
+	// 按实际提交的变量值数量计入限速，第一个已由限速中间件扣除
+	middleware.ChargeSubmitTokens(req.ClientIP, int64(resp.Total-resp.Skipped)-1)
+
+	response.ResSuccess(ctx, resp)
+}
@@ -30,6 +30,42 @@ func (ctrl *PanelController) PanelRouter(router *gin.RouterGroup) {
 	router.POST("/toggle-status", ctrl.TogglePanelStatus)     // 切换面板状态
 	router.POST("/refresh-token", ctrl.RefreshPanelToken)     // 刷新面板Token
 	router.POST("/test-connection", ctrl.TestPanelConnection) // 测试面板连接
+
+	// 面板定时任务
+	router.GET("/:id/crons", ctrl.GetPanelCrons)                // 获取定时任务列表
+	router.POST("/:id/crons", ctrl.CreatePanelCron)             // 创建定时任务
+	router.PUT("/:id/crons", ctrl.UpdatePanelCron)              // 更新定时任务
+	router.DELETE("/:id/crons", ctrl.DeletePanelCrons)          // 删除定时任务
+	router.PUT("/:id/crons/enable", ctrl.EnablePanelCrons)      // 启用定时任务
+	router.PUT("/:id/crons/disable", ctrl.DisablePanelCrons)    // 禁用定时任务
+	router.PUT("/:id/crons/run", ctrl.RunPanelCrons)            // 运行定时任务
+	router.PUT("/:id/crons/stop", ctrl.StopPanelCrons)          // 停止定时任务
+	router.GET("/:id/crons/:cron_id/log", ctrl.GetPanelCronLog) // 获取定时任务日志
+
+	// 面板脚本与配置文件
+	router.GET("/:id/scripts", ctrl.GetPanelScripts)             // 获取脚本列表
+	router.GET("/:id/scripts/detail", ctrl.GetPanelScriptDetail) // 获取脚本内容
+	router.PUT("/:id/scripts", ctrl.SavePanelScript)             // 保存脚本
+	router.DELETE("/:id/scripts", ctrl.DeletePanelScript)        // 删除脚本
+	router.GET("/:id/configs", ctrl.GetPanelConfigs)             // 获取配置文件列表
+	router.GET("/:id/configs/detail", ctrl.GetPanelConfigDetail) // 获取配置文件内容
+	router.PUT("/:id/configs", ctrl.SavePanelConfig)             // 保存配置文件
+	router.POST("/push-script", ctrl.PushScript)                 // 批量推送脚本
+	router.POST("/push-config", ctrl.PushConfig)                 // 批量推送配置
+
+	// 面板依赖
+	router.GET("/:id/dependencies", ctrl.GetPanelDependencies)                     // 获取依赖列表
+	router.POST("/:id/dependencies", ctrl.InstallPanelDependencies)                // 安装依赖
+	router.PUT("/:id/dependencies/reinstall", ctrl.ReinstallPanelDependencies)     // 重新安装依赖
+	router.DELETE("/:id/dependencies", ctrl.DeletePanelDependencies)               // 删除依赖
+	router.GET("/:id/dependencies/:dependency_id/log", ctrl.GetPanelDependencyLog) // 获取依赖安装日志
+	router.GET("/dependency-fleet", ctrl.GetDependencyFleet)                       // 获取依赖在各面板的安装情况
+	router.POST("/dependency-fleet/install", ctrl.InstallMissingDependency)        // 在缺少依赖的面板上批量安装
+
+	// 面板健康检查
+	router.GET("/health", ctrl.GetPanelHealthOverview)      // 获取面板健康概览
+	router.GET("/:id/health", ctrl.GetPanelHealthHistory)   // 获取面板健康检查记录
+	router.POST("/:id/health/check", ctrl.CheckPanelHealth) // 立即检查面板健康状态
 }
 
 // AddPanel 添加面板
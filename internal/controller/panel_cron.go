@@ -0,0 +1,264 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// parsePanelID 解析路径中的面板ID，失败时直接返回错误响应
+func parsePanelID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "面板ID格式错误")
+		return 0, false
+	}
+	return id, true
+}
+
+// GetPanelCrons 获取面板定时任务列表
+// @Summary 获取面板定时任务列表
+// @Description 获取青龙面板中的定时任务，支持按名称或命令搜索
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param search_value query string false "搜索关键字"
+// @Param page query int false "页码（为空时返回全部）"
+// @Param page_size query int false "每页数量"
+// @Success 200 {object} response.Data{data=schema.GetPanelCronsResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/crons [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelCrons(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.GetPanelCronsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelCrons(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// CreatePanelCron 创建面板定时任务
+// @Summary 创建面板定时任务
+// @Description 在青龙面板中创建定时任务
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.CreatePanelCronRequest true "创建定时任务请求参数"
+// @Success 200 {object} response.Data{data=schema.PanelCronResponse} "创建成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "创建失败"
+// @Router /api/panel/{id}/crons [post]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) CreatePanelCron(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.CreatePanelCronRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.CreatePanelCron(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// UpdatePanelCron 更新面板定时任务
+// @Summary 更新面板定时任务
+// @Description 更新青龙面板中的定时任务
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.UpdatePanelCronRequest true "更新定时任务请求参数"
+// @Success 200 {object} response.Data{data=schema.PanelCronResponse} "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "更新失败"
+// @Router /api/panel/{id}/crons [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) UpdatePanelCron(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.UpdatePanelCronRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.UpdatePanelCron(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// DeletePanelCrons 删除面板定时任务
+// @Summary 删除面板定时任务
+// @Description 批量删除青龙面板中的定时任务
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelCronIDsRequest true "定时任务ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelCronActionResponse} "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "删除失败"
+// @Router /api/panel/{id}/crons [delete]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) DeletePanelCrons(c *gin.Context) {
+	ctrl.panelCronAction(c, ctrl.panelService.DeletePanelCrons)
+}
+
+// EnablePanelCrons 启用面板定时任务
+// @Summary 启用面板定时任务
+// @Description 批量启用青龙面板中的定时任务
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelCronIDsRequest true "定时任务ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelCronActionResponse} "启用成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "启用失败"
+// @Router /api/panel/{id}/crons/enable [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) EnablePanelCrons(c *gin.Context) {
+	ctrl.panelCronAction(c, ctrl.panelService.EnablePanelCrons)
+}
+
+// DisablePanelCrons 禁用面板定时任务
+// @Summary 禁用面板定时任务
+// @Description 批量禁用青龙面板中的定时任务
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelCronIDsRequest true "定时任务ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelCronActionResponse} "禁用成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "禁用失败"
+// @Router /api/panel/{id}/crons/disable [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) DisablePanelCrons(c *gin.Context) {
+	ctrl.panelCronAction(c, ctrl.panelService.DisablePanelCrons)
+}
+
+// RunPanelCrons 运行面板定时任务
+// @Summary 运行面板定时任务
+// @Description 立即运行青龙面板中的定时任务，例如在提交变量后触发依赖该变量的脚本
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelCronIDsRequest true "定时任务ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelCronActionResponse} "运行成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "运行失败"
+// @Router /api/panel/{id}/crons/run [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) RunPanelCrons(c *gin.Context) {
+	ctrl.panelCronAction(c, ctrl.panelService.RunPanelCrons)
+}
+
+// StopPanelCrons 停止面板定时任务
+// @Summary 停止面板定时任务
+// @Description 停止青龙面板中运行中的定时任务
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelCronIDsRequest true "定时任务ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelCronActionResponse} "停止成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "停止失败"
+// @Router /api/panel/{id}/crons/stop [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) StopPanelCrons(c *gin.Context) {
+	ctrl.panelCronAction(c, ctrl.panelService.StopPanelCrons)
+}
+
+// GetPanelCronLog 获取面板定时任务日志
+// @Summary 获取面板定时任务日志
+// @Description 获取青龙面板中定时任务最近一次运行的日志
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param cron_id path int true "定时任务ID"
+// @Success 200 {object} response.Data{data=schema.GetPanelCronLogResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/crons/{cron_id}/log [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelCronLog(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	cronID, err := strconv.Atoi(c.Param("cron_id"))
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "定时任务ID格式错误")
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelCronLog(panelID, cronID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// panelCronAction 解析参数并执行批量定时任务操作
+func (ctrl *PanelController) panelCronAction(c *gin.Context, action func(int64, schema.PanelCronIDsRequest) (*schema.PanelCronActionResponse, error)) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.PanelCronIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := action(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
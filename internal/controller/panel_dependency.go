@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetPanelDependencies 获取面板依赖列表
+// @Summary 获取面板依赖列表
+// @Description 获取青龙面板中指定类型的依赖
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param type query int true "依赖类型：0 nodejs，1 python3，2 linux"
+// @Param search_value query string false "搜索关键字"
+// @Success 200 {object} response.Data{data=schema.GetPanelDependenciesResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/dependencies [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelDependencies(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.GetPanelDependenciesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelDependencies(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// InstallPanelDependencies 安装面板依赖
+// @Summary 安装面板依赖
+// @Description 在青龙面板中安装依赖
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.InstallPanelDependenciesRequest true "安装依赖请求参数"
+// @Success 200 {object} response.Data{data=schema.InstallPanelDependenciesResponse} "提交成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "安装失败"
+// @Router /api/panel/{id}/dependencies [post]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) InstallPanelDependencies(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.InstallPanelDependenciesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.InstallPanelDependencies(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// ReinstallPanelDependencies 重新安装面板依赖
+// @Summary 重新安装面板依赖
+// @Description 批量重新安装青龙面板中的依赖
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelDependencyIDsRequest true "依赖ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelDependencyActionResponse} "提交成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "重新安装失败"
+// @Router /api/panel/{id}/dependencies/reinstall [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) ReinstallPanelDependencies(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.PanelDependencyIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.ReinstallPanelDependencies(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// DeletePanelDependencies 删除面板依赖
+// @Summary 删除面板依赖
+// @Description 批量卸载青龙面板中的依赖
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.PanelDependencyIDsRequest true "依赖ID列表"
+// @Success 200 {object} response.Data{data=schema.PanelDependencyActionResponse} "提交成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "删除失败"
+// @Router /api/panel/{id}/dependencies [delete]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) DeletePanelDependencies(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.PanelDependencyIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.DeletePanelDependencies(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetPanelDependencyLog 获取面板依赖安装日志
+// @Summary 获取面板依赖安装日志
+// @Description 获取青龙面板中依赖的状态与安装日志
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param dependency_id path int true "依赖ID"
+// @Success 200 {object} response.Data{data=schema.GetPanelDependencyLogResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/dependencies/{dependency_id}/log [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelDependencyLog(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	dependencyID, err := strconv.Atoi(c.Param("dependency_id"))
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "依赖ID格式错误")
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelDependencyLog(panelID, dependencyID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetDependencyFleet 获取依赖在各面板的安装情况
+// @Summary 获取依赖在各面板的安装情况
+// @Description 查询指定依赖在所有启用面板上是否已安装（比较时忽略版本号）
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param name query string true "依赖名称"
+// @Param type query int true "依赖类型：0 nodejs，1 python3，2 linux"
+// @Success 200 {object} response.Data{data=schema.GetDependencyFleetResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/dependency-fleet [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetDependencyFleet(c *gin.Context) {
+	var req schema.GetDependencyFleetRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.GetDependencyFleet(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// InstallMissingDependency 在缺少依赖的面板上批量安装
+// @Summary 批量安装缺少的依赖
+// @Description 在未安装指定依赖的可达面板上批量提交安装任务，返回各面板结果
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param request body schema.InstallMissingDependencyRequest true "批量安装请求参数"
+// @Success 200 {object} response.Data{data=schema.PushFileResponse} "提交完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "安装失败"
+// @Router /api/panel/dependency-fleet/install [post]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) InstallMissingDependency(c *gin.Context) {
+	var req schema.InstallMissingDependencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.InstallMissingDependency(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
@@ -0,0 +1,288 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetPanelScripts 获取面板脚本列表
+// @Summary 获取面板脚本列表
+// @Description 获取青龙面板中的脚本文件树
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Success 200 {object} response.Data{data=schema.GetPanelScriptsResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/scripts [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelScripts(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelScripts(panelID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetPanelScriptDetail 获取面板脚本内容
+// @Summary 获取面板脚本内容
+// @Description 读取青龙面板中指定脚本的内容
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param filename query string true "文件名"
+// @Param path query string false "所在目录"
+// @Success 200 {object} response.Data{data=schema.PanelScriptDetailResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/scripts/detail [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelScriptDetail(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.GetPanelScriptDetailRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelScriptDetail(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// SavePanelScript 保存面板脚本
+// @Summary 保存面板脚本
+// @Description 保存青龙面板中的脚本内容，文件不存在时创建
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.SavePanelScriptRequest true "保存脚本请求参数"
+// @Success 200 {object} response.Data{data=schema.PanelFileActionResponse} "保存成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "保存失败"
+// @Router /api/panel/{id}/scripts [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) SavePanelScript(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.SavePanelScriptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.SavePanelScript(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// DeletePanelScript 删除面板脚本
+// @Summary 删除面板脚本
+// @Description 删除青龙面板中的脚本或目录
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.DeletePanelScriptRequest true "删除脚本请求参数"
+// @Success 200 {object} response.Data{data=schema.PanelFileActionResponse} "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "删除失败"
+// @Router /api/panel/{id}/scripts [delete]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) DeletePanelScript(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.DeletePanelScriptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.DeletePanelScript(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetPanelConfigs 获取面板配置文件列表
+// @Summary 获取面板配置文件列表
+// @Description 获取青龙面板中的配置文件列表
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Success 200 {object} response.Data{data=schema.GetPanelConfigsResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/configs [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelConfigs(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelConfigs(panelID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetPanelConfigDetail 获取面板配置文件内容
+// @Summary 获取面板配置文件内容
+// @Description 读取青龙面板中指定配置文件的内容，如 config.sh
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param name query string true "配置文件名"
+// @Success 200 {object} response.Data{data=schema.PanelConfigDetailResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/configs/detail [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelConfigDetail(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.GetPanelConfigDetailRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelConfigDetail(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// SavePanelConfig 保存面板配置文件
+// @Summary 保存面板配置文件
+// @Description 覆盖保存青龙面板中的配置文件
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param request body schema.SavePanelConfigRequest true "保存配置文件请求参数"
+// @Success 200 {object} response.Data{data=schema.PanelFileActionResponse} "保存成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "保存失败"
+// @Router /api/panel/{id}/configs [put]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) SavePanelConfig(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.SavePanelConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.SavePanelConfig(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// PushScript 批量推送脚本
+// @Summary 批量推送脚本
+// @Description 将同一脚本保存到多个面板，返回各面板结果
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param request body schema.PushScriptRequest true "推送脚本请求参数"
+// @Success 200 {object} response.Data{data=schema.PushFileResponse} "推送完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "推送失败"
+// @Router /api/panel/push-script [post]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) PushScript(c *gin.Context) {
+	var req schema.PushScriptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.PushScript(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// PushConfig 批量推送配置
+// @Summary 批量推送配置
+// @Description 将配置片段追加（或覆盖）到多个面板的配置文件，返回各面板结果
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param request body schema.PushConfigRequest true "推送配置请求参数"
+// @Success 200 {object} response.Data{data=schema.PushFileResponse} "推送完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "推送失败"
+// @Router /api/panel/push-config [post]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) PushConfig(c *gin.Context) {
+	var req schema.PushConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.PushConfig(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetPanelHealthOverview 获取面板健康概览
+// @Summary 获取面板健康概览
+// @Description 获取所有面板当前的健康状态、连续失败次数与最近检查时间
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.GetPanelHealthOverviewResponse} "获取成功"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/health [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelHealthOverview(c *gin.Context) {
+	resp, err := ctrl.panelService.GetPanelHealthOverview()
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetPanelHealthHistory 获取面板健康检查记录
+// @Summary 获取面板健康检查记录
+// @Description 分页获取面板的健康检查历史，按时间倒序
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Param page query int false "页码"
+// @Param page_size query int false "每页数量"
+// @Success 200 {object} response.Data{data=schema.GetPanelHealthHistoryResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/panel/{id}/health [get]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) GetPanelHealthHistory(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	var req schema.GetPanelHealthHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	resp, err := ctrl.panelService.GetPanelHealthHistory(panelID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// CheckPanelHealth 立即检查面板健康状态
+// @Summary 立即检查面板健康状态
+// @Description 立即探测面板的Token有效性、响应耗时与变量数量，并记录检查结果
+// @Tags 面板管理
+// @Accept json
+// @Produce json
+// @Param id path int true "面板ID"
+// @Success 200 {object} response.Data{data=schema.CheckPanelHealthResponse} "检查完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "检查失败"
+// @Router /api/panel/{id}/health/check [post]
+// @Security ApiKeyAuth
+func (ctrl *PanelController) CheckPanelHealth(c *gin.Context) {
+	panelID, ok := parsePanelID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := ctrl.panelService.CheckPanelHealth(panelID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
@@ -22,17 +22,24 @@ func NewPluginController() *PluginController {
 
 // PluginRouter 插件相关路由注册
 func (ctrl *PluginController) PluginRouter(router *gin.RouterGroup) {
-	router.GET("/list", ctrl.GetPluginList)                    // 获取插件列表
-	router.GET("/:id", ctrl.GetPlugin)                         // 获取单个插件信息
-	router.POST("/create", ctrl.CreatePlugin)                  // 创建插件
-	router.PUT("/update", ctrl.UpdatePlugin)                   // 更新插件
-	router.DELETE("/:id", ctrl.DeletePlugin)                   // 删除插件
-	router.POST("/toggle-status", ctrl.TogglePluginStatus)     // 切换插件状态
-	router.POST("/test", ctrl.TestPlugin)                      // 测试插件
-	router.POST("/bind-env", ctrl.BindPluginToEnv)             // 绑定插件到环境变量
-	router.POST("/unbind-env", ctrl.UnbindPluginFromEnv)       // 解绑插件与环境变量
-	router.GET("/envs/:plugin_id", ctrl.GetPluginEnvs)         // 获取插件关联环境变量
-	router.GET("/execution-logs", ctrl.GetPluginExecutionLogs) // 获取插件执行日志
+	router.GET("/list", ctrl.GetPluginList)                       // 获取插件列表
+	router.GET("/:id", ctrl.GetPlugin)                            // 获取单个插件信息
+	router.POST("/create", ctrl.CreatePlugin)                     // 创建插件
+	router.PUT("/update", ctrl.UpdatePlugin)                      // 更新插件
+	router.DELETE("/:id", ctrl.DeletePlugin)                      // 删除插件
+	router.POST("/toggle-status", ctrl.TogglePluginStatus)        // 切换插件状态
+	router.POST("/test", ctrl.TestPlugin)                         // 测试插件
+	router.POST("/bind-env", ctrl.BindPluginToEnv)                // 绑定插件到环境变量
+	router.POST("/unbind-env", ctrl.UnbindPluginFromEnv)          // 解绑插件与环境变量
+	router.GET("/envs/:plugin_id", ctrl.GetPluginEnvs)            // 获取插件关联环境变量
+	router.GET("/execution-logs", ctrl.GetPluginExecutionLogs)    // 获取插件执行日志
+	router.GET("/test-cases/:plugin_id", ctrl.GetPluginTestCases) // 获取插件测试用例
+	router.POST("/test-cases/create", ctrl.CreatePluginTestCase)  // 创建插件测试用例
+	router.PUT("/test-cases/update", ctrl.UpdatePluginTestCase)   // 更新插件测试用例
+	router.DELETE("/test-cases/:id", ctrl.DeletePluginTestCase)   // 删除插件测试用例
+	router.POST("/run-tests", ctrl.RunPluginTests)                // 运行插件测试用例
+	router.GET("/stats", ctrl.GetPluginStats)                     // 获取插件执行统计
+	router.POST("/reset-breaker", ctrl.ResetPluginBreaker)        // 重置插件熔断状态
 }
 
 // CreatePlugin 创建插件
@@ -381,3 +388,219 @@ func (ctrl *PluginController) GetPluginExecutionLogs(c *gin.Context) {
 
 	response.ResSuccess(c, resp)
 }
+
+// GetPluginTestCases 获取插件测试用例
+// @Summary 获取插件测试用例
+// @Description 获取指定插件保存的全部测试用例
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param plugin_id path int true "插件ID"
+// @Success 200 {object} response.Data{data=schema.GetPluginTestCasesResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/plugin/test-cases/{plugin_id} [get]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) GetPluginTestCases(c *gin.Context) {
+	// 解析路径参数
+	pluginIDStr := c.Param("plugin_id")
+	pluginID, err := strconv.ParseInt(pluginIDStr, 10, 64)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "插件ID格式错误")
+		return
+	}
+
+	// 调用服务层获取测试用例
+	resp, err := ctrl.pluginService.GetPluginTestCases(pluginID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// CreatePluginTestCase 创建插件测试用例
+// @Summary 创建插件测试用例
+// @Description 为插件保存一个测试用例，包含输入值、配置以及期望的bool/env输出或期望错误
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param request body schema.CreatePluginTestCaseRequest true "创建测试用例请求参数"
+// @Success 200 {object} response.Data{data=schema.CreatePluginTestCaseResponse} "创建成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "创建失败"
+// @Router /api/plugin/test-cases/create [post]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) CreatePluginTestCase(c *gin.Context) {
+	// 解析请求参数
+	var req schema.CreatePluginTestCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层创建测试用例
+	resp, err := ctrl.pluginService.CreatePluginTestCase(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// UpdatePluginTestCase 更新插件测试用例
+// @Summary 更新插件测试用例
+// @Description 更新测试用例的输入值、配置和期望结果
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UpdatePluginTestCaseRequest true "更新测试用例请求参数"
+// @Success 200 {object} response.Data{data=schema.UpdatePluginTestCaseResponse} "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "更新失败"
+// @Router /api/plugin/test-cases/update [put]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) UpdatePluginTestCase(c *gin.Context) {
+	// 解析请求参数
+	var req schema.UpdatePluginTestCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层更新测试用例
+	resp, err := ctrl.pluginService.UpdatePluginTestCase(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// DeletePluginTestCase 删除插件测试用例
+// @Summary 删除插件测试用例
+// @Description 根据用例ID删除测试用例
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param id path int true "用例ID"
+// @Success 200 {object} response.Data{data=schema.DeletePluginTestCaseResponse} "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "删除失败"
+// @Router /api/plugin/test-cases/{id} [delete]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) DeletePluginTestCase(c *gin.Context) {
+	// 解析路径参数
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "用例ID格式错误")
+		return
+	}
+
+	// 调用服务层删除测试用例
+	resp, err := ctrl.pluginService.DeletePluginTestCase(id)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// RunPluginTests 运行插件测试用例
+// @Summary 运行插件测试用例
+// @Description 使用已保存或传入的脚本运行插件的全部启用用例，返回每个用例的通过情况及差异
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param request body schema.RunPluginTestsRequest true "运行测试用例请求参数"
+// @Success 200 {object} response.Data{data=schema.RunPluginTestsResponse} "运行完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "运行失败"
+// @Router /api/plugin/run-tests [post]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) RunPluginTests(c *gin.Context) {
+	// 解析请求参数
+	var req schema.RunPluginTestsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层运行测试用例
+	resp, err := ctrl.pluginService.RunPluginTests(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// GetPluginStats 获取插件执行统计
+// @Summary 获取插件执行统计
+// @Description 基于执行日志统计插件的成功率、平均耗时与P95耗时，按插件、插件+环境变量与时间分桶汇总
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param plugin_id query int false "插件ID"
+// @Param env_id query int false "环境变量ID"
+// @Param bucket query string false "分桶粒度(hour/day)" default(hour)
+// @Param start_time query string false "开始时间，默认24小时前"
+// @Param end_time query string false "结束时间，默认当前时间"
+// @Success 200 {object} response.Data{data=schema.GetPluginStatsResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/plugin/stats [get]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) GetPluginStats(c *gin.Context) {
+	// 解析查询参数
+	var req schema.GetPluginStatsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层获取执行统计
+	resp, err := ctrl.pluginService.GetPluginStats(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// ResetPluginBreaker 重置插件熔断状态
+// @Summary 重置插件熔断状态
+// @Description 清除插件与环境变量绑定的连续失败计数与熔断状态，立即恢复执行
+// @Tags 插件管理
+// @Accept json
+// @Produce json
+// @Param request body schema.ResetPluginBreakerRequest true "重置熔断状态请求参数"
+// @Success 200 {object} response.Data{data=schema.ResetPluginBreakerResponse} "重置成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "重置失败"
+// @Router /api/plugin/reset-breaker [post]
+// @Security ApiKeyAuth
+func (ctrl *PluginController) ResetPluginBreaker(c *gin.Context) {
+	// 解析请求参数
+	var req schema.ResetPluginBreakerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层重置熔断状态
+	resp, err := ctrl.pluginService.ResetPluginBreaker(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/response"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+	"github.com/nuanxinqing123/QLToolsV2/internal/service"
+)
+
+type RetentionController struct {
+	retentionService *service.RetentionService
+}
+
+// NewRetentionController 创建RetentionController实例
+func NewRetentionController() *RetentionController {
+	return &RetentionController{
+		retentionService: service.NewRetentionService(),
+	}
+}
+
+// RetentionRouter 数据保留相关路由注册
+func (ctrl *RetentionController) RetentionRouter(router *gin.RouterGroup) {
+	router.GET("/policies", ctrl.GetRetentionPolicies) // 获取数据保留策略
+	router.POST("/purge", ctrl.Purge)                  // 手动清理数据
+}
+
+// GetRetentionPolicies 获取数据保留策略
+// @Summary 获取数据保留策略
+// @Description 获取各数据表当前生效的保留策略（配置优先，未配置时使用默认值）
+// @Tags 数据保留
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.GetRetentionPoliciesResponse} "获取成功"
+// @Failure 500 {object} response.Data "获取失败"
+// @Router /api/retention/policies [get]
+// @Security ApiKeyAuth
+func (ctrl *RetentionController) GetRetentionPolicies(c *gin.Context) {
+	resp, err := ctrl.retentionService.GetRetentionPolicies()
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
+
+// Purge 手动清理数据
+// @Summary 手动清理数据
+// @Description 立即按保留策略清理指定数据表（为空时清理全部），可临时覆盖最长保留天数
+// @Tags 数据保留
+// @Accept json
+// @Produce json
+// @Param request body schema.PurgeRequest true "清理请求参数"
+// @Success 200 {object} response.Data{data=schema.PurgeResponse} "清理完成"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "清理失败"
+// @Router /api/retention/purge [post]
+// @Security ApiKeyAuth
+func (ctrl *RetentionController) Purge(c *gin.Context) {
+	// 解析请求参数
+	var req schema.PurgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误: "+err.Error())
+		return
+	}
+
+	// 调用服务层清理数据
+	resp, err := ctrl.retentionService.Purge(req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, resp)
+}
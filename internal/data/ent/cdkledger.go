@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+)
+
+// CdkLedger is the model entity for the CdkLedger schema.
+type CdkLedger struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID int64 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 卡密ID
+	CdkID int64 `json:"cdk_id,omitempty"`
+	// 环境变量ID
+	EnvID int64 `json:"env_id,omitempty"`
+	// 流水类型(reserve,commit,release)
+	Type string `json:"type,omitempty"`
+	// 额度变动
+	Delta int32 `json:"delta,omitempty"`
+	// 变动后余额
+	Balance int32 `json:"balance,omitempty"`
+	// 关联的预扣流水ID
+	ReservationID *int64 `json:"reservation_id,omitempty"`
+	// 预扣是否已结算(仅预扣流水有效)
+	Settled bool `json:"settled,omitempty"`
+	// 说明
+	Message      *string `json:"message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CdkLedger) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cdkledger.FieldSettled:
+			values[i] = new(sql.NullBool)
+		case cdkledger.FieldID, cdkledger.FieldCdkID, cdkledger.FieldEnvID, cdkledger.FieldDelta, cdkledger.FieldBalance, cdkledger.FieldReservationID:
+			values[i] = new(sql.NullInt64)
+		case cdkledger.FieldType, cdkledger.FieldMessage:
+			values[i] = new(sql.NullString)
+		case cdkledger.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CdkLedger fields.
+func (_m *CdkLedger) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cdkledger.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case cdkledger.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cdkledger.FieldCdkID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cdk_id", values[i])
+			} else if value.Valid {
+				_m.CdkID = value.Int64
+			}
+		case cdkledger.FieldEnvID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				_m.EnvID = value.Int64
+			}
+		case cdkledger.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case cdkledger.FieldDelta:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delta", values[i])
+			} else if value.Valid {
+				_m.Delta = int32(value.Int64)
+			}
+		case cdkledger.FieldBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				_m.Balance = int32(value.Int64)
+			}
+		case cdkledger.FieldReservationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reservation_id", values[i])
+			} else if value.Valid {
+				_m.ReservationID = new(int64)
+				*_m.ReservationID = value.Int64
+			}
+		case cdkledger.FieldSettled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field settled", values[i])
+			} else if value.Valid {
+				_m.Settled = value.Bool
+			}
+		case cdkledger.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = new(string)
+				*_m.Message = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CdkLedger.
+// This includes values selected through modifiers, order, etc.
+func (_m *CdkLedger) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CdkLedger.
+// Note that you need to call CdkLedger.Unwrap() before calling this method if this CdkLedger
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CdkLedger) Update() *CdkLedgerUpdateOne {
+	return NewCdkLedgerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CdkLedger entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CdkLedger) Unwrap() *CdkLedger {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CdkLedger is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CdkLedger) String() string {
+	var builder strings.Builder
+	builder.WriteString("CdkLedger(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cdk_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CdkID))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnvID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("delta=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Delta))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	if v := _m.ReservationID; v != nil {
+		builder.WriteString("reservation_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("settled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Settled))
+	builder.WriteString(", ")
+	if v := _m.Message; v != nil {
+		builder.WriteString("message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CdkLedgers is a parsable slice of CdkLedger.
+type CdkLedgers []*CdkLedger
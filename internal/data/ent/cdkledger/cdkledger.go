@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cdkledger
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the cdkledger type in the database.
+	Label = "cdk_ledger"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCdkID holds the string denoting the cdk_id field in the database.
+	FieldCdkID = "cdk_id"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldDelta holds the string denoting the delta field in the database.
+	FieldDelta = "delta"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldReservationID holds the string denoting the reservation_id field in the database.
+	FieldReservationID = "reservation_id"
+	// FieldSettled holds the string denoting the settled field in the database.
+	FieldSettled = "settled"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// Table holds the table name of the cdkledger in the database.
+	Table = "cdk_ledgers"
+)
+
+// Columns holds all SQL columns for cdkledger fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldCdkID,
+	FieldEnvID,
+	FieldType,
+	FieldDelta,
+	FieldBalance,
+	FieldReservationID,
+	FieldSettled,
+	FieldMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultSettled holds the default value on creation for the "settled" field.
+	DefaultSettled bool
+)
+
+// OrderOption defines the ordering options for the CdkLedger queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCdkID orders the results by the cdk_id field.
+func ByCdkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCdkID, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByDelta orders the results by the delta field.
+func ByDelta(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelta, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByReservationID orders the results by the reservation_id field.
+func ByReservationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReservationID, opts...).ToFunc()
+}
+
+// BySettled orders the results by the settled field.
+func BySettled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettled, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cdkledger
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CdkID applies equality check predicate on the "cdk_id" field. It's identical to CdkIDEQ.
+func CdkID(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldCdkID, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldEnvID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldType, v))
+}
+
+// Delta applies equality check predicate on the "delta" field. It's identical to DeltaEQ.
+func Delta(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldDelta, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldBalance, v))
+}
+
+// ReservationID applies equality check predicate on the "reservation_id" field. It's identical to ReservationIDEQ.
+func ReservationID(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldReservationID, v))
+}
+
+// Settled applies equality check predicate on the "settled" field. It's identical to SettledEQ.
+func Settled(v bool) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldSettled, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CdkIDEQ applies the EQ predicate on the "cdk_id" field.
+func CdkIDEQ(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldCdkID, v))
+}
+
+// CdkIDNEQ applies the NEQ predicate on the "cdk_id" field.
+func CdkIDNEQ(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldCdkID, v))
+}
+
+// CdkIDIn applies the In predicate on the "cdk_id" field.
+func CdkIDIn(vs ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldCdkID, vs...))
+}
+
+// CdkIDNotIn applies the NotIn predicate on the "cdk_id" field.
+func CdkIDNotIn(vs ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldCdkID, vs...))
+}
+
+// CdkIDGT applies the GT predicate on the "cdk_id" field.
+func CdkIDGT(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldCdkID, v))
+}
+
+// CdkIDGTE applies the GTE predicate on the "cdk_id" field.
+func CdkIDGTE(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldCdkID, v))
+}
+
+// CdkIDLT applies the LT predicate on the "cdk_id" field.
+func CdkIDLT(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldCdkID, v))
+}
+
+// CdkIDLTE applies the LTE predicate on the "cdk_id" field.
+func CdkIDLTE(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldCdkID, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldEnvID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldContainsFold(FieldType, v))
+}
+
+// DeltaEQ applies the EQ predicate on the "delta" field.
+func DeltaEQ(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldDelta, v))
+}
+
+// DeltaNEQ applies the NEQ predicate on the "delta" field.
+func DeltaNEQ(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldDelta, v))
+}
+
+// DeltaIn applies the In predicate on the "delta" field.
+func DeltaIn(vs ...int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldDelta, vs...))
+}
+
+// DeltaNotIn applies the NotIn predicate on the "delta" field.
+func DeltaNotIn(vs ...int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldDelta, vs...))
+}
+
+// DeltaGT applies the GT predicate on the "delta" field.
+func DeltaGT(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldDelta, v))
+}
+
+// DeltaGTE applies the GTE predicate on the "delta" field.
+func DeltaGTE(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldDelta, v))
+}
+
+// DeltaLT applies the LT predicate on the "delta" field.
+func DeltaLT(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldDelta, v))
+}
+
+// DeltaLTE applies the LTE predicate on the "delta" field.
+func DeltaLTE(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldDelta, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v int32) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldBalance, v))
+}
+
+// ReservationIDEQ applies the EQ predicate on the "reservation_id" field.
+func ReservationIDEQ(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldReservationID, v))
+}
+
+// ReservationIDNEQ applies the NEQ predicate on the "reservation_id" field.
+func ReservationIDNEQ(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldReservationID, v))
+}
+
+// ReservationIDIn applies the In predicate on the "reservation_id" field.
+func ReservationIDIn(vs ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldReservationID, vs...))
+}
+
+// ReservationIDNotIn applies the NotIn predicate on the "reservation_id" field.
+func ReservationIDNotIn(vs ...int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldReservationID, vs...))
+}
+
+// ReservationIDGT applies the GT predicate on the "reservation_id" field.
+func ReservationIDGT(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldReservationID, v))
+}
+
+// ReservationIDGTE applies the GTE predicate on the "reservation_id" field.
+func ReservationIDGTE(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldReservationID, v))
+}
+
+// ReservationIDLT applies the LT predicate on the "reservation_id" field.
+func ReservationIDLT(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldReservationID, v))
+}
+
+// ReservationIDLTE applies the LTE predicate on the "reservation_id" field.
+func ReservationIDLTE(v int64) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldReservationID, v))
+}
+
+// ReservationIDIsNil applies the IsNil predicate on the "reservation_id" field.
+func ReservationIDIsNil() predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIsNull(FieldReservationID))
+}
+
+// ReservationIDNotNil applies the NotNil predicate on the "reservation_id" field.
+func ReservationIDNotNil() predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotNull(FieldReservationID))
+}
+
+// SettledEQ applies the EQ predicate on the "settled" field.
+func SettledEQ(v bool) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldSettled, v))
+}
+
+// SettledNEQ applies the NEQ predicate on the "settled" field.
+func SettledNEQ(v bool) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldSettled, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CdkLedger) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CdkLedger) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CdkLedger) predicate.CdkLedger {
+	return predicate.CdkLedger(sql.NotPredicates(p))
+}
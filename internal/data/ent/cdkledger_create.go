@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+)
+
+// CdkLedgerCreate is the builder for creating a CdkLedger entity.
+type CdkLedgerCreate struct {
+	config
+	mutation *CdkLedgerMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CdkLedgerCreate) SetCreatedAt(v time.Time) *CdkLedgerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CdkLedgerCreate) SetNillableCreatedAt(v *time.Time) *CdkLedgerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCdkID sets the "cdk_id" field.
+func (_c *CdkLedgerCreate) SetCdkID(v int64) *CdkLedgerCreate {
+	_c.mutation.SetCdkID(v)
+	return _c
+}
+
+// SetEnvID sets the "env_id" field.
+func (_c *CdkLedgerCreate) SetEnvID(v int64) *CdkLedgerCreate {
+	_c.mutation.SetEnvID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *CdkLedgerCreate) SetType(v string) *CdkLedgerCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetDelta sets the "delta" field.
+func (_c *CdkLedgerCreate) SetDelta(v int32) *CdkLedgerCreate {
+	_c.mutation.SetDelta(v)
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *CdkLedgerCreate) SetBalance(v int32) *CdkLedgerCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetReservationID sets the "reservation_id" field.
+func (_c *CdkLedgerCreate) SetReservationID(v int64) *CdkLedgerCreate {
+	_c.mutation.SetReservationID(v)
+	return _c
+}
+
+// SetNillableReservationID sets the "reservation_id" field if the given value is not nil.
+func (_c *CdkLedgerCreate) SetNillableReservationID(v *int64) *CdkLedgerCreate {
+	if v != nil {
+		_c.SetReservationID(*v)
+	}
+	return _c
+}
+
+// SetSettled sets the "settled" field.
+func (_c *CdkLedgerCreate) SetSettled(v bool) *CdkLedgerCreate {
+	_c.mutation.SetSettled(v)
+	return _c
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (_c *CdkLedgerCreate) SetNillableSettled(v *bool) *CdkLedgerCreate {
+	if v != nil {
+		_c.SetSettled(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *CdkLedgerCreate) SetMessage(v string) *CdkLedgerCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *CdkLedgerCreate) SetNillableMessage(v *string) *CdkLedgerCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CdkLedgerCreate) SetID(v int64) *CdkLedgerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CdkLedgerMutation object of the builder.
+func (_c *CdkLedgerCreate) Mutation() *CdkLedgerMutation {
+	return _c.mutation
+}
+
+// Save creates the CdkLedger in the database.
+func (_c *CdkLedgerCreate) Save(ctx context.Context) (*CdkLedger, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CdkLedgerCreate) SaveX(ctx context.Context) *CdkLedger {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CdkLedgerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CdkLedgerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CdkLedgerCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cdkledger.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Settled(); !ok {
+		v := cdkledger.DefaultSettled
+		_c.mutation.SetSettled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CdkLedgerCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CdkLedger.created_at"`)}
+	}
+	if _, ok := _c.mutation.CdkID(); !ok {
+		return &ValidationError{Name: "cdk_id", err: errors.New(`ent: missing required field "CdkLedger.cdk_id"`)}
+	}
+	if _, ok := _c.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`ent: missing required field "CdkLedger.env_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "CdkLedger.type"`)}
+	}
+	if _, ok := _c.mutation.Delta(); !ok {
+		return &ValidationError{Name: "delta", err: errors.New(`ent: missing required field "CdkLedger.delta"`)}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "CdkLedger.balance"`)}
+	}
+	if _, ok := _c.mutation.Settled(); !ok {
+		return &ValidationError{Name: "settled", err: errors.New(`ent: missing required field "CdkLedger.settled"`)}
+	}
+	return nil
+}
+
+func (_c *CdkLedgerCreate) sqlSave(ctx context.Context) (*CdkLedger, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CdkLedgerCreate) createSpec() (*CdkLedger, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CdkLedger{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cdkledger.Table, sqlgraph.NewFieldSpec(cdkledger.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cdkledger.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.CdkID(); ok {
+		_spec.SetField(cdkledger.FieldCdkID, field.TypeInt64, value)
+		_node.CdkID = value
+	}
+	if value, ok := _c.mutation.EnvID(); ok {
+		_spec.SetField(cdkledger.FieldEnvID, field.TypeInt64, value)
+		_node.EnvID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(cdkledger.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Delta(); ok {
+		_spec.SetField(cdkledger.FieldDelta, field.TypeInt32, value)
+		_node.Delta = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(cdkledger.FieldBalance, field.TypeInt32, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.ReservationID(); ok {
+		_spec.SetField(cdkledger.FieldReservationID, field.TypeInt64, value)
+		_node.ReservationID = &value
+	}
+	if value, ok := _c.mutation.Settled(); ok {
+		_spec.SetField(cdkledger.FieldSettled, field.TypeBool, value)
+		_node.Settled = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(cdkledger.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	return _node, _spec
+}
+
+// CdkLedgerCreateBulk is the builder for creating many CdkLedger entities in bulk.
+type CdkLedgerCreateBulk struct {
+	config
+	err      error
+	builders []*CdkLedgerCreate
+}
+
+// Save creates the CdkLedger entities in the database.
+func (_c *CdkLedgerCreateBulk) Save(ctx context.Context) ([]*CdkLedger, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CdkLedger, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CdkLedgerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CdkLedgerCreateBulk) SaveX(ctx context.Context) []*CdkLedger {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CdkLedgerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CdkLedgerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
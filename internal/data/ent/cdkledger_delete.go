@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// CdkLedgerDelete is the builder for deleting a CdkLedger entity.
+type CdkLedgerDelete struct {
+	config
+	hooks    []Hook
+	mutation *CdkLedgerMutation
+}
+
+// Where appends a list predicates to the CdkLedgerDelete builder.
+func (_d *CdkLedgerDelete) Where(ps ...predicate.CdkLedger) *CdkLedgerDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CdkLedgerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CdkLedgerDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CdkLedgerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cdkledger.Table, sqlgraph.NewFieldSpec(cdkledger.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CdkLedgerDeleteOne is the builder for deleting a single CdkLedger entity.
+type CdkLedgerDeleteOne struct {
+	_d *CdkLedgerDelete
+}
+
+// Where appends a list predicates to the CdkLedgerDelete builder.
+func (_d *CdkLedgerDeleteOne) Where(ps ...predicate.CdkLedger) *CdkLedgerDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CdkLedgerDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cdkledger.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CdkLedgerDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// CdkLedgerUpdate is the builder for updating CdkLedger entities.
+type CdkLedgerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CdkLedgerMutation
+}
+
+// Where appends a list predicates to the CdkLedgerUpdate builder.
+func (_u *CdkLedgerUpdate) Where(ps ...predicate.CdkLedger) *CdkLedgerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCdkID sets the "cdk_id" field.
+func (_u *CdkLedgerUpdate) SetCdkID(v int64) *CdkLedgerUpdate {
+	_u.mutation.ResetCdkID()
+	_u.mutation.SetCdkID(v)
+	return _u
+}
+
+// SetNillableCdkID sets the "cdk_id" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableCdkID(v *int64) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetCdkID(*v)
+	}
+	return _u
+}
+
+// AddCdkID adds value to the "cdk_id" field.
+func (_u *CdkLedgerUpdate) AddCdkID(v int64) *CdkLedgerUpdate {
+	_u.mutation.AddCdkID(v)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *CdkLedgerUpdate) SetEnvID(v int64) *CdkLedgerUpdate {
+	_u.mutation.ResetEnvID()
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableEnvID(v *int64) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// AddEnvID adds value to the "env_id" field.
+func (_u *CdkLedgerUpdate) AddEnvID(v int64) *CdkLedgerUpdate {
+	_u.mutation.AddEnvID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *CdkLedgerUpdate) SetType(v string) *CdkLedgerUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableType(v *string) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetDelta sets the "delta" field.
+func (_u *CdkLedgerUpdate) SetDelta(v int32) *CdkLedgerUpdate {
+	_u.mutation.ResetDelta()
+	_u.mutation.SetDelta(v)
+	return _u
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableDelta(v *int32) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetDelta(*v)
+	}
+	return _u
+}
+
+// AddDelta adds value to the "delta" field.
+func (_u *CdkLedgerUpdate) AddDelta(v int32) *CdkLedgerUpdate {
+	_u.mutation.AddDelta(v)
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *CdkLedgerUpdate) SetBalance(v int32) *CdkLedgerUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableBalance(v *int32) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *CdkLedgerUpdate) AddBalance(v int32) *CdkLedgerUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetReservationID sets the "reservation_id" field.
+func (_u *CdkLedgerUpdate) SetReservationID(v int64) *CdkLedgerUpdate {
+	_u.mutation.ResetReservationID()
+	_u.mutation.SetReservationID(v)
+	return _u
+}
+
+// SetNillableReservationID sets the "reservation_id" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableReservationID(v *int64) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetReservationID(*v)
+	}
+	return _u
+}
+
+// AddReservationID adds value to the "reservation_id" field.
+func (_u *CdkLedgerUpdate) AddReservationID(v int64) *CdkLedgerUpdate {
+	_u.mutation.AddReservationID(v)
+	return _u
+}
+
+// ClearReservationID clears the value of the "reservation_id" field.
+func (_u *CdkLedgerUpdate) ClearReservationID() *CdkLedgerUpdate {
+	_u.mutation.ClearReservationID()
+	return _u
+}
+
+// SetSettled sets the "settled" field.
+func (_u *CdkLedgerUpdate) SetSettled(v bool) *CdkLedgerUpdate {
+	_u.mutation.SetSettled(v)
+	return _u
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableSettled(v *bool) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetSettled(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *CdkLedgerUpdate) SetMessage(v string) *CdkLedgerUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *CdkLedgerUpdate) SetNillableMessage(v *string) *CdkLedgerUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *CdkLedgerUpdate) ClearMessage() *CdkLedgerUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Mutation returns the CdkLedgerMutation object of the builder.
+func (_u *CdkLedgerUpdate) Mutation() *CdkLedgerMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CdkLedgerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CdkLedgerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CdkLedgerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CdkLedgerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CdkLedgerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cdkledger.Table, cdkledger.Columns, sqlgraph.NewFieldSpec(cdkledger.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CdkID(); ok {
+		_spec.SetField(cdkledger.FieldCdkID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCdkID(); ok {
+		_spec.AddField(cdkledger.FieldCdkID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EnvID(); ok {
+		_spec.SetField(cdkledger.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEnvID(); ok {
+		_spec.AddField(cdkledger.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(cdkledger.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Delta(); ok {
+		_spec.SetField(cdkledger.FieldDelta, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedDelta(); ok {
+		_spec.AddField(cdkledger.FieldDelta, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(cdkledger.FieldBalance, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(cdkledger.FieldBalance, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ReservationID(); ok {
+		_spec.SetField(cdkledger.FieldReservationID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReservationID(); ok {
+		_spec.AddField(cdkledger.FieldReservationID, field.TypeInt64, value)
+	}
+	if _u.mutation.ReservationIDCleared() {
+		_spec.ClearField(cdkledger.FieldReservationID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Settled(); ok {
+		_spec.SetField(cdkledger.FieldSettled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(cdkledger.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(cdkledger.FieldMessage, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cdkledger.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CdkLedgerUpdateOne is the builder for updating a single CdkLedger entity.
+type CdkLedgerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CdkLedgerMutation
+}
+
+// SetCdkID sets the "cdk_id" field.
+func (_u *CdkLedgerUpdateOne) SetCdkID(v int64) *CdkLedgerUpdateOne {
+	_u.mutation.ResetCdkID()
+	_u.mutation.SetCdkID(v)
+	return _u
+}
+
+// SetNillableCdkID sets the "cdk_id" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableCdkID(v *int64) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetCdkID(*v)
+	}
+	return _u
+}
+
+// AddCdkID adds value to the "cdk_id" field.
+func (_u *CdkLedgerUpdateOne) AddCdkID(v int64) *CdkLedgerUpdateOne {
+	_u.mutation.AddCdkID(v)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *CdkLedgerUpdateOne) SetEnvID(v int64) *CdkLedgerUpdateOne {
+	_u.mutation.ResetEnvID()
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableEnvID(v *int64) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// AddEnvID adds value to the "env_id" field.
+func (_u *CdkLedgerUpdateOne) AddEnvID(v int64) *CdkLedgerUpdateOne {
+	_u.mutation.AddEnvID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *CdkLedgerUpdateOne) SetType(v string) *CdkLedgerUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableType(v *string) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetDelta sets the "delta" field.
+func (_u *CdkLedgerUpdateOne) SetDelta(v int32) *CdkLedgerUpdateOne {
+	_u.mutation.ResetDelta()
+	_u.mutation.SetDelta(v)
+	return _u
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableDelta(v *int32) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetDelta(*v)
+	}
+	return _u
+}
+
+// AddDelta adds value to the "delta" field.
+func (_u *CdkLedgerUpdateOne) AddDelta(v int32) *CdkLedgerUpdateOne {
+	_u.mutation.AddDelta(v)
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *CdkLedgerUpdateOne) SetBalance(v int32) *CdkLedgerUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableBalance(v *int32) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *CdkLedgerUpdateOne) AddBalance(v int32) *CdkLedgerUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetReservationID sets the "reservation_id" field.
+func (_u *CdkLedgerUpdateOne) SetReservationID(v int64) *CdkLedgerUpdateOne {
+	_u.mutation.ResetReservationID()
+	_u.mutation.SetReservationID(v)
+	return _u
+}
+
+// SetNillableReservationID sets the "reservation_id" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableReservationID(v *int64) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetReservationID(*v)
+	}
+	return _u
+}
+
+// AddReservationID adds value to the "reservation_id" field.
+func (_u *CdkLedgerUpdateOne) AddReservationID(v int64) *CdkLedgerUpdateOne {
+	_u.mutation.AddReservationID(v)
+	return _u
+}
+
+// ClearReservationID clears the value of the "reservation_id" field.
+func (_u *CdkLedgerUpdateOne) ClearReservationID() *CdkLedgerUpdateOne {
+	_u.mutation.ClearReservationID()
+	return _u
+}
+
+// SetSettled sets the "settled" field.
+func (_u *CdkLedgerUpdateOne) SetSettled(v bool) *CdkLedgerUpdateOne {
+	_u.mutation.SetSettled(v)
+	return _u
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableSettled(v *bool) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetSettled(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *CdkLedgerUpdateOne) SetMessage(v string) *CdkLedgerUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *CdkLedgerUpdateOne) SetNillableMessage(v *string) *CdkLedgerUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *CdkLedgerUpdateOne) ClearMessage() *CdkLedgerUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Mutation returns the CdkLedgerMutation object of the builder.
+func (_u *CdkLedgerUpdateOne) Mutation() *CdkLedgerMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CdkLedgerUpdate builder.
+func (_u *CdkLedgerUpdateOne) Where(ps ...predicate.CdkLedger) *CdkLedgerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CdkLedgerUpdateOne) Select(field string, fields ...string) *CdkLedgerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CdkLedger entity.
+func (_u *CdkLedgerUpdateOne) Save(ctx context.Context) (*CdkLedger, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CdkLedgerUpdateOne) SaveX(ctx context.Context) *CdkLedger {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CdkLedgerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CdkLedgerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CdkLedgerUpdateOne) sqlSave(ctx context.Context) (_node *CdkLedger, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cdkledger.Table, cdkledger.Columns, sqlgraph.NewFieldSpec(cdkledger.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CdkLedger.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cdkledger.FieldID)
+		for _, f := range fields {
+			if !cdkledger.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cdkledger.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CdkID(); ok {
+		_spec.SetField(cdkledger.FieldCdkID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCdkID(); ok {
+		_spec.AddField(cdkledger.FieldCdkID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EnvID(); ok {
+		_spec.SetField(cdkledger.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEnvID(); ok {
+		_spec.AddField(cdkledger.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(cdkledger.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Delta(); ok {
+		_spec.SetField(cdkledger.FieldDelta, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedDelta(); ok {
+		_spec.AddField(cdkledger.FieldDelta, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(cdkledger.FieldBalance, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(cdkledger.FieldBalance, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ReservationID(); ok {
+		_spec.SetField(cdkledger.FieldReservationID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReservationID(); ok {
+		_spec.AddField(cdkledger.FieldReservationID, field.TypeInt64, value)
+	}
+	if _u.mutation.ReservationIDCleared() {
+		_spec.ClearField(cdkledger.FieldReservationID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Settled(); ok {
+		_spec.SetField(cdkledger.FieldSettled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(cdkledger.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(cdkledger.FieldMessage, field.TypeString)
+	}
+	_node = &CdkLedger{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cdkledger.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
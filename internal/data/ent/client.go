@@ -16,12 +16,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkey"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/idempotencyrecord"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/loginhistory"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/resourcelock"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/user"
 )
 
@@ -32,18 +41,36 @@ type Client struct {
 	Schema *migrate.Schema
 	// CdKey is the client for interacting with the CdKey builders.
 	CdKey *CdKeyClient
+	// CdkLedger is the client for interacting with the CdkLedger builders.
+	CdkLedger *CdkLedgerClient
+	// CronTriggerLog is the client for interacting with the CronTriggerLog builders.
+	CronTriggerLog *CronTriggerLogClient
 	// Env is the client for interacting with the Env builders.
 	Env *EnvClient
+	// EnvCronTrigger is the client for interacting with the EnvCronTrigger builders.
+	EnvCronTrigger *EnvCronTriggerClient
+	// EnvPanel is the client for interacting with the EnvPanel builders.
+	EnvPanel *EnvPanelClient
 	// EnvPlugin is the client for interacting with the EnvPlugin builders.
 	EnvPlugin *EnvPluginClient
+	// EnvReplica is the client for interacting with the EnvReplica builders.
+	EnvReplica *EnvReplicaClient
+	// IdempotencyRecord is the client for interacting with the IdempotencyRecord builders.
+	IdempotencyRecord *IdempotencyRecordClient
 	// LoginHistory is the client for interacting with the LoginHistory builders.
 	LoginHistory *LoginHistoryClient
 	// Panel is the client for interacting with the Panel builders.
 	Panel *PanelClient
+	// PanelHealth is the client for interacting with the PanelHealth builders.
+	PanelHealth *PanelHealthClient
 	// Plugin is the client for interacting with the Plugin builders.
 	Plugin *PluginClient
 	// PluginExecutionLog is the client for interacting with the PluginExecutionLog builders.
 	PluginExecutionLog *PluginExecutionLogClient
+	// PluginTestCase is the client for interacting with the PluginTestCase builders.
+	PluginTestCase *PluginTestCaseClient
+	// ResourceLock is the client for interacting with the ResourceLock builders.
+	ResourceLock *ResourceLockClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -58,12 +85,21 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.CdKey = NewCdKeyClient(c.config)
+	c.CdkLedger = NewCdkLedgerClient(c.config)
+	c.CronTriggerLog = NewCronTriggerLogClient(c.config)
 	c.Env = NewEnvClient(c.config)
+	c.EnvCronTrigger = NewEnvCronTriggerClient(c.config)
+	c.EnvPanel = NewEnvPanelClient(c.config)
 	c.EnvPlugin = NewEnvPluginClient(c.config)
+	c.EnvReplica = NewEnvReplicaClient(c.config)
+	c.IdempotencyRecord = NewIdempotencyRecordClient(c.config)
 	c.LoginHistory = NewLoginHistoryClient(c.config)
 	c.Panel = NewPanelClient(c.config)
+	c.PanelHealth = NewPanelHealthClient(c.config)
 	c.Plugin = NewPluginClient(c.config)
 	c.PluginExecutionLog = NewPluginExecutionLogClient(c.config)
+	c.PluginTestCase = NewPluginTestCaseClient(c.config)
+	c.ResourceLock = NewResourceLockClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -158,12 +194,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                ctx,
 		config:             cfg,
 		CdKey:              NewCdKeyClient(cfg),
+		CdkLedger:          NewCdkLedgerClient(cfg),
+		CronTriggerLog:     NewCronTriggerLogClient(cfg),
 		Env:                NewEnvClient(cfg),
+		EnvCronTrigger:     NewEnvCronTriggerClient(cfg),
+		EnvPanel:           NewEnvPanelClient(cfg),
 		EnvPlugin:          NewEnvPluginClient(cfg),
+		EnvReplica:         NewEnvReplicaClient(cfg),
+		IdempotencyRecord:  NewIdempotencyRecordClient(cfg),
 		LoginHistory:       NewLoginHistoryClient(cfg),
 		Panel:              NewPanelClient(cfg),
+		PanelHealth:        NewPanelHealthClient(cfg),
 		Plugin:             NewPluginClient(cfg),
 		PluginExecutionLog: NewPluginExecutionLogClient(cfg),
+		PluginTestCase:     NewPluginTestCaseClient(cfg),
+		ResourceLock:       NewResourceLockClient(cfg),
 		User:               NewUserClient(cfg),
 	}, nil
 }
@@ -185,12 +230,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                ctx,
 		config:             cfg,
 		CdKey:              NewCdKeyClient(cfg),
+		CdkLedger:          NewCdkLedgerClient(cfg),
+		CronTriggerLog:     NewCronTriggerLogClient(cfg),
 		Env:                NewEnvClient(cfg),
+		EnvCronTrigger:     NewEnvCronTriggerClient(cfg),
+		EnvPanel:           NewEnvPanelClient(cfg),
 		EnvPlugin:          NewEnvPluginClient(cfg),
+		EnvReplica:         NewEnvReplicaClient(cfg),
+		IdempotencyRecord:  NewIdempotencyRecordClient(cfg),
 		LoginHistory:       NewLoginHistoryClient(cfg),
 		Panel:              NewPanelClient(cfg),
+		PanelHealth:        NewPanelHealthClient(cfg),
 		Plugin:             NewPluginClient(cfg),
 		PluginExecutionLog: NewPluginExecutionLogClient(cfg),
+		PluginTestCase:     NewPluginTestCaseClient(cfg),
+		ResourceLock:       NewResourceLockClient(cfg),
 		User:               NewUserClient(cfg),
 	}, nil
 }
@@ -221,8 +275,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CdKey, c.Env, c.EnvPlugin, c.LoginHistory, c.Panel, c.Plugin,
-		c.PluginExecutionLog, c.User,
+		c.CdKey, c.CdkLedger, c.CronTriggerLog, c.Env, c.EnvCronTrigger, c.EnvPanel,
+		c.EnvPlugin, c.EnvReplica, c.IdempotencyRecord, c.LoginHistory, c.Panel,
+		c.PanelHealth, c.Plugin, c.PluginExecutionLog, c.PluginTestCase,
+		c.ResourceLock, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -232,8 +288,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CdKey, c.Env, c.EnvPlugin, c.LoginHistory, c.Panel, c.Plugin,
-		c.PluginExecutionLog, c.User,
+		c.CdKey, c.CdkLedger, c.CronTriggerLog, c.Env, c.EnvCronTrigger, c.EnvPanel,
+		c.EnvPlugin, c.EnvReplica, c.IdempotencyRecord, c.LoginHistory, c.Panel,
+		c.PanelHealth, c.Plugin, c.PluginExecutionLog, c.PluginTestCase,
+		c.ResourceLock, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -244,18 +302,36 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *CdKeyMutation:
 		return c.CdKey.mutate(ctx, m)
+	case *CdkLedgerMutation:
+		return c.CdkLedger.mutate(ctx, m)
+	case *CronTriggerLogMutation:
+		return c.CronTriggerLog.mutate(ctx, m)
 	case *EnvMutation:
 		return c.Env.mutate(ctx, m)
+	case *EnvCronTriggerMutation:
+		return c.EnvCronTrigger.mutate(ctx, m)
+	case *EnvPanelMutation:
+		return c.EnvPanel.mutate(ctx, m)
 	case *EnvPluginMutation:
 		return c.EnvPlugin.mutate(ctx, m)
+	case *EnvReplicaMutation:
+		return c.EnvReplica.mutate(ctx, m)
+	case *IdempotencyRecordMutation:
+		return c.IdempotencyRecord.mutate(ctx, m)
 	case *LoginHistoryMutation:
 		return c.LoginHistory.mutate(ctx, m)
 	case *PanelMutation:
 		return c.Panel.mutate(ctx, m)
+	case *PanelHealthMutation:
+		return c.PanelHealth.mutate(ctx, m)
 	case *PluginMutation:
 		return c.Plugin.mutate(ctx, m)
 	case *PluginExecutionLogMutation:
 		return c.PluginExecutionLog.mutate(ctx, m)
+	case *PluginTestCaseMutation:
+		return c.PluginTestCase.mutate(ctx, m)
+	case *ResourceLockMutation:
+		return c.ResourceLock.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -396,6 +472,272 @@ func (c *CdKeyClient) mutate(ctx context.Context, m *CdKeyMutation) (Value, erro
 	}
 }
 
+// CdkLedgerClient is a client for the CdkLedger schema.
+type CdkLedgerClient struct {
+	config
+}
+
+// NewCdkLedgerClient returns a client for the CdkLedger from the given config.
+func NewCdkLedgerClient(c config) *CdkLedgerClient {
+	return &CdkLedgerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `cdkledger.Hooks(f(g(h())))`.
+func (c *CdkLedgerClient) Use(hooks ...Hook) {
+	c.hooks.CdkLedger = append(c.hooks.CdkLedger, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `cdkledger.Intercept(f(g(h())))`.
+func (c *CdkLedgerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CdkLedger = append(c.inters.CdkLedger, interceptors...)
+}
+
+// Create returns a builder for creating a CdkLedger entity.
+func (c *CdkLedgerClient) Create() *CdkLedgerCreate {
+	mutation := newCdkLedgerMutation(c.config, OpCreate)
+	return &CdkLedgerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CdkLedger entities.
+func (c *CdkLedgerClient) CreateBulk(builders ...*CdkLedgerCreate) *CdkLedgerCreateBulk {
+	return &CdkLedgerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CdkLedgerClient) MapCreateBulk(slice any, setFunc func(*CdkLedgerCreate, int)) *CdkLedgerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CdkLedgerCreateBulk{err: fmt.Errorf("calling to CdkLedgerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CdkLedgerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CdkLedgerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CdkLedger.
+func (c *CdkLedgerClient) Update() *CdkLedgerUpdate {
+	mutation := newCdkLedgerMutation(c.config, OpUpdate)
+	return &CdkLedgerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CdkLedgerClient) UpdateOne(_m *CdkLedger) *CdkLedgerUpdateOne {
+	mutation := newCdkLedgerMutation(c.config, OpUpdateOne, withCdkLedger(_m))
+	return &CdkLedgerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CdkLedgerClient) UpdateOneID(id int64) *CdkLedgerUpdateOne {
+	mutation := newCdkLedgerMutation(c.config, OpUpdateOne, withCdkLedgerID(id))
+	return &CdkLedgerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CdkLedger.
+func (c *CdkLedgerClient) Delete() *CdkLedgerDelete {
+	mutation := newCdkLedgerMutation(c.config, OpDelete)
+	return &CdkLedgerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CdkLedgerClient) DeleteOne(_m *CdkLedger) *CdkLedgerDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CdkLedgerClient) DeleteOneID(id int64) *CdkLedgerDeleteOne {
+	builder := c.Delete().Where(cdkledger.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CdkLedgerDeleteOne{builder}
+}
+
+// Query returns a query builder for CdkLedger.
+func (c *CdkLedgerClient) Query() *CdkLedgerQuery {
+	return &CdkLedgerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCdkLedger},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CdkLedger entity by its id.
+func (c *CdkLedgerClient) Get(ctx context.Context, id int64) (*CdkLedger, error) {
+	return c.Query().Where(cdkledger.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CdkLedgerClient) GetX(ctx context.Context, id int64) *CdkLedger {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CdkLedgerClient) Hooks() []Hook {
+	return c.hooks.CdkLedger
+}
+
+// Interceptors returns the client interceptors.
+func (c *CdkLedgerClient) Interceptors() []Interceptor {
+	return c.inters.CdkLedger
+}
+
+func (c *CdkLedgerClient) mutate(ctx context.Context, m *CdkLedgerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CdkLedgerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CdkLedgerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CdkLedgerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CdkLedgerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CdkLedger mutation op: %q", m.Op())
+	}
+}
+
+// CronTriggerLogClient is a client for the CronTriggerLog schema.
+type CronTriggerLogClient struct {
+	config
+}
+
+// NewCronTriggerLogClient returns a client for the CronTriggerLog from the given config.
+func NewCronTriggerLogClient(c config) *CronTriggerLogClient {
+	return &CronTriggerLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `crontriggerlog.Hooks(f(g(h())))`.
+func (c *CronTriggerLogClient) Use(hooks ...Hook) {
+	c.hooks.CronTriggerLog = append(c.hooks.CronTriggerLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `crontriggerlog.Intercept(f(g(h())))`.
+func (c *CronTriggerLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CronTriggerLog = append(c.inters.CronTriggerLog, interceptors...)
+}
+
+// Create returns a builder for creating a CronTriggerLog entity.
+func (c *CronTriggerLogClient) Create() *CronTriggerLogCreate {
+	mutation := newCronTriggerLogMutation(c.config, OpCreate)
+	return &CronTriggerLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CronTriggerLog entities.
+func (c *CronTriggerLogClient) CreateBulk(builders ...*CronTriggerLogCreate) *CronTriggerLogCreateBulk {
+	return &CronTriggerLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CronTriggerLogClient) MapCreateBulk(slice any, setFunc func(*CronTriggerLogCreate, int)) *CronTriggerLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CronTriggerLogCreateBulk{err: fmt.Errorf("calling to CronTriggerLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CronTriggerLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CronTriggerLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CronTriggerLog.
+func (c *CronTriggerLogClient) Update() *CronTriggerLogUpdate {
+	mutation := newCronTriggerLogMutation(c.config, OpUpdate)
+	return &CronTriggerLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CronTriggerLogClient) UpdateOne(_m *CronTriggerLog) *CronTriggerLogUpdateOne {
+	mutation := newCronTriggerLogMutation(c.config, OpUpdateOne, withCronTriggerLog(_m))
+	return &CronTriggerLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CronTriggerLogClient) UpdateOneID(id int64) *CronTriggerLogUpdateOne {
+	mutation := newCronTriggerLogMutation(c.config, OpUpdateOne, withCronTriggerLogID(id))
+	return &CronTriggerLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CronTriggerLog.
+func (c *CronTriggerLogClient) Delete() *CronTriggerLogDelete {
+	mutation := newCronTriggerLogMutation(c.config, OpDelete)
+	return &CronTriggerLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CronTriggerLogClient) DeleteOne(_m *CronTriggerLog) *CronTriggerLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CronTriggerLogClient) DeleteOneID(id int64) *CronTriggerLogDeleteOne {
+	builder := c.Delete().Where(crontriggerlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CronTriggerLogDeleteOne{builder}
+}
+
+// Query returns a query builder for CronTriggerLog.
+func (c *CronTriggerLogClient) Query() *CronTriggerLogQuery {
+	return &CronTriggerLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCronTriggerLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CronTriggerLog entity by its id.
+func (c *CronTriggerLogClient) Get(ctx context.Context, id int64) (*CronTriggerLog, error) {
+	return c.Query().Where(crontriggerlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CronTriggerLogClient) GetX(ctx context.Context, id int64) *CronTriggerLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CronTriggerLogClient) Hooks() []Hook {
+	return c.hooks.CronTriggerLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *CronTriggerLogClient) Interceptors() []Interceptor {
+	return c.inters.CronTriggerLog
+}
+
+func (c *CronTriggerLogClient) mutate(ctx context.Context, m *CronTriggerLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CronTriggerLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CronTriggerLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CronTriggerLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CronTriggerLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CronTriggerLog mutation op: %q", m.Op())
+	}
+}
+
 // EnvClient is a client for the Env schema.
 type EnvClient struct {
 	config
@@ -536,6 +878,38 @@ func (c *EnvClient) QueryEnvPlugins(_m *Env) *EnvPluginQuery {
 	return query
 }
 
+// QueryCronTriggers queries the cron_triggers edge of a Env.
+func (c *EnvClient) QueryCronTriggers(_m *Env) *EnvCronTriggerQuery {
+	query := (&EnvCronTriggerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, id),
+			sqlgraph.To(envcrontrigger.Table, envcrontrigger.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.CronTriggersTable, env.CronTriggersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvPanels queries the env_panels edge of a Env.
+func (c *EnvClient) QueryEnvPanels(_m *Env) *EnvPanelQuery {
+	query := (&EnvPanelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, id),
+			sqlgraph.To(envpanel.Table, envpanel.EnvColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, env.EnvPanelsTable, env.EnvPanelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvClient) Hooks() []Hook {
 	return c.hooks.Env
@@ -561,107 +935,107 @@ func (c *EnvClient) mutate(ctx context.Context, m *EnvMutation) (Value, error) {
 	}
 }
 
-// EnvPluginClient is a client for the EnvPlugin schema.
-type EnvPluginClient struct {
+// EnvCronTriggerClient is a client for the EnvCronTrigger schema.
+type EnvCronTriggerClient struct {
 	config
 }
 
-// NewEnvPluginClient returns a client for the EnvPlugin from the given config.
-func NewEnvPluginClient(c config) *EnvPluginClient {
-	return &EnvPluginClient{config: c}
+// NewEnvCronTriggerClient returns a client for the EnvCronTrigger from the given config.
+func NewEnvCronTriggerClient(c config) *EnvCronTriggerClient {
+	return &EnvCronTriggerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `envplugin.Hooks(f(g(h())))`.
-func (c *EnvPluginClient) Use(hooks ...Hook) {
-	c.hooks.EnvPlugin = append(c.hooks.EnvPlugin, hooks...)
+// A call to `Use(f, g, h)` equals to `envcrontrigger.Hooks(f(g(h())))`.
+func (c *EnvCronTriggerClient) Use(hooks ...Hook) {
+	c.hooks.EnvCronTrigger = append(c.hooks.EnvCronTrigger, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `envplugin.Intercept(f(g(h())))`.
-func (c *EnvPluginClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EnvPlugin = append(c.inters.EnvPlugin, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `envcrontrigger.Intercept(f(g(h())))`.
+func (c *EnvCronTriggerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvCronTrigger = append(c.inters.EnvCronTrigger, interceptors...)
 }
 
-// Create returns a builder for creating a EnvPlugin entity.
-func (c *EnvPluginClient) Create() *EnvPluginCreate {
-	mutation := newEnvPluginMutation(c.config, OpCreate)
-	return &EnvPluginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EnvCronTrigger entity.
+func (c *EnvCronTriggerClient) Create() *EnvCronTriggerCreate {
+	mutation := newEnvCronTriggerMutation(c.config, OpCreate)
+	return &EnvCronTriggerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EnvPlugin entities.
-func (c *EnvPluginClient) CreateBulk(builders ...*EnvPluginCreate) *EnvPluginCreateBulk {
-	return &EnvPluginCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EnvCronTrigger entities.
+func (c *EnvCronTriggerClient) CreateBulk(builders ...*EnvCronTriggerCreate) *EnvCronTriggerCreateBulk {
+	return &EnvCronTriggerCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EnvPluginClient) MapCreateBulk(slice any, setFunc func(*EnvPluginCreate, int)) *EnvPluginCreateBulk {
+func (c *EnvCronTriggerClient) MapCreateBulk(slice any, setFunc func(*EnvCronTriggerCreate, int)) *EnvCronTriggerCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EnvPluginCreateBulk{err: fmt.Errorf("calling to EnvPluginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EnvCronTriggerCreateBulk{err: fmt.Errorf("calling to EnvCronTriggerClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EnvPluginCreate, rv.Len())
+	builders := make([]*EnvCronTriggerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EnvPluginCreateBulk{config: c.config, builders: builders}
+	return &EnvCronTriggerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EnvPlugin.
-func (c *EnvPluginClient) Update() *EnvPluginUpdate {
-	mutation := newEnvPluginMutation(c.config, OpUpdate)
-	return &EnvPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EnvCronTrigger.
+func (c *EnvCronTriggerClient) Update() *EnvCronTriggerUpdate {
+	mutation := newEnvCronTriggerMutation(c.config, OpUpdate)
+	return &EnvCronTriggerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EnvPluginClient) UpdateOne(_m *EnvPlugin) *EnvPluginUpdateOne {
-	mutation := newEnvPluginMutation(c.config, OpUpdateOne, withEnvPlugin(_m))
-	return &EnvPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnvCronTriggerClient) UpdateOne(_m *EnvCronTrigger) *EnvCronTriggerUpdateOne {
+	mutation := newEnvCronTriggerMutation(c.config, OpUpdateOne, withEnvCronTrigger(_m))
+	return &EnvCronTriggerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EnvPluginClient) UpdateOneID(id int64) *EnvPluginUpdateOne {
-	mutation := newEnvPluginMutation(c.config, OpUpdateOne, withEnvPluginID(id))
-	return &EnvPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EnvCronTriggerClient) UpdateOneID(id int64) *EnvCronTriggerUpdateOne {
+	mutation := newEnvCronTriggerMutation(c.config, OpUpdateOne, withEnvCronTriggerID(id))
+	return &EnvCronTriggerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EnvPlugin.
-func (c *EnvPluginClient) Delete() *EnvPluginDelete {
-	mutation := newEnvPluginMutation(c.config, OpDelete)
-	return &EnvPluginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EnvCronTrigger.
+func (c *EnvCronTriggerClient) Delete() *EnvCronTriggerDelete {
+	mutation := newEnvCronTriggerMutation(c.config, OpDelete)
+	return &EnvCronTriggerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EnvPluginClient) DeleteOne(_m *EnvPlugin) *EnvPluginDeleteOne {
+func (c *EnvCronTriggerClient) DeleteOne(_m *EnvCronTrigger) *EnvCronTriggerDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EnvPluginClient) DeleteOneID(id int64) *EnvPluginDeleteOne {
-	builder := c.Delete().Where(envplugin.ID(id))
+func (c *EnvCronTriggerClient) DeleteOneID(id int64) *EnvCronTriggerDeleteOne {
+	builder := c.Delete().Where(envcrontrigger.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EnvPluginDeleteOne{builder}
+	return &EnvCronTriggerDeleteOne{builder}
 }
 
-// Query returns a query builder for EnvPlugin.
-func (c *EnvPluginClient) Query() *EnvPluginQuery {
-	return &EnvPluginQuery{
+// Query returns a query builder for EnvCronTrigger.
+func (c *EnvCronTriggerClient) Query() *EnvCronTriggerQuery {
+	return &EnvCronTriggerQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEnvPlugin},
+		ctx:    &QueryContext{Type: TypeEnvCronTrigger},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EnvPlugin entity by its id.
-func (c *EnvPluginClient) Get(ctx context.Context, id int64) (*EnvPlugin, error) {
-	return c.Query().Where(envplugin.ID(id)).Only(ctx)
+// Get returns a EnvCronTrigger entity by its id.
+func (c *EnvCronTriggerClient) Get(ctx context.Context, id int64) (*EnvCronTrigger, error) {
+	return c.Query().Where(envcrontrigger.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EnvPluginClient) GetX(ctx context.Context, id int64) *EnvPlugin {
+func (c *EnvCronTriggerClient) GetX(ctx context.Context, id int64) *EnvCronTrigger {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -669,31 +1043,15 @@ func (c *EnvPluginClient) GetX(ctx context.Context, id int64) *EnvPlugin {
 	return obj
 }
 
-// QueryEnv queries the env edge of a EnvPlugin.
-func (c *EnvPluginClient) QueryEnv(_m *EnvPlugin) *EnvQuery {
+// QueryEnv queries the env edge of a EnvCronTrigger.
+func (c *EnvCronTriggerClient) QueryEnv(_m *EnvCronTrigger) *EnvQuery {
 	query := (&EnvClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(envplugin.Table, envplugin.FieldID, id),
+			sqlgraph.From(envcrontrigger.Table, envcrontrigger.FieldID, id),
 			sqlgraph.To(env.Table, env.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, envplugin.EnvTable, envplugin.EnvColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPlugin queries the plugin edge of a EnvPlugin.
-func (c *EnvPluginClient) QueryPlugin(_m *EnvPlugin) *PluginQuery {
-	query := (&PluginClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(envplugin.Table, envplugin.FieldID, id),
-			sqlgraph.To(plugin.Table, plugin.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, envplugin.PluginTable, envplugin.PluginColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, envcrontrigger.EnvTable, envcrontrigger.EnvColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -702,42 +1060,589 @@ func (c *EnvPluginClient) QueryPlugin(_m *EnvPlugin) *PluginQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EnvPluginClient) Hooks() []Hook {
-	return c.hooks.EnvPlugin
+func (c *EnvCronTriggerClient) Hooks() []Hook {
+	return c.hooks.EnvCronTrigger
 }
 
 // Interceptors returns the client interceptors.
-func (c *EnvPluginClient) Interceptors() []Interceptor {
-	return c.inters.EnvPlugin
+func (c *EnvCronTriggerClient) Interceptors() []Interceptor {
+	return c.inters.EnvCronTrigger
 }
 
-func (c *EnvPluginClient) mutate(ctx context.Context, m *EnvPluginMutation) (Value, error) {
+func (c *EnvCronTriggerClient) mutate(ctx context.Context, m *EnvCronTriggerMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EnvPluginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvCronTriggerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EnvPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvCronTriggerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EnvPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EnvCronTriggerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EnvPluginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EnvCronTriggerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EnvPlugin mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EnvCronTrigger mutation op: %q", m.Op())
 	}
 }
 
-// LoginHistoryClient is a client for the LoginHistory schema.
-type LoginHistoryClient struct {
+// EnvPanelClient is a client for the EnvPanel schema.
+type EnvPanelClient struct {
 	config
 }
 
-// NewLoginHistoryClient returns a client for the LoginHistory from the given config.
-func NewLoginHistoryClient(c config) *LoginHistoryClient {
-	return &LoginHistoryClient{config: c}
+// NewEnvPanelClient returns a client for the EnvPanel from the given config.
+func NewEnvPanelClient(c config) *EnvPanelClient {
+	return &EnvPanelClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `loginhistory.Hooks(f(g(h())))`.
+// A call to `Use(f, g, h)` equals to `envpanel.Hooks(f(g(h())))`.
+func (c *EnvPanelClient) Use(hooks ...Hook) {
+	c.hooks.EnvPanel = append(c.hooks.EnvPanel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `envpanel.Intercept(f(g(h())))`.
+func (c *EnvPanelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvPanel = append(c.inters.EnvPanel, interceptors...)
+}
+
+// Create returns a builder for creating a EnvPanel entity.
+func (c *EnvPanelClient) Create() *EnvPanelCreate {
+	mutation := newEnvPanelMutation(c.config, OpCreate)
+	return &EnvPanelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnvPanel entities.
+func (c *EnvPanelClient) CreateBulk(builders ...*EnvPanelCreate) *EnvPanelCreateBulk {
+	return &EnvPanelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvPanelClient) MapCreateBulk(slice any, setFunc func(*EnvPanelCreate, int)) *EnvPanelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvPanelCreateBulk{err: fmt.Errorf("calling to EnvPanelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvPanelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvPanelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnvPanel.
+func (c *EnvPanelClient) Update() *EnvPanelUpdate {
+	mutation := newEnvPanelMutation(c.config, OpUpdate)
+	return &EnvPanelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvPanelClient) UpdateOne(_m *EnvPanel) *EnvPanelUpdateOne {
+	mutation := newEnvPanelMutation(c.config, OpUpdateOne)
+	mutation.env = &_m.EnvID
+	mutation.panel = &_m.PanelID
+	return &EnvPanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnvPanel.
+func (c *EnvPanelClient) Delete() *EnvPanelDelete {
+	mutation := newEnvPanelMutation(c.config, OpDelete)
+	return &EnvPanelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Query returns a query builder for EnvPanel.
+func (c *EnvPanelClient) Query() *EnvPanelQuery {
+	return &EnvPanelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvPanel},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryEnv queries the env edge of a EnvPanel.
+func (c *EnvPanelClient) QueryEnv(_m *EnvPanel) *EnvQuery {
+	return c.Query().
+		Where(envpanel.EnvID(_m.EnvID), envpanel.PanelID(_m.PanelID)).
+		QueryEnv()
+}
+
+// QueryPanel queries the panel edge of a EnvPanel.
+func (c *EnvPanelClient) QueryPanel(_m *EnvPanel) *PanelQuery {
+	return c.Query().
+		Where(envpanel.EnvID(_m.EnvID), envpanel.PanelID(_m.PanelID)).
+		QueryPanel()
+}
+
+// Hooks returns the client hooks.
+func (c *EnvPanelClient) Hooks() []Hook {
+	return c.hooks.EnvPanel
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvPanelClient) Interceptors() []Interceptor {
+	return c.inters.EnvPanel
+}
+
+func (c *EnvPanelClient) mutate(ctx context.Context, m *EnvPanelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvPanelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvPanelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvPanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvPanelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnvPanel mutation op: %q", m.Op())
+	}
+}
+
+// EnvPluginClient is a client for the EnvPlugin schema.
+type EnvPluginClient struct {
+	config
+}
+
+// NewEnvPluginClient returns a client for the EnvPlugin from the given config.
+func NewEnvPluginClient(c config) *EnvPluginClient {
+	return &EnvPluginClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `envplugin.Hooks(f(g(h())))`.
+func (c *EnvPluginClient) Use(hooks ...Hook) {
+	c.hooks.EnvPlugin = append(c.hooks.EnvPlugin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `envplugin.Intercept(f(g(h())))`.
+func (c *EnvPluginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvPlugin = append(c.inters.EnvPlugin, interceptors...)
+}
+
+// Create returns a builder for creating a EnvPlugin entity.
+func (c *EnvPluginClient) Create() *EnvPluginCreate {
+	mutation := newEnvPluginMutation(c.config, OpCreate)
+	return &EnvPluginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnvPlugin entities.
+func (c *EnvPluginClient) CreateBulk(builders ...*EnvPluginCreate) *EnvPluginCreateBulk {
+	return &EnvPluginCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvPluginClient) MapCreateBulk(slice any, setFunc func(*EnvPluginCreate, int)) *EnvPluginCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvPluginCreateBulk{err: fmt.Errorf("calling to EnvPluginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvPluginCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvPluginCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnvPlugin.
+func (c *EnvPluginClient) Update() *EnvPluginUpdate {
+	mutation := newEnvPluginMutation(c.config, OpUpdate)
+	return &EnvPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvPluginClient) UpdateOne(_m *EnvPlugin) *EnvPluginUpdateOne {
+	mutation := newEnvPluginMutation(c.config, OpUpdateOne, withEnvPlugin(_m))
+	return &EnvPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnvPluginClient) UpdateOneID(id int64) *EnvPluginUpdateOne {
+	mutation := newEnvPluginMutation(c.config, OpUpdateOne, withEnvPluginID(id))
+	return &EnvPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnvPlugin.
+func (c *EnvPluginClient) Delete() *EnvPluginDelete {
+	mutation := newEnvPluginMutation(c.config, OpDelete)
+	return &EnvPluginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnvPluginClient) DeleteOne(_m *EnvPlugin) *EnvPluginDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnvPluginClient) DeleteOneID(id int64) *EnvPluginDeleteOne {
+	builder := c.Delete().Where(envplugin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnvPluginDeleteOne{builder}
+}
+
+// Query returns a query builder for EnvPlugin.
+func (c *EnvPluginClient) Query() *EnvPluginQuery {
+	return &EnvPluginQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvPlugin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnvPlugin entity by its id.
+func (c *EnvPluginClient) Get(ctx context.Context, id int64) (*EnvPlugin, error) {
+	return c.Query().Where(envplugin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnvPluginClient) GetX(ctx context.Context, id int64) *EnvPlugin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnv queries the env edge of a EnvPlugin.
+func (c *EnvPluginClient) QueryEnv(_m *EnvPlugin) *EnvQuery {
+	query := (&EnvClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envplugin.Table, envplugin.FieldID, id),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, envplugin.EnvTable, envplugin.EnvColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlugin queries the plugin edge of a EnvPlugin.
+func (c *EnvPluginClient) QueryPlugin(_m *EnvPlugin) *PluginQuery {
+	query := (&PluginClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envplugin.Table, envplugin.FieldID, id),
+			sqlgraph.To(plugin.Table, plugin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, envplugin.PluginTable, envplugin.PluginColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnvPluginClient) Hooks() []Hook {
+	return c.hooks.EnvPlugin
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvPluginClient) Interceptors() []Interceptor {
+	return c.inters.EnvPlugin
+}
+
+func (c *EnvPluginClient) mutate(ctx context.Context, m *EnvPluginMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvPluginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvPluginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnvPlugin mutation op: %q", m.Op())
+	}
+}
+
+// EnvReplicaClient is a client for the EnvReplica schema.
+type EnvReplicaClient struct {
+	config
+}
+
+// NewEnvReplicaClient returns a client for the EnvReplica from the given config.
+func NewEnvReplicaClient(c config) *EnvReplicaClient {
+	return &EnvReplicaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `envreplica.Hooks(f(g(h())))`.
+func (c *EnvReplicaClient) Use(hooks ...Hook) {
+	c.hooks.EnvReplica = append(c.hooks.EnvReplica, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `envreplica.Intercept(f(g(h())))`.
+func (c *EnvReplicaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvReplica = append(c.inters.EnvReplica, interceptors...)
+}
+
+// Create returns a builder for creating a EnvReplica entity.
+func (c *EnvReplicaClient) Create() *EnvReplicaCreate {
+	mutation := newEnvReplicaMutation(c.config, OpCreate)
+	return &EnvReplicaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnvReplica entities.
+func (c *EnvReplicaClient) CreateBulk(builders ...*EnvReplicaCreate) *EnvReplicaCreateBulk {
+	return &EnvReplicaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvReplicaClient) MapCreateBulk(slice any, setFunc func(*EnvReplicaCreate, int)) *EnvReplicaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvReplicaCreateBulk{err: fmt.Errorf("calling to EnvReplicaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvReplicaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvReplicaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnvReplica.
+func (c *EnvReplicaClient) Update() *EnvReplicaUpdate {
+	mutation := newEnvReplicaMutation(c.config, OpUpdate)
+	return &EnvReplicaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvReplicaClient) UpdateOne(_m *EnvReplica) *EnvReplicaUpdateOne {
+	mutation := newEnvReplicaMutation(c.config, OpUpdateOne, withEnvReplica(_m))
+	return &EnvReplicaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnvReplicaClient) UpdateOneID(id int64) *EnvReplicaUpdateOne {
+	mutation := newEnvReplicaMutation(c.config, OpUpdateOne, withEnvReplicaID(id))
+	return &EnvReplicaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnvReplica.
+func (c *EnvReplicaClient) Delete() *EnvReplicaDelete {
+	mutation := newEnvReplicaMutation(c.config, OpDelete)
+	return &EnvReplicaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnvReplicaClient) DeleteOne(_m *EnvReplica) *EnvReplicaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnvReplicaClient) DeleteOneID(id int64) *EnvReplicaDeleteOne {
+	builder := c.Delete().Where(envreplica.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnvReplicaDeleteOne{builder}
+}
+
+// Query returns a query builder for EnvReplica.
+func (c *EnvReplicaClient) Query() *EnvReplicaQuery {
+	return &EnvReplicaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvReplica},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnvReplica entity by its id.
+func (c *EnvReplicaClient) Get(ctx context.Context, id int64) (*EnvReplica, error) {
+	return c.Query().Where(envreplica.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnvReplicaClient) GetX(ctx context.Context, id int64) *EnvReplica {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EnvReplicaClient) Hooks() []Hook {
+	return c.hooks.EnvReplica
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvReplicaClient) Interceptors() []Interceptor {
+	return c.inters.EnvReplica
+}
+
+func (c *EnvReplicaClient) mutate(ctx context.Context, m *EnvReplicaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvReplicaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvReplicaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvReplicaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvReplicaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnvReplica mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyRecordClient is a client for the IdempotencyRecord schema.
+type IdempotencyRecordClient struct {
+	config
+}
+
+// NewIdempotencyRecordClient returns a client for the IdempotencyRecord from the given config.
+func NewIdempotencyRecordClient(c config) *IdempotencyRecordClient {
+	return &IdempotencyRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencyrecord.Hooks(f(g(h())))`.
+func (c *IdempotencyRecordClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyRecord = append(c.hooks.IdempotencyRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencyrecord.Intercept(f(g(h())))`.
+func (c *IdempotencyRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyRecord = append(c.inters.IdempotencyRecord, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyRecord entity.
+func (c *IdempotencyRecordClient) Create() *IdempotencyRecordCreate {
+	mutation := newIdempotencyRecordMutation(c.config, OpCreate)
+	return &IdempotencyRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyRecord entities.
+func (c *IdempotencyRecordClient) CreateBulk(builders ...*IdempotencyRecordCreate) *IdempotencyRecordCreateBulk {
+	return &IdempotencyRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyRecordClient) MapCreateBulk(slice any, setFunc func(*IdempotencyRecordCreate, int)) *IdempotencyRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyRecordCreateBulk{err: fmt.Errorf("calling to IdempotencyRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyRecord.
+func (c *IdempotencyRecordClient) Update() *IdempotencyRecordUpdate {
+	mutation := newIdempotencyRecordMutation(c.config, OpUpdate)
+	return &IdempotencyRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyRecordClient) UpdateOne(_m *IdempotencyRecord) *IdempotencyRecordUpdateOne {
+	mutation := newIdempotencyRecordMutation(c.config, OpUpdateOne, withIdempotencyRecord(_m))
+	return &IdempotencyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyRecordClient) UpdateOneID(id int64) *IdempotencyRecordUpdateOne {
+	mutation := newIdempotencyRecordMutation(c.config, OpUpdateOne, withIdempotencyRecordID(id))
+	return &IdempotencyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyRecord.
+func (c *IdempotencyRecordClient) Delete() *IdempotencyRecordDelete {
+	mutation := newIdempotencyRecordMutation(c.config, OpDelete)
+	return &IdempotencyRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyRecordClient) DeleteOne(_m *IdempotencyRecord) *IdempotencyRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyRecordClient) DeleteOneID(id int64) *IdempotencyRecordDeleteOne {
+	builder := c.Delete().Where(idempotencyrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyRecord.
+func (c *IdempotencyRecordClient) Query() *IdempotencyRecordQuery {
+	return &IdempotencyRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyRecord entity by its id.
+func (c *IdempotencyRecordClient) Get(ctx context.Context, id int64) (*IdempotencyRecord, error) {
+	return c.Query().Where(idempotencyrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyRecordClient) GetX(ctx context.Context, id int64) *IdempotencyRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyRecordClient) Hooks() []Hook {
+	return c.hooks.IdempotencyRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyRecordClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyRecord
+}
+
+func (c *IdempotencyRecordClient) mutate(ctx context.Context, m *IdempotencyRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyRecord mutation op: %q", m.Op())
+	}
+}
+
+// LoginHistoryClient is a client for the LoginHistory schema.
+type LoginHistoryClient struct {
+	config
+}
+
+// NewLoginHistoryClient returns a client for the LoginHistory from the given config.
+func NewLoginHistoryClient(c config) *LoginHistoryClient {
+	return &LoginHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginhistory.Hooks(f(g(h())))`.
 func (c *LoginHistoryClient) Use(hooks ...Hook) {
 	c.hooks.LoginHistory = append(c.hooks.LoginHistory, hooks...)
 }
@@ -849,117 +1754,282 @@ func (c *LoginHistoryClient) mutate(ctx context.Context, m *LoginHistoryMutation
 	case OpCreate:
 		return (&LoginHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LoginHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginHistory mutation op: %q", m.Op())
+	}
+}
+
+// PanelClient is a client for the Panel schema.
+type PanelClient struct {
+	config
+}
+
+// NewPanelClient returns a client for the Panel from the given config.
+func NewPanelClient(c config) *PanelClient {
+	return &PanelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `panel.Hooks(f(g(h())))`.
+func (c *PanelClient) Use(hooks ...Hook) {
+	c.hooks.Panel = append(c.hooks.Panel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `panel.Intercept(f(g(h())))`.
+func (c *PanelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Panel = append(c.inters.Panel, interceptors...)
+}
+
+// Create returns a builder for creating a Panel entity.
+func (c *PanelClient) Create() *PanelCreate {
+	mutation := newPanelMutation(c.config, OpCreate)
+	return &PanelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Panel entities.
+func (c *PanelClient) CreateBulk(builders ...*PanelCreate) *PanelCreateBulk {
+	return &PanelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PanelClient) MapCreateBulk(slice any, setFunc func(*PanelCreate, int)) *PanelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PanelCreateBulk{err: fmt.Errorf("calling to PanelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PanelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PanelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Panel.
+func (c *PanelClient) Update() *PanelUpdate {
+	mutation := newPanelMutation(c.config, OpUpdate)
+	return &PanelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PanelClient) UpdateOne(_m *Panel) *PanelUpdateOne {
+	mutation := newPanelMutation(c.config, OpUpdateOne, withPanel(_m))
+	return &PanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PanelClient) UpdateOneID(id int64) *PanelUpdateOne {
+	mutation := newPanelMutation(c.config, OpUpdateOne, withPanelID(id))
+	return &PanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Panel.
+func (c *PanelClient) Delete() *PanelDelete {
+	mutation := newPanelMutation(c.config, OpDelete)
+	return &PanelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PanelClient) DeleteOne(_m *Panel) *PanelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PanelClient) DeleteOneID(id int64) *PanelDeleteOne {
+	builder := c.Delete().Where(panel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PanelDeleteOne{builder}
+}
+
+// Query returns a query builder for Panel.
+func (c *PanelClient) Query() *PanelQuery {
+	return &PanelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePanel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Panel entity by its id.
+func (c *PanelClient) Get(ctx context.Context, id int64) (*Panel, error) {
+	return c.Query().Where(panel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PanelClient) GetX(ctx context.Context, id int64) *Panel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvs queries the envs edge of a Panel.
+func (c *PanelClient) QueryEnvs(_m *Panel) *EnvQuery {
+	query := (&EnvClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(panel.Table, panel.FieldID, id),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, panel.EnvsTable, panel.EnvsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvPanels queries the env_panels edge of a Panel.
+func (c *PanelClient) QueryEnvPanels(_m *Panel) *EnvPanelQuery {
+	query := (&EnvPanelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(panel.Table, panel.FieldID, id),
+			sqlgraph.To(envpanel.Table, envpanel.PanelColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, panel.EnvPanelsTable, panel.EnvPanelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PanelClient) Hooks() []Hook {
+	return c.hooks.Panel
+}
+
+// Interceptors returns the client interceptors.
+func (c *PanelClient) Interceptors() []Interceptor {
+	return c.inters.Panel
+}
+
+func (c *PanelClient) mutate(ctx context.Context, m *PanelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PanelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PanelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LoginHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PanelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LoginHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Panel mutation op: %q", m.Op())
 	}
 }
 
-// PanelClient is a client for the Panel schema.
-type PanelClient struct {
+// PanelHealthClient is a client for the PanelHealth schema.
+type PanelHealthClient struct {
 	config
 }
 
-// NewPanelClient returns a client for the Panel from the given config.
-func NewPanelClient(c config) *PanelClient {
-	return &PanelClient{config: c}
+// NewPanelHealthClient returns a client for the PanelHealth from the given config.
+func NewPanelHealthClient(c config) *PanelHealthClient {
+	return &PanelHealthClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `panel.Hooks(f(g(h())))`.
-func (c *PanelClient) Use(hooks ...Hook) {
-	c.hooks.Panel = append(c.hooks.Panel, hooks...)
+// A call to `Use(f, g, h)` equals to `panelhealth.Hooks(f(g(h())))`.
+func (c *PanelHealthClient) Use(hooks ...Hook) {
+	c.hooks.PanelHealth = append(c.hooks.PanelHealth, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `panel.Intercept(f(g(h())))`.
-func (c *PanelClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Panel = append(c.inters.Panel, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `panelhealth.Intercept(f(g(h())))`.
+func (c *PanelHealthClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PanelHealth = append(c.inters.PanelHealth, interceptors...)
 }
 
-// Create returns a builder for creating a Panel entity.
-func (c *PanelClient) Create() *PanelCreate {
-	mutation := newPanelMutation(c.config, OpCreate)
-	return &PanelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PanelHealth entity.
+func (c *PanelHealthClient) Create() *PanelHealthCreate {
+	mutation := newPanelHealthMutation(c.config, OpCreate)
+	return &PanelHealthCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Panel entities.
-func (c *PanelClient) CreateBulk(builders ...*PanelCreate) *PanelCreateBulk {
-	return &PanelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PanelHealth entities.
+func (c *PanelHealthClient) CreateBulk(builders ...*PanelHealthCreate) *PanelHealthCreateBulk {
+	return &PanelHealthCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PanelClient) MapCreateBulk(slice any, setFunc func(*PanelCreate, int)) *PanelCreateBulk {
+func (c *PanelHealthClient) MapCreateBulk(slice any, setFunc func(*PanelHealthCreate, int)) *PanelHealthCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PanelCreateBulk{err: fmt.Errorf("calling to PanelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PanelHealthCreateBulk{err: fmt.Errorf("calling to PanelHealthClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PanelCreate, rv.Len())
+	builders := make([]*PanelHealthCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PanelCreateBulk{config: c.config, builders: builders}
+	return &PanelHealthCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Panel.
-func (c *PanelClient) Update() *PanelUpdate {
-	mutation := newPanelMutation(c.config, OpUpdate)
-	return &PanelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PanelHealth.
+func (c *PanelHealthClient) Update() *PanelHealthUpdate {
+	mutation := newPanelHealthMutation(c.config, OpUpdate)
+	return &PanelHealthUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PanelClient) UpdateOne(_m *Panel) *PanelUpdateOne {
-	mutation := newPanelMutation(c.config, OpUpdateOne, withPanel(_m))
-	return &PanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PanelHealthClient) UpdateOne(_m *PanelHealth) *PanelHealthUpdateOne {
+	mutation := newPanelHealthMutation(c.config, OpUpdateOne, withPanelHealth(_m))
+	return &PanelHealthUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PanelClient) UpdateOneID(id int64) *PanelUpdateOne {
-	mutation := newPanelMutation(c.config, OpUpdateOne, withPanelID(id))
-	return &PanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PanelHealthClient) UpdateOneID(id int64) *PanelHealthUpdateOne {
+	mutation := newPanelHealthMutation(c.config, OpUpdateOne, withPanelHealthID(id))
+	return &PanelHealthUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Panel.
-func (c *PanelClient) Delete() *PanelDelete {
-	mutation := newPanelMutation(c.config, OpDelete)
-	return &PanelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PanelHealth.
+func (c *PanelHealthClient) Delete() *PanelHealthDelete {
+	mutation := newPanelHealthMutation(c.config, OpDelete)
+	return &PanelHealthDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PanelClient) DeleteOne(_m *Panel) *PanelDeleteOne {
+func (c *PanelHealthClient) DeleteOne(_m *PanelHealth) *PanelHealthDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PanelClient) DeleteOneID(id int64) *PanelDeleteOne {
-	builder := c.Delete().Where(panel.ID(id))
+func (c *PanelHealthClient) DeleteOneID(id int64) *PanelHealthDeleteOne {
+	builder := c.Delete().Where(panelhealth.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PanelDeleteOne{builder}
+	return &PanelHealthDeleteOne{builder}
 }
 
-// Query returns a query builder for Panel.
-func (c *PanelClient) Query() *PanelQuery {
-	return &PanelQuery{
+// Query returns a query builder for PanelHealth.
+func (c *PanelHealthClient) Query() *PanelHealthQuery {
+	return &PanelHealthQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePanel},
+		ctx:    &QueryContext{Type: TypePanelHealth},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Panel entity by its id.
-func (c *PanelClient) Get(ctx context.Context, id int64) (*Panel, error) {
-	return c.Query().Where(panel.ID(id)).Only(ctx)
+// Get returns a PanelHealth entity by its id.
+func (c *PanelHealthClient) Get(ctx context.Context, id int64) (*PanelHealth, error) {
+	return c.Query().Where(panelhealth.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PanelClient) GetX(ctx context.Context, id int64) *Panel {
+func (c *PanelHealthClient) GetX(ctx context.Context, id int64) *PanelHealth {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -967,44 +2037,28 @@ func (c *PanelClient) GetX(ctx context.Context, id int64) *Panel {
 	return obj
 }
 
-// QueryEnvs queries the envs edge of a Panel.
-func (c *PanelClient) QueryEnvs(_m *Panel) *EnvQuery {
-	query := (&EnvClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(panel.Table, panel.FieldID, id),
-			sqlgraph.To(env.Table, env.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, panel.EnvsTable, panel.EnvsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PanelClient) Hooks() []Hook {
-	return c.hooks.Panel
+func (c *PanelHealthClient) Hooks() []Hook {
+	return c.hooks.PanelHealth
 }
 
 // Interceptors returns the client interceptors.
-func (c *PanelClient) Interceptors() []Interceptor {
-	return c.inters.Panel
+func (c *PanelHealthClient) Interceptors() []Interceptor {
+	return c.inters.PanelHealth
 }
 
-func (c *PanelClient) mutate(ctx context.Context, m *PanelMutation) (Value, error) {
+func (c *PanelHealthClient) mutate(ctx context.Context, m *PanelHealthMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PanelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PanelHealthCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PanelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PanelHealthUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PanelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PanelHealthUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PanelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PanelHealthDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Panel mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PanelHealth mutation op: %q", m.Op())
 	}
 }
 
@@ -1148,6 +2202,22 @@ func (c *PluginClient) QueryExecutionLogs(_m *Plugin) *PluginExecutionLogQuery {
 	return query
 }
 
+// QueryTestCases queries the test_cases edge of a Plugin.
+func (c *PluginClient) QueryTestCases(_m *Plugin) *PluginTestCaseQuery {
+	query := (&PluginTestCaseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plugin.Table, plugin.FieldID, id),
+			sqlgraph.To(plugintestcase.Table, plugintestcase.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plugin.TestCasesTable, plugin.TestCasesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PluginClient) Hooks() []Hook {
 	return c.hooks.Plugin
@@ -1322,6 +2392,288 @@ func (c *PluginExecutionLogClient) mutate(ctx context.Context, m *PluginExecutio
 	}
 }
 
+// PluginTestCaseClient is a client for the PluginTestCase schema.
+type PluginTestCaseClient struct {
+	config
+}
+
+// NewPluginTestCaseClient returns a client for the PluginTestCase from the given config.
+func NewPluginTestCaseClient(c config) *PluginTestCaseClient {
+	return &PluginTestCaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `plugintestcase.Hooks(f(g(h())))`.
+func (c *PluginTestCaseClient) Use(hooks ...Hook) {
+	c.hooks.PluginTestCase = append(c.hooks.PluginTestCase, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `plugintestcase.Intercept(f(g(h())))`.
+func (c *PluginTestCaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PluginTestCase = append(c.inters.PluginTestCase, interceptors...)
+}
+
+// Create returns a builder for creating a PluginTestCase entity.
+func (c *PluginTestCaseClient) Create() *PluginTestCaseCreate {
+	mutation := newPluginTestCaseMutation(c.config, OpCreate)
+	return &PluginTestCaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PluginTestCase entities.
+func (c *PluginTestCaseClient) CreateBulk(builders ...*PluginTestCaseCreate) *PluginTestCaseCreateBulk {
+	return &PluginTestCaseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PluginTestCaseClient) MapCreateBulk(slice any, setFunc func(*PluginTestCaseCreate, int)) *PluginTestCaseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PluginTestCaseCreateBulk{err: fmt.Errorf("calling to PluginTestCaseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PluginTestCaseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PluginTestCaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PluginTestCase.
+func (c *PluginTestCaseClient) Update() *PluginTestCaseUpdate {
+	mutation := newPluginTestCaseMutation(c.config, OpUpdate)
+	return &PluginTestCaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PluginTestCaseClient) UpdateOne(_m *PluginTestCase) *PluginTestCaseUpdateOne {
+	mutation := newPluginTestCaseMutation(c.config, OpUpdateOne, withPluginTestCase(_m))
+	return &PluginTestCaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PluginTestCaseClient) UpdateOneID(id int64) *PluginTestCaseUpdateOne {
+	mutation := newPluginTestCaseMutation(c.config, OpUpdateOne, withPluginTestCaseID(id))
+	return &PluginTestCaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PluginTestCase.
+func (c *PluginTestCaseClient) Delete() *PluginTestCaseDelete {
+	mutation := newPluginTestCaseMutation(c.config, OpDelete)
+	return &PluginTestCaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PluginTestCaseClient) DeleteOne(_m *PluginTestCase) *PluginTestCaseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PluginTestCaseClient) DeleteOneID(id int64) *PluginTestCaseDeleteOne {
+	builder := c.Delete().Where(plugintestcase.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PluginTestCaseDeleteOne{builder}
+}
+
+// Query returns a query builder for PluginTestCase.
+func (c *PluginTestCaseClient) Query() *PluginTestCaseQuery {
+	return &PluginTestCaseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePluginTestCase},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PluginTestCase entity by its id.
+func (c *PluginTestCaseClient) Get(ctx context.Context, id int64) (*PluginTestCase, error) {
+	return c.Query().Where(plugintestcase.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PluginTestCaseClient) GetX(ctx context.Context, id int64) *PluginTestCase {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlugin queries the plugin edge of a PluginTestCase.
+func (c *PluginTestCaseClient) QueryPlugin(_m *PluginTestCase) *PluginQuery {
+	query := (&PluginClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plugintestcase.Table, plugintestcase.FieldID, id),
+			sqlgraph.To(plugin.Table, plugin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, plugintestcase.PluginTable, plugintestcase.PluginColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PluginTestCaseClient) Hooks() []Hook {
+	return c.hooks.PluginTestCase
+}
+
+// Interceptors returns the client interceptors.
+func (c *PluginTestCaseClient) Interceptors() []Interceptor {
+	return c.inters.PluginTestCase
+}
+
+func (c *PluginTestCaseClient) mutate(ctx context.Context, m *PluginTestCaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PluginTestCaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PluginTestCaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PluginTestCaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PluginTestCaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PluginTestCase mutation op: %q", m.Op())
+	}
+}
+
+// ResourceLockClient is a client for the ResourceLock schema.
+type ResourceLockClient struct {
+	config
+}
+
+// NewResourceLockClient returns a client for the ResourceLock from the given config.
+func NewResourceLockClient(c config) *ResourceLockClient {
+	return &ResourceLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcelock.Hooks(f(g(h())))`.
+func (c *ResourceLockClient) Use(hooks ...Hook) {
+	c.hooks.ResourceLock = append(c.hooks.ResourceLock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resourcelock.Intercept(f(g(h())))`.
+func (c *ResourceLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceLock = append(c.inters.ResourceLock, interceptors...)
+}
+
+// Create returns a builder for creating a ResourceLock entity.
+func (c *ResourceLockClient) Create() *ResourceLockCreate {
+	mutation := newResourceLockMutation(c.config, OpCreate)
+	return &ResourceLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceLock entities.
+func (c *ResourceLockClient) CreateBulk(builders ...*ResourceLockCreate) *ResourceLockCreateBulk {
+	return &ResourceLockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceLockClient) MapCreateBulk(slice any, setFunc func(*ResourceLockCreate, int)) *ResourceLockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceLockCreateBulk{err: fmt.Errorf("calling to ResourceLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResourceLockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResourceLock.
+func (c *ResourceLockClient) Update() *ResourceLockUpdate {
+	mutation := newResourceLockMutation(c.config, OpUpdate)
+	return &ResourceLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceLockClient) UpdateOne(_m *ResourceLock) *ResourceLockUpdateOne {
+	mutation := newResourceLockMutation(c.config, OpUpdateOne, withResourceLock(_m))
+	return &ResourceLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceLockClient) UpdateOneID(id int64) *ResourceLockUpdateOne {
+	mutation := newResourceLockMutation(c.config, OpUpdateOne, withResourceLockID(id))
+	return &ResourceLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResourceLock.
+func (c *ResourceLockClient) Delete() *ResourceLockDelete {
+	mutation := newResourceLockMutation(c.config, OpDelete)
+	return &ResourceLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceLockClient) DeleteOne(_m *ResourceLock) *ResourceLockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceLockClient) DeleteOneID(id int64) *ResourceLockDeleteOne {
+	builder := c.Delete().Where(resourcelock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceLockDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceLock.
+func (c *ResourceLockClient) Query() *ResourceLockQuery {
+	return &ResourceLockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResourceLock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResourceLock entity by its id.
+func (c *ResourceLockClient) Get(ctx context.Context, id int64) (*ResourceLock, error) {
+	return c.Query().Where(resourcelock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceLockClient) GetX(ctx context.Context, id int64) *ResourceLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ResourceLockClient) Hooks() []Hook {
+	return c.hooks.ResourceLock
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResourceLockClient) Interceptors() []Interceptor {
+	return c.inters.ResourceLock
+}
+
+func (c *ResourceLockClient) mutate(ctx context.Context, m *ResourceLockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResourceLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResourceLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResourceLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResourceLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResourceLock mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1458,11 +2810,13 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CdKey, Env, EnvPlugin, LoginHistory, Panel, Plugin, PluginExecutionLog,
-		User []ent.Hook
+		CdKey, CdkLedger, CronTriggerLog, Env, EnvCronTrigger, EnvPanel, EnvPlugin,
+		EnvReplica, IdempotencyRecord, LoginHistory, Panel, PanelHealth, Plugin,
+		PluginExecutionLog, PluginTestCase, ResourceLock, User []ent.Hook
 	}
 	inters struct {
-		CdKey, Env, EnvPlugin, LoginHistory, Panel, Plugin, PluginExecutionLog,
-		User []ent.Interceptor
+		CdKey, CdkLedger, CronTriggerLog, Env, EnvCronTrigger, EnvPanel, EnvPlugin,
+		EnvReplica, IdempotencyRecord, LoginHistory, Panel, PanelHealth, Plugin,
+		PluginExecutionLog, PluginTestCase, ResourceLock, User []ent.Interceptor
 	}
 )
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
+)
+
+// CronTriggerLog is the model entity for the CronTriggerLog schema.
+type CronTriggerLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID int64 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 环境变量ID
+	EnvID int64 `json:"env_id,omitempty"`
+	// 面板ID
+	PanelID int64 `json:"panel_id,omitempty"`
+	// 触发配置ID
+	TriggerID int64 `json:"trigger_id,omitempty"`
+	// 已触发的定时任务ID(逗号分隔)
+	CronIds *string `json:"cron_ids,omitempty"`
+	// 触发状态(success,error,skipped)
+	Status string `json:"status,omitempty"`
+	// 结果说明
+	Message      *string `json:"message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CronTriggerLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case crontriggerlog.FieldID, crontriggerlog.FieldEnvID, crontriggerlog.FieldPanelID, crontriggerlog.FieldTriggerID:
+			values[i] = new(sql.NullInt64)
+		case crontriggerlog.FieldCronIds, crontriggerlog.FieldStatus, crontriggerlog.FieldMessage:
+			values[i] = new(sql.NullString)
+		case crontriggerlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CronTriggerLog fields.
+func (_m *CronTriggerLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case crontriggerlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case crontriggerlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case crontriggerlog.FieldEnvID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				_m.EnvID = value.Int64
+			}
+		case crontriggerlog.FieldPanelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field panel_id", values[i])
+			} else if value.Valid {
+				_m.PanelID = value.Int64
+			}
+		case crontriggerlog.FieldTriggerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_id", values[i])
+			} else if value.Valid {
+				_m.TriggerID = value.Int64
+			}
+		case crontriggerlog.FieldCronIds:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_ids", values[i])
+			} else if value.Valid {
+				_m.CronIds = new(string)
+				*_m.CronIds = value.String
+			}
+		case crontriggerlog.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case crontriggerlog.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = new(string)
+				*_m.Message = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CronTriggerLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *CronTriggerLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CronTriggerLog.
+// Note that you need to call CronTriggerLog.Unwrap() before calling this method if this CronTriggerLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CronTriggerLog) Update() *CronTriggerLogUpdateOne {
+	return NewCronTriggerLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CronTriggerLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CronTriggerLog) Unwrap() *CronTriggerLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CronTriggerLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CronTriggerLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("CronTriggerLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnvID))
+	builder.WriteString(", ")
+	builder.WriteString("panel_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PanelID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TriggerID))
+	builder.WriteString(", ")
+	if v := _m.CronIds; v != nil {
+		builder.WriteString("cron_ids=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.Message; v != nil {
+		builder.WriteString("message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CronTriggerLogs is a parsable slice of CronTriggerLog.
+type CronTriggerLogs []*CronTriggerLog
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package crontriggerlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the crontriggerlog type in the database.
+	Label = "cron_trigger_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldPanelID holds the string denoting the panel_id field in the database.
+	FieldPanelID = "panel_id"
+	// FieldTriggerID holds the string denoting the trigger_id field in the database.
+	FieldTriggerID = "trigger_id"
+	// FieldCronIds holds the string denoting the cron_ids field in the database.
+	FieldCronIds = "cron_ids"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// Table holds the table name of the crontriggerlog in the database.
+	Table = "cron_trigger_logs"
+)
+
+// Columns holds all SQL columns for crontriggerlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldEnvID,
+	FieldPanelID,
+	FieldTriggerID,
+	FieldCronIds,
+	FieldStatus,
+	FieldMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CronTriggerLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByPanelID orders the results by the panel_id field.
+func ByPanelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPanelID, opts...).ToFunc()
+}
+
+// ByTriggerID orders the results by the trigger_id field.
+func ByTriggerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerID, opts...).ToFunc()
+}
+
+// ByCronIds orders the results by the cron_ids field.
+func ByCronIds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCronIds, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
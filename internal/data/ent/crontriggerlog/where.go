@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package crontriggerlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldEnvID, v))
+}
+
+// PanelID applies equality check predicate on the "panel_id" field. It's identical to PanelIDEQ.
+func PanelID(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldPanelID, v))
+}
+
+// TriggerID applies equality check predicate on the "trigger_id" field. It's identical to TriggerIDEQ.
+func TriggerID(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldTriggerID, v))
+}
+
+// CronIds applies equality check predicate on the "cron_ids" field. It's identical to CronIdsEQ.
+func CronIds(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldCronIds, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldStatus, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldEnvID, v))
+}
+
+// PanelIDEQ applies the EQ predicate on the "panel_id" field.
+func PanelIDEQ(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldPanelID, v))
+}
+
+// PanelIDNEQ applies the NEQ predicate on the "panel_id" field.
+func PanelIDNEQ(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldPanelID, v))
+}
+
+// PanelIDIn applies the In predicate on the "panel_id" field.
+func PanelIDIn(vs ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldPanelID, vs...))
+}
+
+// PanelIDNotIn applies the NotIn predicate on the "panel_id" field.
+func PanelIDNotIn(vs ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldPanelID, vs...))
+}
+
+// PanelIDGT applies the GT predicate on the "panel_id" field.
+func PanelIDGT(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldPanelID, v))
+}
+
+// PanelIDGTE applies the GTE predicate on the "panel_id" field.
+func PanelIDGTE(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldPanelID, v))
+}
+
+// PanelIDLT applies the LT predicate on the "panel_id" field.
+func PanelIDLT(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldPanelID, v))
+}
+
+// PanelIDLTE applies the LTE predicate on the "panel_id" field.
+func PanelIDLTE(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldPanelID, v))
+}
+
+// TriggerIDEQ applies the EQ predicate on the "trigger_id" field.
+func TriggerIDEQ(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldTriggerID, v))
+}
+
+// TriggerIDNEQ applies the NEQ predicate on the "trigger_id" field.
+func TriggerIDNEQ(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldTriggerID, v))
+}
+
+// TriggerIDIn applies the In predicate on the "trigger_id" field.
+func TriggerIDIn(vs ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldTriggerID, vs...))
+}
+
+// TriggerIDNotIn applies the NotIn predicate on the "trigger_id" field.
+func TriggerIDNotIn(vs ...int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldTriggerID, vs...))
+}
+
+// TriggerIDGT applies the GT predicate on the "trigger_id" field.
+func TriggerIDGT(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldTriggerID, v))
+}
+
+// TriggerIDGTE applies the GTE predicate on the "trigger_id" field.
+func TriggerIDGTE(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldTriggerID, v))
+}
+
+// TriggerIDLT applies the LT predicate on the "trigger_id" field.
+func TriggerIDLT(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldTriggerID, v))
+}
+
+// TriggerIDLTE applies the LTE predicate on the "trigger_id" field.
+func TriggerIDLTE(v int64) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldTriggerID, v))
+}
+
+// CronIdsEQ applies the EQ predicate on the "cron_ids" field.
+func CronIdsEQ(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldCronIds, v))
+}
+
+// CronIdsNEQ applies the NEQ predicate on the "cron_ids" field.
+func CronIdsNEQ(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldCronIds, v))
+}
+
+// CronIdsIn applies the In predicate on the "cron_ids" field.
+func CronIdsIn(vs ...string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldCronIds, vs...))
+}
+
+// CronIdsNotIn applies the NotIn predicate on the "cron_ids" field.
+func CronIdsNotIn(vs ...string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldCronIds, vs...))
+}
+
+// CronIdsGT applies the GT predicate on the "cron_ids" field.
+func CronIdsGT(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldCronIds, v))
+}
+
+// CronIdsGTE applies the GTE predicate on the "cron_ids" field.
+func CronIdsGTE(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldCronIds, v))
+}
+
+// CronIdsLT applies the LT predicate on the "cron_ids" field.
+func CronIdsLT(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldCronIds, v))
+}
+
+// CronIdsLTE applies the LTE predicate on the "cron_ids" field.
+func CronIdsLTE(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldCronIds, v))
+}
+
+// CronIdsContains applies the Contains predicate on the "cron_ids" field.
+func CronIdsContains(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldContains(FieldCronIds, v))
+}
+
+// CronIdsHasPrefix applies the HasPrefix predicate on the "cron_ids" field.
+func CronIdsHasPrefix(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldHasPrefix(FieldCronIds, v))
+}
+
+// CronIdsHasSuffix applies the HasSuffix predicate on the "cron_ids" field.
+func CronIdsHasSuffix(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldHasSuffix(FieldCronIds, v))
+}
+
+// CronIdsIsNil applies the IsNil predicate on the "cron_ids" field.
+func CronIdsIsNil() predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIsNull(FieldCronIds))
+}
+
+// CronIdsNotNil applies the NotNil predicate on the "cron_ids" field.
+func CronIdsNotNil() predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotNull(FieldCronIds))
+}
+
+// CronIdsEqualFold applies the EqualFold predicate on the "cron_ids" field.
+func CronIdsEqualFold(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEqualFold(FieldCronIds, v))
+}
+
+// CronIdsContainsFold applies the ContainsFold predicate on the "cron_ids" field.
+func CronIdsContainsFold(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldContainsFold(FieldCronIds, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CronTriggerLog) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CronTriggerLog) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CronTriggerLog) predicate.CronTriggerLog {
+	return predicate.CronTriggerLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
+)
+
+// CronTriggerLogCreate is the builder for creating a CronTriggerLog entity.
+type CronTriggerLogCreate struct {
+	config
+	mutation *CronTriggerLogMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CronTriggerLogCreate) SetCreatedAt(v time.Time) *CronTriggerLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CronTriggerLogCreate) SetNillableCreatedAt(v *time.Time) *CronTriggerLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnvID sets the "env_id" field.
+func (_c *CronTriggerLogCreate) SetEnvID(v int64) *CronTriggerLogCreate {
+	_c.mutation.SetEnvID(v)
+	return _c
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_c *CronTriggerLogCreate) SetPanelID(v int64) *CronTriggerLogCreate {
+	_c.mutation.SetPanelID(v)
+	return _c
+}
+
+// SetTriggerID sets the "trigger_id" field.
+func (_c *CronTriggerLogCreate) SetTriggerID(v int64) *CronTriggerLogCreate {
+	_c.mutation.SetTriggerID(v)
+	return _c
+}
+
+// SetCronIds sets the "cron_ids" field.
+func (_c *CronTriggerLogCreate) SetCronIds(v string) *CronTriggerLogCreate {
+	_c.mutation.SetCronIds(v)
+	return _c
+}
+
+// SetNillableCronIds sets the "cron_ids" field if the given value is not nil.
+func (_c *CronTriggerLogCreate) SetNillableCronIds(v *string) *CronTriggerLogCreate {
+	if v != nil {
+		_c.SetCronIds(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CronTriggerLogCreate) SetStatus(v string) *CronTriggerLogCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *CronTriggerLogCreate) SetMessage(v string) *CronTriggerLogCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *CronTriggerLogCreate) SetNillableMessage(v *string) *CronTriggerLogCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CronTriggerLogCreate) SetID(v int64) *CronTriggerLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CronTriggerLogMutation object of the builder.
+func (_c *CronTriggerLogCreate) Mutation() *CronTriggerLogMutation {
+	return _c.mutation
+}
+
+// Save creates the CronTriggerLog in the database.
+func (_c *CronTriggerLogCreate) Save(ctx context.Context) (*CronTriggerLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CronTriggerLogCreate) SaveX(ctx context.Context) *CronTriggerLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CronTriggerLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CronTriggerLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CronTriggerLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := crontriggerlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CronTriggerLogCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CronTriggerLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`ent: missing required field "CronTriggerLog.env_id"`)}
+	}
+	if _, ok := _c.mutation.PanelID(); !ok {
+		return &ValidationError{Name: "panel_id", err: errors.New(`ent: missing required field "CronTriggerLog.panel_id"`)}
+	}
+	if _, ok := _c.mutation.TriggerID(); !ok {
+		return &ValidationError{Name: "trigger_id", err: errors.New(`ent: missing required field "CronTriggerLog.trigger_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CronTriggerLog.status"`)}
+	}
+	return nil
+}
+
+func (_c *CronTriggerLogCreate) sqlSave(ctx context.Context) (*CronTriggerLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CronTriggerLogCreate) createSpec() (*CronTriggerLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CronTriggerLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(crontriggerlog.Table, sqlgraph.NewFieldSpec(crontriggerlog.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(crontriggerlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.EnvID(); ok {
+		_spec.SetField(crontriggerlog.FieldEnvID, field.TypeInt64, value)
+		_node.EnvID = value
+	}
+	if value, ok := _c.mutation.PanelID(); ok {
+		_spec.SetField(crontriggerlog.FieldPanelID, field.TypeInt64, value)
+		_node.PanelID = value
+	}
+	if value, ok := _c.mutation.TriggerID(); ok {
+		_spec.SetField(crontriggerlog.FieldTriggerID, field.TypeInt64, value)
+		_node.TriggerID = value
+	}
+	if value, ok := _c.mutation.CronIds(); ok {
+		_spec.SetField(crontriggerlog.FieldCronIds, field.TypeString, value)
+		_node.CronIds = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(crontriggerlog.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(crontriggerlog.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	return _node, _spec
+}
+
+// CronTriggerLogCreateBulk is the builder for creating many CronTriggerLog entities in bulk.
+type CronTriggerLogCreateBulk struct {
+	config
+	err      error
+	builders []*CronTriggerLogCreate
+}
+
+// Save creates the CronTriggerLog entities in the database.
+func (_c *CronTriggerLogCreateBulk) Save(ctx context.Context) ([]*CronTriggerLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CronTriggerLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CronTriggerLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CronTriggerLogCreateBulk) SaveX(ctx context.Context) []*CronTriggerLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CronTriggerLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CronTriggerLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// CronTriggerLogUpdate is the builder for updating CronTriggerLog entities.
+type CronTriggerLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CronTriggerLogMutation
+}
+
+// Where appends a list predicates to the CronTriggerLogUpdate builder.
+func (_u *CronTriggerLogUpdate) Where(ps ...predicate.CronTriggerLog) *CronTriggerLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *CronTriggerLogUpdate) SetEnvID(v int64) *CronTriggerLogUpdate {
+	_u.mutation.ResetEnvID()
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *CronTriggerLogUpdate) SetNillableEnvID(v *int64) *CronTriggerLogUpdate {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// AddEnvID adds value to the "env_id" field.
+func (_u *CronTriggerLogUpdate) AddEnvID(v int64) *CronTriggerLogUpdate {
+	_u.mutation.AddEnvID(v)
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *CronTriggerLogUpdate) SetPanelID(v int64) *CronTriggerLogUpdate {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *CronTriggerLogUpdate) SetNillablePanelID(v *int64) *CronTriggerLogUpdate {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *CronTriggerLogUpdate) AddPanelID(v int64) *CronTriggerLogUpdate {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetTriggerID sets the "trigger_id" field.
+func (_u *CronTriggerLogUpdate) SetTriggerID(v int64) *CronTriggerLogUpdate {
+	_u.mutation.ResetTriggerID()
+	_u.mutation.SetTriggerID(v)
+	return _u
+}
+
+// SetNillableTriggerID sets the "trigger_id" field if the given value is not nil.
+func (_u *CronTriggerLogUpdate) SetNillableTriggerID(v *int64) *CronTriggerLogUpdate {
+	if v != nil {
+		_u.SetTriggerID(*v)
+	}
+	return _u
+}
+
+// AddTriggerID adds value to the "trigger_id" field.
+func (_u *CronTriggerLogUpdate) AddTriggerID(v int64) *CronTriggerLogUpdate {
+	_u.mutation.AddTriggerID(v)
+	return _u
+}
+
+// SetCronIds sets the "cron_ids" field.
+func (_u *CronTriggerLogUpdate) SetCronIds(v string) *CronTriggerLogUpdate {
+	_u.mutation.SetCronIds(v)
+	return _u
+}
+
+// SetNillableCronIds sets the "cron_ids" field if the given value is not nil.
+func (_u *CronTriggerLogUpdate) SetNillableCronIds(v *string) *CronTriggerLogUpdate {
+	if v != nil {
+		_u.SetCronIds(*v)
+	}
+	return _u
+}
+
+// ClearCronIds clears the value of the "cron_ids" field.
+func (_u *CronTriggerLogUpdate) ClearCronIds() *CronTriggerLogUpdate {
+	_u.mutation.ClearCronIds()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CronTriggerLogUpdate) SetStatus(v string) *CronTriggerLogUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CronTriggerLogUpdate) SetNillableStatus(v *string) *CronTriggerLogUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *CronTriggerLogUpdate) SetMessage(v string) *CronTriggerLogUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *CronTriggerLogUpdate) SetNillableMessage(v *string) *CronTriggerLogUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *CronTriggerLogUpdate) ClearMessage() *CronTriggerLogUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Mutation returns the CronTriggerLogMutation object of the builder.
+func (_u *CronTriggerLogUpdate) Mutation() *CronTriggerLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CronTriggerLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CronTriggerLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CronTriggerLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CronTriggerLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CronTriggerLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(crontriggerlog.Table, crontriggerlog.Columns, sqlgraph.NewFieldSpec(crontriggerlog.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EnvID(); ok {
+		_spec.SetField(crontriggerlog.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEnvID(); ok {
+		_spec.AddField(crontriggerlog.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(crontriggerlog.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(crontriggerlog.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TriggerID(); ok {
+		_spec.SetField(crontriggerlog.FieldTriggerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTriggerID(); ok {
+		_spec.AddField(crontriggerlog.FieldTriggerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CronIds(); ok {
+		_spec.SetField(crontriggerlog.FieldCronIds, field.TypeString, value)
+	}
+	if _u.mutation.CronIdsCleared() {
+		_spec.ClearField(crontriggerlog.FieldCronIds, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(crontriggerlog.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(crontriggerlog.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(crontriggerlog.FieldMessage, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{crontriggerlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CronTriggerLogUpdateOne is the builder for updating a single CronTriggerLog entity.
+type CronTriggerLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CronTriggerLogMutation
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *CronTriggerLogUpdateOne) SetEnvID(v int64) *CronTriggerLogUpdateOne {
+	_u.mutation.ResetEnvID()
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *CronTriggerLogUpdateOne) SetNillableEnvID(v *int64) *CronTriggerLogUpdateOne {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// AddEnvID adds value to the "env_id" field.
+func (_u *CronTriggerLogUpdateOne) AddEnvID(v int64) *CronTriggerLogUpdateOne {
+	_u.mutation.AddEnvID(v)
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *CronTriggerLogUpdateOne) SetPanelID(v int64) *CronTriggerLogUpdateOne {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *CronTriggerLogUpdateOne) SetNillablePanelID(v *int64) *CronTriggerLogUpdateOne {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *CronTriggerLogUpdateOne) AddPanelID(v int64) *CronTriggerLogUpdateOne {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetTriggerID sets the "trigger_id" field.
+func (_u *CronTriggerLogUpdateOne) SetTriggerID(v int64) *CronTriggerLogUpdateOne {
+	_u.mutation.ResetTriggerID()
+	_u.mutation.SetTriggerID(v)
+	return _u
+}
+
+// SetNillableTriggerID sets the "trigger_id" field if the given value is not nil.
+func (_u *CronTriggerLogUpdateOne) SetNillableTriggerID(v *int64) *CronTriggerLogUpdateOne {
+	if v != nil {
+		_u.SetTriggerID(*v)
+	}
+	return _u
+}
+
+// AddTriggerID adds value to the "trigger_id" field.
+func (_u *CronTriggerLogUpdateOne) AddTriggerID(v int64) *CronTriggerLogUpdateOne {
+	_u.mutation.AddTriggerID(v)
+	return _u
+}
+
+// SetCronIds sets the "cron_ids" field.
+func (_u *CronTriggerLogUpdateOne) SetCronIds(v string) *CronTriggerLogUpdateOne {
+	_u.mutation.SetCronIds(v)
+	return _u
+}
+
+// SetNillableCronIds sets the "cron_ids" field if the given value is not nil.
+func (_u *CronTriggerLogUpdateOne) SetNillableCronIds(v *string) *CronTriggerLogUpdateOne {
+	if v != nil {
+		_u.SetCronIds(*v)
+	}
+	return _u
+}
+
+// ClearCronIds clears the value of the "cron_ids" field.
+func (_u *CronTriggerLogUpdateOne) ClearCronIds() *CronTriggerLogUpdateOne {
+	_u.mutation.ClearCronIds()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CronTriggerLogUpdateOne) SetStatus(v string) *CronTriggerLogUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CronTriggerLogUpdateOne) SetNillableStatus(v *string) *CronTriggerLogUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *CronTriggerLogUpdateOne) SetMessage(v string) *CronTriggerLogUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *CronTriggerLogUpdateOne) SetNillableMessage(v *string) *CronTriggerLogUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *CronTriggerLogUpdateOne) ClearMessage() *CronTriggerLogUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Mutation returns the CronTriggerLogMutation object of the builder.
+func (_u *CronTriggerLogUpdateOne) Mutation() *CronTriggerLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CronTriggerLogUpdate builder.
+func (_u *CronTriggerLogUpdateOne) Where(ps ...predicate.CronTriggerLog) *CronTriggerLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CronTriggerLogUpdateOne) Select(field string, fields ...string) *CronTriggerLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CronTriggerLog entity.
+func (_u *CronTriggerLogUpdateOne) Save(ctx context.Context) (*CronTriggerLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CronTriggerLogUpdateOne) SaveX(ctx context.Context) *CronTriggerLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CronTriggerLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CronTriggerLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CronTriggerLogUpdateOne) sqlSave(ctx context.Context) (_node *CronTriggerLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(crontriggerlog.Table, crontriggerlog.Columns, sqlgraph.NewFieldSpec(crontriggerlog.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CronTriggerLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, crontriggerlog.FieldID)
+		for _, f := range fields {
+			if !crontriggerlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != crontriggerlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EnvID(); ok {
+		_spec.SetField(crontriggerlog.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEnvID(); ok {
+		_spec.AddField(crontriggerlog.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(crontriggerlog.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(crontriggerlog.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TriggerID(); ok {
+		_spec.SetField(crontriggerlog.FieldTriggerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTriggerID(); ok {
+		_spec.AddField(crontriggerlog.FieldTriggerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CronIds(); ok {
+		_spec.SetField(crontriggerlog.FieldCronIds, field.TypeString, value)
+	}
+	if _u.mutation.CronIdsCleared() {
+		_spec.ClearField(crontriggerlog.FieldCronIds, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(crontriggerlog.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(crontriggerlog.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(crontriggerlog.FieldMessage, field.TypeString)
+	}
+	_node = &CronTriggerLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{crontriggerlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,12 +13,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkey"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/idempotencyrecord"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/loginhistory"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/resourcelock"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/user"
 )
 
@@ -81,12 +90,21 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			cdkey.Table:              cdkey.ValidColumn,
+			cdkledger.Table:          cdkledger.ValidColumn,
+			crontriggerlog.Table:     crontriggerlog.ValidColumn,
 			env.Table:                env.ValidColumn,
+			envcrontrigger.Table:     envcrontrigger.ValidColumn,
+			envpanel.Table:           envpanel.ValidColumn,
 			envplugin.Table:          envplugin.ValidColumn,
+			envreplica.Table:         envreplica.ValidColumn,
+			idempotencyrecord.Table:  idempotencyrecord.ValidColumn,
 			loginhistory.Table:       loginhistory.ValidColumn,
 			panel.Table:              panel.ValidColumn,
+			panelhealth.Table:        panelhealth.ValidColumn,
 			plugin.Table:             plugin.ValidColumn,
 			pluginexecutionlog.Table: pluginexecutionlog.ValidColumn,
+			plugintestcase.Table:     plugintestcase.ValidColumn,
+			resourcelock.Table:       resourcelock.ValidColumn,
 			user.Table:               user.ValidColumn,
 		})
 	})
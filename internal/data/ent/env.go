@@ -48,6 +48,22 @@ type Env struct {
 	PromptContent *string `json:"prompt_content,omitempty"`
 	// 是否启用
 	IsEnable bool `json:"is_enable,omitempty"`
+	// 新建模式面板选择策略
+	SelectStrategy string `json:"select_strategy,omitempty"`
+	// 副本模式写入的面板数量(0表示所有绑定面板)
+	Replicas int32 `json:"replicas,omitempty"`
+	// 更新模式的更新范围(first,all,dedupe)
+	UpdateScope string `json:"update_scope,omitempty"`
+	// 变量值模板，使用匹配正则的命名分组重新组装变量值
+	ValueTemplate *string `json:"value_template,omitempty"`
+	// 匹配键模板，使用更新正则的命名分组组装更新模式下的变量标识
+	MatchKeyTemplate *string `json:"match_key_template,omitempty"`
+	// 批量提交时的分隔符，按整个字符串拆分(为空时按换行分隔)
+	SplitBy string `json:"split_by,omitempty"`
+	// 新建模式重复检测方式(none,value,key)
+	DedupBy string `json:"dedup_by,omitempty"`
+	// 检测到重复时的处理方式(reject,update,allow)
+	DedupAction string `json:"dedup_action,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvQuery when eager-loading is set.
 	Edges        EnvEdges `json:"edges"`
@@ -60,9 +76,13 @@ type EnvEdges struct {
 	Panels []*Panel `json:"panels,omitempty"`
 	// EnvPlugins holds the value of the env_plugins edge.
 	EnvPlugins []*EnvPlugin `json:"env_plugins,omitempty"`
+	// CronTriggers holds the value of the cron_triggers edge.
+	CronTriggers []*EnvCronTrigger `json:"cron_triggers,omitempty"`
+	// EnvPanels holds the value of the env_panels edge.
+	EnvPanels []*EnvPanel `json:"env_panels,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // PanelsOrErr returns the Panels value or an error if the edge
@@ -83,6 +103,24 @@ func (e EnvEdges) EnvPluginsOrErr() ([]*EnvPlugin, error) {
 	return nil, &NotLoadedError{edge: "env_plugins"}
 }
 
+// CronTriggersOrErr returns the CronTriggers value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvEdges) CronTriggersOrErr() ([]*EnvCronTrigger, error) {
+	if e.loadedTypes[2] {
+		return e.CronTriggers, nil
+	}
+	return nil, &NotLoadedError{edge: "cron_triggers"}
+}
+
+// EnvPanelsOrErr returns the EnvPanels value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvEdges) EnvPanelsOrErr() ([]*EnvPanel, error) {
+	if e.loadedTypes[3] {
+		return e.EnvPanels, nil
+	}
+	return nil, &NotLoadedError{edge: "env_panels"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Env) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -90,9 +128,9 @@ func (*Env) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case env.FieldIsAutoEnvEnable, env.FieldEnableKey, env.FieldIsPrompt, env.FieldIsEnable:
 			values[i] = new(sql.NullBool)
-		case env.FieldID, env.FieldQuantity, env.FieldMode, env.FieldCdkLimit:
+		case env.FieldID, env.FieldQuantity, env.FieldMode, env.FieldCdkLimit, env.FieldReplicas:
 			values[i] = new(sql.NullInt64)
-		case env.FieldName, env.FieldRemarks, env.FieldRegex, env.FieldRegexUpdate, env.FieldPromptLevel, env.FieldPromptContent:
+		case env.FieldName, env.FieldRemarks, env.FieldRegex, env.FieldRegexUpdate, env.FieldPromptLevel, env.FieldPromptContent, env.FieldSelectStrategy, env.FieldUpdateScope, env.FieldValueTemplate, env.FieldMatchKeyTemplate, env.FieldSplitBy, env.FieldDedupBy, env.FieldDedupAction:
 			values[i] = new(sql.NullString)
 		case env.FieldCreatedAt, env.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -212,6 +250,56 @@ func (_m *Env) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsEnable = value.Bool
 			}
+		case env.FieldSelectStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field select_strategy", values[i])
+			} else if value.Valid {
+				_m.SelectStrategy = value.String
+			}
+		case env.FieldReplicas:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replicas", values[i])
+			} else if value.Valid {
+				_m.Replicas = int32(value.Int64)
+			}
+		case env.FieldUpdateScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field update_scope", values[i])
+			} else if value.Valid {
+				_m.UpdateScope = value.String
+			}
+		case env.FieldValueTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value_template", values[i])
+			} else if value.Valid {
+				_m.ValueTemplate = new(string)
+				*_m.ValueTemplate = value.String
+			}
+		case env.FieldMatchKeyTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_key_template", values[i])
+			} else if value.Valid {
+				_m.MatchKeyTemplate = new(string)
+				*_m.MatchKeyTemplate = value.String
+			}
+		case env.FieldSplitBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field split_by", values[i])
+			} else if value.Valid {
+				_m.SplitBy = value.String
+			}
+		case env.FieldDedupBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_by", values[i])
+			} else if value.Valid {
+				_m.DedupBy = value.String
+			}
+		case env.FieldDedupAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_action", values[i])
+			} else if value.Valid {
+				_m.DedupAction = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -235,6 +323,16 @@ func (_m *Env) QueryEnvPlugins() *EnvPluginQuery {
 	return NewEnvClient(_m.config).QueryEnvPlugins(_m)
 }
 
+// QueryCronTriggers queries the "cron_triggers" edge of the Env entity.
+func (_m *Env) QueryCronTriggers() *EnvCronTriggerQuery {
+	return NewEnvClient(_m.config).QueryCronTriggers(_m)
+}
+
+// QueryEnvPanels queries the "env_panels" edge of the Env entity.
+func (_m *Env) QueryEnvPanels() *EnvPanelQuery {
+	return NewEnvClient(_m.config).QueryEnvPanels(_m)
+}
+
 // Update returns a builder for updating this Env.
 // Note that you need to call Env.Unwrap() before calling this method if this Env
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -312,6 +410,34 @@ func (_m *Env) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_enable=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsEnable))
+	builder.WriteString(", ")
+	builder.WriteString("select_strategy=")
+	builder.WriteString(_m.SelectStrategy)
+	builder.WriteString(", ")
+	builder.WriteString("replicas=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Replicas))
+	builder.WriteString(", ")
+	builder.WriteString("update_scope=")
+	builder.WriteString(_m.UpdateScope)
+	builder.WriteString(", ")
+	if v := _m.ValueTemplate; v != nil {
+		builder.WriteString("value_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MatchKeyTemplate; v != nil {
+		builder.WriteString("match_key_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("split_by=")
+	builder.WriteString(_m.SplitBy)
+	builder.WriteString(", ")
+	builder.WriteString("dedup_by=")
+	builder.WriteString(_m.DedupBy)
+	builder.WriteString(", ")
+	builder.WriteString("dedup_action=")
+	builder.WriteString(_m.DedupAction)
 	builder.WriteByte(')')
 	return builder.String()
 }
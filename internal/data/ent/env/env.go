@@ -44,10 +44,30 @@ const (
 	FieldPromptContent = "prompt_content"
 	// FieldIsEnable holds the string denoting the is_enable field in the database.
 	FieldIsEnable = "is_enable"
+	// FieldSelectStrategy holds the string denoting the select_strategy field in the database.
+	FieldSelectStrategy = "select_strategy"
+	// FieldReplicas holds the string denoting the replicas field in the database.
+	FieldReplicas = "replicas"
+	// FieldUpdateScope holds the string denoting the update_scope field in the database.
+	FieldUpdateScope = "update_scope"
+	// FieldValueTemplate holds the string denoting the value_template field in the database.
+	FieldValueTemplate = "value_template"
+	// FieldMatchKeyTemplate holds the string denoting the match_key_template field in the database.
+	FieldMatchKeyTemplate = "match_key_template"
+	// FieldSplitBy holds the string denoting the split_by field in the database.
+	FieldSplitBy = "split_by"
+	// FieldDedupBy holds the string denoting the dedup_by field in the database.
+	FieldDedupBy = "dedup_by"
+	// FieldDedupAction holds the string denoting the dedup_action field in the database.
+	FieldDedupAction = "dedup_action"
 	// EdgePanels holds the string denoting the panels edge name in mutations.
 	EdgePanels = "panels"
 	// EdgeEnvPlugins holds the string denoting the env_plugins edge name in mutations.
 	EdgeEnvPlugins = "env_plugins"
+	// EdgeCronTriggers holds the string denoting the cron_triggers edge name in mutations.
+	EdgeCronTriggers = "cron_triggers"
+	// EdgeEnvPanels holds the string denoting the env_panels edge name in mutations.
+	EdgeEnvPanels = "env_panels"
 	// Table holds the table name of the env in the database.
 	Table = "envs"
 	// PanelsTable is the table that holds the panels relation/edge. The primary key declared below.
@@ -62,6 +82,20 @@ const (
 	EnvPluginsInverseTable = "env_plugins"
 	// EnvPluginsColumn is the table column denoting the env_plugins relation/edge.
 	EnvPluginsColumn = "env_id"
+	// CronTriggersTable is the table that holds the cron_triggers relation/edge.
+	CronTriggersTable = "env_cron_triggers"
+	// CronTriggersInverseTable is the table name for the EnvCronTrigger entity.
+	// It exists in this package in order to avoid circular dependency with the "envcrontrigger" package.
+	CronTriggersInverseTable = "env_cron_triggers"
+	// CronTriggersColumn is the table column denoting the cron_triggers relation/edge.
+	CronTriggersColumn = "env_id"
+	// EnvPanelsTable is the table that holds the env_panels relation/edge.
+	EnvPanelsTable = "env_panels"
+	// EnvPanelsInverseTable is the table name for the EnvPanel entity.
+	// It exists in this package in order to avoid circular dependency with the "envpanel" package.
+	EnvPanelsInverseTable = "env_panels"
+	// EnvPanelsColumn is the table column denoting the env_panels relation/edge.
+	EnvPanelsColumn = "env_id"
 )
 
 // Columns holds all SQL columns for env fields.
@@ -82,6 +116,14 @@ var Columns = []string{
 	FieldPromptLevel,
 	FieldPromptContent,
 	FieldIsEnable,
+	FieldSelectStrategy,
+	FieldReplicas,
+	FieldUpdateScope,
+	FieldValueTemplate,
+	FieldMatchKeyTemplate,
+	FieldSplitBy,
+	FieldDedupBy,
+	FieldDedupAction,
 }
 
 var (
@@ -113,6 +155,18 @@ var (
 	DefaultIsAutoEnvEnable bool
 	// DefaultCdkLimit holds the default value on creation for the "cdk_limit" field.
 	DefaultCdkLimit int32
+	// DefaultSelectStrategy holds the default value on creation for the "select_strategy" field.
+	DefaultSelectStrategy string
+	// DefaultReplicas holds the default value on creation for the "replicas" field.
+	DefaultReplicas int32
+	// DefaultUpdateScope holds the default value on creation for the "update_scope" field.
+	DefaultUpdateScope string
+	// DefaultSplitBy holds the default value on creation for the "split_by" field.
+	DefaultSplitBy string
+	// DefaultDedupBy holds the default value on creation for the "dedup_by" field.
+	DefaultDedupBy string
+	// DefaultDedupAction holds the default value on creation for the "dedup_action" field.
+	DefaultDedupAction string
 )
 
 // OrderOption defines the ordering options for the Env queries.
@@ -198,6 +252,46 @@ func ByIsEnable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsEnable, opts...).ToFunc()
 }
 
+// BySelectStrategy orders the results by the select_strategy field.
+func BySelectStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelectStrategy, opts...).ToFunc()
+}
+
+// ByReplicas orders the results by the replicas field.
+func ByReplicas(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplicas, opts...).ToFunc()
+}
+
+// ByUpdateScope orders the results by the update_scope field.
+func ByUpdateScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateScope, opts...).ToFunc()
+}
+
+// ByValueTemplate orders the results by the value_template field.
+func ByValueTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValueTemplate, opts...).ToFunc()
+}
+
+// ByMatchKeyTemplate orders the results by the match_key_template field.
+func ByMatchKeyTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchKeyTemplate, opts...).ToFunc()
+}
+
+// BySplitBy orders the results by the split_by field.
+func BySplitBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitBy, opts...).ToFunc()
+}
+
+// ByDedupBy orders the results by the dedup_by field.
+func ByDedupBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupBy, opts...).ToFunc()
+}
+
+// ByDedupAction orders the results by the dedup_action field.
+func ByDedupAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupAction, opts...).ToFunc()
+}
+
 // ByPanelsCount orders the results by panels count.
 func ByPanelsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -225,6 +319,34 @@ func ByEnvPlugins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEnvPluginsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCronTriggersCount orders the results by cron_triggers count.
+func ByCronTriggersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCronTriggersStep(), opts...)
+	}
+}
+
+// ByCronTriggers orders the results by cron_triggers terms.
+func ByCronTriggers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCronTriggersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnvPanelsCount orders the results by env_panels count.
+func ByEnvPanelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnvPanelsStep(), opts...)
+	}
+}
+
+// ByEnvPanels orders the results by env_panels terms.
+func ByEnvPanels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvPanelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPanelsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -239,3 +361,17 @@ func newEnvPluginsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EnvPluginsTable, EnvPluginsColumn),
 	)
 }
+func newCronTriggersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CronTriggersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CronTriggersTable, CronTriggersColumn),
+	)
+}
+func newEnvPanelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvPanelsInverseTable, EnvPanelsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, EnvPanelsTable, EnvPanelsColumn),
+	)
+}
@@ -130,6 +130,46 @@ func IsEnable(v bool) predicate.Env {
 	return predicate.Env(sql.FieldEQ(FieldIsEnable, v))
 }
 
+// SelectStrategy applies equality check predicate on the "select_strategy" field. It's identical to SelectStrategyEQ.
+func SelectStrategy(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSelectStrategy, v))
+}
+
+// Replicas applies equality check predicate on the "replicas" field. It's identical to ReplicasEQ.
+func Replicas(v int32) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldReplicas, v))
+}
+
+// UpdateScope applies equality check predicate on the "update_scope" field. It's identical to UpdateScopeEQ.
+func UpdateScope(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldUpdateScope, v))
+}
+
+// ValueTemplate applies equality check predicate on the "value_template" field. It's identical to ValueTemplateEQ.
+func ValueTemplate(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldValueTemplate, v))
+}
+
+// MatchKeyTemplate applies equality check predicate on the "match_key_template" field. It's identical to MatchKeyTemplateEQ.
+func MatchKeyTemplate(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldMatchKeyTemplate, v))
+}
+
+// SplitBy applies equality check predicate on the "split_by" field. It's identical to SplitByEQ.
+func SplitBy(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSplitBy, v))
+}
+
+// DedupBy applies equality check predicate on the "dedup_by" field. It's identical to DedupByEQ.
+func DedupBy(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldDedupBy, v))
+}
+
+// DedupAction applies equality check predicate on the "dedup_action" field. It's identical to DedupActionEQ.
+func DedupAction(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldDedupAction, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Env {
 	return predicate.Env(sql.FieldEQ(FieldCreatedAt, v))
@@ -810,6 +850,521 @@ func IsEnableNEQ(v bool) predicate.Env {
 	return predicate.Env(sql.FieldNEQ(FieldIsEnable, v))
 }
 
+// SelectStrategyEQ applies the EQ predicate on the "select_strategy" field.
+func SelectStrategyEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSelectStrategy, v))
+}
+
+// SelectStrategyNEQ applies the NEQ predicate on the "select_strategy" field.
+func SelectStrategyNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldSelectStrategy, v))
+}
+
+// SelectStrategyIn applies the In predicate on the "select_strategy" field.
+func SelectStrategyIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldSelectStrategy, vs...))
+}
+
+// SelectStrategyNotIn applies the NotIn predicate on the "select_strategy" field.
+func SelectStrategyNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldSelectStrategy, vs...))
+}
+
+// SelectStrategyGT applies the GT predicate on the "select_strategy" field.
+func SelectStrategyGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldSelectStrategy, v))
+}
+
+// SelectStrategyGTE applies the GTE predicate on the "select_strategy" field.
+func SelectStrategyGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldSelectStrategy, v))
+}
+
+// SelectStrategyLT applies the LT predicate on the "select_strategy" field.
+func SelectStrategyLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldSelectStrategy, v))
+}
+
+// SelectStrategyLTE applies the LTE predicate on the "select_strategy" field.
+func SelectStrategyLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldSelectStrategy, v))
+}
+
+// SelectStrategyContains applies the Contains predicate on the "select_strategy" field.
+func SelectStrategyContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldSelectStrategy, v))
+}
+
+// SelectStrategyHasPrefix applies the HasPrefix predicate on the "select_strategy" field.
+func SelectStrategyHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldSelectStrategy, v))
+}
+
+// SelectStrategyHasSuffix applies the HasSuffix predicate on the "select_strategy" field.
+func SelectStrategyHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldSelectStrategy, v))
+}
+
+// SelectStrategyEqualFold applies the EqualFold predicate on the "select_strategy" field.
+func SelectStrategyEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldSelectStrategy, v))
+}
+
+// SelectStrategyContainsFold applies the ContainsFold predicate on the "select_strategy" field.
+func SelectStrategyContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldSelectStrategy, v))
+}
+
+// ReplicasEQ applies the EQ predicate on the "replicas" field.
+func ReplicasEQ(v int32) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldReplicas, v))
+}
+
+// ReplicasNEQ applies the NEQ predicate on the "replicas" field.
+func ReplicasNEQ(v int32) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldReplicas, v))
+}
+
+// ReplicasIn applies the In predicate on the "replicas" field.
+func ReplicasIn(vs ...int32) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldReplicas, vs...))
+}
+
+// ReplicasNotIn applies the NotIn predicate on the "replicas" field.
+func ReplicasNotIn(vs ...int32) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldReplicas, vs...))
+}
+
+// ReplicasGT applies the GT predicate on the "replicas" field.
+func ReplicasGT(v int32) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldReplicas, v))
+}
+
+// ReplicasGTE applies the GTE predicate on the "replicas" field.
+func ReplicasGTE(v int32) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldReplicas, v))
+}
+
+// ReplicasLT applies the LT predicate on the "replicas" field.
+func ReplicasLT(v int32) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldReplicas, v))
+}
+
+// ReplicasLTE applies the LTE predicate on the "replicas" field.
+func ReplicasLTE(v int32) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldReplicas, v))
+}
+
+// UpdateScopeEQ applies the EQ predicate on the "update_scope" field.
+func UpdateScopeEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldUpdateScope, v))
+}
+
+// UpdateScopeNEQ applies the NEQ predicate on the "update_scope" field.
+func UpdateScopeNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldUpdateScope, v))
+}
+
+// UpdateScopeIn applies the In predicate on the "update_scope" field.
+func UpdateScopeIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldUpdateScope, vs...))
+}
+
+// UpdateScopeNotIn applies the NotIn predicate on the "update_scope" field.
+func UpdateScopeNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldUpdateScope, vs...))
+}
+
+// UpdateScopeGT applies the GT predicate on the "update_scope" field.
+func UpdateScopeGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldUpdateScope, v))
+}
+
+// UpdateScopeGTE applies the GTE predicate on the "update_scope" field.
+func UpdateScopeGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldUpdateScope, v))
+}
+
+// UpdateScopeLT applies the LT predicate on the "update_scope" field.
+func UpdateScopeLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldUpdateScope, v))
+}
+
+// UpdateScopeLTE applies the LTE predicate on the "update_scope" field.
+func UpdateScopeLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldUpdateScope, v))
+}
+
+// UpdateScopeContains applies the Contains predicate on the "update_scope" field.
+func UpdateScopeContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldUpdateScope, v))
+}
+
+// UpdateScopeHasPrefix applies the HasPrefix predicate on the "update_scope" field.
+func UpdateScopeHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldUpdateScope, v))
+}
+
+// UpdateScopeHasSuffix applies the HasSuffix predicate on the "update_scope" field.
+func UpdateScopeHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldUpdateScope, v))
+}
+
+// UpdateScopeEqualFold applies the EqualFold predicate on the "update_scope" field.
+func UpdateScopeEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldUpdateScope, v))
+}
+
+// UpdateScopeContainsFold applies the ContainsFold predicate on the "update_scope" field.
+func UpdateScopeContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldUpdateScope, v))
+}
+
+// ValueTemplateEQ applies the EQ predicate on the "value_template" field.
+func ValueTemplateEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldValueTemplate, v))
+}
+
+// ValueTemplateNEQ applies the NEQ predicate on the "value_template" field.
+func ValueTemplateNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldValueTemplate, v))
+}
+
+// ValueTemplateIn applies the In predicate on the "value_template" field.
+func ValueTemplateIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldValueTemplate, vs...))
+}
+
+// ValueTemplateNotIn applies the NotIn predicate on the "value_template" field.
+func ValueTemplateNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldValueTemplate, vs...))
+}
+
+// ValueTemplateGT applies the GT predicate on the "value_template" field.
+func ValueTemplateGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldValueTemplate, v))
+}
+
+// ValueTemplateGTE applies the GTE predicate on the "value_template" field.
+func ValueTemplateGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldValueTemplate, v))
+}
+
+// ValueTemplateLT applies the LT predicate on the "value_template" field.
+func ValueTemplateLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldValueTemplate, v))
+}
+
+// ValueTemplateLTE applies the LTE predicate on the "value_template" field.
+func ValueTemplateLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldValueTemplate, v))
+}
+
+// ValueTemplateContains applies the Contains predicate on the "value_template" field.
+func ValueTemplateContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldValueTemplate, v))
+}
+
+// ValueTemplateHasPrefix applies the HasPrefix predicate on the "value_template" field.
+func ValueTemplateHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldValueTemplate, v))
+}
+
+// ValueTemplateHasSuffix applies the HasSuffix predicate on the "value_template" field.
+func ValueTemplateHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldValueTemplate, v))
+}
+
+// ValueTemplateIsNil applies the IsNil predicate on the "value_template" field.
+func ValueTemplateIsNil() predicate.Env {
+	return predicate.Env(sql.FieldIsNull(FieldValueTemplate))
+}
+
+// ValueTemplateNotNil applies the NotNil predicate on the "value_template" field.
+func ValueTemplateNotNil() predicate.Env {
+	return predicate.Env(sql.FieldNotNull(FieldValueTemplate))
+}
+
+// ValueTemplateEqualFold applies the EqualFold predicate on the "value_template" field.
+func ValueTemplateEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldValueTemplate, v))
+}
+
+// ValueTemplateContainsFold applies the ContainsFold predicate on the "value_template" field.
+func ValueTemplateContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldValueTemplate, v))
+}
+
+// MatchKeyTemplateEQ applies the EQ predicate on the "match_key_template" field.
+func MatchKeyTemplateEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateNEQ applies the NEQ predicate on the "match_key_template" field.
+func MatchKeyTemplateNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateIn applies the In predicate on the "match_key_template" field.
+func MatchKeyTemplateIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldMatchKeyTemplate, vs...))
+}
+
+// MatchKeyTemplateNotIn applies the NotIn predicate on the "match_key_template" field.
+func MatchKeyTemplateNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldMatchKeyTemplate, vs...))
+}
+
+// MatchKeyTemplateGT applies the GT predicate on the "match_key_template" field.
+func MatchKeyTemplateGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateGTE applies the GTE predicate on the "match_key_template" field.
+func MatchKeyTemplateGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateLT applies the LT predicate on the "match_key_template" field.
+func MatchKeyTemplateLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateLTE applies the LTE predicate on the "match_key_template" field.
+func MatchKeyTemplateLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateContains applies the Contains predicate on the "match_key_template" field.
+func MatchKeyTemplateContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateHasPrefix applies the HasPrefix predicate on the "match_key_template" field.
+func MatchKeyTemplateHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateHasSuffix applies the HasSuffix predicate on the "match_key_template" field.
+func MatchKeyTemplateHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateIsNil applies the IsNil predicate on the "match_key_template" field.
+func MatchKeyTemplateIsNil() predicate.Env {
+	return predicate.Env(sql.FieldIsNull(FieldMatchKeyTemplate))
+}
+
+// MatchKeyTemplateNotNil applies the NotNil predicate on the "match_key_template" field.
+func MatchKeyTemplateNotNil() predicate.Env {
+	return predicate.Env(sql.FieldNotNull(FieldMatchKeyTemplate))
+}
+
+// MatchKeyTemplateEqualFold applies the EqualFold predicate on the "match_key_template" field.
+func MatchKeyTemplateEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldMatchKeyTemplate, v))
+}
+
+// MatchKeyTemplateContainsFold applies the ContainsFold predicate on the "match_key_template" field.
+func MatchKeyTemplateContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldMatchKeyTemplate, v))
+}
+
+// SplitByEQ applies the EQ predicate on the "split_by" field.
+func SplitByEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSplitBy, v))
+}
+
+// SplitByNEQ applies the NEQ predicate on the "split_by" field.
+func SplitByNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldSplitBy, v))
+}
+
+// SplitByIn applies the In predicate on the "split_by" field.
+func SplitByIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldSplitBy, vs...))
+}
+
+// SplitByNotIn applies the NotIn predicate on the "split_by" field.
+func SplitByNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldSplitBy, vs...))
+}
+
+// SplitByGT applies the GT predicate on the "split_by" field.
+func SplitByGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldSplitBy, v))
+}
+
+// SplitByGTE applies the GTE predicate on the "split_by" field.
+func SplitByGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldSplitBy, v))
+}
+
+// SplitByLT applies the LT predicate on the "split_by" field.
+func SplitByLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldSplitBy, v))
+}
+
+// SplitByLTE applies the LTE predicate on the "split_by" field.
+func SplitByLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldSplitBy, v))
+}
+
+// SplitByContains applies the Contains predicate on the "split_by" field.
+func SplitByContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldSplitBy, v))
+}
+
+// SplitByHasPrefix applies the HasPrefix predicate on the "split_by" field.
+func SplitByHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldSplitBy, v))
+}
+
+// SplitByHasSuffix applies the HasSuffix predicate on the "split_by" field.
+func SplitByHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldSplitBy, v))
+}
+
+// SplitByEqualFold applies the EqualFold predicate on the "split_by" field.
+func SplitByEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldSplitBy, v))
+}
+
+// SplitByContainsFold applies the ContainsFold predicate on the "split_by" field.
+func SplitByContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldSplitBy, v))
+}
+
+// DedupByEQ applies the EQ predicate on the "dedup_by" field.
+func DedupByEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldDedupBy, v))
+}
+
+// DedupByNEQ applies the NEQ predicate on the "dedup_by" field.
+func DedupByNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldDedupBy, v))
+}
+
+// DedupByIn applies the In predicate on the "dedup_by" field.
+func DedupByIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldDedupBy, vs...))
+}
+
+// DedupByNotIn applies the NotIn predicate on the "dedup_by" field.
+func DedupByNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldDedupBy, vs...))
+}
+
+// DedupByGT applies the GT predicate on the "dedup_by" field.
+func DedupByGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldDedupBy, v))
+}
+
+// DedupByGTE applies the GTE predicate on the "dedup_by" field.
+func DedupByGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldDedupBy, v))
+}
+
+// DedupByLT applies the LT predicate on the "dedup_by" field.
+func DedupByLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldDedupBy, v))
+}
+
+// DedupByLTE applies the LTE predicate on the "dedup_by" field.
+func DedupByLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldDedupBy, v))
+}
+
+// DedupByContains applies the Contains predicate on the "dedup_by" field.
+func DedupByContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldDedupBy, v))
+}
+
+// DedupByHasPrefix applies the HasPrefix predicate on the "dedup_by" field.
+func DedupByHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldDedupBy, v))
+}
+
+// DedupByHasSuffix applies the HasSuffix predicate on the "dedup_by" field.
+func DedupByHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldDedupBy, v))
+}
+
+// DedupByEqualFold applies the EqualFold predicate on the "dedup_by" field.
+func DedupByEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldDedupBy, v))
+}
+
+// DedupByContainsFold applies the ContainsFold predicate on the "dedup_by" field.
+func DedupByContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldDedupBy, v))
+}
+
+// DedupActionEQ applies the EQ predicate on the "dedup_action" field.
+func DedupActionEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldDedupAction, v))
+}
+
+// DedupActionNEQ applies the NEQ predicate on the "dedup_action" field.
+func DedupActionNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldDedupAction, v))
+}
+
+// DedupActionIn applies the In predicate on the "dedup_action" field.
+func DedupActionIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldDedupAction, vs...))
+}
+
+// DedupActionNotIn applies the NotIn predicate on the "dedup_action" field.
+func DedupActionNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldDedupAction, vs...))
+}
+
+// DedupActionGT applies the GT predicate on the "dedup_action" field.
+func DedupActionGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldDedupAction, v))
+}
+
+// DedupActionGTE applies the GTE predicate on the "dedup_action" field.
+func DedupActionGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldDedupAction, v))
+}
+
+// DedupActionLT applies the LT predicate on the "dedup_action" field.
+func DedupActionLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldDedupAction, v))
+}
+
+// DedupActionLTE applies the LTE predicate on the "dedup_action" field.
+func DedupActionLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldDedupAction, v))
+}
+
+// DedupActionContains applies the Contains predicate on the "dedup_action" field.
+func DedupActionContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldDedupAction, v))
+}
+
+// DedupActionHasPrefix applies the HasPrefix predicate on the "dedup_action" field.
+func DedupActionHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldDedupAction, v))
+}
+
+// DedupActionHasSuffix applies the HasSuffix predicate on the "dedup_action" field.
+func DedupActionHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldDedupAction, v))
+}
+
+// DedupActionEqualFold applies the EqualFold predicate on the "dedup_action" field.
+func DedupActionEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldDedupAction, v))
+}
+
+// DedupActionContainsFold applies the ContainsFold predicate on the "dedup_action" field.
+func DedupActionContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldDedupAction, v))
+}
+
 // HasPanels applies the HasEdge predicate on the "panels" edge.
 func HasPanels() predicate.Env {
 	return predicate.Env(func(s *sql.Selector) {
@@ -856,6 +1411,52 @@ func HasEnvPluginsWith(preds ...predicate.EnvPlugin) predicate.Env {
 	})
 }
 
+// HasCronTriggers applies the HasEdge predicate on the "cron_triggers" edge.
+func HasCronTriggers() predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CronTriggersTable, CronTriggersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCronTriggersWith applies the HasEdge predicate on the "cron_triggers" edge with a given conditions (other predicates).
+func HasCronTriggersWith(preds ...predicate.EnvCronTrigger) predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := newCronTriggersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvPanels applies the HasEdge predicate on the "env_panels" edge.
+func HasEnvPanels() predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EnvPanelsTable, EnvPanelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvPanelsWith applies the HasEdge predicate on the "env_panels" edge with a given conditions (other predicates).
+func HasEnvPanelsWith(preds ...predicate.EnvPanel) predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := newEnvPanelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Env) predicate.Env {
 	return predicate.Env(sql.AndPredicates(predicates...))
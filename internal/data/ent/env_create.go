@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
 )
@@ -184,6 +185,118 @@ func (_c *EnvCreate) SetIsEnable(v bool) *EnvCreate {
 	return _c
 }
 
+// SetSelectStrategy sets the "select_strategy" field.
+func (_c *EnvCreate) SetSelectStrategy(v string) *EnvCreate {
+	_c.mutation.SetSelectStrategy(v)
+	return _c
+}
+
+// SetNillableSelectStrategy sets the "select_strategy" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableSelectStrategy(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetSelectStrategy(*v)
+	}
+	return _c
+}
+
+// SetReplicas sets the "replicas" field.
+func (_c *EnvCreate) SetReplicas(v int32) *EnvCreate {
+	_c.mutation.SetReplicas(v)
+	return _c
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableReplicas(v *int32) *EnvCreate {
+	if v != nil {
+		_c.SetReplicas(*v)
+	}
+	return _c
+}
+
+// SetUpdateScope sets the "update_scope" field.
+func (_c *EnvCreate) SetUpdateScope(v string) *EnvCreate {
+	_c.mutation.SetUpdateScope(v)
+	return _c
+}
+
+// SetNillableUpdateScope sets the "update_scope" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableUpdateScope(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetUpdateScope(*v)
+	}
+	return _c
+}
+
+// SetValueTemplate sets the "value_template" field.
+func (_c *EnvCreate) SetValueTemplate(v string) *EnvCreate {
+	_c.mutation.SetValueTemplate(v)
+	return _c
+}
+
+// SetNillableValueTemplate sets the "value_template" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableValueTemplate(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetValueTemplate(*v)
+	}
+	return _c
+}
+
+// SetMatchKeyTemplate sets the "match_key_template" field.
+func (_c *EnvCreate) SetMatchKeyTemplate(v string) *EnvCreate {
+	_c.mutation.SetMatchKeyTemplate(v)
+	return _c
+}
+
+// SetNillableMatchKeyTemplate sets the "match_key_template" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableMatchKeyTemplate(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetMatchKeyTemplate(*v)
+	}
+	return _c
+}
+
+// SetSplitBy sets the "split_by" field.
+func (_c *EnvCreate) SetSplitBy(v string) *EnvCreate {
+	_c.mutation.SetSplitBy(v)
+	return _c
+}
+
+// SetNillableSplitBy sets the "split_by" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableSplitBy(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetSplitBy(*v)
+	}
+	return _c
+}
+
+// SetDedupBy sets the "dedup_by" field.
+func (_c *EnvCreate) SetDedupBy(v string) *EnvCreate {
+	_c.mutation.SetDedupBy(v)
+	return _c
+}
+
+// SetNillableDedupBy sets the "dedup_by" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableDedupBy(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetDedupBy(*v)
+	}
+	return _c
+}
+
+// SetDedupAction sets the "dedup_action" field.
+func (_c *EnvCreate) SetDedupAction(v string) *EnvCreate {
+	_c.mutation.SetDedupAction(v)
+	return _c
+}
+
+// SetNillableDedupAction sets the "dedup_action" field if the given value is not nil.
+func (_c *EnvCreate) SetNillableDedupAction(v *string) *EnvCreate {
+	if v != nil {
+		_c.SetDedupAction(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *EnvCreate) SetID(v int64) *EnvCreate {
 	_c.mutation.SetID(v)
@@ -220,6 +333,21 @@ func (_c *EnvCreate) AddEnvPlugins(v ...*EnvPlugin) *EnvCreate {
 	return _c.AddEnvPluginIDs(ids...)
 }
 
+// AddCronTriggerIDs adds the "cron_triggers" edge to the EnvCronTrigger entity by IDs.
+func (_c *EnvCreate) AddCronTriggerIDs(ids ...int64) *EnvCreate {
+	_c.mutation.AddCronTriggerIDs(ids...)
+	return _c
+}
+
+// AddCronTriggers adds the "cron_triggers" edges to the EnvCronTrigger entity.
+func (_c *EnvCreate) AddCronTriggers(v ...*EnvCronTrigger) *EnvCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCronTriggerIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (_c *EnvCreate) Mutation() *EnvMutation {
 	return _c.mutation
@@ -271,6 +399,30 @@ func (_c *EnvCreate) defaults() {
 		v := env.DefaultCdkLimit
 		_c.mutation.SetCdkLimit(v)
 	}
+	if _, ok := _c.mutation.SelectStrategy(); !ok {
+		v := env.DefaultSelectStrategy
+		_c.mutation.SetSelectStrategy(v)
+	}
+	if _, ok := _c.mutation.Replicas(); !ok {
+		v := env.DefaultReplicas
+		_c.mutation.SetReplicas(v)
+	}
+	if _, ok := _c.mutation.UpdateScope(); !ok {
+		v := env.DefaultUpdateScope
+		_c.mutation.SetUpdateScope(v)
+	}
+	if _, ok := _c.mutation.SplitBy(); !ok {
+		v := env.DefaultSplitBy
+		_c.mutation.SetSplitBy(v)
+	}
+	if _, ok := _c.mutation.DedupBy(); !ok {
+		v := env.DefaultDedupBy
+		_c.mutation.SetDedupBy(v)
+	}
+	if _, ok := _c.mutation.DedupAction(); !ok {
+		v := env.DefaultDedupAction
+		_c.mutation.SetDedupAction(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -310,6 +462,24 @@ func (_c *EnvCreate) check() error {
 	if _, ok := _c.mutation.IsEnable(); !ok {
 		return &ValidationError{Name: "is_enable", err: errors.New(`ent: missing required field "Env.is_enable"`)}
 	}
+	if _, ok := _c.mutation.SelectStrategy(); !ok {
+		return &ValidationError{Name: "select_strategy", err: errors.New(`ent: missing required field "Env.select_strategy"`)}
+	}
+	if _, ok := _c.mutation.Replicas(); !ok {
+		return &ValidationError{Name: "replicas", err: errors.New(`ent: missing required field "Env.replicas"`)}
+	}
+	if _, ok := _c.mutation.UpdateScope(); !ok {
+		return &ValidationError{Name: "update_scope", err: errors.New(`ent: missing required field "Env.update_scope"`)}
+	}
+	if _, ok := _c.mutation.SplitBy(); !ok {
+		return &ValidationError{Name: "split_by", err: errors.New(`ent: missing required field "Env.split_by"`)}
+	}
+	if _, ok := _c.mutation.DedupBy(); !ok {
+		return &ValidationError{Name: "dedup_by", err: errors.New(`ent: missing required field "Env.dedup_by"`)}
+	}
+	if _, ok := _c.mutation.DedupAction(); !ok {
+		return &ValidationError{Name: "dedup_action", err: errors.New(`ent: missing required field "Env.dedup_action"`)}
+	}
 	return nil
 }
 
@@ -402,6 +572,38 @@ func (_c *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		_spec.SetField(env.FieldIsEnable, field.TypeBool, value)
 		_node.IsEnable = value
 	}
+	if value, ok := _c.mutation.SelectStrategy(); ok {
+		_spec.SetField(env.FieldSelectStrategy, field.TypeString, value)
+		_node.SelectStrategy = value
+	}
+	if value, ok := _c.mutation.Replicas(); ok {
+		_spec.SetField(env.FieldReplicas, field.TypeInt32, value)
+		_node.Replicas = value
+	}
+	if value, ok := _c.mutation.UpdateScope(); ok {
+		_spec.SetField(env.FieldUpdateScope, field.TypeString, value)
+		_node.UpdateScope = value
+	}
+	if value, ok := _c.mutation.ValueTemplate(); ok {
+		_spec.SetField(env.FieldValueTemplate, field.TypeString, value)
+		_node.ValueTemplate = &value
+	}
+	if value, ok := _c.mutation.MatchKeyTemplate(); ok {
+		_spec.SetField(env.FieldMatchKeyTemplate, field.TypeString, value)
+		_node.MatchKeyTemplate = &value
+	}
+	if value, ok := _c.mutation.SplitBy(); ok {
+		_spec.SetField(env.FieldSplitBy, field.TypeString, value)
+		_node.SplitBy = value
+	}
+	if value, ok := _c.mutation.DedupBy(); ok {
+		_spec.SetField(env.FieldDedupBy, field.TypeString, value)
+		_node.DedupBy = value
+	}
+	if value, ok := _c.mutation.DedupAction(); ok {
+		_spec.SetField(env.FieldDedupAction, field.TypeString, value)
+		_node.DedupAction = value
+	}
 	if nodes := _c.mutation.PanelsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -416,6 +618,10 @@ func (_c *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _c.config, mutation: newEnvPanelMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.EnvPluginsIDs(); len(nodes) > 0 {
@@ -434,6 +640,22 @@ func (_c *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CronTriggersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
@@ -21,12 +23,14 @@ import (
 // EnvQuery is the builder for querying Env entities.
 type EnvQuery struct {
 	config
-	ctx            *QueryContext
-	order          []env.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Env
-	withPanels     *PanelQuery
-	withEnvPlugins *EnvPluginQuery
+	ctx              *QueryContext
+	order            []env.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Env
+	withPanels       *PanelQuery
+	withEnvPlugins   *EnvPluginQuery
+	withCronTriggers *EnvCronTriggerQuery
+	withEnvPanels    *EnvPanelQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,50 @@ func (_q *EnvQuery) QueryEnvPlugins() *EnvPluginQuery {
 	return query
 }
 
+// QueryCronTriggers chains the current query on the "cron_triggers" edge.
+func (_q *EnvQuery) QueryCronTriggers() *EnvCronTriggerQuery {
+	query := (&EnvCronTriggerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, selector),
+			sqlgraph.To(envcrontrigger.Table, envcrontrigger.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.CronTriggersTable, env.CronTriggersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvPanels chains the current query on the "env_panels" edge.
+func (_q *EnvQuery) QueryEnvPanels() *EnvPanelQuery {
+	query := (&EnvPanelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, selector),
+			sqlgraph.To(envpanel.Table, envpanel.EnvColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, env.EnvPanelsTable, env.EnvPanelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Env entity from the query.
 // Returns a *NotFoundError when no Env was found.
 func (_q *EnvQuery) First(ctx context.Context) (*Env, error) {
@@ -294,13 +342,15 @@ func (_q *EnvQuery) Clone() *EnvQuery {
 		return nil
 	}
 	return &EnvQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]env.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Env{}, _q.predicates...),
-		withPanels:     _q.withPanels.Clone(),
-		withEnvPlugins: _q.withEnvPlugins.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]env.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Env{}, _q.predicates...),
+		withPanels:       _q.withPanels.Clone(),
+		withEnvPlugins:   _q.withEnvPlugins.Clone(),
+		withCronTriggers: _q.withCronTriggers.Clone(),
+		withEnvPanels:    _q.withEnvPanels.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +379,28 @@ func (_q *EnvQuery) WithEnvPlugins(opts ...func(*EnvPluginQuery)) *EnvQuery {
 	return _q
 }
 
+// WithCronTriggers tells the query-builder to eager-load the nodes that are connected to
+// the "cron_triggers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnvQuery) WithCronTriggers(opts ...func(*EnvCronTriggerQuery)) *EnvQuery {
+	query := (&EnvCronTriggerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCronTriggers = query
+	return _q
+}
+
+// WithEnvPanels tells the query-builder to eager-load the nodes that are connected to
+// the "env_panels" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnvQuery) WithEnvPanels(opts ...func(*EnvPanelQuery)) *EnvQuery {
+	query := (&EnvPanelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnvPanels = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +479,11 @@ func (_q *EnvQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Env, err
 	var (
 		nodes       = []*Env{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withPanels != nil,
 			_q.withEnvPlugins != nil,
+			_q.withCronTriggers != nil,
+			_q.withEnvPanels != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +518,20 @@ func (_q *EnvQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Env, err
 			return nil, err
 		}
 	}
+	if query := _q.withCronTriggers; query != nil {
+		if err := _q.loadCronTriggers(ctx, query, nodes,
+			func(n *Env) { n.Edges.CronTriggers = []*EnvCronTrigger{} },
+			func(n *Env, e *EnvCronTrigger) { n.Edges.CronTriggers = append(n.Edges.CronTriggers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEnvPanels; query != nil {
+		if err := _q.loadEnvPanels(ctx, query, nodes,
+			func(n *Env) { n.Edges.EnvPanels = []*EnvPanel{} },
+			func(n *Env, e *EnvPanel) { n.Edges.EnvPanels = append(n.Edges.EnvPanels, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -538,6 +626,66 @@ func (_q *EnvQuery) loadEnvPlugins(ctx context.Context, query *EnvPluginQuery, n
 	}
 	return nil
 }
+func (_q *EnvQuery) loadCronTriggers(ctx context.Context, query *EnvCronTriggerQuery, nodes []*Env, init func(*Env), assign func(*Env, *EnvCronTrigger)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Env)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(envcrontrigger.FieldEnvID)
+	}
+	query.Where(predicate.EnvCronTrigger(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(env.CronTriggersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "env_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *EnvQuery) loadEnvPanels(ctx context.Context, query *EnvPanelQuery, nodes []*Env, init func(*Env), assign func(*Env, *EnvPanel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Env)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(envpanel.FieldEnvID)
+	}
+	query.Where(predicate.EnvPanel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(env.EnvPanelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "env_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EnvQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
@@ -269,6 +270,137 @@ func (_u *EnvUpdate) SetNillableIsEnable(v *bool) *EnvUpdate {
 	return _u
 }
 
+// SetSelectStrategy sets the "select_strategy" field.
+func (_u *EnvUpdate) SetSelectStrategy(v string) *EnvUpdate {
+	_u.mutation.SetSelectStrategy(v)
+	return _u
+}
+
+// SetNillableSelectStrategy sets the "select_strategy" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableSelectStrategy(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetSelectStrategy(*v)
+	}
+	return _u
+}
+
+// SetReplicas sets the "replicas" field.
+func (_u *EnvUpdate) SetReplicas(v int32) *EnvUpdate {
+	_u.mutation.ResetReplicas()
+	_u.mutation.SetReplicas(v)
+	return _u
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableReplicas(v *int32) *EnvUpdate {
+	if v != nil {
+		_u.SetReplicas(*v)
+	}
+	return _u
+}
+
+// AddReplicas adds value to the "replicas" field.
+func (_u *EnvUpdate) AddReplicas(v int32) *EnvUpdate {
+	_u.mutation.AddReplicas(v)
+	return _u
+}
+
+// SetUpdateScope sets the "update_scope" field.
+func (_u *EnvUpdate) SetUpdateScope(v string) *EnvUpdate {
+	_u.mutation.SetUpdateScope(v)
+	return _u
+}
+
+// SetNillableUpdateScope sets the "update_scope" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableUpdateScope(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetUpdateScope(*v)
+	}
+	return _u
+}
+
+// SetValueTemplate sets the "value_template" field.
+func (_u *EnvUpdate) SetValueTemplate(v string) *EnvUpdate {
+	_u.mutation.SetValueTemplate(v)
+	return _u
+}
+
+// SetNillableValueTemplate sets the "value_template" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableValueTemplate(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetValueTemplate(*v)
+	}
+	return _u
+}
+
+// ClearValueTemplate clears the value of the "value_template" field.
+func (_u *EnvUpdate) ClearValueTemplate() *EnvUpdate {
+	_u.mutation.ClearValueTemplate()
+	return _u
+}
+
+// SetMatchKeyTemplate sets the "match_key_template" field.
+func (_u *EnvUpdate) SetMatchKeyTemplate(v string) *EnvUpdate {
+	_u.mutation.SetMatchKeyTemplate(v)
+	return _u
+}
+
+// SetNillableMatchKeyTemplate sets the "match_key_template" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableMatchKeyTemplate(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetMatchKeyTemplate(*v)
+	}
+	return _u
+}
+
+// ClearMatchKeyTemplate clears the value of the "match_key_template" field.
+func (_u *EnvUpdate) ClearMatchKeyTemplate() *EnvUpdate {
+	_u.mutation.ClearMatchKeyTemplate()
+	return _u
+}
+
+// SetSplitBy sets the "split_by" field.
+func (_u *EnvUpdate) SetSplitBy(v string) *EnvUpdate {
+	_u.mutation.SetSplitBy(v)
+	return _u
+}
+
+// SetNillableSplitBy sets the "split_by" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableSplitBy(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetSplitBy(*v)
+	}
+	return _u
+}
+
+// SetDedupBy sets the "dedup_by" field.
+func (_u *EnvUpdate) SetDedupBy(v string) *EnvUpdate {
+	_u.mutation.SetDedupBy(v)
+	return _u
+}
+
+// SetNillableDedupBy sets the "dedup_by" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableDedupBy(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetDedupBy(*v)
+	}
+	return _u
+}
+
+// SetDedupAction sets the "dedup_action" field.
+func (_u *EnvUpdate) SetDedupAction(v string) *EnvUpdate {
+	_u.mutation.SetDedupAction(v)
+	return _u
+}
+
+// SetNillableDedupAction sets the "dedup_action" field if the given value is not nil.
+func (_u *EnvUpdate) SetNillableDedupAction(v *string) *EnvUpdate {
+	if v != nil {
+		_u.SetDedupAction(*v)
+	}
+	return _u
+}
+
 // AddPanelIDs adds the "panels" edge to the Panel entity by IDs.
 func (_u *EnvUpdate) AddPanelIDs(ids ...int64) *EnvUpdate {
 	_u.mutation.AddPanelIDs(ids...)
@@ -299,6 +431,21 @@ func (_u *EnvUpdate) AddEnvPlugins(v ...*EnvPlugin) *EnvUpdate {
 	return _u.AddEnvPluginIDs(ids...)
 }
 
+// AddCronTriggerIDs adds the "cron_triggers" edge to the EnvCronTrigger entity by IDs.
+func (_u *EnvUpdate) AddCronTriggerIDs(ids ...int64) *EnvUpdate {
+	_u.mutation.AddCronTriggerIDs(ids...)
+	return _u
+}
+
+// AddCronTriggers adds the "cron_triggers" edges to the EnvCronTrigger entity.
+func (_u *EnvUpdate) AddCronTriggers(v ...*EnvCronTrigger) *EnvUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCronTriggerIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (_u *EnvUpdate) Mutation() *EnvMutation {
 	return _u.mutation
@@ -346,6 +493,27 @@ func (_u *EnvUpdate) RemoveEnvPlugins(v ...*EnvPlugin) *EnvUpdate {
 	return _u.RemoveEnvPluginIDs(ids...)
 }
 
+// ClearCronTriggers clears all "cron_triggers" edges to the EnvCronTrigger entity.
+func (_u *EnvUpdate) ClearCronTriggers() *EnvUpdate {
+	_u.mutation.ClearCronTriggers()
+	return _u
+}
+
+// RemoveCronTriggerIDs removes the "cron_triggers" edge to EnvCronTrigger entities by IDs.
+func (_u *EnvUpdate) RemoveCronTriggerIDs(ids ...int64) *EnvUpdate {
+	_u.mutation.RemoveCronTriggerIDs(ids...)
+	return _u
+}
+
+// RemoveCronTriggers removes "cron_triggers" edges to EnvCronTrigger entities.
+func (_u *EnvUpdate) RemoveCronTriggers(v ...*EnvCronTrigger) *EnvUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCronTriggerIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EnvUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -470,6 +638,39 @@ func (_u *EnvUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsEnable(); ok {
 		_spec.SetField(env.FieldIsEnable, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.SelectStrategy(); ok {
+		_spec.SetField(env.FieldSelectStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Replicas(); ok {
+		_spec.SetField(env.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedReplicas(); ok {
+		_spec.AddField(env.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.UpdateScope(); ok {
+		_spec.SetField(env.FieldUpdateScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ValueTemplate(); ok {
+		_spec.SetField(env.FieldValueTemplate, field.TypeString, value)
+	}
+	if _u.mutation.ValueTemplateCleared() {
+		_spec.ClearField(env.FieldValueTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.MatchKeyTemplate(); ok {
+		_spec.SetField(env.FieldMatchKeyTemplate, field.TypeString, value)
+	}
+	if _u.mutation.MatchKeyTemplateCleared() {
+		_spec.ClearField(env.FieldMatchKeyTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitBy(); ok {
+		_spec.SetField(env.FieldSplitBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DedupBy(); ok {
+		_spec.SetField(env.FieldDedupBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DedupAction(); ok {
+		_spec.SetField(env.FieldDedupAction, field.TypeString, value)
+	}
 	if _u.mutation.PanelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -481,6 +682,10 @@ func (_u *EnvUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
 			},
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedPanelsIDs(); len(nodes) > 0 && !_u.mutation.PanelsCleared() {
@@ -497,6 +702,10 @@ func (_u *EnvUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.PanelsIDs(); len(nodes) > 0 {
@@ -513,6 +722,10 @@ func (_u *EnvUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.EnvPluginsCleared() {
@@ -560,6 +773,51 @@ func (_u *EnvUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CronTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCronTriggersIDs(); len(nodes) > 0 && !_u.mutation.CronTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CronTriggersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{env.Label}
@@ -819,6 +1077,137 @@ func (_u *EnvUpdateOne) SetNillableIsEnable(v *bool) *EnvUpdateOne {
 	return _u
 }
 
+// SetSelectStrategy sets the "select_strategy" field.
+func (_u *EnvUpdateOne) SetSelectStrategy(v string) *EnvUpdateOne {
+	_u.mutation.SetSelectStrategy(v)
+	return _u
+}
+
+// SetNillableSelectStrategy sets the "select_strategy" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableSelectStrategy(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetSelectStrategy(*v)
+	}
+	return _u
+}
+
+// SetReplicas sets the "replicas" field.
+func (_u *EnvUpdateOne) SetReplicas(v int32) *EnvUpdateOne {
+	_u.mutation.ResetReplicas()
+	_u.mutation.SetReplicas(v)
+	return _u
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableReplicas(v *int32) *EnvUpdateOne {
+	if v != nil {
+		_u.SetReplicas(*v)
+	}
+	return _u
+}
+
+// AddReplicas adds value to the "replicas" field.
+func (_u *EnvUpdateOne) AddReplicas(v int32) *EnvUpdateOne {
+	_u.mutation.AddReplicas(v)
+	return _u
+}
+
+// SetUpdateScope sets the "update_scope" field.
+func (_u *EnvUpdateOne) SetUpdateScope(v string) *EnvUpdateOne {
+	_u.mutation.SetUpdateScope(v)
+	return _u
+}
+
+// SetNillableUpdateScope sets the "update_scope" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableUpdateScope(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetUpdateScope(*v)
+	}
+	return _u
+}
+
+// SetValueTemplate sets the "value_template" field.
+func (_u *EnvUpdateOne) SetValueTemplate(v string) *EnvUpdateOne {
+	_u.mutation.SetValueTemplate(v)
+	return _u
+}
+
+// SetNillableValueTemplate sets the "value_template" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableValueTemplate(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetValueTemplate(*v)
+	}
+	return _u
+}
+
+// ClearValueTemplate clears the value of the "value_template" field.
+func (_u *EnvUpdateOne) ClearValueTemplate() *EnvUpdateOne {
+	_u.mutation.ClearValueTemplate()
+	return _u
+}
+
+// SetMatchKeyTemplate sets the "match_key_template" field.
+func (_u *EnvUpdateOne) SetMatchKeyTemplate(v string) *EnvUpdateOne {
+	_u.mutation.SetMatchKeyTemplate(v)
+	return _u
+}
+
+// SetNillableMatchKeyTemplate sets the "match_key_template" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableMatchKeyTemplate(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetMatchKeyTemplate(*v)
+	}
+	return _u
+}
+
+// ClearMatchKeyTemplate clears the value of the "match_key_template" field.
+func (_u *EnvUpdateOne) ClearMatchKeyTemplate() *EnvUpdateOne {
+	_u.mutation.ClearMatchKeyTemplate()
+	return _u
+}
+
+// SetSplitBy sets the "split_by" field.
+func (_u *EnvUpdateOne) SetSplitBy(v string) *EnvUpdateOne {
+	_u.mutation.SetSplitBy(v)
+	return _u
+}
+
+// SetNillableSplitBy sets the "split_by" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableSplitBy(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetSplitBy(*v)
+	}
+	return _u
+}
+
+// SetDedupBy sets the "dedup_by" field.
+func (_u *EnvUpdateOne) SetDedupBy(v string) *EnvUpdateOne {
+	_u.mutation.SetDedupBy(v)
+	return _u
+}
+
+// SetNillableDedupBy sets the "dedup_by" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableDedupBy(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetDedupBy(*v)
+	}
+	return _u
+}
+
+// SetDedupAction sets the "dedup_action" field.
+func (_u *EnvUpdateOne) SetDedupAction(v string) *EnvUpdateOne {
+	_u.mutation.SetDedupAction(v)
+	return _u
+}
+
+// SetNillableDedupAction sets the "dedup_action" field if the given value is not nil.
+func (_u *EnvUpdateOne) SetNillableDedupAction(v *string) *EnvUpdateOne {
+	if v != nil {
+		_u.SetDedupAction(*v)
+	}
+	return _u
+}
+
 // AddPanelIDs adds the "panels" edge to the Panel entity by IDs.
 func (_u *EnvUpdateOne) AddPanelIDs(ids ...int64) *EnvUpdateOne {
 	_u.mutation.AddPanelIDs(ids...)
@@ -849,6 +1238,21 @@ func (_u *EnvUpdateOne) AddEnvPlugins(v ...*EnvPlugin) *EnvUpdateOne {
 	return _u.AddEnvPluginIDs(ids...)
 }
 
+// AddCronTriggerIDs adds the "cron_triggers" edge to the EnvCronTrigger entity by IDs.
+func (_u *EnvUpdateOne) AddCronTriggerIDs(ids ...int64) *EnvUpdateOne {
+	_u.mutation.AddCronTriggerIDs(ids...)
+	return _u
+}
+
+// AddCronTriggers adds the "cron_triggers" edges to the EnvCronTrigger entity.
+func (_u *EnvUpdateOne) AddCronTriggers(v ...*EnvCronTrigger) *EnvUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCronTriggerIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (_u *EnvUpdateOne) Mutation() *EnvMutation {
 	return _u.mutation
@@ -896,6 +1300,27 @@ func (_u *EnvUpdateOne) RemoveEnvPlugins(v ...*EnvPlugin) *EnvUpdateOne {
 	return _u.RemoveEnvPluginIDs(ids...)
 }
 
+// ClearCronTriggers clears all "cron_triggers" edges to the EnvCronTrigger entity.
+func (_u *EnvUpdateOne) ClearCronTriggers() *EnvUpdateOne {
+	_u.mutation.ClearCronTriggers()
+	return _u
+}
+
+// RemoveCronTriggerIDs removes the "cron_triggers" edge to EnvCronTrigger entities by IDs.
+func (_u *EnvUpdateOne) RemoveCronTriggerIDs(ids ...int64) *EnvUpdateOne {
+	_u.mutation.RemoveCronTriggerIDs(ids...)
+	return _u
+}
+
+// RemoveCronTriggers removes "cron_triggers" edges to EnvCronTrigger entities.
+func (_u *EnvUpdateOne) RemoveCronTriggers(v ...*EnvCronTrigger) *EnvUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCronTriggerIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvUpdate builder.
 func (_u *EnvUpdateOne) Where(ps ...predicate.Env) *EnvUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1050,6 +1475,39 @@ func (_u *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 	if value, ok := _u.mutation.IsEnable(); ok {
 		_spec.SetField(env.FieldIsEnable, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.SelectStrategy(); ok {
+		_spec.SetField(env.FieldSelectStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Replicas(); ok {
+		_spec.SetField(env.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedReplicas(); ok {
+		_spec.AddField(env.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.UpdateScope(); ok {
+		_spec.SetField(env.FieldUpdateScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ValueTemplate(); ok {
+		_spec.SetField(env.FieldValueTemplate, field.TypeString, value)
+	}
+	if _u.mutation.ValueTemplateCleared() {
+		_spec.ClearField(env.FieldValueTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.MatchKeyTemplate(); ok {
+		_spec.SetField(env.FieldMatchKeyTemplate, field.TypeString, value)
+	}
+	if _u.mutation.MatchKeyTemplateCleared() {
+		_spec.ClearField(env.FieldMatchKeyTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitBy(); ok {
+		_spec.SetField(env.FieldSplitBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DedupBy(); ok {
+		_spec.SetField(env.FieldDedupBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DedupAction(); ok {
+		_spec.SetField(env.FieldDedupAction, field.TypeString, value)
+	}
 	if _u.mutation.PanelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1061,6 +1519,10 @@ func (_u *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
 			},
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedPanelsIDs(); len(nodes) > 0 && !_u.mutation.PanelsCleared() {
@@ -1077,6 +1539,10 @@ func (_u *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.PanelsIDs(); len(nodes) > 0 {
@@ -1093,6 +1559,10 @@ func (_u *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.EnvPluginsCleared() {
@@ -1140,6 +1610,51 @@ func (_u *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CronTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCronTriggersIDs(); len(nodes) > 0 && !_u.mutation.CronTriggersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CronTriggersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.CronTriggersTable,
+			Columns: []string{env.CronTriggersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Env{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+)
+
+// EnvCronTrigger is the model entity for the EnvCronTrigger schema.
+type EnvCronTrigger struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID int64 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 环境变量ID
+	EnvID int64 `json:"env_id,omitempty"`
+	// 面板ID(0表示变量绑定的所有面板)
+	PanelID int64 `json:"panel_id,omitempty"`
+	// 定时任务ID
+	CronID *int `json:"cron_id,omitempty"`
+	// 定时任务名称匹配正则
+	NamePattern *string `json:"name_pattern,omitempty"`
+	// 是否启用
+	IsEnable bool `json:"is_enable,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnvCronTriggerQuery when eager-loading is set.
+	Edges        EnvCronTriggerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnvCronTriggerEdges holds the relations/edges for other nodes in the graph.
+type EnvCronTriggerEdges struct {
+	// Env holds the value of the env edge.
+	Env *Env `json:"env,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EnvOrErr returns the Env value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvCronTriggerEdges) EnvOrErr() (*Env, error) {
+	if e.Env != nil {
+		return e.Env, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: env.Label}
+	}
+	return nil, &NotLoadedError{edge: "env"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvCronTrigger) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envcrontrigger.FieldIsEnable:
+			values[i] = new(sql.NullBool)
+		case envcrontrigger.FieldID, envcrontrigger.FieldEnvID, envcrontrigger.FieldPanelID, envcrontrigger.FieldCronID:
+			values[i] = new(sql.NullInt64)
+		case envcrontrigger.FieldNamePattern:
+			values[i] = new(sql.NullString)
+		case envcrontrigger.FieldCreatedAt, envcrontrigger.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvCronTrigger fields.
+func (_m *EnvCronTrigger) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envcrontrigger.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case envcrontrigger.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case envcrontrigger.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case envcrontrigger.FieldEnvID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				_m.EnvID = value.Int64
+			}
+		case envcrontrigger.FieldPanelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field panel_id", values[i])
+			} else if value.Valid {
+				_m.PanelID = value.Int64
+			}
+		case envcrontrigger.FieldCronID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_id", values[i])
+			} else if value.Valid {
+				_m.CronID = new(int)
+				*_m.CronID = int(value.Int64)
+			}
+		case envcrontrigger.FieldNamePattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_pattern", values[i])
+			} else if value.Valid {
+				_m.NamePattern = new(string)
+				*_m.NamePattern = value.String
+			}
+		case envcrontrigger.FieldIsEnable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enable", values[i])
+			} else if value.Valid {
+				_m.IsEnable = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvCronTrigger.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnvCronTrigger) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEnv queries the "env" edge of the EnvCronTrigger entity.
+func (_m *EnvCronTrigger) QueryEnv() *EnvQuery {
+	return NewEnvCronTriggerClient(_m.config).QueryEnv(_m)
+}
+
+// Update returns a builder for updating this EnvCronTrigger.
+// Note that you need to call EnvCronTrigger.Unwrap() before calling this method if this EnvCronTrigger
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnvCronTrigger) Update() *EnvCronTriggerUpdateOne {
+	return NewEnvCronTriggerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnvCronTrigger entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnvCronTrigger) Unwrap() *EnvCronTrigger {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnvCronTrigger is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnvCronTrigger) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvCronTrigger(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnvID))
+	builder.WriteString(", ")
+	builder.WriteString("panel_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PanelID))
+	builder.WriteString(", ")
+	if v := _m.CronID; v != nil {
+		builder.WriteString("cron_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.NamePattern; v != nil {
+		builder.WriteString("name_pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_enable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsEnable))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvCronTriggers is a parsable slice of EnvCronTrigger.
+type EnvCronTriggers []*EnvCronTrigger
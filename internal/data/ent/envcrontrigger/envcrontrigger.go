@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envcrontrigger
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the envcrontrigger type in the database.
+	Label = "env_cron_trigger"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldPanelID holds the string denoting the panel_id field in the database.
+	FieldPanelID = "panel_id"
+	// FieldCronID holds the string denoting the cron_id field in the database.
+	FieldCronID = "cron_id"
+	// FieldNamePattern holds the string denoting the name_pattern field in the database.
+	FieldNamePattern = "name_pattern"
+	// FieldIsEnable holds the string denoting the is_enable field in the database.
+	FieldIsEnable = "is_enable"
+	// EdgeEnv holds the string denoting the env edge name in mutations.
+	EdgeEnv = "env"
+	// Table holds the table name of the envcrontrigger in the database.
+	Table = "env_cron_triggers"
+	// EnvTable is the table that holds the env relation/edge.
+	EnvTable = "env_cron_triggers"
+	// EnvInverseTable is the table name for the Env entity.
+	// It exists in this package in order to avoid circular dependency with the "env" package.
+	EnvInverseTable = "envs"
+	// EnvColumn is the table column denoting the env relation/edge.
+	EnvColumn = "env_id"
+)
+
+// Columns holds all SQL columns for envcrontrigger fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldEnvID,
+	FieldPanelID,
+	FieldCronID,
+	FieldNamePattern,
+	FieldIsEnable,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultPanelID holds the default value on creation for the "panel_id" field.
+	DefaultPanelID int64
+	// DefaultIsEnable holds the default value on creation for the "is_enable" field.
+	DefaultIsEnable bool
+)
+
+// OrderOption defines the ordering options for the EnvCronTrigger queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByPanelID orders the results by the panel_id field.
+func ByPanelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPanelID, opts...).ToFunc()
+}
+
+// ByCronID orders the results by the cron_id field.
+func ByCronID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCronID, opts...).ToFunc()
+}
+
+// ByNamePattern orders the results by the name_pattern field.
+func ByNamePattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamePattern, opts...).ToFunc()
+}
+
+// ByIsEnable orders the results by the is_enable field.
+func ByIsEnable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEnable, opts...).ToFunc()
+}
+
+// ByEnvField orders the results by env field.
+func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEnvStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
+	)
+}
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envcrontrigger
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldEnvID, v))
+}
+
+// PanelID applies equality check predicate on the "panel_id" field. It's identical to PanelIDEQ.
+func PanelID(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldPanelID, v))
+}
+
+// CronID applies equality check predicate on the "cron_id" field. It's identical to CronIDEQ.
+func CronID(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldCronID, v))
+}
+
+// NamePattern applies equality check predicate on the "name_pattern" field. It's identical to NamePatternEQ.
+func NamePattern(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldNamePattern, v))
+}
+
+// IsEnable applies equality check predicate on the "is_enable" field. It's identical to IsEnableEQ.
+func IsEnable(v bool) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldIsEnable, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// PanelIDEQ applies the EQ predicate on the "panel_id" field.
+func PanelIDEQ(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldPanelID, v))
+}
+
+// PanelIDNEQ applies the NEQ predicate on the "panel_id" field.
+func PanelIDNEQ(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldPanelID, v))
+}
+
+// PanelIDIn applies the In predicate on the "panel_id" field.
+func PanelIDIn(vs ...int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldPanelID, vs...))
+}
+
+// PanelIDNotIn applies the NotIn predicate on the "panel_id" field.
+func PanelIDNotIn(vs ...int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldPanelID, vs...))
+}
+
+// PanelIDGT applies the GT predicate on the "panel_id" field.
+func PanelIDGT(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGT(FieldPanelID, v))
+}
+
+// PanelIDGTE applies the GTE predicate on the "panel_id" field.
+func PanelIDGTE(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGTE(FieldPanelID, v))
+}
+
+// PanelIDLT applies the LT predicate on the "panel_id" field.
+func PanelIDLT(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLT(FieldPanelID, v))
+}
+
+// PanelIDLTE applies the LTE predicate on the "panel_id" field.
+func PanelIDLTE(v int64) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLTE(FieldPanelID, v))
+}
+
+// CronIDEQ applies the EQ predicate on the "cron_id" field.
+func CronIDEQ(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldCronID, v))
+}
+
+// CronIDNEQ applies the NEQ predicate on the "cron_id" field.
+func CronIDNEQ(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldCronID, v))
+}
+
+// CronIDIn applies the In predicate on the "cron_id" field.
+func CronIDIn(vs ...int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldCronID, vs...))
+}
+
+// CronIDNotIn applies the NotIn predicate on the "cron_id" field.
+func CronIDNotIn(vs ...int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldCronID, vs...))
+}
+
+// CronIDGT applies the GT predicate on the "cron_id" field.
+func CronIDGT(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGT(FieldCronID, v))
+}
+
+// CronIDGTE applies the GTE predicate on the "cron_id" field.
+func CronIDGTE(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGTE(FieldCronID, v))
+}
+
+// CronIDLT applies the LT predicate on the "cron_id" field.
+func CronIDLT(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLT(FieldCronID, v))
+}
+
+// CronIDLTE applies the LTE predicate on the "cron_id" field.
+func CronIDLTE(v int) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLTE(FieldCronID, v))
+}
+
+// CronIDIsNil applies the IsNil predicate on the "cron_id" field.
+func CronIDIsNil() predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIsNull(FieldCronID))
+}
+
+// CronIDNotNil applies the NotNil predicate on the "cron_id" field.
+func CronIDNotNil() predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotNull(FieldCronID))
+}
+
+// NamePatternEQ applies the EQ predicate on the "name_pattern" field.
+func NamePatternEQ(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldNamePattern, v))
+}
+
+// NamePatternNEQ applies the NEQ predicate on the "name_pattern" field.
+func NamePatternNEQ(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldNamePattern, v))
+}
+
+// NamePatternIn applies the In predicate on the "name_pattern" field.
+func NamePatternIn(vs ...string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIn(FieldNamePattern, vs...))
+}
+
+// NamePatternNotIn applies the NotIn predicate on the "name_pattern" field.
+func NamePatternNotIn(vs ...string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotIn(FieldNamePattern, vs...))
+}
+
+// NamePatternGT applies the GT predicate on the "name_pattern" field.
+func NamePatternGT(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGT(FieldNamePattern, v))
+}
+
+// NamePatternGTE applies the GTE predicate on the "name_pattern" field.
+func NamePatternGTE(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldGTE(FieldNamePattern, v))
+}
+
+// NamePatternLT applies the LT predicate on the "name_pattern" field.
+func NamePatternLT(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLT(FieldNamePattern, v))
+}
+
+// NamePatternLTE applies the LTE predicate on the "name_pattern" field.
+func NamePatternLTE(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldLTE(FieldNamePattern, v))
+}
+
+// NamePatternContains applies the Contains predicate on the "name_pattern" field.
+func NamePatternContains(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldContains(FieldNamePattern, v))
+}
+
+// NamePatternHasPrefix applies the HasPrefix predicate on the "name_pattern" field.
+func NamePatternHasPrefix(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldHasPrefix(FieldNamePattern, v))
+}
+
+// NamePatternHasSuffix applies the HasSuffix predicate on the "name_pattern" field.
+func NamePatternHasSuffix(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldHasSuffix(FieldNamePattern, v))
+}
+
+// NamePatternIsNil applies the IsNil predicate on the "name_pattern" field.
+func NamePatternIsNil() predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldIsNull(FieldNamePattern))
+}
+
+// NamePatternNotNil applies the NotNil predicate on the "name_pattern" field.
+func NamePatternNotNil() predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNotNull(FieldNamePattern))
+}
+
+// NamePatternEqualFold applies the EqualFold predicate on the "name_pattern" field.
+func NamePatternEqualFold(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEqualFold(FieldNamePattern, v))
+}
+
+// NamePatternContainsFold applies the ContainsFold predicate on the "name_pattern" field.
+func NamePatternContainsFold(v string) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldContainsFold(FieldNamePattern, v))
+}
+
+// IsEnableEQ applies the EQ predicate on the "is_enable" field.
+func IsEnableEQ(v bool) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldEQ(FieldIsEnable, v))
+}
+
+// IsEnableNEQ applies the NEQ predicate on the "is_enable" field.
+func IsEnableNEQ(v bool) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.FieldNEQ(FieldIsEnable, v))
+}
+
+// HasEnv applies the HasEdge predicate on the "env" edge.
+func HasEnv() predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvWith applies the HasEdge predicate on the "env" edge with a given conditions (other predicates).
+func HasEnvWith(preds ...predicate.Env) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(func(s *sql.Selector) {
+		step := newEnvStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvCronTrigger) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvCronTrigger) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvCronTrigger) predicate.EnvCronTrigger {
+	return predicate.EnvCronTrigger(sql.NotPredicates(p))
+}
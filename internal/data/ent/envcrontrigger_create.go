@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+)
+
+// EnvCronTriggerCreate is the builder for creating a EnvCronTrigger entity.
+type EnvCronTriggerCreate struct {
+	config
+	mutation *EnvCronTriggerMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnvCronTriggerCreate) SetCreatedAt(v time.Time) *EnvCronTriggerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnvCronTriggerCreate) SetNillableCreatedAt(v *time.Time) *EnvCronTriggerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EnvCronTriggerCreate) SetUpdatedAt(v time.Time) *EnvCronTriggerCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EnvCronTriggerCreate) SetNillableUpdatedAt(v *time.Time) *EnvCronTriggerCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnvID sets the "env_id" field.
+func (_c *EnvCronTriggerCreate) SetEnvID(v int64) *EnvCronTriggerCreate {
+	_c.mutation.SetEnvID(v)
+	return _c
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_c *EnvCronTriggerCreate) SetPanelID(v int64) *EnvCronTriggerCreate {
+	_c.mutation.SetPanelID(v)
+	return _c
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_c *EnvCronTriggerCreate) SetNillablePanelID(v *int64) *EnvCronTriggerCreate {
+	if v != nil {
+		_c.SetPanelID(*v)
+	}
+	return _c
+}
+
+// SetCronID sets the "cron_id" field.
+func (_c *EnvCronTriggerCreate) SetCronID(v int) *EnvCronTriggerCreate {
+	_c.mutation.SetCronID(v)
+	return _c
+}
+
+// SetNillableCronID sets the "cron_id" field if the given value is not nil.
+func (_c *EnvCronTriggerCreate) SetNillableCronID(v *int) *EnvCronTriggerCreate {
+	if v != nil {
+		_c.SetCronID(*v)
+	}
+	return _c
+}
+
+// SetNamePattern sets the "name_pattern" field.
+func (_c *EnvCronTriggerCreate) SetNamePattern(v string) *EnvCronTriggerCreate {
+	_c.mutation.SetNamePattern(v)
+	return _c
+}
+
+// SetNillableNamePattern sets the "name_pattern" field if the given value is not nil.
+func (_c *EnvCronTriggerCreate) SetNillableNamePattern(v *string) *EnvCronTriggerCreate {
+	if v != nil {
+		_c.SetNamePattern(*v)
+	}
+	return _c
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_c *EnvCronTriggerCreate) SetIsEnable(v bool) *EnvCronTriggerCreate {
+	_c.mutation.SetIsEnable(v)
+	return _c
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_c *EnvCronTriggerCreate) SetNillableIsEnable(v *bool) *EnvCronTriggerCreate {
+	if v != nil {
+		_c.SetIsEnable(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EnvCronTriggerCreate) SetID(v int64) *EnvCronTriggerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (_c *EnvCronTriggerCreate) SetEnv(v *Env) *EnvCronTriggerCreate {
+	return _c.SetEnvID(v.ID)
+}
+
+// Mutation returns the EnvCronTriggerMutation object of the builder.
+func (_c *EnvCronTriggerCreate) Mutation() *EnvCronTriggerMutation {
+	return _c.mutation
+}
+
+// Save creates the EnvCronTrigger in the database.
+func (_c *EnvCronTriggerCreate) Save(ctx context.Context) (*EnvCronTrigger, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnvCronTriggerCreate) SaveX(ctx context.Context) *EnvCronTrigger {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvCronTriggerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvCronTriggerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnvCronTriggerCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := envcrontrigger.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := envcrontrigger.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.PanelID(); !ok {
+		v := envcrontrigger.DefaultPanelID
+		_c.mutation.SetPanelID(v)
+	}
+	if _, ok := _c.mutation.IsEnable(); !ok {
+		v := envcrontrigger.DefaultIsEnable
+		_c.mutation.SetIsEnable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnvCronTriggerCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnvCronTrigger.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EnvCronTrigger.updated_at"`)}
+	}
+	if _, ok := _c.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`ent: missing required field "EnvCronTrigger.env_id"`)}
+	}
+	if _, ok := _c.mutation.PanelID(); !ok {
+		return &ValidationError{Name: "panel_id", err: errors.New(`ent: missing required field "EnvCronTrigger.panel_id"`)}
+	}
+	if _, ok := _c.mutation.IsEnable(); !ok {
+		return &ValidationError{Name: "is_enable", err: errors.New(`ent: missing required field "EnvCronTrigger.is_enable"`)}
+	}
+	if len(_c.mutation.EnvIDs()) == 0 {
+		return &ValidationError{Name: "env", err: errors.New(`ent: missing required edge "EnvCronTrigger.env"`)}
+	}
+	return nil
+}
+
+func (_c *EnvCronTriggerCreate) sqlSave(ctx context.Context) (*EnvCronTrigger, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnvCronTriggerCreate) createSpec() (*EnvCronTrigger, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvCronTrigger{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(envcrontrigger.Table, sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(envcrontrigger.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(envcrontrigger.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.PanelID(); ok {
+		_spec.SetField(envcrontrigger.FieldPanelID, field.TypeInt64, value)
+		_node.PanelID = value
+	}
+	if value, ok := _c.mutation.CronID(); ok {
+		_spec.SetField(envcrontrigger.FieldCronID, field.TypeInt, value)
+		_node.CronID = &value
+	}
+	if value, ok := _c.mutation.NamePattern(); ok {
+		_spec.SetField(envcrontrigger.FieldNamePattern, field.TypeString, value)
+		_node.NamePattern = &value
+	}
+	if value, ok := _c.mutation.IsEnable(); ok {
+		_spec.SetField(envcrontrigger.FieldIsEnable, field.TypeBool, value)
+		_node.IsEnable = value
+	}
+	if nodes := _c.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envcrontrigger.EnvTable,
+			Columns: []string{envcrontrigger.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnvCronTriggerCreateBulk is the builder for creating many EnvCronTrigger entities in bulk.
+type EnvCronTriggerCreateBulk struct {
+	config
+	err      error
+	builders []*EnvCronTriggerCreate
+}
+
+// Save creates the EnvCronTrigger entities in the database.
+func (_c *EnvCronTriggerCreateBulk) Save(ctx context.Context) ([]*EnvCronTrigger, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnvCronTrigger, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvCronTriggerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnvCronTriggerCreateBulk) SaveX(ctx context.Context) []*EnvCronTrigger {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvCronTriggerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvCronTriggerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// EnvCronTriggerUpdate is the builder for updating EnvCronTrigger entities.
+type EnvCronTriggerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvCronTriggerMutation
+}
+
+// Where appends a list predicates to the EnvCronTriggerUpdate builder.
+func (_u *EnvCronTriggerUpdate) Where(ps ...predicate.EnvCronTrigger) *EnvCronTriggerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnvCronTriggerUpdate) SetUpdatedAt(v time.Time) *EnvCronTriggerUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *EnvCronTriggerUpdate) SetEnvID(v int64) *EnvCronTriggerUpdate {
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdate) SetNillableEnvID(v *int64) *EnvCronTriggerUpdate {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *EnvCronTriggerUpdate) SetPanelID(v int64) *EnvCronTriggerUpdate {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdate) SetNillablePanelID(v *int64) *EnvCronTriggerUpdate {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *EnvCronTriggerUpdate) AddPanelID(v int64) *EnvCronTriggerUpdate {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetCronID sets the "cron_id" field.
+func (_u *EnvCronTriggerUpdate) SetCronID(v int) *EnvCronTriggerUpdate {
+	_u.mutation.ResetCronID()
+	_u.mutation.SetCronID(v)
+	return _u
+}
+
+// SetNillableCronID sets the "cron_id" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdate) SetNillableCronID(v *int) *EnvCronTriggerUpdate {
+	if v != nil {
+		_u.SetCronID(*v)
+	}
+	return _u
+}
+
+// AddCronID adds value to the "cron_id" field.
+func (_u *EnvCronTriggerUpdate) AddCronID(v int) *EnvCronTriggerUpdate {
+	_u.mutation.AddCronID(v)
+	return _u
+}
+
+// ClearCronID clears the value of the "cron_id" field.
+func (_u *EnvCronTriggerUpdate) ClearCronID() *EnvCronTriggerUpdate {
+	_u.mutation.ClearCronID()
+	return _u
+}
+
+// SetNamePattern sets the "name_pattern" field.
+func (_u *EnvCronTriggerUpdate) SetNamePattern(v string) *EnvCronTriggerUpdate {
+	_u.mutation.SetNamePattern(v)
+	return _u
+}
+
+// SetNillableNamePattern sets the "name_pattern" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdate) SetNillableNamePattern(v *string) *EnvCronTriggerUpdate {
+	if v != nil {
+		_u.SetNamePattern(*v)
+	}
+	return _u
+}
+
+// ClearNamePattern clears the value of the "name_pattern" field.
+func (_u *EnvCronTriggerUpdate) ClearNamePattern() *EnvCronTriggerUpdate {
+	_u.mutation.ClearNamePattern()
+	return _u
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_u *EnvCronTriggerUpdate) SetIsEnable(v bool) *EnvCronTriggerUpdate {
+	_u.mutation.SetIsEnable(v)
+	return _u
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdate) SetNillableIsEnable(v *bool) *EnvCronTriggerUpdate {
+	if v != nil {
+		_u.SetIsEnable(*v)
+	}
+	return _u
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (_u *EnvCronTriggerUpdate) SetEnv(v *Env) *EnvCronTriggerUpdate {
+	return _u.SetEnvID(v.ID)
+}
+
+// Mutation returns the EnvCronTriggerMutation object of the builder.
+func (_u *EnvCronTriggerUpdate) Mutation() *EnvCronTriggerMutation {
+	return _u.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (_u *EnvCronTriggerUpdate) ClearEnv() *EnvCronTriggerUpdate {
+	_u.mutation.ClearEnv()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnvCronTriggerUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvCronTriggerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnvCronTriggerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvCronTriggerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnvCronTriggerUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := envcrontrigger.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvCronTriggerUpdate) check() error {
+	if _u.mutation.EnvCleared() && len(_u.mutation.EnvIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvCronTrigger.env"`)
+	}
+	return nil
+}
+
+func (_u *EnvCronTriggerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envcrontrigger.Table, envcrontrigger.Columns, sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(envcrontrigger.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(envcrontrigger.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(envcrontrigger.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CronID(); ok {
+		_spec.SetField(envcrontrigger.FieldCronID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCronID(); ok {
+		_spec.AddField(envcrontrigger.FieldCronID, field.TypeInt, value)
+	}
+	if _u.mutation.CronIDCleared() {
+		_spec.ClearField(envcrontrigger.FieldCronID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.NamePattern(); ok {
+		_spec.SetField(envcrontrigger.FieldNamePattern, field.TypeString, value)
+	}
+	if _u.mutation.NamePatternCleared() {
+		_spec.ClearField(envcrontrigger.FieldNamePattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEnable(); ok {
+		_spec.SetField(envcrontrigger.FieldIsEnable, field.TypeBool, value)
+	}
+	if _u.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envcrontrigger.EnvTable,
+			Columns: []string{envcrontrigger.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envcrontrigger.EnvTable,
+			Columns: []string{envcrontrigger.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envcrontrigger.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnvCronTriggerUpdateOne is the builder for updating a single EnvCronTrigger entity.
+type EnvCronTriggerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvCronTriggerMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnvCronTriggerUpdateOne) SetUpdatedAt(v time.Time) *EnvCronTriggerUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *EnvCronTriggerUpdateOne) SetEnvID(v int64) *EnvCronTriggerUpdateOne {
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdateOne) SetNillableEnvID(v *int64) *EnvCronTriggerUpdateOne {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *EnvCronTriggerUpdateOne) SetPanelID(v int64) *EnvCronTriggerUpdateOne {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdateOne) SetNillablePanelID(v *int64) *EnvCronTriggerUpdateOne {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *EnvCronTriggerUpdateOne) AddPanelID(v int64) *EnvCronTriggerUpdateOne {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetCronID sets the "cron_id" field.
+func (_u *EnvCronTriggerUpdateOne) SetCronID(v int) *EnvCronTriggerUpdateOne {
+	_u.mutation.ResetCronID()
+	_u.mutation.SetCronID(v)
+	return _u
+}
+
+// SetNillableCronID sets the "cron_id" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdateOne) SetNillableCronID(v *int) *EnvCronTriggerUpdateOne {
+	if v != nil {
+		_u.SetCronID(*v)
+	}
+	return _u
+}
+
+// AddCronID adds value to the "cron_id" field.
+func (_u *EnvCronTriggerUpdateOne) AddCronID(v int) *EnvCronTriggerUpdateOne {
+	_u.mutation.AddCronID(v)
+	return _u
+}
+
+// ClearCronID clears the value of the "cron_id" field.
+func (_u *EnvCronTriggerUpdateOne) ClearCronID() *EnvCronTriggerUpdateOne {
+	_u.mutation.ClearCronID()
+	return _u
+}
+
+// SetNamePattern sets the "name_pattern" field.
+func (_u *EnvCronTriggerUpdateOne) SetNamePattern(v string) *EnvCronTriggerUpdateOne {
+	_u.mutation.SetNamePattern(v)
+	return _u
+}
+
+// SetNillableNamePattern sets the "name_pattern" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdateOne) SetNillableNamePattern(v *string) *EnvCronTriggerUpdateOne {
+	if v != nil {
+		_u.SetNamePattern(*v)
+	}
+	return _u
+}
+
+// ClearNamePattern clears the value of the "name_pattern" field.
+func (_u *EnvCronTriggerUpdateOne) ClearNamePattern() *EnvCronTriggerUpdateOne {
+	_u.mutation.ClearNamePattern()
+	return _u
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_u *EnvCronTriggerUpdateOne) SetIsEnable(v bool) *EnvCronTriggerUpdateOne {
+	_u.mutation.SetIsEnable(v)
+	return _u
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_u *EnvCronTriggerUpdateOne) SetNillableIsEnable(v *bool) *EnvCronTriggerUpdateOne {
+	if v != nil {
+		_u.SetIsEnable(*v)
+	}
+	return _u
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (_u *EnvCronTriggerUpdateOne) SetEnv(v *Env) *EnvCronTriggerUpdateOne {
+	return _u.SetEnvID(v.ID)
+}
+
+// Mutation returns the EnvCronTriggerMutation object of the builder.
+func (_u *EnvCronTriggerUpdateOne) Mutation() *EnvCronTriggerMutation {
+	return _u.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (_u *EnvCronTriggerUpdateOne) ClearEnv() *EnvCronTriggerUpdateOne {
+	_u.mutation.ClearEnv()
+	return _u
+}
+
+// Where appends a list predicates to the EnvCronTriggerUpdate builder.
+func (_u *EnvCronTriggerUpdateOne) Where(ps ...predicate.EnvCronTrigger) *EnvCronTriggerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnvCronTriggerUpdateOne) Select(field string, fields ...string) *EnvCronTriggerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnvCronTrigger entity.
+func (_u *EnvCronTriggerUpdateOne) Save(ctx context.Context) (*EnvCronTrigger, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvCronTriggerUpdateOne) SaveX(ctx context.Context) *EnvCronTrigger {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnvCronTriggerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvCronTriggerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnvCronTriggerUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := envcrontrigger.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvCronTriggerUpdateOne) check() error {
+	if _u.mutation.EnvCleared() && len(_u.mutation.EnvIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvCronTrigger.env"`)
+	}
+	return nil
+}
+
+func (_u *EnvCronTriggerUpdateOne) sqlSave(ctx context.Context) (_node *EnvCronTrigger, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envcrontrigger.Table, envcrontrigger.Columns, sqlgraph.NewFieldSpec(envcrontrigger.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnvCronTrigger.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envcrontrigger.FieldID)
+		for _, f := range fields {
+			if !envcrontrigger.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != envcrontrigger.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(envcrontrigger.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(envcrontrigger.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(envcrontrigger.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CronID(); ok {
+		_spec.SetField(envcrontrigger.FieldCronID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCronID(); ok {
+		_spec.AddField(envcrontrigger.FieldCronID, field.TypeInt, value)
+	}
+	if _u.mutation.CronIDCleared() {
+		_spec.ClearField(envcrontrigger.FieldCronID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.NamePattern(); ok {
+		_spec.SetField(envcrontrigger.FieldNamePattern, field.TypeString, value)
+	}
+	if _u.mutation.NamePatternCleared() {
+		_spec.ClearField(envcrontrigger.FieldNamePattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEnable(); ok {
+		_spec.SetField(envcrontrigger.FieldIsEnable, field.TypeBool, value)
+	}
+	if _u.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envcrontrigger.EnvTable,
+			Columns: []string{envcrontrigger.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envcrontrigger.EnvTable,
+			Columns: []string{envcrontrigger.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnvCronTrigger{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envcrontrigger.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
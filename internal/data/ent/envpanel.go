@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+)
+
+// EnvPanel is the model entity for the EnvPanel schema.
+type EnvPanel struct {
+	config `json:"-"`
+	// 环境变量ID
+	EnvID int64 `json:"env_id,omitempty"`
+	// 面板ID
+	PanelID int64 `json:"panel_id,omitempty"`
+	// 该面板上的最大数量(0表示不限制)
+	MaxCount int32 `json:"max_count,omitempty"`
+	// 绑定权重(为空时按1处理)
+	Weight *int `json:"weight,omitempty"`
+	// 是否启用
+	IsEnable bool `json:"is_enable,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnvPanelQuery when eager-loading is set.
+	Edges        EnvPanelEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnvPanelEdges holds the relations/edges for other nodes in the graph.
+type EnvPanelEdges struct {
+	// Env holds the value of the env edge.
+	Env *Env `json:"env,omitempty"`
+	// Panel holds the value of the panel edge.
+	Panel *Panel `json:"panel,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EnvOrErr returns the Env value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvPanelEdges) EnvOrErr() (*Env, error) {
+	if e.Env != nil {
+		return e.Env, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: env.Label}
+	}
+	return nil, &NotLoadedError{edge: "env"}
+}
+
+// PanelOrErr returns the Panel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvPanelEdges) PanelOrErr() (*Panel, error) {
+	if e.Panel != nil {
+		return e.Panel, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: panel.Label}
+	}
+	return nil, &NotLoadedError{edge: "panel"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvPanel) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envpanel.FieldIsEnable:
+			values[i] = new(sql.NullBool)
+		case envpanel.FieldEnvID, envpanel.FieldPanelID, envpanel.FieldMaxCount, envpanel.FieldWeight:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvPanel fields.
+func (_m *EnvPanel) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envpanel.FieldEnvID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				_m.EnvID = value.Int64
+			}
+		case envpanel.FieldPanelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field panel_id", values[i])
+			} else if value.Valid {
+				_m.PanelID = value.Int64
+			}
+		case envpanel.FieldMaxCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_count", values[i])
+			} else if value.Valid {
+				_m.MaxCount = int32(value.Int64)
+			}
+		case envpanel.FieldWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = new(int)
+				*_m.Weight = int(value.Int64)
+			}
+		case envpanel.FieldIsEnable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enable", values[i])
+			} else if value.Valid {
+				_m.IsEnable = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvPanel.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnvPanel) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEnv queries the "env" edge of the EnvPanel entity.
+func (_m *EnvPanel) QueryEnv() *EnvQuery {
+	return NewEnvPanelClient(_m.config).QueryEnv(_m)
+}
+
+// QueryPanel queries the "panel" edge of the EnvPanel entity.
+func (_m *EnvPanel) QueryPanel() *PanelQuery {
+	return NewEnvPanelClient(_m.config).QueryPanel(_m)
+}
+
+// Update returns a builder for updating this EnvPanel.
+// Note that you need to call EnvPanel.Unwrap() before calling this method if this EnvPanel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnvPanel) Update() *EnvPanelUpdateOne {
+	return NewEnvPanelClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnvPanel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnvPanel) Unwrap() *EnvPanel {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnvPanel is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnvPanel) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvPanel(")
+	builder.WriteString("env_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnvID))
+	builder.WriteString(", ")
+	builder.WriteString("panel_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PanelID))
+	builder.WriteString(", ")
+	builder.WriteString("max_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxCount))
+	builder.WriteString(", ")
+	if v := _m.Weight; v != nil {
+		builder.WriteString("weight=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_enable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsEnable))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvPanels is a parsable slice of EnvPanel.
+type EnvPanels []*EnvPanel
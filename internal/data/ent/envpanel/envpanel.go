@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envpanel
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the envpanel type in the database.
+	Label = "env_panel"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldPanelID holds the string denoting the panel_id field in the database.
+	FieldPanelID = "panel_id"
+	// FieldMaxCount holds the string denoting the max_count field in the database.
+	FieldMaxCount = "max_count"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldIsEnable holds the string denoting the is_enable field in the database.
+	FieldIsEnable = "is_enable"
+	// EdgeEnv holds the string denoting the env edge name in mutations.
+	EdgeEnv = "env"
+	// EdgePanel holds the string denoting the panel edge name in mutations.
+	EdgePanel = "panel"
+	// EnvFieldID holds the string denoting the ID field of the Env.
+	EnvFieldID = "id"
+	// PanelFieldID holds the string denoting the ID field of the Panel.
+	PanelFieldID = "id"
+	// Table holds the table name of the envpanel in the database.
+	Table = "env_panels"
+	// EnvTable is the table that holds the env relation/edge.
+	EnvTable = "env_panels"
+	// EnvInverseTable is the table name for the Env entity.
+	// It exists in this package in order to avoid circular dependency with the "env" package.
+	EnvInverseTable = "envs"
+	// EnvColumn is the table column denoting the env relation/edge.
+	EnvColumn = "env_id"
+	// PanelTable is the table that holds the panel relation/edge.
+	PanelTable = "env_panels"
+	// PanelInverseTable is the table name for the Panel entity.
+	// It exists in this package in order to avoid circular dependency with the "panel" package.
+	PanelInverseTable = "panels"
+	// PanelColumn is the table column denoting the panel relation/edge.
+	PanelColumn = "panel_id"
+)
+
+// Columns holds all SQL columns for envpanel fields.
+var Columns = []string{
+	FieldEnvID,
+	FieldPanelID,
+	FieldMaxCount,
+	FieldWeight,
+	FieldIsEnable,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMaxCount holds the default value on creation for the "max_count" field.
+	DefaultMaxCount int32
+	// DefaultIsEnable holds the default value on creation for the "is_enable" field.
+	DefaultIsEnable bool
+)
+
+// OrderOption defines the ordering options for the EnvPanel queries.
+type OrderOption func(*sql.Selector)
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByPanelID orders the results by the panel_id field.
+func ByPanelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPanelID, opts...).ToFunc()
+}
+
+// ByMaxCount orders the results by the max_count field.
+func ByMaxCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCount, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByIsEnable orders the results by the is_enable field.
+func ByIsEnable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEnable, opts...).ToFunc()
+}
+
+// ByEnvField orders the results by env field.
+func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPanelField orders the results by panel field.
+func ByPanelField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPanelStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEnvStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, EnvColumn),
+		sqlgraph.To(EnvInverseTable, EnvFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EnvTable, EnvColumn),
+	)
+}
+func newPanelStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, PanelColumn),
+		sqlgraph.To(PanelInverseTable, PanelFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PanelTable, PanelColumn),
+	)
+}
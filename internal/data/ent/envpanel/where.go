@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envpanel
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldEnvID, v))
+}
+
+// PanelID applies equality check predicate on the "panel_id" field. It's identical to PanelIDEQ.
+func PanelID(v int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldPanelID, v))
+}
+
+// MaxCount applies equality check predicate on the "max_count" field. It's identical to MaxCountEQ.
+func MaxCount(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldMaxCount, v))
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldWeight, v))
+}
+
+// IsEnable applies equality check predicate on the "is_enable" field. It's identical to IsEnableEQ.
+func IsEnable(v bool) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldIsEnable, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// PanelIDEQ applies the EQ predicate on the "panel_id" field.
+func PanelIDEQ(v int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldPanelID, v))
+}
+
+// PanelIDNEQ applies the NEQ predicate on the "panel_id" field.
+func PanelIDNEQ(v int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNEQ(FieldPanelID, v))
+}
+
+// PanelIDIn applies the In predicate on the "panel_id" field.
+func PanelIDIn(vs ...int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldIn(FieldPanelID, vs...))
+}
+
+// PanelIDNotIn applies the NotIn predicate on the "panel_id" field.
+func PanelIDNotIn(vs ...int64) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNotIn(FieldPanelID, vs...))
+}
+
+// MaxCountEQ applies the EQ predicate on the "max_count" field.
+func MaxCountEQ(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldMaxCount, v))
+}
+
+// MaxCountNEQ applies the NEQ predicate on the "max_count" field.
+func MaxCountNEQ(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNEQ(FieldMaxCount, v))
+}
+
+// MaxCountIn applies the In predicate on the "max_count" field.
+func MaxCountIn(vs ...int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldIn(FieldMaxCount, vs...))
+}
+
+// MaxCountNotIn applies the NotIn predicate on the "max_count" field.
+func MaxCountNotIn(vs ...int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNotIn(FieldMaxCount, vs...))
+}
+
+// MaxCountGT applies the GT predicate on the "max_count" field.
+func MaxCountGT(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldGT(FieldMaxCount, v))
+}
+
+// MaxCountGTE applies the GTE predicate on the "max_count" field.
+func MaxCountGTE(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldGTE(FieldMaxCount, v))
+}
+
+// MaxCountLT applies the LT predicate on the "max_count" field.
+func MaxCountLT(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldLT(FieldMaxCount, v))
+}
+
+// MaxCountLTE applies the LTE predicate on the "max_count" field.
+func MaxCountLTE(v int32) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldLTE(FieldMaxCount, v))
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v int) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldLTE(FieldWeight, v))
+}
+
+// WeightIsNil applies the IsNil predicate on the "weight" field.
+func WeightIsNil() predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldIsNull(FieldWeight))
+}
+
+// WeightNotNil applies the NotNil predicate on the "weight" field.
+func WeightNotNil() predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNotNull(FieldWeight))
+}
+
+// IsEnableEQ applies the EQ predicate on the "is_enable" field.
+func IsEnableEQ(v bool) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldEQ(FieldIsEnable, v))
+}
+
+// IsEnableNEQ applies the NEQ predicate on the "is_enable" field.
+func IsEnableNEQ(v bool) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.FieldNEQ(FieldIsEnable, v))
+}
+
+// HasEnv applies the HasEdge predicate on the "env" edge.
+func HasEnv() predicate.EnvPanel {
+	return predicate.EnvPanel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, EnvColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, EnvTable, EnvColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvWith applies the HasEdge predicate on the "env" edge with a given conditions (other predicates).
+func HasEnvWith(preds ...predicate.Env) predicate.EnvPanel {
+	return predicate.EnvPanel(func(s *sql.Selector) {
+		step := newEnvStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPanel applies the HasEdge predicate on the "panel" edge.
+func HasPanel() predicate.EnvPanel {
+	return predicate.EnvPanel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, PanelColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, PanelTable, PanelColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPanelWith applies the HasEdge predicate on the "panel" edge with a given conditions (other predicates).
+func HasPanelWith(preds ...predicate.Panel) predicate.EnvPanel {
+	return predicate.EnvPanel(func(s *sql.Selector) {
+		step := newPanelStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvPanel) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvPanel) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvPanel) predicate.EnvPanel {
+	return predicate.EnvPanel(sql.NotPredicates(p))
+}
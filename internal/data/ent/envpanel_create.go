@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+)
+
+// EnvPanelCreate is the builder for creating a EnvPanel entity.
+type EnvPanelCreate struct {
+	config
+	mutation *EnvPanelMutation
+	hooks    []Hook
+}
+
+// SetEnvID sets the "env_id" field.
+func (_c *EnvPanelCreate) SetEnvID(v int64) *EnvPanelCreate {
+	_c.mutation.SetEnvID(v)
+	return _c
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_c *EnvPanelCreate) SetPanelID(v int64) *EnvPanelCreate {
+	_c.mutation.SetPanelID(v)
+	return _c
+}
+
+// SetMaxCount sets the "max_count" field.
+func (_c *EnvPanelCreate) SetMaxCount(v int32) *EnvPanelCreate {
+	_c.mutation.SetMaxCount(v)
+	return _c
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (_c *EnvPanelCreate) SetNillableMaxCount(v *int32) *EnvPanelCreate {
+	if v != nil {
+		_c.SetMaxCount(*v)
+	}
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *EnvPanelCreate) SetWeight(v int) *EnvPanelCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *EnvPanelCreate) SetNillableWeight(v *int) *EnvPanelCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_c *EnvPanelCreate) SetIsEnable(v bool) *EnvPanelCreate {
+	_c.mutation.SetIsEnable(v)
+	return _c
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_c *EnvPanelCreate) SetNillableIsEnable(v *bool) *EnvPanelCreate {
+	if v != nil {
+		_c.SetIsEnable(*v)
+	}
+	return _c
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (_c *EnvPanelCreate) SetEnv(v *Env) *EnvPanelCreate {
+	return _c.SetEnvID(v.ID)
+}
+
+// SetPanel sets the "panel" edge to the Panel entity.
+func (_c *EnvPanelCreate) SetPanel(v *Panel) *EnvPanelCreate {
+	return _c.SetPanelID(v.ID)
+}
+
+// Mutation returns the EnvPanelMutation object of the builder.
+func (_c *EnvPanelCreate) Mutation() *EnvPanelMutation {
+	return _c.mutation
+}
+
+// Save creates the EnvPanel in the database.
+func (_c *EnvPanelCreate) Save(ctx context.Context) (*EnvPanel, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnvPanelCreate) SaveX(ctx context.Context) *EnvPanel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvPanelCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvPanelCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnvPanelCreate) defaults() {
+	if _, ok := _c.mutation.MaxCount(); !ok {
+		v := envpanel.DefaultMaxCount
+		_c.mutation.SetMaxCount(v)
+	}
+	if _, ok := _c.mutation.IsEnable(); !ok {
+		v := envpanel.DefaultIsEnable
+		_c.mutation.SetIsEnable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnvPanelCreate) check() error {
+	if _, ok := _c.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`ent: missing required field "EnvPanel.env_id"`)}
+	}
+	if _, ok := _c.mutation.PanelID(); !ok {
+		return &ValidationError{Name: "panel_id", err: errors.New(`ent: missing required field "EnvPanel.panel_id"`)}
+	}
+	if _, ok := _c.mutation.MaxCount(); !ok {
+		return &ValidationError{Name: "max_count", err: errors.New(`ent: missing required field "EnvPanel.max_count"`)}
+	}
+	if _, ok := _c.mutation.IsEnable(); !ok {
+		return &ValidationError{Name: "is_enable", err: errors.New(`ent: missing required field "EnvPanel.is_enable"`)}
+	}
+	if len(_c.mutation.EnvIDs()) == 0 {
+		return &ValidationError{Name: "env", err: errors.New(`ent: missing required edge "EnvPanel.env"`)}
+	}
+	if len(_c.mutation.PanelIDs()) == 0 {
+		return &ValidationError{Name: "panel", err: errors.New(`ent: missing required edge "EnvPanel.panel"`)}
+	}
+	return nil
+}
+
+func (_c *EnvPanelCreate) sqlSave(ctx context.Context) (*EnvPanel, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *EnvPanelCreate) createSpec() (*EnvPanel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvPanel{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(envpanel.Table, nil)
+	)
+	if value, ok := _c.mutation.MaxCount(); ok {
+		_spec.SetField(envpanel.FieldMaxCount, field.TypeInt32, value)
+		_node.MaxCount = value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(envpanel.FieldWeight, field.TypeInt, value)
+		_node.Weight = &value
+	}
+	if value, ok := _c.mutation.IsEnable(); ok {
+		_spec.SetField(envpanel.FieldIsEnable, field.TypeBool, value)
+		_node.IsEnable = value
+	}
+	if nodes := _c.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.EnvTable,
+			Columns: []string{envpanel.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PanelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.PanelTable,
+			Columns: []string{envpanel.PanelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PanelID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EnvPanelCreateBulk is the builder for creating many EnvPanel entities in bulk.
+type EnvPanelCreateBulk struct {
+	config
+	err      error
+	builders []*EnvPanelCreate
+}
+
+// Save creates the EnvPanel entities in the database.
+func (_c *EnvPanelCreateBulk) Save(ctx context.Context) ([]*EnvPanel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnvPanel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvPanelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnvPanelCreateBulk) SaveX(ctx context.Context) []*EnvPanel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvPanelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvPanelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// EnvPanelQuery is the builder for querying EnvPanel entities.
+type EnvPanelQuery struct {
+	config
+	ctx        *QueryContext
+	order      []envpanel.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EnvPanel
+	withEnv    *EnvQuery
+	withPanel  *PanelQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EnvPanelQuery builder.
+func (_q *EnvPanelQuery) Where(ps ...predicate.EnvPanel) *EnvPanelQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *EnvPanelQuery) Limit(limit int) *EnvPanelQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *EnvPanelQuery) Offset(offset int) *EnvPanelQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *EnvPanelQuery) Unique(unique bool) *EnvPanelQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *EnvPanelQuery) Order(o ...envpanel.OrderOption) *EnvPanelQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryEnv chains the current query on the "env" edge.
+func (_q *EnvPanelQuery) QueryEnv() *EnvQuery {
+	query := (&EnvClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envpanel.Table, envpanel.EnvColumn, selector),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, envpanel.EnvTable, envpanel.EnvColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPanel chains the current query on the "panel" edge.
+func (_q *EnvPanelQuery) QueryPanel() *PanelQuery {
+	query := (&PanelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envpanel.Table, envpanel.PanelColumn, selector),
+			sqlgraph.To(panel.Table, panel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, envpanel.PanelTable, envpanel.PanelColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EnvPanel entity from the query.
+// Returns a *NotFoundError when no EnvPanel was found.
+func (_q *EnvPanelQuery) First(ctx context.Context) (*EnvPanel, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{envpanel.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *EnvPanelQuery) FirstX(ctx context.Context) *EnvPanel {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single EnvPanel entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EnvPanel entity is found.
+// Returns a *NotFoundError when no EnvPanel entities are found.
+func (_q *EnvPanelQuery) Only(ctx context.Context) (*EnvPanel, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{envpanel.Label}
+	default:
+		return nil, &NotSingularError{envpanel.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *EnvPanelQuery) OnlyX(ctx context.Context) *EnvPanel {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of EnvPanels.
+func (_q *EnvPanelQuery) All(ctx context.Context) ([]*EnvPanel, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EnvPanel, *EnvPanelQuery]()
+	return withInterceptors[[]*EnvPanel](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *EnvPanelQuery) AllX(ctx context.Context) []*EnvPanel {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (_q *EnvPanelQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*EnvPanelQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *EnvPanelQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *EnvPanelQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *EnvPanelQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EnvPanelQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *EnvPanelQuery) Clone() *EnvPanelQuery {
+	if _q == nil {
+		return nil
+	}
+	return &EnvPanelQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]envpanel.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.EnvPanel{}, _q.predicates...),
+		withEnv:    _q.withEnv.Clone(),
+		withPanel:  _q.withPanel.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithEnv tells the query-builder to eager-load the nodes that are connected to
+// the "env" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnvPanelQuery) WithEnv(opts ...func(*EnvQuery)) *EnvPanelQuery {
+	query := (&EnvClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnv = query
+	return _q
+}
+
+// WithPanel tells the query-builder to eager-load the nodes that are connected to
+// the "panel" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnvPanelQuery) WithPanel(opts ...func(*PanelQuery)) *EnvPanelQuery {
+	query := (&PanelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPanel = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EnvID int64 `json:"env_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EnvPanel.Query().
+//		GroupBy(envpanel.FieldEnvID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *EnvPanelQuery) GroupBy(field string, fields ...string) *EnvPanelGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EnvPanelGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = envpanel.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EnvID int64 `json:"env_id,omitempty"`
+//	}
+//
+//	client.EnvPanel.Query().
+//		Select(envpanel.FieldEnvID).
+//		Scan(ctx, &v)
+func (_q *EnvPanelQuery) Select(fields ...string) *EnvPanelSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &EnvPanelSelect{EnvPanelQuery: _q}
+	sbuild.label = envpanel.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EnvPanelSelect configured with the given aggregations.
+func (_q *EnvPanelQuery) Aggregate(fns ...AggregateFunc) *EnvPanelSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *EnvPanelQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !envpanel.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *EnvPanelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EnvPanel, error) {
+	var (
+		nodes       = []*EnvPanel{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withEnv != nil,
+			_q.withPanel != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EnvPanel).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EnvPanel{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withEnv; query != nil {
+		if err := _q.loadEnv(ctx, query, nodes, nil,
+			func(n *EnvPanel, e *Env) { n.Edges.Env = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPanel; query != nil {
+		if err := _q.loadPanel(ctx, query, nodes, nil,
+			func(n *EnvPanel, e *Panel) { n.Edges.Panel = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *EnvPanelQuery) loadEnv(ctx context.Context, query *EnvQuery, nodes []*EnvPanel, init func(*EnvPanel), assign func(*EnvPanel, *Env)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*EnvPanel)
+	for i := range nodes {
+		fk := nodes[i].EnvID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(env.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "env_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EnvPanelQuery) loadPanel(ctx context.Context, query *PanelQuery, nodes []*EnvPanel, init func(*EnvPanel), assign func(*EnvPanel, *Panel)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*EnvPanel)
+	for i := range nodes {
+		fk := nodes[i].PanelID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(panel.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "panel_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *EnvPanelQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *EnvPanelQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(envpanel.Table, envpanel.Columns, nil)
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if _q.withEnv != nil {
+			_spec.Node.AddColumnOnce(envpanel.FieldEnvID)
+		}
+		if _q.withPanel != nil {
+			_spec.Node.AddColumnOnce(envpanel.FieldPanelID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *EnvPanelQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(envpanel.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = envpanel.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EnvPanelGroupBy is the group-by builder for EnvPanel entities.
+type EnvPanelGroupBy struct {
+	selector
+	build *EnvPanelQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *EnvPanelGroupBy) Aggregate(fns ...AggregateFunc) *EnvPanelGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *EnvPanelGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvPanelQuery, *EnvPanelGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *EnvPanelGroupBy) sqlScan(ctx context.Context, root *EnvPanelQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EnvPanelSelect is the builder for selecting fields of EnvPanel entities.
+type EnvPanelSelect struct {
+	*EnvPanelQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *EnvPanelSelect) Aggregate(fns ...AggregateFunc) *EnvPanelSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *EnvPanelSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvPanelQuery, *EnvPanelSelect](ctx, _s.EnvPanelQuery, _s, _s.inters, v)
+}
+
+func (_s *EnvPanelSelect) sqlScan(ctx context.Context, root *EnvPanelQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
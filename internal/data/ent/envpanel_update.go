@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// EnvPanelUpdate is the builder for updating EnvPanel entities.
+type EnvPanelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvPanelMutation
+}
+
+// Where appends a list predicates to the EnvPanelUpdate builder.
+func (_u *EnvPanelUpdate) Where(ps ...predicate.EnvPanel) *EnvPanelUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *EnvPanelUpdate) SetEnvID(v int64) *EnvPanelUpdate {
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *EnvPanelUpdate) SetNillableEnvID(v *int64) *EnvPanelUpdate {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *EnvPanelUpdate) SetPanelID(v int64) *EnvPanelUpdate {
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *EnvPanelUpdate) SetNillablePanelID(v *int64) *EnvPanelUpdate {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// SetMaxCount sets the "max_count" field.
+func (_u *EnvPanelUpdate) SetMaxCount(v int32) *EnvPanelUpdate {
+	_u.mutation.ResetMaxCount()
+	_u.mutation.SetMaxCount(v)
+	return _u
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (_u *EnvPanelUpdate) SetNillableMaxCount(v *int32) *EnvPanelUpdate {
+	if v != nil {
+		_u.SetMaxCount(*v)
+	}
+	return _u
+}
+
+// AddMaxCount adds value to the "max_count" field.
+func (_u *EnvPanelUpdate) AddMaxCount(v int32) *EnvPanelUpdate {
+	_u.mutation.AddMaxCount(v)
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *EnvPanelUpdate) SetWeight(v int) *EnvPanelUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *EnvPanelUpdate) SetNillableWeight(v *int) *EnvPanelUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *EnvPanelUpdate) AddWeight(v int) *EnvPanelUpdate {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *EnvPanelUpdate) ClearWeight() *EnvPanelUpdate {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_u *EnvPanelUpdate) SetIsEnable(v bool) *EnvPanelUpdate {
+	_u.mutation.SetIsEnable(v)
+	return _u
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_u *EnvPanelUpdate) SetNillableIsEnable(v *bool) *EnvPanelUpdate {
+	if v != nil {
+		_u.SetIsEnable(*v)
+	}
+	return _u
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (_u *EnvPanelUpdate) SetEnv(v *Env) *EnvPanelUpdate {
+	return _u.SetEnvID(v.ID)
+}
+
+// SetPanel sets the "panel" edge to the Panel entity.
+func (_u *EnvPanelUpdate) SetPanel(v *Panel) *EnvPanelUpdate {
+	return _u.SetPanelID(v.ID)
+}
+
+// Mutation returns the EnvPanelMutation object of the builder.
+func (_u *EnvPanelUpdate) Mutation() *EnvPanelMutation {
+	return _u.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (_u *EnvPanelUpdate) ClearEnv() *EnvPanelUpdate {
+	_u.mutation.ClearEnv()
+	return _u
+}
+
+// ClearPanel clears the "panel" edge to the Panel entity.
+func (_u *EnvPanelUpdate) ClearPanel() *EnvPanelUpdate {
+	_u.mutation.ClearPanel()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnvPanelUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvPanelUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnvPanelUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvPanelUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvPanelUpdate) check() error {
+	if _u.mutation.EnvCleared() && len(_u.mutation.EnvIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvPanel.env"`)
+	}
+	if _u.mutation.PanelCleared() && len(_u.mutation.PanelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvPanel.panel"`)
+	}
+	return nil
+}
+
+func (_u *EnvPanelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envpanel.Table, envpanel.Columns, sqlgraph.NewFieldSpec(envpanel.FieldEnvID, field.TypeInt64), sqlgraph.NewFieldSpec(envpanel.FieldPanelID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MaxCount(); ok {
+		_spec.SetField(envpanel.FieldMaxCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCount(); ok {
+		_spec.AddField(envpanel.FieldMaxCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(envpanel.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(envpanel.FieldWeight, field.TypeInt, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(envpanel.FieldWeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IsEnable(); ok {
+		_spec.SetField(envpanel.FieldIsEnable, field.TypeBool, value)
+	}
+	if _u.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.EnvTable,
+			Columns: []string{envpanel.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.EnvTable,
+			Columns: []string{envpanel.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PanelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.PanelTable,
+			Columns: []string{envpanel.PanelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PanelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.PanelTable,
+			Columns: []string{envpanel.PanelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envpanel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnvPanelUpdateOne is the builder for updating a single EnvPanel entity.
+type EnvPanelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvPanelMutation
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *EnvPanelUpdateOne) SetEnvID(v int64) *EnvPanelUpdateOne {
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *EnvPanelUpdateOne) SetNillableEnvID(v *int64) *EnvPanelUpdateOne {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *EnvPanelUpdateOne) SetPanelID(v int64) *EnvPanelUpdateOne {
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *EnvPanelUpdateOne) SetNillablePanelID(v *int64) *EnvPanelUpdateOne {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// SetMaxCount sets the "max_count" field.
+func (_u *EnvPanelUpdateOne) SetMaxCount(v int32) *EnvPanelUpdateOne {
+	_u.mutation.ResetMaxCount()
+	_u.mutation.SetMaxCount(v)
+	return _u
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (_u *EnvPanelUpdateOne) SetNillableMaxCount(v *int32) *EnvPanelUpdateOne {
+	if v != nil {
+		_u.SetMaxCount(*v)
+	}
+	return _u
+}
+
+// AddMaxCount adds value to the "max_count" field.
+func (_u *EnvPanelUpdateOne) AddMaxCount(v int32) *EnvPanelUpdateOne {
+	_u.mutation.AddMaxCount(v)
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *EnvPanelUpdateOne) SetWeight(v int) *EnvPanelUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *EnvPanelUpdateOne) SetNillableWeight(v *int) *EnvPanelUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *EnvPanelUpdateOne) AddWeight(v int) *EnvPanelUpdateOne {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *EnvPanelUpdateOne) ClearWeight() *EnvPanelUpdateOne {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_u *EnvPanelUpdateOne) SetIsEnable(v bool) *EnvPanelUpdateOne {
+	_u.mutation.SetIsEnable(v)
+	return _u
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_u *EnvPanelUpdateOne) SetNillableIsEnable(v *bool) *EnvPanelUpdateOne {
+	if v != nil {
+		_u.SetIsEnable(*v)
+	}
+	return _u
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (_u *EnvPanelUpdateOne) SetEnv(v *Env) *EnvPanelUpdateOne {
+	return _u.SetEnvID(v.ID)
+}
+
+// SetPanel sets the "panel" edge to the Panel entity.
+func (_u *EnvPanelUpdateOne) SetPanel(v *Panel) *EnvPanelUpdateOne {
+	return _u.SetPanelID(v.ID)
+}
+
+// Mutation returns the EnvPanelMutation object of the builder.
+func (_u *EnvPanelUpdateOne) Mutation() *EnvPanelMutation {
+	return _u.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (_u *EnvPanelUpdateOne) ClearEnv() *EnvPanelUpdateOne {
+	_u.mutation.ClearEnv()
+	return _u
+}
+
+// ClearPanel clears the "panel" edge to the Panel entity.
+func (_u *EnvPanelUpdateOne) ClearPanel() *EnvPanelUpdateOne {
+	_u.mutation.ClearPanel()
+	return _u
+}
+
+// Where appends a list predicates to the EnvPanelUpdate builder.
+func (_u *EnvPanelUpdateOne) Where(ps ...predicate.EnvPanel) *EnvPanelUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnvPanelUpdateOne) Select(field string, fields ...string) *EnvPanelUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnvPanel entity.
+func (_u *EnvPanelUpdateOne) Save(ctx context.Context) (*EnvPanel, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvPanelUpdateOne) SaveX(ctx context.Context) *EnvPanel {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnvPanelUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvPanelUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvPanelUpdateOne) check() error {
+	if _u.mutation.EnvCleared() && len(_u.mutation.EnvIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvPanel.env"`)
+	}
+	if _u.mutation.PanelCleared() && len(_u.mutation.PanelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvPanel.panel"`)
+	}
+	return nil
+}
+
+func (_u *EnvPanelUpdateOne) sqlSave(ctx context.Context) (_node *EnvPanel, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envpanel.Table, envpanel.Columns, sqlgraph.NewFieldSpec(envpanel.FieldEnvID, field.TypeInt64), sqlgraph.NewFieldSpec(envpanel.FieldPanelID, field.TypeInt64))
+	if id, ok := _u.mutation.EnvID(); !ok {
+		return nil, &ValidationError{Name: "env_id", err: errors.New(`ent: missing "EnvPanel.env_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.PanelID(); !ok {
+		return nil, &ValidationError{Name: "panel_id", err: errors.New(`ent: missing "EnvPanel.panel_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !envpanel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MaxCount(); ok {
+		_spec.SetField(envpanel.FieldMaxCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCount(); ok {
+		_spec.AddField(envpanel.FieldMaxCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(envpanel.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(envpanel.FieldWeight, field.TypeInt, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(envpanel.FieldWeight, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IsEnable(); ok {
+		_spec.SetField(envpanel.FieldIsEnable, field.TypeBool, value)
+	}
+	if _u.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.EnvTable,
+			Columns: []string{envpanel.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.EnvTable,
+			Columns: []string{envpanel.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PanelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.PanelTable,
+			Columns: []string{envpanel.PanelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PanelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envpanel.PanelTable,
+			Columns: []string{envpanel.PanelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(panel.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnvPanel{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envpanel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -34,6 +34,18 @@ type EnvPlugin struct {
 	ExecutionOrder int32 `json:"execution_order,omitempty"`
 	// 插件配置参数
 	Config *string `json:"config,omitempty"`
+	// 执行失败处理策略(fail_closed,fail_open,auto_disable)
+	FailurePolicy string `json:"failure_policy,omitempty"`
+	// 连续失败熔断阈值
+	FailureThreshold int32 `json:"failure_threshold,omitempty"`
+	// 熔断冷却时长(秒)
+	CooldownSeconds int32 `json:"cooldown_seconds,omitempty"`
+	// 连续失败次数
+	ConsecutiveFailures int32 `json:"consecutive_failures,omitempty"`
+	// 熔断截止时间
+	DisabledUntil *time.Time `json:"disabled_until,omitempty"`
+	// 熔断原因
+	DisabledReason *string `json:"disabled_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvPluginQuery when eager-loading is set.
 	Edges        EnvPluginEdges `json:"edges"`
@@ -80,11 +92,11 @@ func (*EnvPlugin) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case envplugin.FieldIsEnable:
 			values[i] = new(sql.NullBool)
-		case envplugin.FieldID, envplugin.FieldEnvID, envplugin.FieldPluginID, envplugin.FieldExecutionOrder:
+		case envplugin.FieldID, envplugin.FieldEnvID, envplugin.FieldPluginID, envplugin.FieldExecutionOrder, envplugin.FieldFailureThreshold, envplugin.FieldCooldownSeconds, envplugin.FieldConsecutiveFailures:
 			values[i] = new(sql.NullInt64)
-		case envplugin.FieldConfig:
+		case envplugin.FieldConfig, envplugin.FieldFailurePolicy, envplugin.FieldDisabledReason:
 			values[i] = new(sql.NullString)
-		case envplugin.FieldCreatedAt, envplugin.FieldUpdatedAt:
+		case envplugin.FieldCreatedAt, envplugin.FieldUpdatedAt, envplugin.FieldDisabledUntil:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -150,6 +162,44 @@ func (_m *EnvPlugin) assignValues(columns []string, values []any) error {
 				_m.Config = new(string)
 				*_m.Config = value.String
 			}
+		case envplugin.FieldFailurePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_policy", values[i])
+			} else if value.Valid {
+				_m.FailurePolicy = value.String
+			}
+		case envplugin.FieldFailureThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_threshold", values[i])
+			} else if value.Valid {
+				_m.FailureThreshold = int32(value.Int64)
+			}
+		case envplugin.FieldCooldownSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cooldown_seconds", values[i])
+			} else if value.Valid {
+				_m.CooldownSeconds = int32(value.Int64)
+			}
+		case envplugin.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int32(value.Int64)
+			}
+		case envplugin.FieldDisabledUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_until", values[i])
+			} else if value.Valid {
+				_m.DisabledUntil = new(time.Time)
+				*_m.DisabledUntil = value.Time
+			}
+		case envplugin.FieldDisabledReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_reason", values[i])
+			} else if value.Valid {
+				_m.DisabledReason = new(string)
+				*_m.DisabledReason = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -218,6 +268,28 @@ func (_m *EnvPlugin) String() string {
 		builder.WriteString("config=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("failure_policy=")
+	builder.WriteString(_m.FailurePolicy)
+	builder.WriteString(", ")
+	builder.WriteString("failure_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailureThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("cooldown_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CooldownSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.DisabledUntil; v != nil {
+		builder.WriteString("disabled_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DisabledReason; v != nil {
+		builder.WriteString("disabled_reason=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
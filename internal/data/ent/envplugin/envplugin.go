@@ -28,6 +28,18 @@ const (
 	FieldExecutionOrder = "execution_order"
 	// FieldConfig holds the string denoting the config field in the database.
 	FieldConfig = "config"
+	// FieldFailurePolicy holds the string denoting the failure_policy field in the database.
+	FieldFailurePolicy = "failure_policy"
+	// FieldFailureThreshold holds the string denoting the failure_threshold field in the database.
+	FieldFailureThreshold = "failure_threshold"
+	// FieldCooldownSeconds holds the string denoting the cooldown_seconds field in the database.
+	FieldCooldownSeconds = "cooldown_seconds"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldDisabledUntil holds the string denoting the disabled_until field in the database.
+	FieldDisabledUntil = "disabled_until"
+	// FieldDisabledReason holds the string denoting the disabled_reason field in the database.
+	FieldDisabledReason = "disabled_reason"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// EdgePlugin holds the string denoting the plugin edge name in mutations.
@@ -60,6 +72,12 @@ var Columns = []string{
 	FieldIsEnable,
 	FieldExecutionOrder,
 	FieldConfig,
+	FieldFailurePolicy,
+	FieldFailureThreshold,
+	FieldCooldownSeconds,
+	FieldConsecutiveFailures,
+	FieldDisabledUntil,
+	FieldDisabledReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -83,6 +101,14 @@ var (
 	DefaultIsEnable bool
 	// DefaultExecutionOrder holds the default value on creation for the "execution_order" field.
 	DefaultExecutionOrder int32
+	// DefaultFailurePolicy holds the default value on creation for the "failure_policy" field.
+	DefaultFailurePolicy string
+	// DefaultFailureThreshold holds the default value on creation for the "failure_threshold" field.
+	DefaultFailureThreshold int32
+	// DefaultCooldownSeconds holds the default value on creation for the "cooldown_seconds" field.
+	DefaultCooldownSeconds int32
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int32
 )
 
 // OrderOption defines the ordering options for the EnvPlugin queries.
@@ -128,6 +154,36 @@ func ByConfig(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConfig, opts...).ToFunc()
 }
 
+// ByFailurePolicy orders the results by the failure_policy field.
+func ByFailurePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailurePolicy, opts...).ToFunc()
+}
+
+// ByFailureThreshold orders the results by the failure_threshold field.
+func ByFailureThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureThreshold, opts...).ToFunc()
+}
+
+// ByCooldownSeconds orders the results by the cooldown_seconds field.
+func ByCooldownSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCooldownSeconds, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByDisabledUntil orders the results by the disabled_until field.
+func ByDisabledUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledUntil, opts...).ToFunc()
+}
+
+// ByDisabledReason orders the results by the disabled_reason field.
+func ByDisabledReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledReason, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -90,6 +90,36 @@ func Config(v string) predicate.EnvPlugin {
 	return predicate.EnvPlugin(sql.FieldEQ(FieldConfig, v))
 }
 
+// FailurePolicy applies equality check predicate on the "failure_policy" field. It's identical to FailurePolicyEQ.
+func FailurePolicy(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldFailurePolicy, v))
+}
+
+// FailureThreshold applies equality check predicate on the "failure_threshold" field. It's identical to FailureThresholdEQ.
+func FailureThreshold(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldFailureThreshold, v))
+}
+
+// CooldownSeconds applies equality check predicate on the "cooldown_seconds" field. It's identical to CooldownSecondsEQ.
+func CooldownSeconds(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldCooldownSeconds, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// DisabledUntil applies equality check predicate on the "disabled_until" field. It's identical to DisabledUntilEQ.
+func DisabledUntil(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldDisabledUntil, v))
+}
+
+// DisabledReason applies equality check predicate on the "disabled_reason" field. It's identical to DisabledReasonEQ.
+func DisabledReason(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldDisabledReason, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EnvPlugin {
 	return predicate.EnvPlugin(sql.FieldEQ(FieldCreatedAt, v))
@@ -335,6 +365,316 @@ func ConfigContainsFold(v string) predicate.EnvPlugin {
 	return predicate.EnvPlugin(sql.FieldContainsFold(FieldConfig, v))
 }
 
+// FailurePolicyEQ applies the EQ predicate on the "failure_policy" field.
+func FailurePolicyEQ(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldFailurePolicy, v))
+}
+
+// FailurePolicyNEQ applies the NEQ predicate on the "failure_policy" field.
+func FailurePolicyNEQ(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNEQ(FieldFailurePolicy, v))
+}
+
+// FailurePolicyIn applies the In predicate on the "failure_policy" field.
+func FailurePolicyIn(vs ...string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIn(FieldFailurePolicy, vs...))
+}
+
+// FailurePolicyNotIn applies the NotIn predicate on the "failure_policy" field.
+func FailurePolicyNotIn(vs ...string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotIn(FieldFailurePolicy, vs...))
+}
+
+// FailurePolicyGT applies the GT predicate on the "failure_policy" field.
+func FailurePolicyGT(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGT(FieldFailurePolicy, v))
+}
+
+// FailurePolicyGTE applies the GTE predicate on the "failure_policy" field.
+func FailurePolicyGTE(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGTE(FieldFailurePolicy, v))
+}
+
+// FailurePolicyLT applies the LT predicate on the "failure_policy" field.
+func FailurePolicyLT(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLT(FieldFailurePolicy, v))
+}
+
+// FailurePolicyLTE applies the LTE predicate on the "failure_policy" field.
+func FailurePolicyLTE(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLTE(FieldFailurePolicy, v))
+}
+
+// FailurePolicyContains applies the Contains predicate on the "failure_policy" field.
+func FailurePolicyContains(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldContains(FieldFailurePolicy, v))
+}
+
+// FailurePolicyHasPrefix applies the HasPrefix predicate on the "failure_policy" field.
+func FailurePolicyHasPrefix(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldHasPrefix(FieldFailurePolicy, v))
+}
+
+// FailurePolicyHasSuffix applies the HasSuffix predicate on the "failure_policy" field.
+func FailurePolicyHasSuffix(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldHasSuffix(FieldFailurePolicy, v))
+}
+
+// FailurePolicyEqualFold applies the EqualFold predicate on the "failure_policy" field.
+func FailurePolicyEqualFold(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEqualFold(FieldFailurePolicy, v))
+}
+
+// FailurePolicyContainsFold applies the ContainsFold predicate on the "failure_policy" field.
+func FailurePolicyContainsFold(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldContainsFold(FieldFailurePolicy, v))
+}
+
+// FailureThresholdEQ applies the EQ predicate on the "failure_threshold" field.
+func FailureThresholdEQ(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldFailureThreshold, v))
+}
+
+// FailureThresholdNEQ applies the NEQ predicate on the "failure_threshold" field.
+func FailureThresholdNEQ(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNEQ(FieldFailureThreshold, v))
+}
+
+// FailureThresholdIn applies the In predicate on the "failure_threshold" field.
+func FailureThresholdIn(vs ...int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIn(FieldFailureThreshold, vs...))
+}
+
+// FailureThresholdNotIn applies the NotIn predicate on the "failure_threshold" field.
+func FailureThresholdNotIn(vs ...int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotIn(FieldFailureThreshold, vs...))
+}
+
+// FailureThresholdGT applies the GT predicate on the "failure_threshold" field.
+func FailureThresholdGT(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGT(FieldFailureThreshold, v))
+}
+
+// FailureThresholdGTE applies the GTE predicate on the "failure_threshold" field.
+func FailureThresholdGTE(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGTE(FieldFailureThreshold, v))
+}
+
+// FailureThresholdLT applies the LT predicate on the "failure_threshold" field.
+func FailureThresholdLT(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLT(FieldFailureThreshold, v))
+}
+
+// FailureThresholdLTE applies the LTE predicate on the "failure_threshold" field.
+func FailureThresholdLTE(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLTE(FieldFailureThreshold, v))
+}
+
+// CooldownSecondsEQ applies the EQ predicate on the "cooldown_seconds" field.
+func CooldownSecondsEQ(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldCooldownSeconds, v))
+}
+
+// CooldownSecondsNEQ applies the NEQ predicate on the "cooldown_seconds" field.
+func CooldownSecondsNEQ(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNEQ(FieldCooldownSeconds, v))
+}
+
+// CooldownSecondsIn applies the In predicate on the "cooldown_seconds" field.
+func CooldownSecondsIn(vs ...int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIn(FieldCooldownSeconds, vs...))
+}
+
+// CooldownSecondsNotIn applies the NotIn predicate on the "cooldown_seconds" field.
+func CooldownSecondsNotIn(vs ...int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotIn(FieldCooldownSeconds, vs...))
+}
+
+// CooldownSecondsGT applies the GT predicate on the "cooldown_seconds" field.
+func CooldownSecondsGT(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGT(FieldCooldownSeconds, v))
+}
+
+// CooldownSecondsGTE applies the GTE predicate on the "cooldown_seconds" field.
+func CooldownSecondsGTE(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGTE(FieldCooldownSeconds, v))
+}
+
+// CooldownSecondsLT applies the LT predicate on the "cooldown_seconds" field.
+func CooldownSecondsLT(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLT(FieldCooldownSeconds, v))
+}
+
+// CooldownSecondsLTE applies the LTE predicate on the "cooldown_seconds" field.
+func CooldownSecondsLTE(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLTE(FieldCooldownSeconds, v))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int32) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// DisabledUntilEQ applies the EQ predicate on the "disabled_until" field.
+func DisabledUntilEQ(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldDisabledUntil, v))
+}
+
+// DisabledUntilNEQ applies the NEQ predicate on the "disabled_until" field.
+func DisabledUntilNEQ(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNEQ(FieldDisabledUntil, v))
+}
+
+// DisabledUntilIn applies the In predicate on the "disabled_until" field.
+func DisabledUntilIn(vs ...time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIn(FieldDisabledUntil, vs...))
+}
+
+// DisabledUntilNotIn applies the NotIn predicate on the "disabled_until" field.
+func DisabledUntilNotIn(vs ...time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotIn(FieldDisabledUntil, vs...))
+}
+
+// DisabledUntilGT applies the GT predicate on the "disabled_until" field.
+func DisabledUntilGT(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGT(FieldDisabledUntil, v))
+}
+
+// DisabledUntilGTE applies the GTE predicate on the "disabled_until" field.
+func DisabledUntilGTE(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGTE(FieldDisabledUntil, v))
+}
+
+// DisabledUntilLT applies the LT predicate on the "disabled_until" field.
+func DisabledUntilLT(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLT(FieldDisabledUntil, v))
+}
+
+// DisabledUntilLTE applies the LTE predicate on the "disabled_until" field.
+func DisabledUntilLTE(v time.Time) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLTE(FieldDisabledUntil, v))
+}
+
+// DisabledUntilIsNil applies the IsNil predicate on the "disabled_until" field.
+func DisabledUntilIsNil() predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIsNull(FieldDisabledUntil))
+}
+
+// DisabledUntilNotNil applies the NotNil predicate on the "disabled_until" field.
+func DisabledUntilNotNil() predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotNull(FieldDisabledUntil))
+}
+
+// DisabledReasonEQ applies the EQ predicate on the "disabled_reason" field.
+func DisabledReasonEQ(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEQ(FieldDisabledReason, v))
+}
+
+// DisabledReasonNEQ applies the NEQ predicate on the "disabled_reason" field.
+func DisabledReasonNEQ(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNEQ(FieldDisabledReason, v))
+}
+
+// DisabledReasonIn applies the In predicate on the "disabled_reason" field.
+func DisabledReasonIn(vs ...string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIn(FieldDisabledReason, vs...))
+}
+
+// DisabledReasonNotIn applies the NotIn predicate on the "disabled_reason" field.
+func DisabledReasonNotIn(vs ...string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotIn(FieldDisabledReason, vs...))
+}
+
+// DisabledReasonGT applies the GT predicate on the "disabled_reason" field.
+func DisabledReasonGT(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGT(FieldDisabledReason, v))
+}
+
+// DisabledReasonGTE applies the GTE predicate on the "disabled_reason" field.
+func DisabledReasonGTE(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldGTE(FieldDisabledReason, v))
+}
+
+// DisabledReasonLT applies the LT predicate on the "disabled_reason" field.
+func DisabledReasonLT(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLT(FieldDisabledReason, v))
+}
+
+// DisabledReasonLTE applies the LTE predicate on the "disabled_reason" field.
+func DisabledReasonLTE(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldLTE(FieldDisabledReason, v))
+}
+
+// DisabledReasonContains applies the Contains predicate on the "disabled_reason" field.
+func DisabledReasonContains(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldContains(FieldDisabledReason, v))
+}
+
+// DisabledReasonHasPrefix applies the HasPrefix predicate on the "disabled_reason" field.
+func DisabledReasonHasPrefix(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldHasPrefix(FieldDisabledReason, v))
+}
+
+// DisabledReasonHasSuffix applies the HasSuffix predicate on the "disabled_reason" field.
+func DisabledReasonHasSuffix(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldHasSuffix(FieldDisabledReason, v))
+}
+
+// DisabledReasonIsNil applies the IsNil predicate on the "disabled_reason" field.
+func DisabledReasonIsNil() predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldIsNull(FieldDisabledReason))
+}
+
+// DisabledReasonNotNil applies the NotNil predicate on the "disabled_reason" field.
+func DisabledReasonNotNil() predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldNotNull(FieldDisabledReason))
+}
+
+// DisabledReasonEqualFold applies the EqualFold predicate on the "disabled_reason" field.
+func DisabledReasonEqualFold(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldEqualFold(FieldDisabledReason, v))
+}
+
+// DisabledReasonContainsFold applies the ContainsFold predicate on the "disabled_reason" field.
+func DisabledReasonContainsFold(v string) predicate.EnvPlugin {
+	return predicate.EnvPlugin(sql.FieldContainsFold(FieldDisabledReason, v))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.EnvPlugin {
 	return predicate.EnvPlugin(func(s *sql.Selector) {
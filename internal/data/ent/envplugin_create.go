@@ -104,6 +104,90 @@ func (_c *EnvPluginCreate) SetNillableConfig(v *string) *EnvPluginCreate {
 	return _c
 }
 
+// SetFailurePolicy sets the "failure_policy" field.
+func (_c *EnvPluginCreate) SetFailurePolicy(v string) *EnvPluginCreate {
+	_c.mutation.SetFailurePolicy(v)
+	return _c
+}
+
+// SetNillableFailurePolicy sets the "failure_policy" field if the given value is not nil.
+func (_c *EnvPluginCreate) SetNillableFailurePolicy(v *string) *EnvPluginCreate {
+	if v != nil {
+		_c.SetFailurePolicy(*v)
+	}
+	return _c
+}
+
+// SetFailureThreshold sets the "failure_threshold" field.
+func (_c *EnvPluginCreate) SetFailureThreshold(v int32) *EnvPluginCreate {
+	_c.mutation.SetFailureThreshold(v)
+	return _c
+}
+
+// SetNillableFailureThreshold sets the "failure_threshold" field if the given value is not nil.
+func (_c *EnvPluginCreate) SetNillableFailureThreshold(v *int32) *EnvPluginCreate {
+	if v != nil {
+		_c.SetFailureThreshold(*v)
+	}
+	return _c
+}
+
+// SetCooldownSeconds sets the "cooldown_seconds" field.
+func (_c *EnvPluginCreate) SetCooldownSeconds(v int32) *EnvPluginCreate {
+	_c.mutation.SetCooldownSeconds(v)
+	return _c
+}
+
+// SetNillableCooldownSeconds sets the "cooldown_seconds" field if the given value is not nil.
+func (_c *EnvPluginCreate) SetNillableCooldownSeconds(v *int32) *EnvPluginCreate {
+	if v != nil {
+		_c.SetCooldownSeconds(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *EnvPluginCreate) SetConsecutiveFailures(v int32) *EnvPluginCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *EnvPluginCreate) SetNillableConsecutiveFailures(v *int32) *EnvPluginCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetDisabledUntil sets the "disabled_until" field.
+func (_c *EnvPluginCreate) SetDisabledUntil(v time.Time) *EnvPluginCreate {
+	_c.mutation.SetDisabledUntil(v)
+	return _c
+}
+
+// SetNillableDisabledUntil sets the "disabled_until" field if the given value is not nil.
+func (_c *EnvPluginCreate) SetNillableDisabledUntil(v *time.Time) *EnvPluginCreate {
+	if v != nil {
+		_c.SetDisabledUntil(*v)
+	}
+	return _c
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (_c *EnvPluginCreate) SetDisabledReason(v string) *EnvPluginCreate {
+	_c.mutation.SetDisabledReason(v)
+	return _c
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (_c *EnvPluginCreate) SetNillableDisabledReason(v *string) *EnvPluginCreate {
+	if v != nil {
+		_c.SetDisabledReason(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *EnvPluginCreate) SetID(v int64) *EnvPluginCreate {
 	_c.mutation.SetID(v)
@@ -171,6 +255,22 @@ func (_c *EnvPluginCreate) defaults() {
 		v := envplugin.DefaultExecutionOrder
 		_c.mutation.SetExecutionOrder(v)
 	}
+	if _, ok := _c.mutation.FailurePolicy(); !ok {
+		v := envplugin.DefaultFailurePolicy
+		_c.mutation.SetFailurePolicy(v)
+	}
+	if _, ok := _c.mutation.FailureThreshold(); !ok {
+		v := envplugin.DefaultFailureThreshold
+		_c.mutation.SetFailureThreshold(v)
+	}
+	if _, ok := _c.mutation.CooldownSeconds(); !ok {
+		v := envplugin.DefaultCooldownSeconds
+		_c.mutation.SetCooldownSeconds(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := envplugin.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -193,6 +293,18 @@ func (_c *EnvPluginCreate) check() error {
 	if _, ok := _c.mutation.ExecutionOrder(); !ok {
 		return &ValidationError{Name: "execution_order", err: errors.New(`ent: missing required field "EnvPlugin.execution_order"`)}
 	}
+	if _, ok := _c.mutation.FailurePolicy(); !ok {
+		return &ValidationError{Name: "failure_policy", err: errors.New(`ent: missing required field "EnvPlugin.failure_policy"`)}
+	}
+	if _, ok := _c.mutation.FailureThreshold(); !ok {
+		return &ValidationError{Name: "failure_threshold", err: errors.New(`ent: missing required field "EnvPlugin.failure_threshold"`)}
+	}
+	if _, ok := _c.mutation.CooldownSeconds(); !ok {
+		return &ValidationError{Name: "cooldown_seconds", err: errors.New(`ent: missing required field "EnvPlugin.cooldown_seconds"`)}
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "EnvPlugin.consecutive_failures"`)}
+	}
 	if len(_c.mutation.EnvIDs()) == 0 {
 		return &ValidationError{Name: "env", err: errors.New(`ent: missing required edge "EnvPlugin.env"`)}
 	}
@@ -251,6 +363,30 @@ func (_c *EnvPluginCreate) createSpec() (*EnvPlugin, *sqlgraph.CreateSpec) {
 		_spec.SetField(envplugin.FieldConfig, field.TypeString, value)
 		_node.Config = &value
 	}
+	if value, ok := _c.mutation.FailurePolicy(); ok {
+		_spec.SetField(envplugin.FieldFailurePolicy, field.TypeString, value)
+		_node.FailurePolicy = value
+	}
+	if value, ok := _c.mutation.FailureThreshold(); ok {
+		_spec.SetField(envplugin.FieldFailureThreshold, field.TypeInt32, value)
+		_node.FailureThreshold = value
+	}
+	if value, ok := _c.mutation.CooldownSeconds(); ok {
+		_spec.SetField(envplugin.FieldCooldownSeconds, field.TypeInt32, value)
+		_node.CooldownSeconds = value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(envplugin.FieldConsecutiveFailures, field.TypeInt32, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.DisabledUntil(); ok {
+		_spec.SetField(envplugin.FieldDisabledUntil, field.TypeTime, value)
+		_node.DisabledUntil = &value
+	}
+	if value, ok := _c.mutation.DisabledReason(); ok {
+		_spec.SetField(envplugin.FieldDisabledReason, field.TypeString, value)
+		_node.DisabledReason = &value
+	}
 	if nodes := _c.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
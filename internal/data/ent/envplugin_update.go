@@ -119,6 +119,123 @@ func (_u *EnvPluginUpdate) ClearConfig() *EnvPluginUpdate {
 	return _u
 }
 
+// SetFailurePolicy sets the "failure_policy" field.
+func (_u *EnvPluginUpdate) SetFailurePolicy(v string) *EnvPluginUpdate {
+	_u.mutation.SetFailurePolicy(v)
+	return _u
+}
+
+// SetNillableFailurePolicy sets the "failure_policy" field if the given value is not nil.
+func (_u *EnvPluginUpdate) SetNillableFailurePolicy(v *string) *EnvPluginUpdate {
+	if v != nil {
+		_u.SetFailurePolicy(*v)
+	}
+	return _u
+}
+
+// SetFailureThreshold sets the "failure_threshold" field.
+func (_u *EnvPluginUpdate) SetFailureThreshold(v int32) *EnvPluginUpdate {
+	_u.mutation.ResetFailureThreshold()
+	_u.mutation.SetFailureThreshold(v)
+	return _u
+}
+
+// SetNillableFailureThreshold sets the "failure_threshold" field if the given value is not nil.
+func (_u *EnvPluginUpdate) SetNillableFailureThreshold(v *int32) *EnvPluginUpdate {
+	if v != nil {
+		_u.SetFailureThreshold(*v)
+	}
+	return _u
+}
+
+// AddFailureThreshold adds value to the "failure_threshold" field.
+func (_u *EnvPluginUpdate) AddFailureThreshold(v int32) *EnvPluginUpdate {
+	_u.mutation.AddFailureThreshold(v)
+	return _u
+}
+
+// SetCooldownSeconds sets the "cooldown_seconds" field.
+func (_u *EnvPluginUpdate) SetCooldownSeconds(v int32) *EnvPluginUpdate {
+	_u.mutation.ResetCooldownSeconds()
+	_u.mutation.SetCooldownSeconds(v)
+	return _u
+}
+
+// SetNillableCooldownSeconds sets the "cooldown_seconds" field if the given value is not nil.
+func (_u *EnvPluginUpdate) SetNillableCooldownSeconds(v *int32) *EnvPluginUpdate {
+	if v != nil {
+		_u.SetCooldownSeconds(*v)
+	}
+	return _u
+}
+
+// AddCooldownSeconds adds value to the "cooldown_seconds" field.
+func (_u *EnvPluginUpdate) AddCooldownSeconds(v int32) *EnvPluginUpdate {
+	_u.mutation.AddCooldownSeconds(v)
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *EnvPluginUpdate) SetConsecutiveFailures(v int32) *EnvPluginUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *EnvPluginUpdate) SetNillableConsecutiveFailures(v *int32) *EnvPluginUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *EnvPluginUpdate) AddConsecutiveFailures(v int32) *EnvPluginUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetDisabledUntil sets the "disabled_until" field.
+func (_u *EnvPluginUpdate) SetDisabledUntil(v time.Time) *EnvPluginUpdate {
+	_u.mutation.SetDisabledUntil(v)
+	return _u
+}
+
+// SetNillableDisabledUntil sets the "disabled_until" field if the given value is not nil.
+func (_u *EnvPluginUpdate) SetNillableDisabledUntil(v *time.Time) *EnvPluginUpdate {
+	if v != nil {
+		_u.SetDisabledUntil(*v)
+	}
+	return _u
+}
+
+// ClearDisabledUntil clears the value of the "disabled_until" field.
+func (_u *EnvPluginUpdate) ClearDisabledUntil() *EnvPluginUpdate {
+	_u.mutation.ClearDisabledUntil()
+	return _u
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (_u *EnvPluginUpdate) SetDisabledReason(v string) *EnvPluginUpdate {
+	_u.mutation.SetDisabledReason(v)
+	return _u
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (_u *EnvPluginUpdate) SetNillableDisabledReason(v *string) *EnvPluginUpdate {
+	if v != nil {
+		_u.SetDisabledReason(*v)
+	}
+	return _u
+}
+
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (_u *EnvPluginUpdate) ClearDisabledReason() *EnvPluginUpdate {
+	_u.mutation.ClearDisabledReason()
+	return _u
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (_u *EnvPluginUpdate) SetEnv(v *Env) *EnvPluginUpdate {
 	return _u.SetEnvID(v.ID)
@@ -223,6 +340,39 @@ func (_u *EnvPluginUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ConfigCleared() {
 		_spec.ClearField(envplugin.FieldConfig, field.TypeString)
 	}
+	if value, ok := _u.mutation.FailurePolicy(); ok {
+		_spec.SetField(envplugin.FieldFailurePolicy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailureThreshold(); ok {
+		_spec.SetField(envplugin.FieldFailureThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedFailureThreshold(); ok {
+		_spec.AddField(envplugin.FieldFailureThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.CooldownSeconds(); ok {
+		_spec.SetField(envplugin.FieldCooldownSeconds, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedCooldownSeconds(); ok {
+		_spec.AddField(envplugin.FieldCooldownSeconds, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(envplugin.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(envplugin.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.DisabledUntil(); ok {
+		_spec.SetField(envplugin.FieldDisabledUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DisabledUntilCleared() {
+		_spec.ClearField(envplugin.FieldDisabledUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisabledReason(); ok {
+		_spec.SetField(envplugin.FieldDisabledReason, field.TypeString, value)
+	}
+	if _u.mutation.DisabledReasonCleared() {
+		_spec.ClearField(envplugin.FieldDisabledReason, field.TypeString)
+	}
 	if _u.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -390,6 +540,123 @@ func (_u *EnvPluginUpdateOne) ClearConfig() *EnvPluginUpdateOne {
 	return _u
 }
 
+// SetFailurePolicy sets the "failure_policy" field.
+func (_u *EnvPluginUpdateOne) SetFailurePolicy(v string) *EnvPluginUpdateOne {
+	_u.mutation.SetFailurePolicy(v)
+	return _u
+}
+
+// SetNillableFailurePolicy sets the "failure_policy" field if the given value is not nil.
+func (_u *EnvPluginUpdateOne) SetNillableFailurePolicy(v *string) *EnvPluginUpdateOne {
+	if v != nil {
+		_u.SetFailurePolicy(*v)
+	}
+	return _u
+}
+
+// SetFailureThreshold sets the "failure_threshold" field.
+func (_u *EnvPluginUpdateOne) SetFailureThreshold(v int32) *EnvPluginUpdateOne {
+	_u.mutation.ResetFailureThreshold()
+	_u.mutation.SetFailureThreshold(v)
+	return _u
+}
+
+// SetNillableFailureThreshold sets the "failure_threshold" field if the given value is not nil.
+func (_u *EnvPluginUpdateOne) SetNillableFailureThreshold(v *int32) *EnvPluginUpdateOne {
+	if v != nil {
+		_u.SetFailureThreshold(*v)
+	}
+	return _u
+}
+
+// AddFailureThreshold adds value to the "failure_threshold" field.
+func (_u *EnvPluginUpdateOne) AddFailureThreshold(v int32) *EnvPluginUpdateOne {
+	_u.mutation.AddFailureThreshold(v)
+	return _u
+}
+
+// SetCooldownSeconds sets the "cooldown_seconds" field.
+func (_u *EnvPluginUpdateOne) SetCooldownSeconds(v int32) *EnvPluginUpdateOne {
+	_u.mutation.ResetCooldownSeconds()
+	_u.mutation.SetCooldownSeconds(v)
+	return _u
+}
+
+// SetNillableCooldownSeconds sets the "cooldown_seconds" field if the given value is not nil.
+func (_u *EnvPluginUpdateOne) SetNillableCooldownSeconds(v *int32) *EnvPluginUpdateOne {
+	if v != nil {
+		_u.SetCooldownSeconds(*v)
+	}
+	return _u
+}
+
+// AddCooldownSeconds adds value to the "cooldown_seconds" field.
+func (_u *EnvPluginUpdateOne) AddCooldownSeconds(v int32) *EnvPluginUpdateOne {
+	_u.mutation.AddCooldownSeconds(v)
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *EnvPluginUpdateOne) SetConsecutiveFailures(v int32) *EnvPluginUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *EnvPluginUpdateOne) SetNillableConsecutiveFailures(v *int32) *EnvPluginUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *EnvPluginUpdateOne) AddConsecutiveFailures(v int32) *EnvPluginUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetDisabledUntil sets the "disabled_until" field.
+func (_u *EnvPluginUpdateOne) SetDisabledUntil(v time.Time) *EnvPluginUpdateOne {
+	_u.mutation.SetDisabledUntil(v)
+	return _u
+}
+
+// SetNillableDisabledUntil sets the "disabled_until" field if the given value is not nil.
+func (_u *EnvPluginUpdateOne) SetNillableDisabledUntil(v *time.Time) *EnvPluginUpdateOne {
+	if v != nil {
+		_u.SetDisabledUntil(*v)
+	}
+	return _u
+}
+
+// ClearDisabledUntil clears the value of the "disabled_until" field.
+func (_u *EnvPluginUpdateOne) ClearDisabledUntil() *EnvPluginUpdateOne {
+	_u.mutation.ClearDisabledUntil()
+	return _u
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (_u *EnvPluginUpdateOne) SetDisabledReason(v string) *EnvPluginUpdateOne {
+	_u.mutation.SetDisabledReason(v)
+	return _u
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (_u *EnvPluginUpdateOne) SetNillableDisabledReason(v *string) *EnvPluginUpdateOne {
+	if v != nil {
+		_u.SetDisabledReason(*v)
+	}
+	return _u
+}
+
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (_u *EnvPluginUpdateOne) ClearDisabledReason() *EnvPluginUpdateOne {
+	_u.mutation.ClearDisabledReason()
+	return _u
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (_u *EnvPluginUpdateOne) SetEnv(v *Env) *EnvPluginUpdateOne {
 	return _u.SetEnvID(v.ID)
@@ -524,6 +791,39 @@ func (_u *EnvPluginUpdateOne) sqlSave(ctx context.Context) (_node *EnvPlugin, er
 	if _u.mutation.ConfigCleared() {
 		_spec.ClearField(envplugin.FieldConfig, field.TypeString)
 	}
+	if value, ok := _u.mutation.FailurePolicy(); ok {
+		_spec.SetField(envplugin.FieldFailurePolicy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailureThreshold(); ok {
+		_spec.SetField(envplugin.FieldFailureThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedFailureThreshold(); ok {
+		_spec.AddField(envplugin.FieldFailureThreshold, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.CooldownSeconds(); ok {
+		_spec.SetField(envplugin.FieldCooldownSeconds, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedCooldownSeconds(); ok {
+		_spec.AddField(envplugin.FieldCooldownSeconds, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(envplugin.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(envplugin.FieldConsecutiveFailures, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.DisabledUntil(); ok {
+		_spec.SetField(envplugin.FieldDisabledUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DisabledUntilCleared() {
+		_spec.ClearField(envplugin.FieldDisabledUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisabledReason(); ok {
+		_spec.SetField(envplugin.FieldDisabledReason, field.TypeString, value)
+	}
+	if _u.mutation.DisabledReasonCleared() {
+		_spec.ClearField(envplugin.FieldDisabledReason, field.TypeString)
+	}
 	if _u.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+)
+
+// EnvReplica is the model entity for the EnvReplica schema.
+type EnvReplica struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID int64 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 环境变量ID
+	EnvID int64 `json:"env_id,omitempty"`
+	// 副本组ID，同一次提交写入的副本属于同一组
+	GroupID string `json:"group_id,omitempty"`
+	// 面板ID
+	PanelID int64 `json:"panel_id,omitempty"`
+	// 青龙面板中的变量ID
+	PanelEnvID   int `json:"panel_env_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvReplica) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envreplica.FieldID, envreplica.FieldEnvID, envreplica.FieldPanelID, envreplica.FieldPanelEnvID:
+			values[i] = new(sql.NullInt64)
+		case envreplica.FieldGroupID:
+			values[i] = new(sql.NullString)
+		case envreplica.FieldCreatedAt, envreplica.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvReplica fields.
+func (_m *EnvReplica) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envreplica.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case envreplica.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case envreplica.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case envreplica.FieldEnvID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				_m.EnvID = value.Int64
+			}
+		case envreplica.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case envreplica.FieldPanelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field panel_id", values[i])
+			} else if value.Valid {
+				_m.PanelID = value.Int64
+			}
+		case envreplica.FieldPanelEnvID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field panel_env_id", values[i])
+			} else if value.Valid {
+				_m.PanelEnvID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvReplica.
+// This includes values selected through modifiers, order, etc.
+func (_m *EnvReplica) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EnvReplica.
+// Note that you need to call EnvReplica.Unwrap() before calling this method if this EnvReplica
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EnvReplica) Update() *EnvReplicaUpdateOne {
+	return NewEnvReplicaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EnvReplica entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EnvReplica) Unwrap() *EnvReplica {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnvReplica is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EnvReplica) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvReplica(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnvID))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("panel_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PanelID))
+	builder.WriteString(", ")
+	builder.WriteString("panel_env_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PanelEnvID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvReplicas is a parsable slice of EnvReplica.
+type EnvReplicas []*EnvReplica
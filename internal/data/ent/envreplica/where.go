@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envreplica
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldEnvID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldGroupID, v))
+}
+
+// PanelID applies equality check predicate on the "panel_id" field. It's identical to PanelIDEQ.
+func PanelID(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldPanelID, v))
+}
+
+// PanelEnvID applies equality check predicate on the "panel_env_id" field. It's identical to PanelEnvIDEQ.
+func PanelEnvID(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldPanelEnvID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldEnvID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// PanelIDEQ applies the EQ predicate on the "panel_id" field.
+func PanelIDEQ(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldPanelID, v))
+}
+
+// PanelIDNEQ applies the NEQ predicate on the "panel_id" field.
+func PanelIDNEQ(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldPanelID, v))
+}
+
+// PanelIDIn applies the In predicate on the "panel_id" field.
+func PanelIDIn(vs ...int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldPanelID, vs...))
+}
+
+// PanelIDNotIn applies the NotIn predicate on the "panel_id" field.
+func PanelIDNotIn(vs ...int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldPanelID, vs...))
+}
+
+// PanelIDGT applies the GT predicate on the "panel_id" field.
+func PanelIDGT(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldPanelID, v))
+}
+
+// PanelIDGTE applies the GTE predicate on the "panel_id" field.
+func PanelIDGTE(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldPanelID, v))
+}
+
+// PanelIDLT applies the LT predicate on the "panel_id" field.
+func PanelIDLT(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldPanelID, v))
+}
+
+// PanelIDLTE applies the LTE predicate on the "panel_id" field.
+func PanelIDLTE(v int64) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldPanelID, v))
+}
+
+// PanelEnvIDEQ applies the EQ predicate on the "panel_env_id" field.
+func PanelEnvIDEQ(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldEQ(FieldPanelEnvID, v))
+}
+
+// PanelEnvIDNEQ applies the NEQ predicate on the "panel_env_id" field.
+func PanelEnvIDNEQ(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNEQ(FieldPanelEnvID, v))
+}
+
+// PanelEnvIDIn applies the In predicate on the "panel_env_id" field.
+func PanelEnvIDIn(vs ...int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldIn(FieldPanelEnvID, vs...))
+}
+
+// PanelEnvIDNotIn applies the NotIn predicate on the "panel_env_id" field.
+func PanelEnvIDNotIn(vs ...int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldNotIn(FieldPanelEnvID, vs...))
+}
+
+// PanelEnvIDGT applies the GT predicate on the "panel_env_id" field.
+func PanelEnvIDGT(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGT(FieldPanelEnvID, v))
+}
+
+// PanelEnvIDGTE applies the GTE predicate on the "panel_env_id" field.
+func PanelEnvIDGTE(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldGTE(FieldPanelEnvID, v))
+}
+
+// PanelEnvIDLT applies the LT predicate on the "panel_env_id" field.
+func PanelEnvIDLT(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLT(FieldPanelEnvID, v))
+}
+
+// PanelEnvIDLTE applies the LTE predicate on the "panel_env_id" field.
+func PanelEnvIDLTE(v int) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.FieldLTE(FieldPanelEnvID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvReplica) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvReplica) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvReplica) predicate.EnvReplica {
+	return predicate.EnvReplica(sql.NotPredicates(p))
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+)
+
+// EnvReplicaCreate is the builder for creating a EnvReplica entity.
+type EnvReplicaCreate struct {
+	config
+	mutation *EnvReplicaMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnvReplicaCreate) SetCreatedAt(v time.Time) *EnvReplicaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnvReplicaCreate) SetNillableCreatedAt(v *time.Time) *EnvReplicaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EnvReplicaCreate) SetUpdatedAt(v time.Time) *EnvReplicaCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EnvReplicaCreate) SetNillableUpdatedAt(v *time.Time) *EnvReplicaCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnvID sets the "env_id" field.
+func (_c *EnvReplicaCreate) SetEnvID(v int64) *EnvReplicaCreate {
+	_c.mutation.SetEnvID(v)
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *EnvReplicaCreate) SetGroupID(v string) *EnvReplicaCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_c *EnvReplicaCreate) SetPanelID(v int64) *EnvReplicaCreate {
+	_c.mutation.SetPanelID(v)
+	return _c
+}
+
+// SetPanelEnvID sets the "panel_env_id" field.
+func (_c *EnvReplicaCreate) SetPanelEnvID(v int) *EnvReplicaCreate {
+	_c.mutation.SetPanelEnvID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EnvReplicaCreate) SetID(v int64) *EnvReplicaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EnvReplicaMutation object of the builder.
+func (_c *EnvReplicaCreate) Mutation() *EnvReplicaMutation {
+	return _c.mutation
+}
+
+// Save creates the EnvReplica in the database.
+func (_c *EnvReplicaCreate) Save(ctx context.Context) (*EnvReplica, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnvReplicaCreate) SaveX(ctx context.Context) *EnvReplica {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvReplicaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvReplicaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnvReplicaCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := envreplica.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := envreplica.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnvReplicaCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnvReplica.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EnvReplica.updated_at"`)}
+	}
+	if _, ok := _c.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`ent: missing required field "EnvReplica.env_id"`)}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "EnvReplica.group_id"`)}
+	}
+	if _, ok := _c.mutation.PanelID(); !ok {
+		return &ValidationError{Name: "panel_id", err: errors.New(`ent: missing required field "EnvReplica.panel_id"`)}
+	}
+	if _, ok := _c.mutation.PanelEnvID(); !ok {
+		return &ValidationError{Name: "panel_env_id", err: errors.New(`ent: missing required field "EnvReplica.panel_env_id"`)}
+	}
+	return nil
+}
+
+func (_c *EnvReplicaCreate) sqlSave(ctx context.Context) (*EnvReplica, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnvReplicaCreate) createSpec() (*EnvReplica, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvReplica{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(envreplica.Table, sqlgraph.NewFieldSpec(envreplica.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(envreplica.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(envreplica.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.EnvID(); ok {
+		_spec.SetField(envreplica.FieldEnvID, field.TypeInt64, value)
+		_node.EnvID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(envreplica.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.PanelID(); ok {
+		_spec.SetField(envreplica.FieldPanelID, field.TypeInt64, value)
+		_node.PanelID = value
+	}
+	if value, ok := _c.mutation.PanelEnvID(); ok {
+		_spec.SetField(envreplica.FieldPanelEnvID, field.TypeInt, value)
+		_node.PanelEnvID = value
+	}
+	return _node, _spec
+}
+
+// EnvReplicaCreateBulk is the builder for creating many EnvReplica entities in bulk.
+type EnvReplicaCreateBulk struct {
+	config
+	err      error
+	builders []*EnvReplicaCreate
+}
+
+// Save creates the EnvReplica entities in the database.
+func (_c *EnvReplicaCreateBulk) Save(ctx context.Context) ([]*EnvReplica, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnvReplica, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvReplicaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnvReplicaCreateBulk) SaveX(ctx context.Context) []*EnvReplica {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvReplicaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvReplicaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
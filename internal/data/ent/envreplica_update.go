@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// EnvReplicaUpdate is the builder for updating EnvReplica entities.
+type EnvReplicaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvReplicaMutation
+}
+
+// Where appends a list predicates to the EnvReplicaUpdate builder.
+func (_u *EnvReplicaUpdate) Where(ps ...predicate.EnvReplica) *EnvReplicaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnvReplicaUpdate) SetUpdatedAt(v time.Time) *EnvReplicaUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *EnvReplicaUpdate) SetEnvID(v int64) *EnvReplicaUpdate {
+	_u.mutation.ResetEnvID()
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdate) SetNillableEnvID(v *int64) *EnvReplicaUpdate {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// AddEnvID adds value to the "env_id" field.
+func (_u *EnvReplicaUpdate) AddEnvID(v int64) *EnvReplicaUpdate {
+	_u.mutation.AddEnvID(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *EnvReplicaUpdate) SetGroupID(v string) *EnvReplicaUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdate) SetNillableGroupID(v *string) *EnvReplicaUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *EnvReplicaUpdate) SetPanelID(v int64) *EnvReplicaUpdate {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdate) SetNillablePanelID(v *int64) *EnvReplicaUpdate {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *EnvReplicaUpdate) AddPanelID(v int64) *EnvReplicaUpdate {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetPanelEnvID sets the "panel_env_id" field.
+func (_u *EnvReplicaUpdate) SetPanelEnvID(v int) *EnvReplicaUpdate {
+	_u.mutation.ResetPanelEnvID()
+	_u.mutation.SetPanelEnvID(v)
+	return _u
+}
+
+// SetNillablePanelEnvID sets the "panel_env_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdate) SetNillablePanelEnvID(v *int) *EnvReplicaUpdate {
+	if v != nil {
+		_u.SetPanelEnvID(*v)
+	}
+	return _u
+}
+
+// AddPanelEnvID adds value to the "panel_env_id" field.
+func (_u *EnvReplicaUpdate) AddPanelEnvID(v int) *EnvReplicaUpdate {
+	_u.mutation.AddPanelEnvID(v)
+	return _u
+}
+
+// Mutation returns the EnvReplicaMutation object of the builder.
+func (_u *EnvReplicaUpdate) Mutation() *EnvReplicaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnvReplicaUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvReplicaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnvReplicaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvReplicaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnvReplicaUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := envreplica.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *EnvReplicaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(envreplica.Table, envreplica.Columns, sqlgraph.NewFieldSpec(envreplica.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(envreplica.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EnvID(); ok {
+		_spec.SetField(envreplica.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEnvID(); ok {
+		_spec.AddField(envreplica.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(envreplica.FieldGroupID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(envreplica.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(envreplica.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PanelEnvID(); ok {
+		_spec.SetField(envreplica.FieldPanelEnvID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPanelEnvID(); ok {
+		_spec.AddField(envreplica.FieldPanelEnvID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envreplica.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnvReplicaUpdateOne is the builder for updating a single EnvReplica entity.
+type EnvReplicaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvReplicaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnvReplicaUpdateOne) SetUpdatedAt(v time.Time) *EnvReplicaUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEnvID sets the "env_id" field.
+func (_u *EnvReplicaUpdateOne) SetEnvID(v int64) *EnvReplicaUpdateOne {
+	_u.mutation.ResetEnvID()
+	_u.mutation.SetEnvID(v)
+	return _u
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdateOne) SetNillableEnvID(v *int64) *EnvReplicaUpdateOne {
+	if v != nil {
+		_u.SetEnvID(*v)
+	}
+	return _u
+}
+
+// AddEnvID adds value to the "env_id" field.
+func (_u *EnvReplicaUpdateOne) AddEnvID(v int64) *EnvReplicaUpdateOne {
+	_u.mutation.AddEnvID(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *EnvReplicaUpdateOne) SetGroupID(v string) *EnvReplicaUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdateOne) SetNillableGroupID(v *string) *EnvReplicaUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *EnvReplicaUpdateOne) SetPanelID(v int64) *EnvReplicaUpdateOne {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdateOne) SetNillablePanelID(v *int64) *EnvReplicaUpdateOne {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *EnvReplicaUpdateOne) AddPanelID(v int64) *EnvReplicaUpdateOne {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetPanelEnvID sets the "panel_env_id" field.
+func (_u *EnvReplicaUpdateOne) SetPanelEnvID(v int) *EnvReplicaUpdateOne {
+	_u.mutation.ResetPanelEnvID()
+	_u.mutation.SetPanelEnvID(v)
+	return _u
+}
+
+// SetNillablePanelEnvID sets the "panel_env_id" field if the given value is not nil.
+func (_u *EnvReplicaUpdateOne) SetNillablePanelEnvID(v *int) *EnvReplicaUpdateOne {
+	if v != nil {
+		_u.SetPanelEnvID(*v)
+	}
+	return _u
+}
+
+// AddPanelEnvID adds value to the "panel_env_id" field.
+func (_u *EnvReplicaUpdateOne) AddPanelEnvID(v int) *EnvReplicaUpdateOne {
+	_u.mutation.AddPanelEnvID(v)
+	return _u
+}
+
+// Mutation returns the EnvReplicaMutation object of the builder.
+func (_u *EnvReplicaUpdateOne) Mutation() *EnvReplicaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EnvReplicaUpdate builder.
+func (_u *EnvReplicaUpdateOne) Where(ps ...predicate.EnvReplica) *EnvReplicaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnvReplicaUpdateOne) Select(field string, fields ...string) *EnvReplicaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnvReplica entity.
+func (_u *EnvReplicaUpdateOne) Save(ctx context.Context) (*EnvReplica, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvReplicaUpdateOne) SaveX(ctx context.Context) *EnvReplica {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnvReplicaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvReplicaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnvReplicaUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := envreplica.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *EnvReplicaUpdateOne) sqlSave(ctx context.Context) (_node *EnvReplica, err error) {
+	_spec := sqlgraph.NewUpdateSpec(envreplica.Table, envreplica.Columns, sqlgraph.NewFieldSpec(envreplica.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnvReplica.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envreplica.FieldID)
+		for _, f := range fields {
+			if !envreplica.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != envreplica.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(envreplica.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EnvID(); ok {
+		_spec.SetField(envreplica.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEnvID(); ok {
+		_spec.AddField(envreplica.FieldEnvID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(envreplica.FieldGroupID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(envreplica.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(envreplica.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PanelEnvID(); ok {
+		_spec.SetField(envreplica.FieldPanelEnvID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPanelEnvID(); ok {
+		_spec.AddField(envreplica.FieldPanelEnvID, field.TypeInt, value)
+	}
+	_node = &EnvReplica{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envreplica.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,30 @@ func (f CdKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CdKeyMutation", m)
 }
 
+// The CdkLedgerFunc type is an adapter to allow the use of ordinary
+// function as CdkLedger mutator.
+type CdkLedgerFunc func(context.Context, *ent.CdkLedgerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CdkLedgerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CdkLedgerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CdkLedgerMutation", m)
+}
+
+// The CronTriggerLogFunc type is an adapter to allow the use of ordinary
+// function as CronTriggerLog mutator.
+type CronTriggerLogFunc func(context.Context, *ent.CronTriggerLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CronTriggerLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CronTriggerLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CronTriggerLogMutation", m)
+}
+
 // The EnvFunc type is an adapter to allow the use of ordinary
 // function as Env mutator.
 type EnvFunc func(context.Context, *ent.EnvMutation) (ent.Value, error)
@@ -33,6 +57,30 @@ func (f EnvFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvMutation", m)
 }
 
+// The EnvCronTriggerFunc type is an adapter to allow the use of ordinary
+// function as EnvCronTrigger mutator.
+type EnvCronTriggerFunc func(context.Context, *ent.EnvCronTriggerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvCronTriggerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnvCronTriggerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvCronTriggerMutation", m)
+}
+
+// The EnvPanelFunc type is an adapter to allow the use of ordinary
+// function as EnvPanel mutator.
+type EnvPanelFunc func(context.Context, *ent.EnvPanelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvPanelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnvPanelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvPanelMutation", m)
+}
+
 // The EnvPluginFunc type is an adapter to allow the use of ordinary
 // function as EnvPlugin mutator.
 type EnvPluginFunc func(context.Context, *ent.EnvPluginMutation) (ent.Value, error)
@@ -45,6 +93,30 @@ func (f EnvPluginFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvPluginMutation", m)
 }
 
+// The EnvReplicaFunc type is an adapter to allow the use of ordinary
+// function as EnvReplica mutator.
+type EnvReplicaFunc func(context.Context, *ent.EnvReplicaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvReplicaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnvReplicaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvReplicaMutation", m)
+}
+
+// The IdempotencyRecordFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyRecord mutator.
+type IdempotencyRecordFunc func(context.Context, *ent.IdempotencyRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyRecordMutation", m)
+}
+
 // The LoginHistoryFunc type is an adapter to allow the use of ordinary
 // function as LoginHistory mutator.
 type LoginHistoryFunc func(context.Context, *ent.LoginHistoryMutation) (ent.Value, error)
@@ -69,6 +141,18 @@ func (f PanelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PanelMutation", m)
 }
 
+// The PanelHealthFunc type is an adapter to allow the use of ordinary
+// function as PanelHealth mutator.
+type PanelHealthFunc func(context.Context, *ent.PanelHealthMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PanelHealthFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PanelHealthMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PanelHealthMutation", m)
+}
+
 // The PluginFunc type is an adapter to allow the use of ordinary
 // function as Plugin mutator.
 type PluginFunc func(context.Context, *ent.PluginMutation) (ent.Value, error)
@@ -93,6 +177,30 @@ func (f PluginExecutionLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PluginExecutionLogMutation", m)
 }
 
+// The PluginTestCaseFunc type is an adapter to allow the use of ordinary
+// function as PluginTestCase mutator.
+type PluginTestCaseFunc func(context.Context, *ent.PluginTestCaseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PluginTestCaseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PluginTestCaseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PluginTestCaseMutation", m)
+}
+
+// The ResourceLockFunc type is an adapter to allow the use of ordinary
+// function as ResourceLock mutator.
+type ResourceLockFunc func(context.Context, *ent.ResourceLockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResourceLockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ResourceLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResourceLockMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -30,6 +30,76 @@ var (
 			},
 		},
 	}
+	// CdkLedgersColumns holds the columns for the "cdk_ledgers" table.
+	CdkLedgersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "cdk_id", Type: field.TypeInt64},
+		{Name: "env_id", Type: field.TypeInt64},
+		{Name: "type", Type: field.TypeString},
+		{Name: "delta", Type: field.TypeInt32},
+		{Name: "balance", Type: field.TypeInt32},
+		{Name: "reservation_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "settled", Type: field.TypeBool, Default: false},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// CdkLedgersTable holds the schema information for the "cdk_ledgers" table.
+	CdkLedgersTable = &schema.Table{
+		Name:       "cdk_ledgers",
+		Columns:    CdkLedgersColumns,
+		PrimaryKey: []*schema.Column{CdkLedgersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cdkledger_cdk_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CdkLedgersColumns[2], CdkLedgersColumns[1]},
+			},
+			{
+				Name:    "cdkledger_type_settled_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CdkLedgersColumns[4], CdkLedgersColumns[8], CdkLedgersColumns[1]},
+			},
+			{
+				Name:    "cdkledger_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CdkLedgersColumns[1]},
+			},
+		},
+	}
+	// CronTriggerLogsColumns holds the columns for the "cron_trigger_logs" table.
+	CronTriggerLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "env_id", Type: field.TypeInt64},
+		{Name: "panel_id", Type: field.TypeInt64},
+		{Name: "trigger_id", Type: field.TypeInt64},
+		{Name: "cron_ids", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// CronTriggerLogsTable holds the schema information for the "cron_trigger_logs" table.
+	CronTriggerLogsTable = &schema.Table{
+		Name:       "cron_trigger_logs",
+		Columns:    CronTriggerLogsColumns,
+		PrimaryKey: []*schema.Column{CronTriggerLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "crontriggerlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CronTriggerLogsColumns[1]},
+			},
+			{
+				Name:    "crontriggerlog_env_id",
+				Unique:  false,
+				Columns: []*schema.Column{CronTriggerLogsColumns[2]},
+			},
+			{
+				Name:    "crontriggerlog_status",
+				Unique:  false,
+				Columns: []*schema.Column{CronTriggerLogsColumns[6]},
+			},
+		},
+	}
 	// EnvsColumns holds the columns for the "envs" table.
 	EnvsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -48,6 +118,14 @@ var (
 		{Name: "prompt_level", Type: field.TypeString, Nullable: true},
 		{Name: "prompt_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "is_enable", Type: field.TypeBool},
+		{Name: "select_strategy", Type: field.TypeString, Default: "least_loaded"},
+		{Name: "replicas", Type: field.TypeInt32, Default: 0},
+		{Name: "update_scope", Type: field.TypeString, Default: "first"},
+		{Name: "value_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "match_key_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "split_by", Type: field.TypeString, Default: ""},
+		{Name: "dedup_by", Type: field.TypeString, Default: "none"},
+		{Name: "dedup_action", Type: field.TypeString, Default: "reject"},
 	}
 	// EnvsTable holds the schema information for the "envs" table.
 	EnvsTable = &schema.Table{
@@ -55,6 +133,71 @@ var (
 		Columns:    EnvsColumns,
 		PrimaryKey: []*schema.Column{EnvsColumns[0]},
 	}
+	// EnvCronTriggersColumns holds the columns for the "env_cron_triggers" table.
+	EnvCronTriggersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "panel_id", Type: field.TypeInt64, Default: 0},
+		{Name: "cron_id", Type: field.TypeInt, Nullable: true},
+		{Name: "name_pattern", Type: field.TypeString, Nullable: true},
+		{Name: "is_enable", Type: field.TypeBool, Default: true},
+		{Name: "env_id", Type: field.TypeInt64},
+	}
+	// EnvCronTriggersTable holds the schema information for the "env_cron_triggers" table.
+	EnvCronTriggersTable = &schema.Table{
+		Name:       "env_cron_triggers",
+		Columns:    EnvCronTriggersColumns,
+		PrimaryKey: []*schema.Column{EnvCronTriggersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "env_cron_triggers_envs_cron_triggers",
+				Columns:    []*schema.Column{EnvCronTriggersColumns[7]},
+				RefColumns: []*schema.Column{EnvsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envcrontrigger_env_id",
+				Unique:  false,
+				Columns: []*schema.Column{EnvCronTriggersColumns[7]},
+			},
+			{
+				Name:    "envcrontrigger_panel_id",
+				Unique:  false,
+				Columns: []*schema.Column{EnvCronTriggersColumns[3]},
+			},
+		},
+	}
+	// EnvPanelsColumns holds the columns for the "env_panels" table.
+	EnvPanelsColumns = []*schema.Column{
+		{Name: "max_count", Type: field.TypeInt32, Default: 0},
+		{Name: "weight", Type: field.TypeInt, Nullable: true},
+		{Name: "is_enable", Type: field.TypeBool, Default: true},
+		{Name: "env_id", Type: field.TypeInt64},
+		{Name: "panel_id", Type: field.TypeInt64},
+	}
+	// EnvPanelsTable holds the schema information for the "env_panels" table.
+	EnvPanelsTable = &schema.Table{
+		Name:       "env_panels",
+		Columns:    EnvPanelsColumns,
+		PrimaryKey: []*schema.Column{EnvPanelsColumns[3], EnvPanelsColumns[4]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "env_panels_env_id",
+				Columns:    []*schema.Column{EnvPanelsColumns[3]},
+				RefColumns: []*schema.Column{EnvsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "env_panels_panel_id",
+				Columns:    []*schema.Column{EnvPanelsColumns[4]},
+				RefColumns: []*schema.Column{PanelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// EnvPluginsColumns holds the columns for the "env_plugins" table.
 	EnvPluginsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -63,6 +206,12 @@ var (
 		{Name: "is_enable", Type: field.TypeBool, Default: true},
 		{Name: "execution_order", Type: field.TypeInt32, Default: 100},
 		{Name: "config", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "failure_policy", Type: field.TypeString, Default: "fail_closed"},
+		{Name: "failure_threshold", Type: field.TypeInt32, Default: 5},
+		{Name: "cooldown_seconds", Type: field.TypeInt32, Default: 300},
+		{Name: "consecutive_failures", Type: field.TypeInt32, Default: 0},
+		{Name: "disabled_until", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_reason", Type: field.TypeString, Nullable: true},
 		{Name: "env_id", Type: field.TypeInt64},
 		{Name: "plugin_id", Type: field.TypeInt64},
 	}
@@ -74,13 +223,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "env_plugins_envs_env_plugins",
-				Columns:    []*schema.Column{EnvPluginsColumns[6]},
+				Columns:    []*schema.Column{EnvPluginsColumns[12]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "env_plugins_plugins_env_plugins",
-				Columns:    []*schema.Column{EnvPluginsColumns[7]},
+				Columns:    []*schema.Column{EnvPluginsColumns[13]},
 				RefColumns: []*schema.Column{PluginsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -89,17 +238,17 @@ var (
 			{
 				Name:    "envplugin_env_id_plugin_id",
 				Unique:  true,
-				Columns: []*schema.Column{EnvPluginsColumns[6], EnvPluginsColumns[7]},
+				Columns: []*schema.Column{EnvPluginsColumns[12], EnvPluginsColumns[13]},
 			},
 			{
 				Name:    "envplugin_env_id",
 				Unique:  false,
-				Columns: []*schema.Column{EnvPluginsColumns[6]},
+				Columns: []*schema.Column{EnvPluginsColumns[12]},
 			},
 			{
 				Name:    "envplugin_plugin_id",
 				Unique:  false,
-				Columns: []*schema.Column{EnvPluginsColumns[7]},
+				Columns: []*schema.Column{EnvPluginsColumns[13]},
 			},
 			{
 				Name:    "envplugin_is_enable",
@@ -113,6 +262,63 @@ var (
 			},
 		},
 	}
+	// EnvReplicasColumns holds the columns for the "env_replicas" table.
+	EnvReplicasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "env_id", Type: field.TypeInt64},
+		{Name: "group_id", Type: field.TypeString},
+		{Name: "panel_id", Type: field.TypeInt64},
+		{Name: "panel_env_id", Type: field.TypeInt},
+	}
+	// EnvReplicasTable holds the schema information for the "env_replicas" table.
+	EnvReplicasTable = &schema.Table{
+		Name:       "env_replicas",
+		Columns:    EnvReplicasColumns,
+		PrimaryKey: []*schema.Column{EnvReplicasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envreplica_env_id_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{EnvReplicasColumns[3], EnvReplicasColumns[4]},
+			},
+			{
+				Name:    "envreplica_panel_id_panel_env_id",
+				Unique:  true,
+				Columns: []*schema.Column{EnvReplicasColumns[5], EnvReplicasColumns[6]},
+			},
+		},
+	}
+	// IdempotencyRecordsColumns holds the columns for the "idempotency_records" table.
+	IdempotencyRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "response", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyRecordsTable holds the schema information for the "idempotency_records" table.
+	IdempotencyRecordsTable = &schema.Table{
+		Name:       "idempotency_records",
+		Columns:    IdempotencyRecordsColumns,
+		PrimaryKey: []*schema.Column{IdempotencyRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencyrecord_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyRecordsColumns[2]},
+			},
+			{
+				Name:    "idempotencyrecord_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyRecordsColumns[7]},
+			},
+		},
+	}
 	// LoginHistoriesColumns holds the columns for the "login_histories" table.
 	LoginHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -140,6 +346,14 @@ var (
 		{Name: "is_enable", Type: field.TypeBool},
 		{Name: "token", Type: field.TypeString},
 		{Name: "params", Type: field.TypeInt32},
+		{Name: "ql_version", Type: field.TypeString, Nullable: true},
+		{Name: "node_version", Type: field.TypeString, Nullable: true},
+		{Name: "uptime", Type: field.TypeInt64, Nullable: true},
+		{Name: "system_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "health_status", Type: field.TypeString, Default: "unknown"},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "health_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "auto_disabled", Type: field.TypeBool, Default: false},
 	}
 	// PanelsTable holds the schema information for the "panels" table.
 	PanelsTable = &schema.Table{
@@ -147,6 +361,35 @@ var (
 		Columns:    PanelsColumns,
 		PrimaryKey: []*schema.Column{PanelsColumns[0]},
 	}
+	// PanelHealthsColumns holds the columns for the "panel_healths" table.
+	PanelHealthsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "panel_id", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeString},
+		{Name: "token_valid", Type: field.TypeBool},
+		{Name: "latency", Type: field.TypeInt64},
+		{Name: "env_count", Type: field.TypeInt32, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// PanelHealthsTable holds the schema information for the "panel_healths" table.
+	PanelHealthsTable = &schema.Table{
+		Name:       "panel_healths",
+		Columns:    PanelHealthsColumns,
+		PrimaryKey: []*schema.Column{PanelHealthsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "panelhealth_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PanelHealthsColumns[1]},
+			},
+			{
+				Name:    "panelhealth_panel_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PanelHealthsColumns[2], PanelHealthsColumns[1]},
+			},
+		},
+	}
 	// PluginsColumns holds the columns for the "plugins" table.
 	PluginsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -161,6 +404,7 @@ var (
 		{Name: "execution_timeout", Type: field.TypeInt32, Default: 10000},
 		{Name: "trigger_event", Type: field.TypeString, Default: "before_submit"},
 		{Name: "priority", Type: field.TypeInt32, Default: 10},
+		{Name: "require_tests_pass", Type: field.TypeBool, Default: false},
 	}
 	// PluginsTable holds the schema information for the "plugins" table.
 	PluginsTable = &schema.Table{
@@ -229,6 +473,62 @@ var (
 			},
 		},
 	}
+	// PluginTestCasesColumns holds the columns for the "plugin_test_cases" table.
+	PluginTestCasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "input_value", Type: field.TypeString, Size: 2147483647},
+		{Name: "config", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expected_bool", Type: field.TypeBool, Nullable: true},
+		{Name: "expected_env", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expected_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "is_enable", Type: field.TypeBool, Default: true},
+		{Name: "plugin_id", Type: field.TypeInt64},
+	}
+	// PluginTestCasesTable holds the schema information for the "plugin_test_cases" table.
+	PluginTestCasesTable = &schema.Table{
+		Name:       "plugin_test_cases",
+		Columns:    PluginTestCasesColumns,
+		PrimaryKey: []*schema.Column{PluginTestCasesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "plugin_test_cases_plugins_test_cases",
+				Columns:    []*schema.Column{PluginTestCasesColumns[10]},
+				RefColumns: []*schema.Column{PluginsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "plugintestcase_plugin_id",
+				Unique:  false,
+				Columns: []*schema.Column{PluginTestCasesColumns[10]},
+			},
+		},
+	}
+	// ResourceLocksColumns holds the columns for the "resource_locks" table.
+	ResourceLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// ResourceLocksTable holds the schema information for the "resource_locks" table.
+	ResourceLocksTable = &schema.Table{
+		Name:       "resource_locks",
+		Columns:    ResourceLocksColumns,
+		PrimaryKey: []*schema.Column{ResourceLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcelock_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceLocksColumns[2]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -243,49 +543,34 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
-	// EnvPanelsColumns holds the columns for the "env_panels" table.
-	EnvPanelsColumns = []*schema.Column{
-		{Name: "env_id", Type: field.TypeInt64},
-		{Name: "panel_id", Type: field.TypeInt64},
-	}
-	// EnvPanelsTable holds the schema information for the "env_panels" table.
-	EnvPanelsTable = &schema.Table{
-		Name:       "env_panels",
-		Columns:    EnvPanelsColumns,
-		PrimaryKey: []*schema.Column{EnvPanelsColumns[0], EnvPanelsColumns[1]},
-		ForeignKeys: []*schema.ForeignKey{
-			{
-				Symbol:     "env_panels_env_id",
-				Columns:    []*schema.Column{EnvPanelsColumns[0]},
-				RefColumns: []*schema.Column{EnvsColumns[0]},
-				OnDelete:   schema.Cascade,
-			},
-			{
-				Symbol:     "env_panels_panel_id",
-				Columns:    []*schema.Column{EnvPanelsColumns[1]},
-				RefColumns: []*schema.Column{PanelsColumns[0]},
-				OnDelete:   schema.Cascade,
-			},
-		},
-	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CdKeysTable,
+		CdkLedgersTable,
+		CronTriggerLogsTable,
 		EnvsTable,
+		EnvCronTriggersTable,
+		EnvPanelsTable,
 		EnvPluginsTable,
+		EnvReplicasTable,
+		IdempotencyRecordsTable,
 		LoginHistoriesTable,
 		PanelsTable,
+		PanelHealthsTable,
 		PluginsTable,
 		PluginExecutionLogsTable,
+		PluginTestCasesTable,
+		ResourceLocksTable,
 		UsersTable,
-		EnvPanelsTable,
 	}
 )
 
 func init() {
+	EnvCronTriggersTable.ForeignKeys[0].RefTable = EnvsTable
+	EnvPanelsTable.ForeignKeys[0].RefTable = EnvsTable
+	EnvPanelsTable.ForeignKeys[1].RefTable = PanelsTable
 	EnvPluginsTable.ForeignKeys[0].RefTable = EnvsTable
 	EnvPluginsTable.ForeignKeys[1].RefTable = PluginsTable
 	PluginExecutionLogsTable.ForeignKeys[0].RefTable = PluginsTable
-	EnvPanelsTable.ForeignKeys[0].RefTable = EnvsTable
-	EnvPanelsTable.ForeignKeys[1].RefTable = PanelsTable
+	PluginTestCasesTable.ForeignKeys[0].RefTable = PluginsTable
 }
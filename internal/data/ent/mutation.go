@@ -12,13 +12,22 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkey"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/idempotencyrecord"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/loginhistory"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/resourcelock"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/user"
 )
 
@@ -32,12 +41,21 @@ const (
 
 	// Node types.
 	TypeCdKey              = "CdKey"
+	TypeCdkLedger          = "CdkLedger"
+	TypeCronTriggerLog     = "CronTriggerLog"
 	TypeEnv                = "Env"
+	TypeEnvCronTrigger     = "EnvCronTrigger"
+	TypeEnvPanel           = "EnvPanel"
 	TypeEnvPlugin          = "EnvPlugin"
+	TypeEnvReplica         = "EnvReplica"
+	TypeIdempotencyRecord  = "IdempotencyRecord"
 	TypeLoginHistory       = "LoginHistory"
 	TypePanel              = "Panel"
+	TypePanelHealth        = "PanelHealth"
 	TypePlugin             = "Plugin"
 	TypePluginExecutionLog = "PluginExecutionLog"
+	TypePluginTestCase     = "PluginTestCase"
+	TypeResourceLock       = "ResourceLock"
 	TypeUser               = "User"
 )
 
@@ -625,53 +643,43 @@ func (m *CdKeyMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown CdKey edge %s", name)
 }
 
-// EnvMutation represents an operation that mutates the Env nodes in the graph.
-type EnvMutation struct {
+// CdkLedgerMutation represents an operation that mutates the CdkLedger nodes in the graph.
+type CdkLedgerMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int64
-	created_at         *time.Time
-	updated_at         *time.Time
-	name               *string
-	remarks            *string
-	quantity           *int32
-	addquantity        *int32
-	regex              *string
-	mode               *int32
-	addmode            *int32
-	regex_update       *string
-	is_auto_env_enable *bool
-	enable_key         *bool
-	cdk_limit          *int32
-	addcdk_limit       *int32
-	is_prompt          *bool
-	prompt_level       *string
-	prompt_content     *string
-	is_enable          *bool
-	clearedFields      map[string]struct{}
-	panels             map[int64]struct{}
-	removedpanels      map[int64]struct{}
-	clearedpanels      bool
-	env_plugins        map[int64]struct{}
-	removedenv_plugins map[int64]struct{}
-	clearedenv_plugins bool
-	done               bool
-	oldValue           func(context.Context) (*Env, error)
-	predicates         []predicate.Env
+	op                Op
+	typ               string
+	id                *int64
+	created_at        *time.Time
+	cdk_id            *int64
+	addcdk_id         *int64
+	env_id            *int64
+	addenv_id         *int64
+	_type             *string
+	delta             *int32
+	adddelta          *int32
+	balance           *int32
+	addbalance        *int32
+	reservation_id    *int64
+	addreservation_id *int64
+	settled           *bool
+	message           *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*CdkLedger, error)
+	predicates        []predicate.CdkLedger
 }
 
-var _ ent.Mutation = (*EnvMutation)(nil)
+var _ ent.Mutation = (*CdkLedgerMutation)(nil)
 
-// envOption allows management of the mutation configuration using functional options.
-type envOption func(*EnvMutation)
+// cdkledgerOption allows management of the mutation configuration using functional options.
+type cdkledgerOption func(*CdkLedgerMutation)
 
-// newEnvMutation creates new mutation for the Env entity.
-func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
-	m := &EnvMutation{
+// newCdkLedgerMutation creates new mutation for the CdkLedger entity.
+func newCdkLedgerMutation(c config, op Op, opts ...cdkledgerOption) *CdkLedgerMutation {
+	m := &CdkLedgerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnv,
+		typ:           TypeCdkLedger,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -680,20 +688,20 @@ func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
 	return m
 }
 
-// withEnvID sets the ID field of the mutation.
-func withEnvID(id int64) envOption {
-	return func(m *EnvMutation) {
+// withCdkLedgerID sets the ID field of the mutation.
+func withCdkLedgerID(id int64) cdkledgerOption {
+	return func(m *CdkLedgerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Env
+			value *CdkLedger
 		)
-		m.oldValue = func(ctx context.Context) (*Env, error) {
+		m.oldValue = func(ctx context.Context) (*CdkLedger, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Env.Get(ctx, id)
+					value, err = m.Client().CdkLedger.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -702,10 +710,10 @@ func withEnvID(id int64) envOption {
 	}
 }
 
-// withEnv sets the old Env of the mutation.
-func withEnv(node *Env) envOption {
-	return func(m *EnvMutation) {
-		m.oldValue = func(context.Context) (*Env, error) {
+// withCdkLedger sets the old CdkLedger of the mutation.
+func withCdkLedger(node *CdkLedger) cdkledgerOption {
+	return func(m *CdkLedgerMutation) {
+		m.oldValue = func(context.Context) (*CdkLedger, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -714,7 +722,7 @@ func withEnv(node *Env) envOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvMutation) Client() *Client {
+func (m CdkLedgerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -722,7 +730,7 @@ func (m EnvMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvMutation) Tx() (*Tx, error) {
+func (m CdkLedgerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -732,14 +740,14 @@ func (m EnvMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Env entities.
-func (m *EnvMutation) SetID(id int64) {
+// operation is only accepted on creation of CdkLedger entities.
+func (m *CdkLedgerMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvMutation) ID() (id int64, exists bool) {
+func (m *CdkLedgerMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -750,7 +758,7 @@ func (m *EnvMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *CdkLedgerMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -759,19 +767,19 @@ func (m *EnvMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CdkLedger.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EnvMutation) SetCreatedAt(t time.Time) {
+func (m *CdkLedgerMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CdkLedgerMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -779,10 +787,10 @@ func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CdkLedgerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -797,1034 +805,1457 @@ func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvMutation) ResetCreatedAt() {
+func (m *CdkLedgerMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCdkID sets the "cdk_id" field.
+func (m *CdkLedgerMutation) SetCdkID(i int64) {
+	m.cdk_id = &i
+	m.addcdk_id = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CdkID returns the value of the "cdk_id" field in the mutation.
+func (m *CdkLedgerMutation) CdkID() (r int64, exists bool) {
+	v := m.cdk_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldCdkID returns the old "cdk_id" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CdkLedgerMutation) OldCdkID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCdkID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCdkID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCdkID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CdkID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddCdkID adds i to the "cdk_id" field.
+func (m *CdkLedgerMutation) AddCdkID(i int64) {
+	if m.addcdk_id != nil {
+		*m.addcdk_id += i
+	} else {
+		m.addcdk_id = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *EnvMutation) SetName(s string) {
-	m.name = &s
+// AddedCdkID returns the value that was added to the "cdk_id" field in this mutation.
+func (m *CdkLedgerMutation) AddedCdkID() (r int64, exists bool) {
+	v := m.addcdk_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *EnvMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetCdkID resets all changes to the "cdk_id" field.
+func (m *CdkLedgerMutation) ResetCdkID() {
+	m.cdk_id = nil
+	m.addcdk_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *CdkLedgerMutation) SetEnvID(i int64) {
+	m.env_id = &i
+	m.addenv_id = nil
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *CdkLedgerMutation) EnvID() (r int64, exists bool) {
+	v := m.env_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldEnvID returns the old "env_id" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CdkLedgerMutation) OldEnvID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.EnvID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *EnvMutation) ResetName() {
-	m.name = nil
+// AddEnvID adds i to the "env_id" field.
+func (m *CdkLedgerMutation) AddEnvID(i int64) {
+	if m.addenv_id != nil {
+		*m.addenv_id += i
+	} else {
+		m.addenv_id = &i
+	}
 }
 
-// SetRemarks sets the "remarks" field.
-func (m *EnvMutation) SetRemarks(s string) {
-	m.remarks = &s
+// AddedEnvID returns the value that was added to the "env_id" field in this mutation.
+func (m *CdkLedgerMutation) AddedEnvID() (r int64, exists bool) {
+	v := m.addenv_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Remarks returns the value of the "remarks" field in the mutation.
-func (m *EnvMutation) Remarks() (r string, exists bool) {
-	v := m.remarks
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *CdkLedgerMutation) ResetEnvID() {
+	m.env_id = nil
+	m.addenv_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *CdkLedgerMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *CdkLedgerMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemarks returns the old "remarks" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldRemarks(ctx context.Context) (v *string, err error) {
+func (m *CdkLedgerMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemarks is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemarks requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemarks: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Remarks, nil
-}
-
-// ClearRemarks clears the value of the "remarks" field.
-func (m *EnvMutation) ClearRemarks() {
-	m.remarks = nil
-	m.clearedFields[env.FieldRemarks] = struct{}{}
-}
-
-// RemarksCleared returns if the "remarks" field was cleared in this mutation.
-func (m *EnvMutation) RemarksCleared() bool {
-	_, ok := m.clearedFields[env.FieldRemarks]
-	return ok
+	return oldValue.Type, nil
 }
 
-// ResetRemarks resets all changes to the "remarks" field.
-func (m *EnvMutation) ResetRemarks() {
-	m.remarks = nil
-	delete(m.clearedFields, env.FieldRemarks)
+// ResetType resets all changes to the "type" field.
+func (m *CdkLedgerMutation) ResetType() {
+	m._type = nil
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *EnvMutation) SetQuantity(i int32) {
-	m.quantity = &i
-	m.addquantity = nil
+// SetDelta sets the "delta" field.
+func (m *CdkLedgerMutation) SetDelta(i int32) {
+	m.delta = &i
+	m.adddelta = nil
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *EnvMutation) Quantity() (r int32, exists bool) {
-	v := m.quantity
+// Delta returns the value of the "delta" field in the mutation.
+func (m *CdkLedgerMutation) Delta() (r int32, exists bool) {
+	v := m.delta
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldDelta returns the old "delta" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldQuantity(ctx context.Context) (v int32, err error) {
+func (m *CdkLedgerMutation) OldDelta(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldDelta is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldDelta requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+		return v, fmt.Errorf("querying old value for OldDelta: %w", err)
 	}
-	return oldValue.Quantity, nil
+	return oldValue.Delta, nil
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (m *EnvMutation) AddQuantity(i int32) {
-	if m.addquantity != nil {
-		*m.addquantity += i
+// AddDelta adds i to the "delta" field.
+func (m *CdkLedgerMutation) AddDelta(i int32) {
+	if m.adddelta != nil {
+		*m.adddelta += i
 	} else {
-		m.addquantity = &i
+		m.adddelta = &i
 	}
 }
 
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *EnvMutation) AddedQuantity() (r int32, exists bool) {
-	v := m.addquantity
+// AddedDelta returns the value that was added to the "delta" field in this mutation.
+func (m *CdkLedgerMutation) AddedDelta() (r int32, exists bool) {
+	v := m.adddelta
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *EnvMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
+// ResetDelta resets all changes to the "delta" field.
+func (m *CdkLedgerMutation) ResetDelta() {
+	m.delta = nil
+	m.adddelta = nil
 }
 
-// SetRegex sets the "regex" field.
-func (m *EnvMutation) SetRegex(s string) {
-	m.regex = &s
+// SetBalance sets the "balance" field.
+func (m *CdkLedgerMutation) SetBalance(i int32) {
+	m.balance = &i
+	m.addbalance = nil
 }
 
-// Regex returns the value of the "regex" field in the mutation.
-func (m *EnvMutation) Regex() (r string, exists bool) {
-	v := m.regex
+// Balance returns the value of the "balance" field in the mutation.
+func (m *CdkLedgerMutation) Balance() (r int32, exists bool) {
+	v := m.balance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegex returns the old "regex" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldBalance returns the old "balance" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldRegex(ctx context.Context) (v *string, err error) {
+func (m *CdkLedgerMutation) OldBalance(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegex is only allowed on UpdateOne operations")
+		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegex requires an ID field in the mutation")
+		return v, errors.New("OldBalance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegex: %w", err)
+		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
 	}
-	return oldValue.Regex, nil
+	return oldValue.Balance, nil
 }
 
-// ClearRegex clears the value of the "regex" field.
-func (m *EnvMutation) ClearRegex() {
-	m.regex = nil
-	m.clearedFields[env.FieldRegex] = struct{}{}
+// AddBalance adds i to the "balance" field.
+func (m *CdkLedgerMutation) AddBalance(i int32) {
+	if m.addbalance != nil {
+		*m.addbalance += i
+	} else {
+		m.addbalance = &i
+	}
 }
 
-// RegexCleared returns if the "regex" field was cleared in this mutation.
-func (m *EnvMutation) RegexCleared() bool {
-	_, ok := m.clearedFields[env.FieldRegex]
-	return ok
+// AddedBalance returns the value that was added to the "balance" field in this mutation.
+func (m *CdkLedgerMutation) AddedBalance() (r int32, exists bool) {
+	v := m.addbalance
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetRegex resets all changes to the "regex" field.
-func (m *EnvMutation) ResetRegex() {
-	m.regex = nil
-	delete(m.clearedFields, env.FieldRegex)
+// ResetBalance resets all changes to the "balance" field.
+func (m *CdkLedgerMutation) ResetBalance() {
+	m.balance = nil
+	m.addbalance = nil
 }
 
-// SetMode sets the "mode" field.
-func (m *EnvMutation) SetMode(i int32) {
-	m.mode = &i
-	m.addmode = nil
+// SetReservationID sets the "reservation_id" field.
+func (m *CdkLedgerMutation) SetReservationID(i int64) {
+	m.reservation_id = &i
+	m.addreservation_id = nil
 }
 
-// Mode returns the value of the "mode" field in the mutation.
-func (m *EnvMutation) Mode() (r int32, exists bool) {
-	v := m.mode
+// ReservationID returns the value of the "reservation_id" field in the mutation.
+func (m *CdkLedgerMutation) ReservationID() (r int64, exists bool) {
+	v := m.reservation_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMode returns the old "mode" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldReservationID returns the old "reservation_id" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldMode(ctx context.Context) (v int32, err error) {
+func (m *CdkLedgerMutation) OldReservationID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldReservationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMode requires an ID field in the mutation")
+		return v, errors.New("OldReservationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldReservationID: %w", err)
 	}
-	return oldValue.Mode, nil
+	return oldValue.ReservationID, nil
 }
 
-// AddMode adds i to the "mode" field.
-func (m *EnvMutation) AddMode(i int32) {
-	if m.addmode != nil {
-		*m.addmode += i
+// AddReservationID adds i to the "reservation_id" field.
+func (m *CdkLedgerMutation) AddReservationID(i int64) {
+	if m.addreservation_id != nil {
+		*m.addreservation_id += i
 	} else {
-		m.addmode = &i
+		m.addreservation_id = &i
 	}
 }
 
-// AddedMode returns the value that was added to the "mode" field in this mutation.
-func (m *EnvMutation) AddedMode() (r int32, exists bool) {
-	v := m.addmode
+// AddedReservationID returns the value that was added to the "reservation_id" field in this mutation.
+func (m *CdkLedgerMutation) AddedReservationID() (r int64, exists bool) {
+	v := m.addreservation_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMode resets all changes to the "mode" field.
-func (m *EnvMutation) ResetMode() {
-	m.mode = nil
-	m.addmode = nil
+// ClearReservationID clears the value of the "reservation_id" field.
+func (m *CdkLedgerMutation) ClearReservationID() {
+	m.reservation_id = nil
+	m.addreservation_id = nil
+	m.clearedFields[cdkledger.FieldReservationID] = struct{}{}
 }
 
-// SetRegexUpdate sets the "regex_update" field.
-func (m *EnvMutation) SetRegexUpdate(s string) {
-	m.regex_update = &s
+// ReservationIDCleared returns if the "reservation_id" field was cleared in this mutation.
+func (m *CdkLedgerMutation) ReservationIDCleared() bool {
+	_, ok := m.clearedFields[cdkledger.FieldReservationID]
+	return ok
 }
 
-// RegexUpdate returns the value of the "regex_update" field in the mutation.
-func (m *EnvMutation) RegexUpdate() (r string, exists bool) {
-	v := m.regex_update
+// ResetReservationID resets all changes to the "reservation_id" field.
+func (m *CdkLedgerMutation) ResetReservationID() {
+	m.reservation_id = nil
+	m.addreservation_id = nil
+	delete(m.clearedFields, cdkledger.FieldReservationID)
+}
+
+// SetSettled sets the "settled" field.
+func (m *CdkLedgerMutation) SetSettled(b bool) {
+	m.settled = &b
+}
+
+// Settled returns the value of the "settled" field in the mutation.
+func (m *CdkLedgerMutation) Settled() (r bool, exists bool) {
+	v := m.settled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegexUpdate returns the old "regex_update" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldSettled returns the old "settled" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldRegexUpdate(ctx context.Context) (v *string, err error) {
+func (m *CdkLedgerMutation) OldSettled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegexUpdate is only allowed on UpdateOne operations")
+		return v, errors.New("OldSettled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegexUpdate requires an ID field in the mutation")
+		return v, errors.New("OldSettled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegexUpdate: %w", err)
+		return v, fmt.Errorf("querying old value for OldSettled: %w", err)
 	}
-	return oldValue.RegexUpdate, nil
+	return oldValue.Settled, nil
 }
 
-// ClearRegexUpdate clears the value of the "regex_update" field.
-func (m *EnvMutation) ClearRegexUpdate() {
-	m.regex_update = nil
-	m.clearedFields[env.FieldRegexUpdate] = struct{}{}
+// ResetSettled resets all changes to the "settled" field.
+func (m *CdkLedgerMutation) ResetSettled() {
+	m.settled = nil
 }
 
-// RegexUpdateCleared returns if the "regex_update" field was cleared in this mutation.
-func (m *EnvMutation) RegexUpdateCleared() bool {
-	_, ok := m.clearedFields[env.FieldRegexUpdate]
-	return ok
+// SetMessage sets the "message" field.
+func (m *CdkLedgerMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// ResetRegexUpdate resets all changes to the "regex_update" field.
-func (m *EnvMutation) ResetRegexUpdate() {
-	m.regex_update = nil
-	delete(m.clearedFields, env.FieldRegexUpdate)
+// Message returns the value of the "message" field in the mutation.
+func (m *CdkLedgerMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetIsAutoEnvEnable sets the "is_auto_env_enable" field.
-func (m *EnvMutation) SetIsAutoEnvEnable(b bool) {
-	m.is_auto_env_enable = &b
-}
-
-// IsAutoEnvEnable returns the value of the "is_auto_env_enable" field in the mutation.
-func (m *EnvMutation) IsAutoEnvEnable() (r bool, exists bool) {
-	v := m.is_auto_env_enable
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsAutoEnvEnable returns the old "is_auto_env_enable" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the CdkLedger entity.
+// If the CdkLedger object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldIsAutoEnvEnable(ctx context.Context) (v bool, err error) {
+func (m *CdkLedgerMutation) OldMessage(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAutoEnvEnable is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAutoEnvEnable requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAutoEnvEnable: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.IsAutoEnvEnable, nil
+	return oldValue.Message, nil
 }
 
-// ResetIsAutoEnvEnable resets all changes to the "is_auto_env_enable" field.
-func (m *EnvMutation) ResetIsAutoEnvEnable() {
-	m.is_auto_env_enable = nil
+// ClearMessage clears the value of the "message" field.
+func (m *CdkLedgerMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[cdkledger.FieldMessage] = struct{}{}
 }
 
-// SetEnableKey sets the "enable_key" field.
-func (m *EnvMutation) SetEnableKey(b bool) {
-	m.enable_key = &b
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *CdkLedgerMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[cdkledger.FieldMessage]
+	return ok
 }
 
-// EnableKey returns the value of the "enable_key" field in the mutation.
-func (m *EnvMutation) EnableKey() (r bool, exists bool) {
-	v := m.enable_key
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetMessage resets all changes to the "message" field.
+func (m *CdkLedgerMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, cdkledger.FieldMessage)
 }
 
-// OldEnableKey returns the old "enable_key" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldEnableKey(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnableKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnableKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnableKey: %w", err)
+// Where appends a list predicates to the CdkLedgerMutation builder.
+func (m *CdkLedgerMutation) Where(ps ...predicate.CdkLedger) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CdkLedgerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CdkLedgerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CdkLedger, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.EnableKey, nil
+	m.Where(p...)
 }
 
-// ResetEnableKey resets all changes to the "enable_key" field.
-func (m *EnvMutation) ResetEnableKey() {
-	m.enable_key = nil
+// Op returns the operation name.
+func (m *CdkLedgerMutation) Op() Op {
+	return m.op
 }
 
-// SetCdkLimit sets the "cdk_limit" field.
-func (m *EnvMutation) SetCdkLimit(i int32) {
-	m.cdk_limit = &i
-	m.addcdk_limit = nil
+// SetOp allows setting the mutation operation.
+func (m *CdkLedgerMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CdkLimit returns the value of the "cdk_limit" field in the mutation.
-func (m *EnvMutation) CdkLimit() (r int32, exists bool) {
-	v := m.cdk_limit
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (CdkLedger).
+func (m *CdkLedgerMutation) Type() string {
+	return m.typ
 }
 
-// OldCdkLimit returns the old "cdk_limit" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCdkLimit(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCdkLimit is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CdkLedgerMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, cdkledger.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCdkLimit requires an ID field in the mutation")
+	if m.cdk_id != nil {
+		fields = append(fields, cdkledger.FieldCdkID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCdkLimit: %w", err)
+	if m.env_id != nil {
+		fields = append(fields, cdkledger.FieldEnvID)
 	}
-	return oldValue.CdkLimit, nil
-}
-
-// AddCdkLimit adds i to the "cdk_limit" field.
-func (m *EnvMutation) AddCdkLimit(i int32) {
-	if m.addcdk_limit != nil {
-		*m.addcdk_limit += i
-	} else {
-		m.addcdk_limit = &i
+	if m._type != nil {
+		fields = append(fields, cdkledger.FieldType)
 	}
-}
-
-// AddedCdkLimit returns the value that was added to the "cdk_limit" field in this mutation.
-func (m *EnvMutation) AddedCdkLimit() (r int32, exists bool) {
-	v := m.addcdk_limit
-	if v == nil {
-		return
+	if m.delta != nil {
+		fields = append(fields, cdkledger.FieldDelta)
 	}
-	return *v, true
-}
-
-// ResetCdkLimit resets all changes to the "cdk_limit" field.
-func (m *EnvMutation) ResetCdkLimit() {
-	m.cdk_limit = nil
-	m.addcdk_limit = nil
-}
-
-// SetIsPrompt sets the "is_prompt" field.
-func (m *EnvMutation) SetIsPrompt(b bool) {
-	m.is_prompt = &b
-}
-
-// IsPrompt returns the value of the "is_prompt" field in the mutation.
-func (m *EnvMutation) IsPrompt() (r bool, exists bool) {
-	v := m.is_prompt
-	if v == nil {
-		return
+	if m.balance != nil {
+		fields = append(fields, cdkledger.FieldBalance)
 	}
-	return *v, true
-}
-
-// OldIsPrompt returns the old "is_prompt" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldIsPrompt(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPrompt is only allowed on UpdateOne operations")
+	if m.reservation_id != nil {
+		fields = append(fields, cdkledger.FieldReservationID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPrompt requires an ID field in the mutation")
+	if m.settled != nil {
+		fields = append(fields, cdkledger.FieldSettled)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPrompt: %w", err)
+	if m.message != nil {
+		fields = append(fields, cdkledger.FieldMessage)
 	}
-	return oldValue.IsPrompt, nil
+	return fields
 }
 
-// ResetIsPrompt resets all changes to the "is_prompt" field.
-func (m *EnvMutation) ResetIsPrompt() {
-	m.is_prompt = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CdkLedgerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cdkledger.FieldCreatedAt:
+		return m.CreatedAt()
+	case cdkledger.FieldCdkID:
+		return m.CdkID()
+	case cdkledger.FieldEnvID:
+		return m.EnvID()
+	case cdkledger.FieldType:
+		return m.GetType()
+	case cdkledger.FieldDelta:
+		return m.Delta()
+	case cdkledger.FieldBalance:
+		return m.Balance()
+	case cdkledger.FieldReservationID:
+		return m.ReservationID()
+	case cdkledger.FieldSettled:
+		return m.Settled()
+	case cdkledger.FieldMessage:
+		return m.Message()
+	}
+	return nil, false
 }
 
-// SetPromptLevel sets the "prompt_level" field.
-func (m *EnvMutation) SetPromptLevel(s string) {
-	m.prompt_level = &s
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CdkLedgerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cdkledger.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cdkledger.FieldCdkID:
+		return m.OldCdkID(ctx)
+	case cdkledger.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case cdkledger.FieldType:
+		return m.OldType(ctx)
+	case cdkledger.FieldDelta:
+		return m.OldDelta(ctx)
+	case cdkledger.FieldBalance:
+		return m.OldBalance(ctx)
+	case cdkledger.FieldReservationID:
+		return m.OldReservationID(ctx)
+	case cdkledger.FieldSettled:
+		return m.OldSettled(ctx)
+	case cdkledger.FieldMessage:
+		return m.OldMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown CdkLedger field %s", name)
 }
 
-// PromptLevel returns the value of the "prompt_level" field in the mutation.
-func (m *EnvMutation) PromptLevel() (r string, exists bool) {
-	v := m.prompt_level
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CdkLedgerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cdkledger.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cdkledger.FieldCdkID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCdkID(v)
+		return nil
+	case cdkledger.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case cdkledger.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case cdkledger.FieldDelta:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelta(v)
+		return nil
+	case cdkledger.FieldBalance:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalance(v)
+		return nil
+	case cdkledger.FieldReservationID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReservationID(v)
+		return nil
+	case cdkledger.FieldSettled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettled(v)
+		return nil
+	case cdkledger.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown CdkLedger field %s", name)
 }
 
-// OldPromptLevel returns the old "prompt_level" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldPromptLevel(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptLevel is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CdkLedgerMutation) AddedFields() []string {
+	var fields []string
+	if m.addcdk_id != nil {
+		fields = append(fields, cdkledger.FieldCdkID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptLevel requires an ID field in the mutation")
+	if m.addenv_id != nil {
+		fields = append(fields, cdkledger.FieldEnvID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptLevel: %w", err)
+	if m.adddelta != nil {
+		fields = append(fields, cdkledger.FieldDelta)
 	}
-	return oldValue.PromptLevel, nil
-}
-
-// ClearPromptLevel clears the value of the "prompt_level" field.
-func (m *EnvMutation) ClearPromptLevel() {
-	m.prompt_level = nil
-	m.clearedFields[env.FieldPromptLevel] = struct{}{}
+	if m.addbalance != nil {
+		fields = append(fields, cdkledger.FieldBalance)
+	}
+	if m.addreservation_id != nil {
+		fields = append(fields, cdkledger.FieldReservationID)
+	}
+	return fields
 }
 
-// PromptLevelCleared returns if the "prompt_level" field was cleared in this mutation.
-func (m *EnvMutation) PromptLevelCleared() bool {
-	_, ok := m.clearedFields[env.FieldPromptLevel]
-	return ok
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CdkLedgerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cdkledger.FieldCdkID:
+		return m.AddedCdkID()
+	case cdkledger.FieldEnvID:
+		return m.AddedEnvID()
+	case cdkledger.FieldDelta:
+		return m.AddedDelta()
+	case cdkledger.FieldBalance:
+		return m.AddedBalance()
+	case cdkledger.FieldReservationID:
+		return m.AddedReservationID()
+	}
+	return nil, false
 }
 
-// ResetPromptLevel resets all changes to the "prompt_level" field.
-func (m *EnvMutation) ResetPromptLevel() {
-	m.prompt_level = nil
-	delete(m.clearedFields, env.FieldPromptLevel)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CdkLedgerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cdkledger.FieldCdkID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCdkID(v)
+		return nil
+	case cdkledger.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnvID(v)
+		return nil
+	case cdkledger.FieldDelta:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDelta(v)
+		return nil
+	case cdkledger.FieldBalance:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBalance(v)
+		return nil
+	case cdkledger.FieldReservationID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReservationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CdkLedger numeric field %s", name)
 }
 
-// SetPromptContent sets the "prompt_content" field.
-func (m *EnvMutation) SetPromptContent(s string) {
-	m.prompt_content = &s
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CdkLedgerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cdkledger.FieldReservationID) {
+		fields = append(fields, cdkledger.FieldReservationID)
+	}
+	if m.FieldCleared(cdkledger.FieldMessage) {
+		fields = append(fields, cdkledger.FieldMessage)
+	}
+	return fields
 }
 
-// PromptContent returns the value of the "prompt_content" field in the mutation.
-func (m *EnvMutation) PromptContent() (r string, exists bool) {
-	v := m.prompt_content
-	if v == nil {
-		return
-	}
-	return *v, true
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CdkLedgerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// OldPromptContent returns the old "prompt_content" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldPromptContent(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptContent: %w", err)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CdkLedgerMutation) ClearField(name string) error {
+	switch name {
+	case cdkledger.FieldReservationID:
+		m.ClearReservationID()
+		return nil
+	case cdkledger.FieldMessage:
+		m.ClearMessage()
+		return nil
 	}
-	return oldValue.PromptContent, nil
+	return fmt.Errorf("unknown CdkLedger nullable field %s", name)
 }
 
-// ClearPromptContent clears the value of the "prompt_content" field.
-func (m *EnvMutation) ClearPromptContent() {
-	m.prompt_content = nil
-	m.clearedFields[env.FieldPromptContent] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CdkLedgerMutation) ResetField(name string) error {
+	switch name {
+	case cdkledger.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cdkledger.FieldCdkID:
+		m.ResetCdkID()
+		return nil
+	case cdkledger.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case cdkledger.FieldType:
+		m.ResetType()
+		return nil
+	case cdkledger.FieldDelta:
+		m.ResetDelta()
+		return nil
+	case cdkledger.FieldBalance:
+		m.ResetBalance()
+		return nil
+	case cdkledger.FieldReservationID:
+		m.ResetReservationID()
+		return nil
+	case cdkledger.FieldSettled:
+		m.ResetSettled()
+		return nil
+	case cdkledger.FieldMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown CdkLedger field %s", name)
 }
 
-// PromptContentCleared returns if the "prompt_content" field was cleared in this mutation.
-func (m *EnvMutation) PromptContentCleared() bool {
-	_, ok := m.clearedFields[env.FieldPromptContent]
-	return ok
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CdkLedgerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetPromptContent resets all changes to the "prompt_content" field.
-func (m *EnvMutation) ResetPromptContent() {
-	m.prompt_content = nil
-	delete(m.clearedFields, env.FieldPromptContent)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CdkLedgerMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetIsEnable sets the "is_enable" field.
-func (m *EnvMutation) SetIsEnable(b bool) {
-	m.is_enable = &b
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CdkLedgerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// IsEnable returns the value of the "is_enable" field in the mutation.
-func (m *EnvMutation) IsEnable() (r bool, exists bool) {
-	v := m.is_enable
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CdkLedgerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldIsEnable returns the old "is_enable" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnable requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
-	}
-	return oldValue.IsEnable, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CdkLedgerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetIsEnable resets all changes to the "is_enable" field.
-func (m *EnvMutation) ResetIsEnable() {
-	m.is_enable = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CdkLedgerMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// AddPanelIDs adds the "panels" edge to the Panel entity by ids.
-func (m *EnvMutation) AddPanelIDs(ids ...int64) {
-	if m.panels == nil {
-		m.panels = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.panels[ids[i]] = struct{}{}
-	}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CdkLedgerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CdkLedger unique edge %s", name)
 }
 
-// ClearPanels clears the "panels" edge to the Panel entity.
-func (m *EnvMutation) ClearPanels() {
-	m.clearedpanels = true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CdkLedgerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CdkLedger edge %s", name)
 }
 
-// PanelsCleared reports if the "panels" edge to the Panel entity was cleared.
-func (m *EnvMutation) PanelsCleared() bool {
-	return m.clearedpanels
+// CronTriggerLogMutation represents an operation that mutates the CronTriggerLog nodes in the graph.
+type CronTriggerLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	env_id        *int64
+	addenv_id     *int64
+	panel_id      *int64
+	addpanel_id   *int64
+	trigger_id    *int64
+	addtrigger_id *int64
+	cron_ids      *string
+	status        *string
+	message       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CronTriggerLog, error)
+	predicates    []predicate.CronTriggerLog
 }
 
-// RemovePanelIDs removes the "panels" edge to the Panel entity by IDs.
-func (m *EnvMutation) RemovePanelIDs(ids ...int64) {
-	if m.removedpanels == nil {
-		m.removedpanels = make(map[int64]struct{})
+var _ ent.Mutation = (*CronTriggerLogMutation)(nil)
+
+// crontriggerlogOption allows management of the mutation configuration using functional options.
+type crontriggerlogOption func(*CronTriggerLogMutation)
+
+// newCronTriggerLogMutation creates new mutation for the CronTriggerLog entity.
+func newCronTriggerLogMutation(c config, op Op, opts ...crontriggerlogOption) *CronTriggerLogMutation {
+	m := &CronTriggerLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCronTriggerLog,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.panels, ids[i])
-		m.removedpanels[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedPanels returns the removed IDs of the "panels" edge to the Panel entity.
-func (m *EnvMutation) RemovedPanelsIDs() (ids []int64) {
-	for id := range m.removedpanels {
-		ids = append(ids, id)
+// withCronTriggerLogID sets the ID field of the mutation.
+func withCronTriggerLogID(id int64) crontriggerlogOption {
+	return func(m *CronTriggerLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CronTriggerLog
+		)
+		m.oldValue = func(ctx context.Context) (*CronTriggerLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CronTriggerLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// PanelsIDs returns the "panels" edge IDs in the mutation.
-func (m *EnvMutation) PanelsIDs() (ids []int64) {
-	for id := range m.panels {
-		ids = append(ids, id)
+// withCronTriggerLog sets the old CronTriggerLog of the mutation.
+func withCronTriggerLog(node *CronTriggerLog) crontriggerlogOption {
+	return func(m *CronTriggerLogMutation) {
+		m.oldValue = func(context.Context) (*CronTriggerLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetPanels resets all changes to the "panels" edge.
-func (m *EnvMutation) ResetPanels() {
-	m.panels = nil
-	m.clearedpanels = false
-	m.removedpanels = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CronTriggerLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddEnvPluginIDs adds the "env_plugins" edge to the EnvPlugin entity by ids.
-func (m *EnvMutation) AddEnvPluginIDs(ids ...int64) {
-	if m.env_plugins == nil {
-		m.env_plugins = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.env_plugins[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CronTriggerLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearEnvPlugins clears the "env_plugins" edge to the EnvPlugin entity.
-func (m *EnvMutation) ClearEnvPlugins() {
-	m.clearedenv_plugins = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CronTriggerLog entities.
+func (m *CronTriggerLogMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// EnvPluginsCleared reports if the "env_plugins" edge to the EnvPlugin entity was cleared.
-func (m *EnvMutation) EnvPluginsCleared() bool {
-	return m.clearedenv_plugins
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CronTriggerLogMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// RemoveEnvPluginIDs removes the "env_plugins" edge to the EnvPlugin entity by IDs.
-func (m *EnvMutation) RemoveEnvPluginIDs(ids ...int64) {
-	if m.removedenv_plugins == nil {
-		m.removedenv_plugins = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.env_plugins, ids[i])
-		m.removedenv_plugins[ids[i]] = struct{}{}
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CronTriggerLogMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CronTriggerLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// RemovedEnvPlugins returns the removed IDs of the "env_plugins" edge to the EnvPlugin entity.
-func (m *EnvMutation) RemovedEnvPluginsIDs() (ids []int64) {
-	for id := range m.removedenv_plugins {
-		ids = append(ids, id)
+// SetCreatedAt sets the "created_at" field.
+func (m *CronTriggerLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CronTriggerLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// EnvPluginsIDs returns the "env_plugins" edge IDs in the mutation.
-func (m *EnvMutation) EnvPluginsIDs() (ids []int64) {
-	for id := range m.env_plugins {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEnvPlugins resets all changes to the "env_plugins" edge.
-func (m *EnvMutation) ResetEnvPlugins() {
-	m.env_plugins = nil
-	m.clearedenv_plugins = false
-	m.removedenv_plugins = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CronTriggerLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the EnvMutation builder.
-func (m *EnvMutation) Where(ps ...predicate.Env) {
-	m.predicates = append(m.predicates, ps...)
+// SetEnvID sets the "env_id" field.
+func (m *CronTriggerLogMutation) SetEnvID(i int64) {
+	m.env_id = &i
+	m.addenv_id = nil
 }
 
-// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Env, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *CronTriggerLogMutation) EnvID() (r int64, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *EnvMutation) Op() Op {
-	return m.op
+// OldEnvID returns the old "env_id" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldEnvID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EnvMutation) SetOp(op Op) {
-	m.op = op
+// AddEnvID adds i to the "env_id" field.
+func (m *CronTriggerLogMutation) AddEnvID(i int64) {
+	if m.addenv_id != nil {
+		*m.addenv_id += i
+	} else {
+		m.addenv_id = &i
+	}
 }
 
-// Type returns the node type of this mutation (Env).
-func (m *EnvMutation) Type() string {
-	return m.typ
+// AddedEnvID returns the value that was added to the "env_id" field in this mutation.
+func (m *CronTriggerLogMutation) AddedEnvID() (r int64, exists bool) {
+	v := m.addenv_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EnvMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.created_at != nil {
-		fields = append(fields, env.FieldCreatedAt)
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *CronTriggerLogMutation) ResetEnvID() {
+	m.env_id = nil
+	m.addenv_id = nil
+}
+
+// SetPanelID sets the "panel_id" field.
+func (m *CronTriggerLogMutation) SetPanelID(i int64) {
+	m.panel_id = &i
+	m.addpanel_id = nil
+}
+
+// PanelID returns the value of the "panel_id" field in the mutation.
+func (m *CronTriggerLogMutation) PanelID() (r int64, exists bool) {
+	v := m.panel_id
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, env.FieldUpdatedAt)
+	return *v, true
+}
+
+// OldPanelID returns the old "panel_id" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldPanelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPanelID is only allowed on UpdateOne operations")
 	}
-	if m.name != nil {
-		fields = append(fields, env.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPanelID requires an ID field in the mutation")
 	}
-	if m.remarks != nil {
-		fields = append(fields, env.FieldRemarks)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPanelID: %w", err)
 	}
-	if m.quantity != nil {
-		fields = append(fields, env.FieldQuantity)
+	return oldValue.PanelID, nil
+}
+
+// AddPanelID adds i to the "panel_id" field.
+func (m *CronTriggerLogMutation) AddPanelID(i int64) {
+	if m.addpanel_id != nil {
+		*m.addpanel_id += i
+	} else {
+		m.addpanel_id = &i
 	}
-	if m.regex != nil {
-		fields = append(fields, env.FieldRegex)
+}
+
+// AddedPanelID returns the value that was added to the "panel_id" field in this mutation.
+func (m *CronTriggerLogMutation) AddedPanelID() (r int64, exists bool) {
+	v := m.addpanel_id
+	if v == nil {
+		return
 	}
-	if m.mode != nil {
-		fields = append(fields, env.FieldMode)
+	return *v, true
+}
+
+// ResetPanelID resets all changes to the "panel_id" field.
+func (m *CronTriggerLogMutation) ResetPanelID() {
+	m.panel_id = nil
+	m.addpanel_id = nil
+}
+
+// SetTriggerID sets the "trigger_id" field.
+func (m *CronTriggerLogMutation) SetTriggerID(i int64) {
+	m.trigger_id = &i
+	m.addtrigger_id = nil
+}
+
+// TriggerID returns the value of the "trigger_id" field in the mutation.
+func (m *CronTriggerLogMutation) TriggerID() (r int64, exists bool) {
+	v := m.trigger_id
+	if v == nil {
+		return
 	}
-	if m.regex_update != nil {
-		fields = append(fields, env.FieldRegexUpdate)
+	return *v, true
+}
+
+// OldTriggerID returns the old "trigger_id" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldTriggerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerID is only allowed on UpdateOne operations")
 	}
-	if m.is_auto_env_enable != nil {
-		fields = append(fields, env.FieldIsAutoEnvEnable)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerID requires an ID field in the mutation")
 	}
-	if m.enable_key != nil {
-		fields = append(fields, env.FieldEnableKey)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerID: %w", err)
 	}
-	if m.cdk_limit != nil {
-		fields = append(fields, env.FieldCdkLimit)
+	return oldValue.TriggerID, nil
+}
+
+// AddTriggerID adds i to the "trigger_id" field.
+func (m *CronTriggerLogMutation) AddTriggerID(i int64) {
+	if m.addtrigger_id != nil {
+		*m.addtrigger_id += i
+	} else {
+		m.addtrigger_id = &i
 	}
-	if m.is_prompt != nil {
-		fields = append(fields, env.FieldIsPrompt)
+}
+
+// AddedTriggerID returns the value that was added to the "trigger_id" field in this mutation.
+func (m *CronTriggerLogMutation) AddedTriggerID() (r int64, exists bool) {
+	v := m.addtrigger_id
+	if v == nil {
+		return
 	}
-	if m.prompt_level != nil {
-		fields = append(fields, env.FieldPromptLevel)
+	return *v, true
+}
+
+// ResetTriggerID resets all changes to the "trigger_id" field.
+func (m *CronTriggerLogMutation) ResetTriggerID() {
+	m.trigger_id = nil
+	m.addtrigger_id = nil
+}
+
+// SetCronIds sets the "cron_ids" field.
+func (m *CronTriggerLogMutation) SetCronIds(s string) {
+	m.cron_ids = &s
+}
+
+// CronIds returns the value of the "cron_ids" field in the mutation.
+func (m *CronTriggerLogMutation) CronIds() (r string, exists bool) {
+	v := m.cron_ids
+	if v == nil {
+		return
 	}
-	if m.prompt_content != nil {
-		fields = append(fields, env.FieldPromptContent)
+	return *v, true
+}
+
+// OldCronIds returns the old "cron_ids" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldCronIds(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCronIds is only allowed on UpdateOne operations")
 	}
-	if m.is_enable != nil {
-		fields = append(fields, env.FieldIsEnable)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCronIds requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCronIds: %w", err)
+	}
+	return oldValue.CronIds, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EnvMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case env.FieldCreatedAt:
-		return m.CreatedAt()
-	case env.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case env.FieldName:
-		return m.Name()
-	case env.FieldRemarks:
-		return m.Remarks()
-	case env.FieldQuantity:
-		return m.Quantity()
-	case env.FieldRegex:
-		return m.Regex()
-	case env.FieldMode:
-		return m.Mode()
-	case env.FieldRegexUpdate:
-		return m.RegexUpdate()
-	case env.FieldIsAutoEnvEnable:
-		return m.IsAutoEnvEnable()
-	case env.FieldEnableKey:
-		return m.EnableKey()
-	case env.FieldCdkLimit:
-		return m.CdkLimit()
-	case env.FieldIsPrompt:
-		return m.IsPrompt()
-	case env.FieldPromptLevel:
-		return m.PromptLevel()
-	case env.FieldPromptContent:
-		return m.PromptContent()
-	case env.FieldIsEnable:
-		return m.IsEnable()
+// ClearCronIds clears the value of the "cron_ids" field.
+func (m *CronTriggerLogMutation) ClearCronIds() {
+	m.cron_ids = nil
+	m.clearedFields[crontriggerlog.FieldCronIds] = struct{}{}
+}
+
+// CronIdsCleared returns if the "cron_ids" field was cleared in this mutation.
+func (m *CronTriggerLogMutation) CronIdsCleared() bool {
+	_, ok := m.clearedFields[crontriggerlog.FieldCronIds]
+	return ok
+}
+
+// ResetCronIds resets all changes to the "cron_ids" field.
+func (m *CronTriggerLogMutation) ResetCronIds() {
+	m.cron_ids = nil
+	delete(m.clearedFields, crontriggerlog.FieldCronIds)
+}
+
+// SetStatus sets the "status" field.
+func (m *CronTriggerLogMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CronTriggerLogMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
+// OldStatus returns the old "status" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CronTriggerLogMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *CronTriggerLogMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *CronTriggerLogMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the CronTriggerLog entity.
+// If the CronTriggerLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronTriggerLogMutation) OldMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *CronTriggerLogMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[crontriggerlog.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *CronTriggerLogMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[crontriggerlog.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *CronTriggerLogMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, crontriggerlog.FieldMessage)
+}
+
+// Where appends a list predicates to the CronTriggerLogMutation builder.
+func (m *CronTriggerLogMutation) Where(ps ...predicate.CronTriggerLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CronTriggerLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CronTriggerLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CronTriggerLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CronTriggerLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CronTriggerLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CronTriggerLog).
+func (m *CronTriggerLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CronTriggerLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, crontriggerlog.FieldCreatedAt)
+	}
+	if m.env_id != nil {
+		fields = append(fields, crontriggerlog.FieldEnvID)
+	}
+	if m.panel_id != nil {
+		fields = append(fields, crontriggerlog.FieldPanelID)
+	}
+	if m.trigger_id != nil {
+		fields = append(fields, crontriggerlog.FieldTriggerID)
+	}
+	if m.cron_ids != nil {
+		fields = append(fields, crontriggerlog.FieldCronIds)
+	}
+	if m.status != nil {
+		fields = append(fields, crontriggerlog.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, crontriggerlog.FieldMessage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CronTriggerLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case crontriggerlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case crontriggerlog.FieldEnvID:
+		return m.EnvID()
+	case crontriggerlog.FieldPanelID:
+		return m.PanelID()
+	case crontriggerlog.FieldTriggerID:
+		return m.TriggerID()
+	case crontriggerlog.FieldCronIds:
+		return m.CronIds()
+	case crontriggerlog.FieldStatus:
+		return m.Status()
+	case crontriggerlog.FieldMessage:
+		return m.Message()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CronTriggerLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case env.FieldCreatedAt:
+	case crontriggerlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case env.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case env.FieldName:
-		return m.OldName(ctx)
-	case env.FieldRemarks:
-		return m.OldRemarks(ctx)
-	case env.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case env.FieldRegex:
-		return m.OldRegex(ctx)
-	case env.FieldMode:
-		return m.OldMode(ctx)
-	case env.FieldRegexUpdate:
-		return m.OldRegexUpdate(ctx)
-	case env.FieldIsAutoEnvEnable:
-		return m.OldIsAutoEnvEnable(ctx)
-	case env.FieldEnableKey:
-		return m.OldEnableKey(ctx)
-	case env.FieldCdkLimit:
-		return m.OldCdkLimit(ctx)
-	case env.FieldIsPrompt:
-		return m.OldIsPrompt(ctx)
-	case env.FieldPromptLevel:
-		return m.OldPromptLevel(ctx)
-	case env.FieldPromptContent:
-		return m.OldPromptContent(ctx)
-	case env.FieldIsEnable:
-		return m.OldIsEnable(ctx)
+	case crontriggerlog.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case crontriggerlog.FieldPanelID:
+		return m.OldPanelID(ctx)
+	case crontriggerlog.FieldTriggerID:
+		return m.OldTriggerID(ctx)
+	case crontriggerlog.FieldCronIds:
+		return m.OldCronIds(ctx)
+	case crontriggerlog.FieldStatus:
+		return m.OldStatus(ctx)
+	case crontriggerlog.FieldMessage:
+		return m.OldMessage(ctx)
 	}
-	return nil, fmt.Errorf("unknown Env field %s", name)
+	return nil, fmt.Errorf("unknown CronTriggerLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvMutation) SetField(name string, value ent.Value) error {
+func (m *CronTriggerLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case env.FieldCreatedAt:
+	case crontriggerlog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case env.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case env.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case env.FieldRemarks:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRemarks(v)
-		return nil
-	case env.FieldQuantity:
-		v, ok := value.(int32)
+	case crontriggerlog.FieldEnvID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantity(v)
+		m.SetEnvID(v)
 		return nil
-	case env.FieldRegex:
-		v, ok := value.(string)
+	case crontriggerlog.FieldPanelID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegex(v)
+		m.SetPanelID(v)
 		return nil
-	case env.FieldMode:
-		v, ok := value.(int32)
+	case crontriggerlog.FieldTriggerID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMode(v)
+		m.SetTriggerID(v)
 		return nil
-	case env.FieldRegexUpdate:
+	case crontriggerlog.FieldCronIds:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegexUpdate(v)
-		return nil
-	case env.FieldIsAutoEnvEnable:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsAutoEnvEnable(v)
-		return nil
-	case env.FieldEnableKey:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnableKey(v)
-		return nil
-	case env.FieldCdkLimit:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCdkLimit(v)
-		return nil
-	case env.FieldIsPrompt:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPrompt(v)
+		m.SetCronIds(v)
 		return nil
-	case env.FieldPromptLevel:
+	case crontriggerlog.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromptLevel(v)
+		m.SetStatus(v)
 		return nil
-	case env.FieldPromptContent:
+	case crontriggerlog.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromptContent(v)
-		return nil
-	case env.FieldIsEnable:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsEnable(v)
+		m.SetMessage(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return fmt.Errorf("unknown CronTriggerLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvMutation) AddedFields() []string {
+func (m *CronTriggerLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, env.FieldQuantity)
+	if m.addenv_id != nil {
+		fields = append(fields, crontriggerlog.FieldEnvID)
 	}
-	if m.addmode != nil {
-		fields = append(fields, env.FieldMode)
+	if m.addpanel_id != nil {
+		fields = append(fields, crontriggerlog.FieldPanelID)
 	}
-	if m.addcdk_limit != nil {
-		fields = append(fields, env.FieldCdkLimit)
+	if m.addtrigger_id != nil {
+		fields = append(fields, crontriggerlog.FieldTriggerID)
 	}
 	return fields
 }
@@ -1832,14 +2263,14 @@ func (m *EnvMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CronTriggerLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case env.FieldQuantity:
-		return m.AddedQuantity()
-	case env.FieldMode:
-		return m.AddedMode()
-	case env.FieldCdkLimit:
-		return m.AddedCdkLimit()
+	case crontriggerlog.FieldEnvID:
+		return m.AddedEnvID()
+	case crontriggerlog.FieldPanelID:
+		return m.AddedPanelID()
+	case crontriggerlog.FieldTriggerID:
+		return m.AddedTriggerID()
 	}
 	return nil, false
 }
@@ -1847,281 +2278,203 @@ func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvMutation) AddField(name string, value ent.Value) error {
+func (m *CronTriggerLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case env.FieldQuantity:
-		v, ok := value.(int32)
+	case crontriggerlog.FieldEnvID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuantity(v)
+		m.AddEnvID(v)
 		return nil
-	case env.FieldMode:
-		v, ok := value.(int32)
+	case crontriggerlog.FieldPanelID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMode(v)
+		m.AddPanelID(v)
 		return nil
-	case env.FieldCdkLimit:
-		v, ok := value.(int32)
+	case crontriggerlog.FieldTriggerID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCdkLimit(v)
+		m.AddTriggerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Env numeric field %s", name)
+	return fmt.Errorf("unknown CronTriggerLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvMutation) ClearedFields() []string {
+func (m *CronTriggerLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(env.FieldRemarks) {
-		fields = append(fields, env.FieldRemarks)
-	}
-	if m.FieldCleared(env.FieldRegex) {
-		fields = append(fields, env.FieldRegex)
-	}
-	if m.FieldCleared(env.FieldRegexUpdate) {
-		fields = append(fields, env.FieldRegexUpdate)
+	if m.FieldCleared(crontriggerlog.FieldCronIds) {
+		fields = append(fields, crontriggerlog.FieldCronIds)
 	}
-	if m.FieldCleared(env.FieldPromptLevel) {
-		fields = append(fields, env.FieldPromptLevel)
-	}
-	if m.FieldCleared(env.FieldPromptContent) {
-		fields = append(fields, env.FieldPromptContent)
+	if m.FieldCleared(crontriggerlog.FieldMessage) {
+		fields = append(fields, crontriggerlog.FieldMessage)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvMutation) FieldCleared(name string) bool {
+func (m *CronTriggerLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvMutation) ClearField(name string) error {
+func (m *CronTriggerLogMutation) ClearField(name string) error {
 	switch name {
-	case env.FieldRemarks:
-		m.ClearRemarks()
-		return nil
-	case env.FieldRegex:
-		m.ClearRegex()
+	case crontriggerlog.FieldCronIds:
+		m.ClearCronIds()
 		return nil
-	case env.FieldRegexUpdate:
-		m.ClearRegexUpdate()
-		return nil
-	case env.FieldPromptLevel:
-		m.ClearPromptLevel()
-		return nil
-	case env.FieldPromptContent:
-		m.ClearPromptContent()
+	case crontriggerlog.FieldMessage:
+		m.ClearMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Env nullable field %s", name)
+	return fmt.Errorf("unknown CronTriggerLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvMutation) ResetField(name string) error {
+func (m *CronTriggerLogMutation) ResetField(name string) error {
 	switch name {
-	case env.FieldCreatedAt:
+	case crontriggerlog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case env.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case env.FieldName:
-		m.ResetName()
-		return nil
-	case env.FieldRemarks:
-		m.ResetRemarks()
-		return nil
-	case env.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case env.FieldRegex:
-		m.ResetRegex()
-		return nil
-	case env.FieldMode:
-		m.ResetMode()
-		return nil
-	case env.FieldRegexUpdate:
-		m.ResetRegexUpdate()
-		return nil
-	case env.FieldIsAutoEnvEnable:
-		m.ResetIsAutoEnvEnable()
-		return nil
-	case env.FieldEnableKey:
-		m.ResetEnableKey()
+	case crontriggerlog.FieldEnvID:
+		m.ResetEnvID()
 		return nil
-	case env.FieldCdkLimit:
-		m.ResetCdkLimit()
+	case crontriggerlog.FieldPanelID:
+		m.ResetPanelID()
 		return nil
-	case env.FieldIsPrompt:
-		m.ResetIsPrompt()
+	case crontriggerlog.FieldTriggerID:
+		m.ResetTriggerID()
 		return nil
-	case env.FieldPromptLevel:
-		m.ResetPromptLevel()
+	case crontriggerlog.FieldCronIds:
+		m.ResetCronIds()
 		return nil
-	case env.FieldPromptContent:
-		m.ResetPromptContent()
+	case crontriggerlog.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case env.FieldIsEnable:
-		m.ResetIsEnable()
+	case crontriggerlog.FieldMessage:
+		m.ResetMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return fmt.Errorf("unknown CronTriggerLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.panels != nil {
-		edges = append(edges, env.EdgePanels)
-	}
-	if m.env_plugins != nil {
-		edges = append(edges, env.EdgeEnvPlugins)
-	}
+func (m *CronTriggerLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case env.EdgePanels:
-		ids := make([]ent.Value, 0, len(m.panels))
-		for id := range m.panels {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeEnvPlugins:
-		ids := make([]ent.Value, 0, len(m.env_plugins))
-		for id := range m.env_plugins {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CronTriggerLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedpanels != nil {
-		edges = append(edges, env.EdgePanels)
-	}
-	if m.removedenv_plugins != nil {
-		edges = append(edges, env.EdgeEnvPlugins)
-	}
+func (m *CronTriggerLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case env.EdgePanels:
-		ids := make([]ent.Value, 0, len(m.removedpanels))
-		for id := range m.removedpanels {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeEnvPlugins:
-		ids := make([]ent.Value, 0, len(m.removedenv_plugins))
-		for id := range m.removedenv_plugins {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CronTriggerLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpanels {
-		edges = append(edges, env.EdgePanels)
-	}
-	if m.clearedenv_plugins {
-		edges = append(edges, env.EdgeEnvPlugins)
-	}
+func (m *CronTriggerLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvMutation) EdgeCleared(name string) bool {
-	switch name {
-	case env.EdgePanels:
-		return m.clearedpanels
-	case env.EdgeEnvPlugins:
-		return m.clearedenv_plugins
-	}
+func (m *CronTriggerLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Env unique edge %s", name)
+func (m *CronTriggerLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CronTriggerLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvMutation) ResetEdge(name string) error {
-	switch name {
-	case env.EdgePanels:
-		m.ResetPanels()
-		return nil
-	case env.EdgeEnvPlugins:
-		m.ResetEnvPlugins()
-		return nil
-	}
-	return fmt.Errorf("unknown Env edge %s", name)
+func (m *CronTriggerLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CronTriggerLog edge %s", name)
 }
 
-// EnvPluginMutation represents an operation that mutates the EnvPlugin nodes in the graph.
-type EnvPluginMutation struct {
+// EnvMutation represents an operation that mutates the Env nodes in the graph.
+type EnvMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int64
-	created_at         *time.Time
-	updated_at         *time.Time
-	is_enable          *bool
-	execution_order    *int32
-	addexecution_order *int32
-	_config            *string
-	clearedFields      map[string]struct{}
-	env                *int64
-	clearedenv         bool
-	plugin             *int64
-	clearedplugin      bool
-	done               bool
-	oldValue           func(context.Context) (*EnvPlugin, error)
-	predicates         []predicate.EnvPlugin
+	op                   Op
+	typ                  string
+	id                   *int64
+	created_at           *time.Time
+	updated_at           *time.Time
+	name                 *string
+	remarks              *string
+	quantity             *int32
+	addquantity          *int32
+	regex                *string
+	mode                 *int32
+	addmode              *int32
+	regex_update         *string
+	is_auto_env_enable   *bool
+	enable_key           *bool
+	cdk_limit            *int32
+	addcdk_limit         *int32
+	is_prompt            *bool
+	prompt_level         *string
+	prompt_content       *string
+	is_enable            *bool
+	select_strategy      *string
+	replicas             *int32
+	addreplicas          *int32
+	update_scope         *string
+	value_template       *string
+	match_key_template   *string
+	split_by             *string
+	dedup_by             *string
+	dedup_action         *string
+	clearedFields        map[string]struct{}
+	panels               map[int64]struct{}
+	removedpanels        map[int64]struct{}
+	clearedpanels        bool
+	env_plugins          map[int64]struct{}
+	removedenv_plugins   map[int64]struct{}
+	clearedenv_plugins   bool
+	cron_triggers        map[int64]struct{}
+	removedcron_triggers map[int64]struct{}
+	clearedcron_triggers bool
+	done                 bool
+	oldValue             func(context.Context) (*Env, error)
+	predicates           []predicate.Env
 }
 
-var _ ent.Mutation = (*EnvPluginMutation)(nil)
+var _ ent.Mutation = (*EnvMutation)(nil)
 
-// envpluginOption allows management of the mutation configuration using functional options.
-type envpluginOption func(*EnvPluginMutation)
+// envOption allows management of the mutation configuration using functional options.
+type envOption func(*EnvMutation)
 
-// newEnvPluginMutation creates new mutation for the EnvPlugin entity.
-func newEnvPluginMutation(c config, op Op, opts ...envpluginOption) *EnvPluginMutation {
-	m := &EnvPluginMutation{
+// newEnvMutation creates new mutation for the Env entity.
+func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
+	m := &EnvMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvPlugin,
+		typ:           TypeEnv,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2130,20 +2483,20 @@ func newEnvPluginMutation(c config, op Op, opts ...envpluginOption) *EnvPluginMu
 	return m
 }
 
-// withEnvPluginID sets the ID field of the mutation.
-func withEnvPluginID(id int64) envpluginOption {
-	return func(m *EnvPluginMutation) {
+// withEnvID sets the ID field of the mutation.
+func withEnvID(id int64) envOption {
+	return func(m *EnvMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnvPlugin
+			value *Env
 		)
-		m.oldValue = func(ctx context.Context) (*EnvPlugin, error) {
+		m.oldValue = func(ctx context.Context) (*Env, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnvPlugin.Get(ctx, id)
+					value, err = m.Client().Env.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2152,10 +2505,10 @@ func withEnvPluginID(id int64) envpluginOption {
 	}
 }
 
-// withEnvPlugin sets the old EnvPlugin of the mutation.
-func withEnvPlugin(node *EnvPlugin) envpluginOption {
-	return func(m *EnvPluginMutation) {
-		m.oldValue = func(context.Context) (*EnvPlugin, error) {
+// withEnv sets the old Env of the mutation.
+func withEnv(node *Env) envOption {
+	return func(m *EnvMutation) {
+		m.oldValue = func(context.Context) (*Env, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2164,7 +2517,7 @@ func withEnvPlugin(node *EnvPlugin) envpluginOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvPluginMutation) Client() *Client {
+func (m EnvMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2172,7 +2525,7 @@ func (m EnvPluginMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvPluginMutation) Tx() (*Tx, error) {
+func (m EnvMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2182,14 +2535,14 @@ func (m EnvPluginMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvPlugin entities.
-func (m *EnvPluginMutation) SetID(id int64) {
+// operation is only accepted on creation of Env entities.
+func (m *EnvMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvPluginMutation) ID() (id int64, exists bool) {
+func (m *EnvMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2200,7 +2553,7 @@ func (m *EnvPluginMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvPluginMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *EnvMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2209,19 +2562,19 @@ func (m *EnvPluginMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvPlugin.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EnvPluginMutation) SetCreatedAt(t time.Time) {
+func (m *EnvMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvPluginMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2229,10 +2582,10 @@ func (m *EnvPluginMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2247,17 +2600,17 @@ func (m *EnvPluginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvPluginMutation) ResetCreatedAt() {
+func (m *EnvMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EnvPluginMutation) SetUpdatedAt(t time.Time) {
+func (m *EnvMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvPluginMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2265,10 +2618,10 @@ func (m *EnvPluginMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2283,1732 +2636,9558 @@ func (m *EnvPluginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvPluginMutation) ResetUpdatedAt() {
+func (m *EnvMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *EnvPluginMutation) SetEnvID(i int64) {
-	m.env = &i
+// SetName sets the "name" field.
+func (m *EnvMutation) SetName(s string) {
+	m.name = &s
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvPluginMutation) EnvID() (r int64, exists bool) {
-	v := m.env
+// Name returns the value of the "name" field in the mutation.
+func (m *EnvMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldEnvID(ctx context.Context) (v int64, err error) {
+func (m *EnvMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.EnvID, nil
+	return oldValue.Name, nil
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvPluginMutation) ResetEnvID() {
-	m.env = nil
+// ResetName resets all changes to the "name" field.
+func (m *EnvMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPluginID sets the "plugin_id" field.
-func (m *EnvPluginMutation) SetPluginID(i int64) {
-	m.plugin = &i
+// SetRemarks sets the "remarks" field.
+func (m *EnvMutation) SetRemarks(s string) {
+	m.remarks = &s
 }
 
-// PluginID returns the value of the "plugin_id" field in the mutation.
-func (m *EnvPluginMutation) PluginID() (r int64, exists bool) {
-	v := m.plugin
+// Remarks returns the value of the "remarks" field in the mutation.
+func (m *EnvMutation) Remarks() (r string, exists bool) {
+	v := m.remarks
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPluginID returns the old "plugin_id" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldRemarks returns the old "remarks" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldPluginID(ctx context.Context) (v int64, err error) {
+func (m *EnvMutation) OldRemarks(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPluginID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemarks is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPluginID requires an ID field in the mutation")
+		return v, errors.New("OldRemarks requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPluginID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemarks: %w", err)
 	}
-	return oldValue.PluginID, nil
+	return oldValue.Remarks, nil
 }
 
-// ResetPluginID resets all changes to the "plugin_id" field.
-func (m *EnvPluginMutation) ResetPluginID() {
-	m.plugin = nil
+// ClearRemarks clears the value of the "remarks" field.
+func (m *EnvMutation) ClearRemarks() {
+	m.remarks = nil
+	m.clearedFields[env.FieldRemarks] = struct{}{}
 }
 
-// SetIsEnable sets the "is_enable" field.
-func (m *EnvPluginMutation) SetIsEnable(b bool) {
-	m.is_enable = &b
+// RemarksCleared returns if the "remarks" field was cleared in this mutation.
+func (m *EnvMutation) RemarksCleared() bool {
+	_, ok := m.clearedFields[env.FieldRemarks]
+	return ok
 }
 
-// IsEnable returns the value of the "is_enable" field in the mutation.
-func (m *EnvPluginMutation) IsEnable() (r bool, exists bool) {
-	v := m.is_enable
+// ResetRemarks resets all changes to the "remarks" field.
+func (m *EnvMutation) ResetRemarks() {
+	m.remarks = nil
+	delete(m.clearedFields, env.FieldRemarks)
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *EnvMutation) SetQuantity(i int32) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *EnvMutation) Quantity() (r int32, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsEnable returns the old "is_enable" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+func (m *EnvMutation) OldQuantity(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnable requires an ID field in the mutation")
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
-	return oldValue.IsEnable, nil
+	return oldValue.Quantity, nil
 }
 
-// ResetIsEnable resets all changes to the "is_enable" field.
-func (m *EnvPluginMutation) ResetIsEnable() {
-	m.is_enable = nil
+// AddQuantity adds i to the "quantity" field.
+func (m *EnvMutation) AddQuantity(i int32) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
 }
 
-// SetExecutionOrder sets the "execution_order" field.
-func (m *EnvPluginMutation) SetExecutionOrder(i int32) {
-	m.execution_order = &i
-	m.addexecution_order = nil
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *EnvMutation) AddedQuantity() (r int32, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ExecutionOrder returns the value of the "execution_order" field in the mutation.
-func (m *EnvPluginMutation) ExecutionOrder() (r int32, exists bool) {
-	v := m.execution_order
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *EnvMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetRegex sets the "regex" field.
+func (m *EnvMutation) SetRegex(s string) {
+	m.regex = &s
+}
+
+// Regex returns the value of the "regex" field in the mutation.
+func (m *EnvMutation) Regex() (r string, exists bool) {
+	v := m.regex
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExecutionOrder returns the old "execution_order" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldRegex returns the old "regex" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldExecutionOrder(ctx context.Context) (v int32, err error) {
+func (m *EnvMutation) OldRegex(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldRegex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionOrder requires an ID field in the mutation")
+		return v, errors.New("OldRegex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegex: %w", err)
 	}
-	return oldValue.ExecutionOrder, nil
+	return oldValue.Regex, nil
 }
 
-// AddExecutionOrder adds i to the "execution_order" field.
-func (m *EnvPluginMutation) AddExecutionOrder(i int32) {
-	if m.addexecution_order != nil {
-		*m.addexecution_order += i
-	} else {
-		m.addexecution_order = &i
-	}
+// ClearRegex clears the value of the "regex" field.
+func (m *EnvMutation) ClearRegex() {
+	m.regex = nil
+	m.clearedFields[env.FieldRegex] = struct{}{}
 }
 
-// AddedExecutionOrder returns the value that was added to the "execution_order" field in this mutation.
-func (m *EnvPluginMutation) AddedExecutionOrder() (r int32, exists bool) {
-	v := m.addexecution_order
-	if v == nil {
-		return
-	}
-	return *v, true
+// RegexCleared returns if the "regex" field was cleared in this mutation.
+func (m *EnvMutation) RegexCleared() bool {
+	_, ok := m.clearedFields[env.FieldRegex]
+	return ok
 }
 
-// ResetExecutionOrder resets all changes to the "execution_order" field.
-func (m *EnvPluginMutation) ResetExecutionOrder() {
-	m.execution_order = nil
-	m.addexecution_order = nil
+// ResetRegex resets all changes to the "regex" field.
+func (m *EnvMutation) ResetRegex() {
+	m.regex = nil
+	delete(m.clearedFields, env.FieldRegex)
 }
 
-// SetConfig sets the "config" field.
-func (m *EnvPluginMutation) SetConfig(s string) {
-	m._config = &s
+// SetMode sets the "mode" field.
+func (m *EnvMutation) SetMode(i int32) {
+	m.mode = &i
+	m.addmode = nil
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *EnvPluginMutation) Config() (r string, exists bool) {
-	v := m._config
+// Mode returns the value of the "mode" field in the mutation.
+func (m *EnvMutation) Mode() (r int32, exists bool) {
+	v := m.mode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the EnvPlugin entity.
-// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// OldMode returns the old "mode" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvPluginMutation) OldConfig(ctx context.Context) (v *string, err error) {
+func (m *EnvMutation) OldMode(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldMode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
 	}
-	return oldValue.Config, nil
+	return oldValue.Mode, nil
 }
 
-// ClearConfig clears the value of the "config" field.
-func (m *EnvPluginMutation) ClearConfig() {
-	m._config = nil
-	m.clearedFields[envplugin.FieldConfig] = struct{}{}
+// AddMode adds i to the "mode" field.
+func (m *EnvMutation) AddMode(i int32) {
+	if m.addmode != nil {
+		*m.addmode += i
+	} else {
+		m.addmode = &i
+	}
 }
 
-// ConfigCleared returns if the "config" field was cleared in this mutation.
-func (m *EnvPluginMutation) ConfigCleared() bool {
-	_, ok := m.clearedFields[envplugin.FieldConfig]
-	return ok
+// AddedMode returns the value that was added to the "mode" field in this mutation.
+func (m *EnvMutation) AddedMode() (r int32, exists bool) {
+	v := m.addmode
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *EnvPluginMutation) ResetConfig() {
-	m._config = nil
-	delete(m.clearedFields, envplugin.FieldConfig)
+// ResetMode resets all changes to the "mode" field.
+func (m *EnvMutation) ResetMode() {
+	m.mode = nil
+	m.addmode = nil
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvPluginMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envplugin.FieldEnvID] = struct{}{}
+// SetRegexUpdate sets the "regex_update" field.
+func (m *EnvMutation) SetRegexUpdate(s string) {
+	m.regex_update = &s
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvPluginMutation) EnvCleared() bool {
-	return m.clearedenv
+// RegexUpdate returns the value of the "regex_update" field in the mutation.
+func (m *EnvMutation) RegexUpdate() (r string, exists bool) {
+	v := m.regex_update
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvPluginMutation) EnvIDs() (ids []int64) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// OldRegexUpdate returns the old "regex_update" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldRegexUpdate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegexUpdate is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegexUpdate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegexUpdate: %w", err)
+	}
+	return oldValue.RegexUpdate, nil
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvPluginMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// ClearRegexUpdate clears the value of the "regex_update" field.
+func (m *EnvMutation) ClearRegexUpdate() {
+	m.regex_update = nil
+	m.clearedFields[env.FieldRegexUpdate] = struct{}{}
 }
 
-// ClearPlugin clears the "plugin" edge to the Plugin entity.
-func (m *EnvPluginMutation) ClearPlugin() {
-	m.clearedplugin = true
-	m.clearedFields[envplugin.FieldPluginID] = struct{}{}
+// RegexUpdateCleared returns if the "regex_update" field was cleared in this mutation.
+func (m *EnvMutation) RegexUpdateCleared() bool {
+	_, ok := m.clearedFields[env.FieldRegexUpdate]
+	return ok
 }
 
-// PluginCleared reports if the "plugin" edge to the Plugin entity was cleared.
-func (m *EnvPluginMutation) PluginCleared() bool {
-	return m.clearedplugin
+// ResetRegexUpdate resets all changes to the "regex_update" field.
+func (m *EnvMutation) ResetRegexUpdate() {
+	m.regex_update = nil
+	delete(m.clearedFields, env.FieldRegexUpdate)
 }
 
-// PluginIDs returns the "plugin" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PluginID instead. It exists only for internal usage by the builders.
-func (m *EnvPluginMutation) PluginIDs() (ids []int64) {
-	if id := m.plugin; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetIsAutoEnvEnable sets the "is_auto_env_enable" field.
+func (m *EnvMutation) SetIsAutoEnvEnable(b bool) {
+	m.is_auto_env_enable = &b
 }
 
-// ResetPlugin resets all changes to the "plugin" edge.
-func (m *EnvPluginMutation) ResetPlugin() {
-	m.plugin = nil
-	m.clearedplugin = false
-}
-
-// Where appends a list predicates to the EnvPluginMutation builder.
-func (m *EnvPluginMutation) Where(ps ...predicate.EnvPlugin) {
-	m.predicates = append(m.predicates, ps...)
+// IsAutoEnvEnable returns the value of the "is_auto_env_enable" field in the mutation.
+func (m *EnvMutation) IsAutoEnvEnable() (r bool, exists bool) {
+	v := m.is_auto_env_enable
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the EnvPluginMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvPluginMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvPlugin, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldIsAutoEnvEnable returns the old "is_auto_env_enable" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldIsAutoEnvEnable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAutoEnvEnable is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAutoEnvEnable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAutoEnvEnable: %w", err)
+	}
+	return oldValue.IsAutoEnvEnable, nil
 }
 
-// Op returns the operation name.
-func (m *EnvPluginMutation) Op() Op {
-	return m.op
+// ResetIsAutoEnvEnable resets all changes to the "is_auto_env_enable" field.
+func (m *EnvMutation) ResetIsAutoEnvEnable() {
+	m.is_auto_env_enable = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EnvPluginMutation) SetOp(op Op) {
-	m.op = op
+// SetEnableKey sets the "enable_key" field.
+func (m *EnvMutation) SetEnableKey(b bool) {
+	m.enable_key = &b
 }
 
-// Type returns the node type of this mutation (EnvPlugin).
-func (m *EnvPluginMutation) Type() string {
-	return m.typ
+// EnableKey returns the value of the "enable_key" field in the mutation.
+func (m *EnvMutation) EnableKey() (r bool, exists bool) {
+	v := m.enable_key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EnvPluginMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, envplugin.FieldCreatedAt)
+// OldEnableKey returns the old "enable_key" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldEnableKey(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnableKey is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, envplugin.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnableKey requires an ID field in the mutation")
 	}
-	if m.env != nil {
-		fields = append(fields, envplugin.FieldEnvID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnableKey: %w", err)
 	}
-	if m.plugin != nil {
-		fields = append(fields, envplugin.FieldPluginID)
+	return oldValue.EnableKey, nil
+}
+
+// ResetEnableKey resets all changes to the "enable_key" field.
+func (m *EnvMutation) ResetEnableKey() {
+	m.enable_key = nil
+}
+
+// SetCdkLimit sets the "cdk_limit" field.
+func (m *EnvMutation) SetCdkLimit(i int32) {
+	m.cdk_limit = &i
+	m.addcdk_limit = nil
+}
+
+// CdkLimit returns the value of the "cdk_limit" field in the mutation.
+func (m *EnvMutation) CdkLimit() (r int32, exists bool) {
+	v := m.cdk_limit
+	if v == nil {
+		return
 	}
-	if m.is_enable != nil {
-		fields = append(fields, envplugin.FieldIsEnable)
+	return *v, true
+}
+
+// OldCdkLimit returns the old "cdk_limit" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldCdkLimit(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCdkLimit is only allowed on UpdateOne operations")
 	}
-	if m.execution_order != nil {
-		fields = append(fields, envplugin.FieldExecutionOrder)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCdkLimit requires an ID field in the mutation")
 	}
-	if m._config != nil {
-		fields = append(fields, envplugin.FieldConfig)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCdkLimit: %w", err)
 	}
-	return fields
+	return oldValue.CdkLimit, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EnvPluginMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case envplugin.FieldCreatedAt:
-		return m.CreatedAt()
-	case envplugin.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case envplugin.FieldEnvID:
-		return m.EnvID()
-	case envplugin.FieldPluginID:
-		return m.PluginID()
-	case envplugin.FieldIsEnable:
-		return m.IsEnable()
-	case envplugin.FieldExecutionOrder:
-		return m.ExecutionOrder()
-	case envplugin.FieldConfig:
-		return m.Config()
+// AddCdkLimit adds i to the "cdk_limit" field.
+func (m *EnvMutation) AddCdkLimit(i int32) {
+	if m.addcdk_limit != nil {
+		*m.addcdk_limit += i
+	} else {
+		m.addcdk_limit = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EnvPluginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case envplugin.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case envplugin.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case envplugin.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case envplugin.FieldPluginID:
-		return m.OldPluginID(ctx)
-	case envplugin.FieldIsEnable:
-		return m.OldIsEnable(ctx)
-	case envplugin.FieldExecutionOrder:
-		return m.OldExecutionOrder(ctx)
-	case envplugin.FieldConfig:
-		return m.OldConfig(ctx)
+// AddedCdkLimit returns the value that was added to the "cdk_limit" field in this mutation.
+func (m *EnvMutation) AddedCdkLimit() (r int32, exists bool) {
+	v := m.addcdk_limit
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown EnvPlugin field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnvPluginMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case envplugin.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case envplugin.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case envplugin.FieldEnvID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvID(v)
-		return nil
-	case envplugin.FieldPluginID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPluginID(v)
-		return nil
-	case envplugin.FieldIsEnable:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsEnable(v)
-		return nil
-	case envplugin.FieldExecutionOrder:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionOrder(v)
-		return nil
-	case envplugin.FieldConfig:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConfig(v)
-		return nil
-	}
-	return fmt.Errorf("unknown EnvPlugin field %s", name)
+// ResetCdkLimit resets all changes to the "cdk_limit" field.
+func (m *EnvMutation) ResetCdkLimit() {
+	m.cdk_limit = nil
+	m.addcdk_limit = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EnvPluginMutation) AddedFields() []string {
-	var fields []string
-	if m.addexecution_order != nil {
-		fields = append(fields, envplugin.FieldExecutionOrder)
+// SetIsPrompt sets the "is_prompt" field.
+func (m *EnvMutation) SetIsPrompt(b bool) {
+	m.is_prompt = &b
+}
+
+// IsPrompt returns the value of the "is_prompt" field in the mutation.
+func (m *EnvMutation) IsPrompt() (r bool, exists bool) {
+	v := m.is_prompt
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EnvPluginMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case envplugin.FieldExecutionOrder:
-		return m.AddedExecutionOrder()
+// OldIsPrompt returns the old "is_prompt" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldIsPrompt(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPrompt is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPrompt: %w", err)
+	}
+	return oldValue.IsPrompt, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EnvPluginMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case envplugin.FieldExecutionOrder:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExecutionOrder(v)
-		return nil
+// ResetIsPrompt resets all changes to the "is_prompt" field.
+func (m *EnvMutation) ResetIsPrompt() {
+	m.is_prompt = nil
+}
+
+// SetPromptLevel sets the "prompt_level" field.
+func (m *EnvMutation) SetPromptLevel(s string) {
+	m.prompt_level = &s
+}
+
+// PromptLevel returns the value of the "prompt_level" field in the mutation.
+func (m *EnvMutation) PromptLevel() (r string, exists bool) {
+	v := m.prompt_level
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EnvPlugin numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EnvPluginMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(envplugin.FieldConfig) {
-		fields = append(fields, envplugin.FieldConfig)
+// OldPromptLevel returns the old "prompt_level" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldPromptLevel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptLevel is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptLevel: %w", err)
+	}
+	return oldValue.PromptLevel, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EnvPluginMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearPromptLevel clears the value of the "prompt_level" field.
+func (m *EnvMutation) ClearPromptLevel() {
+	m.prompt_level = nil
+	m.clearedFields[env.FieldPromptLevel] = struct{}{}
+}
+
+// PromptLevelCleared returns if the "prompt_level" field was cleared in this mutation.
+func (m *EnvMutation) PromptLevelCleared() bool {
+	_, ok := m.clearedFields[env.FieldPromptLevel]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EnvPluginMutation) ClearField(name string) error {
-	switch name {
-	case envplugin.FieldConfig:
-		m.ClearConfig()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvPlugin nullable field %s", name)
+// ResetPromptLevel resets all changes to the "prompt_level" field.
+func (m *EnvMutation) ResetPromptLevel() {
+	m.prompt_level = nil
+	delete(m.clearedFields, env.FieldPromptLevel)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EnvPluginMutation) ResetField(name string) error {
-	switch name {
-	case envplugin.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case envplugin.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case envplugin.FieldEnvID:
-		m.ResetEnvID()
-		return nil
-	case envplugin.FieldPluginID:
-		m.ResetPluginID()
-		return nil
-	case envplugin.FieldIsEnable:
-		m.ResetIsEnable()
-		return nil
-	case envplugin.FieldExecutionOrder:
-		m.ResetExecutionOrder()
-		return nil
-	case envplugin.FieldConfig:
-		m.ResetConfig()
-		return nil
+// SetPromptContent sets the "prompt_content" field.
+func (m *EnvMutation) SetPromptContent(s string) {
+	m.prompt_content = &s
+}
+
+// PromptContent returns the value of the "prompt_content" field in the mutation.
+func (m *EnvMutation) PromptContent() (r string, exists bool) {
+	v := m.prompt_content
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EnvPlugin field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvPluginMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.env != nil {
-		edges = append(edges, envplugin.EdgeEnv)
+// OldPromptContent returns the old "prompt_content" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldPromptContent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptContent is only allowed on UpdateOne operations")
 	}
-	if m.plugin != nil {
-		edges = append(edges, envplugin.EdgePlugin)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptContent requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptContent: %w", err)
+	}
+	return oldValue.PromptContent, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EnvPluginMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case envplugin.EdgeEnv:
-		if id := m.env; id != nil {
-			return []ent.Value{*id}
-		}
-	case envplugin.EdgePlugin:
-		if id := m.plugin; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ClearPromptContent clears the value of the "prompt_content" field.
+func (m *EnvMutation) ClearPromptContent() {
+	m.prompt_content = nil
+	m.clearedFields[env.FieldPromptContent] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvPluginMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// PromptContentCleared returns if the "prompt_content" field was cleared in this mutation.
+func (m *EnvMutation) PromptContentCleared() bool {
+	_, ok := m.clearedFields[env.FieldPromptContent]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EnvPluginMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetPromptContent resets all changes to the "prompt_content" field.
+func (m *EnvMutation) ResetPromptContent() {
+	m.prompt_content = nil
+	delete(m.clearedFields, env.FieldPromptContent)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvPluginMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedenv {
-		edges = append(edges, envplugin.EdgeEnv)
-	}
-	if m.clearedplugin {
-		edges = append(edges, envplugin.EdgePlugin)
-	}
-	return edges
+// SetIsEnable sets the "is_enable" field.
+func (m *EnvMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EnvPluginMutation) EdgeCleared(name string) bool {
-	switch name {
-	case envplugin.EdgeEnv:
-		return m.clearedenv
-	case envplugin.EdgePlugin:
-		return m.clearedplugin
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *EnvMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EnvPluginMutation) ClearEdge(name string) error {
-	switch name {
-	case envplugin.EdgeEnv:
-		m.ClearEnv()
-		return nil
-	case envplugin.EdgePlugin:
-		m.ClearPlugin()
-		return nil
+// OldIsEnable returns the old "is_enable" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown EnvPlugin unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+	}
+	return oldValue.IsEnable, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EnvPluginMutation) ResetEdge(name string) error {
-	switch name {
-	case envplugin.EdgeEnv:
-		m.ResetEnv()
-		return nil
-	case envplugin.EdgePlugin:
-		m.ResetPlugin()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvPlugin edge %s", name)
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *EnvMutation) ResetIsEnable() {
+	m.is_enable = nil
 }
 
-// LoginHistoryMutation represents an operation that mutates the LoginHistory nodes in the graph.
-type LoginHistoryMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int64
-	created_at    *time.Time
-	updated_at    *time.Time
-	ip            *string
-	address       *string
-	state         *bool
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*LoginHistory, error)
-	predicates    []predicate.LoginHistory
+// SetSelectStrategy sets the "select_strategy" field.
+func (m *EnvMutation) SetSelectStrategy(s string) {
+	m.select_strategy = &s
 }
 
-var _ ent.Mutation = (*LoginHistoryMutation)(nil)
-
-// loginhistoryOption allows management of the mutation configuration using functional options.
-type loginhistoryOption func(*LoginHistoryMutation)
+// SelectStrategy returns the value of the "select_strategy" field in the mutation.
+func (m *EnvMutation) SelectStrategy() (r string, exists bool) {
+	v := m.select_strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newLoginHistoryMutation creates new mutation for the LoginHistory entity.
-func newLoginHistoryMutation(c config, op Op, opts ...loginhistoryOption) *LoginHistoryMutation {
-	m := &LoginHistoryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeLoginHistory,
-		clearedFields: make(map[string]struct{}),
+// OldSelectStrategy returns the old "select_strategy" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldSelectStrategy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelectStrategy is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelectStrategy requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelectStrategy: %w", err)
+	}
+	return oldValue.SelectStrategy, nil
 }
 
-// withLoginHistoryID sets the ID field of the mutation.
-func withLoginHistoryID(id int64) loginhistoryOption {
-	return func(m *LoginHistoryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *LoginHistory
-		)
-		m.oldValue = func(ctx context.Context) (*LoginHistory, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().LoginHistory.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetSelectStrategy resets all changes to the "select_strategy" field.
+func (m *EnvMutation) ResetSelectStrategy() {
+	m.select_strategy = nil
 }
 
-// withLoginHistory sets the old LoginHistory of the mutation.
-func withLoginHistory(node *LoginHistory) loginhistoryOption {
-	return func(m *LoginHistoryMutation) {
-		m.oldValue = func(context.Context) (*LoginHistory, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetReplicas sets the "replicas" field.
+func (m *EnvMutation) SetReplicas(i int32) {
+	m.replicas = &i
+	m.addreplicas = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LoginHistoryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Replicas returns the value of the "replicas" field in the mutation.
+func (m *EnvMutation) Replicas() (r int32, exists bool) {
+	v := m.replicas
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m LoginHistoryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldReplicas returns the old "replicas" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldReplicas(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicas is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicas: %w", err)
+	}
+	return oldValue.Replicas, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LoginHistory entities.
-func (m *LoginHistoryMutation) SetID(id int64) {
-	m.id = &id
+// AddReplicas adds i to the "replicas" field.
+func (m *EnvMutation) AddReplicas(i int32) {
+	if m.addreplicas != nil {
+		*m.addreplicas += i
+	} else {
+		m.addreplicas = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *LoginHistoryMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// AddedReplicas returns the value that was added to the "replicas" field in this mutation.
+func (m *EnvMutation) AddedReplicas() (r int32, exists bool) {
+	v := m.addreplicas
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *LoginHistoryMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LoginHistory.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetReplicas resets all changes to the "replicas" field.
+func (m *EnvMutation) ResetReplicas() {
+	m.replicas = nil
+	m.addreplicas = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LoginHistoryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdateScope sets the "update_scope" field.
+func (m *EnvMutation) SetUpdateScope(s string) {
+	m.update_scope = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LoginHistoryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdateScope returns the value of the "update_scope" field in the mutation.
+func (m *EnvMutation) UpdateScope() (r string, exists bool) {
+	v := m.update_scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the LoginHistory entity.
-// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateScope returns the old "update_scope" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldUpdateScope(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdateScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateScope: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdateScope, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LoginHistoryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdateScope resets all changes to the "update_scope" field.
+func (m *EnvMutation) ResetUpdateScope() {
+	m.update_scope = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *LoginHistoryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetValueTemplate sets the "value_template" field.
+func (m *EnvMutation) SetValueTemplate(s string) {
+	m.value_template = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LoginHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ValueTemplate returns the value of the "value_template" field in the mutation.
+func (m *EnvMutation) ValueTemplate() (r string, exists bool) {
+	v := m.value_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the LoginHistory entity.
-// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldValueTemplate returns the old "value_template" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldValueTemplate(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldValueTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldValueTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldValueTemplate: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ValueTemplate, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LoginHistoryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearValueTemplate clears the value of the "value_template" field.
+func (m *EnvMutation) ClearValueTemplate() {
+	m.value_template = nil
+	m.clearedFields[env.FieldValueTemplate] = struct{}{}
 }
 
-// SetIP sets the "ip" field.
-func (m *LoginHistoryMutation) SetIP(s string) {
-	m.ip = &s
+// ValueTemplateCleared returns if the "value_template" field was cleared in this mutation.
+func (m *EnvMutation) ValueTemplateCleared() bool {
+	_, ok := m.clearedFields[env.FieldValueTemplate]
+	return ok
 }
 
-// IP returns the value of the "ip" field in the mutation.
-func (m *LoginHistoryMutation) IP() (r string, exists bool) {
-	v := m.ip
+// ResetValueTemplate resets all changes to the "value_template" field.
+func (m *EnvMutation) ResetValueTemplate() {
+	m.value_template = nil
+	delete(m.clearedFields, env.FieldValueTemplate)
+}
+
+// SetMatchKeyTemplate sets the "match_key_template" field.
+func (m *EnvMutation) SetMatchKeyTemplate(s string) {
+	m.match_key_template = &s
+}
+
+// MatchKeyTemplate returns the value of the "match_key_template" field in the mutation.
+func (m *EnvMutation) MatchKeyTemplate() (r string, exists bool) {
+	v := m.match_key_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIP returns the old "ip" field's value of the LoginHistory entity.
-// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldMatchKeyTemplate returns the old "match_key_template" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginHistoryMutation) OldIP(ctx context.Context) (v string, err error) {
+func (m *EnvMutation) OldMatchKeyTemplate(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldMatchKeyTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIP requires an ID field in the mutation")
+		return v, errors.New("OldMatchKeyTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldMatchKeyTemplate: %w", err)
 	}
-	return oldValue.IP, nil
+	return oldValue.MatchKeyTemplate, nil
 }
 
-// ResetIP resets all changes to the "ip" field.
-func (m *LoginHistoryMutation) ResetIP() {
-	m.ip = nil
+// ClearMatchKeyTemplate clears the value of the "match_key_template" field.
+func (m *EnvMutation) ClearMatchKeyTemplate() {
+	m.match_key_template = nil
+	m.clearedFields[env.FieldMatchKeyTemplate] = struct{}{}
 }
 
-// SetAddress sets the "address" field.
-func (m *LoginHistoryMutation) SetAddress(s string) {
-	m.address = &s
+// MatchKeyTemplateCleared returns if the "match_key_template" field was cleared in this mutation.
+func (m *EnvMutation) MatchKeyTemplateCleared() bool {
+	_, ok := m.clearedFields[env.FieldMatchKeyTemplate]
+	return ok
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *LoginHistoryMutation) Address() (r string, exists bool) {
-	v := m.address
+// ResetMatchKeyTemplate resets all changes to the "match_key_template" field.
+func (m *EnvMutation) ResetMatchKeyTemplate() {
+	m.match_key_template = nil
+	delete(m.clearedFields, env.FieldMatchKeyTemplate)
+}
+
+// SetSplitBy sets the "split_by" field.
+func (m *EnvMutation) SetSplitBy(s string) {
+	m.split_by = &s
+}
+
+// SplitBy returns the value of the "split_by" field in the mutation.
+func (m *EnvMutation) SplitBy() (r string, exists bool) {
+	v := m.split_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAddress returns the old "address" field's value of the LoginHistory entity.
-// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldSplitBy returns the old "split_by" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginHistoryMutation) OldAddress(ctx context.Context) (v *string, err error) {
+func (m *EnvMutation) OldSplitBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldSplitBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
+		return v, errors.New("OldSplitBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldSplitBy: %w", err)
 	}
-	return oldValue.Address, nil
-}
-
-// ClearAddress clears the value of the "address" field.
-func (m *LoginHistoryMutation) ClearAddress() {
-	m.address = nil
-	m.clearedFields[loginhistory.FieldAddress] = struct{}{}
-}
-
-// AddressCleared returns if the "address" field was cleared in this mutation.
-func (m *LoginHistoryMutation) AddressCleared() bool {
-	_, ok := m.clearedFields[loginhistory.FieldAddress]
-	return ok
+	return oldValue.SplitBy, nil
 }
 
-// ResetAddress resets all changes to the "address" field.
-func (m *LoginHistoryMutation) ResetAddress() {
-	m.address = nil
-	delete(m.clearedFields, loginhistory.FieldAddress)
+// ResetSplitBy resets all changes to the "split_by" field.
+func (m *EnvMutation) ResetSplitBy() {
+	m.split_by = nil
 }
 
-// SetState sets the "state" field.
-func (m *LoginHistoryMutation) SetState(b bool) {
-	m.state = &b
+// SetDedupBy sets the "dedup_by" field.
+func (m *EnvMutation) SetDedupBy(s string) {
+	m.dedup_by = &s
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *LoginHistoryMutation) State() (r bool, exists bool) {
-	v := m.state
+// DedupBy returns the value of the "dedup_by" field in the mutation.
+func (m *EnvMutation) DedupBy() (r string, exists bool) {
+	v := m.dedup_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the LoginHistory entity.
-// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldDedupBy returns the old "dedup_by" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginHistoryMutation) OldState(ctx context.Context) (v bool, err error) {
+func (m *EnvMutation) OldDedupBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldDedupBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldDedupBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldDedupBy: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.DedupBy, nil
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *LoginHistoryMutation) ResetState() {
-	m.state = nil
+// ResetDedupBy resets all changes to the "dedup_by" field.
+func (m *EnvMutation) ResetDedupBy() {
+	m.dedup_by = nil
 }
 
-// Where appends a list predicates to the LoginHistoryMutation builder.
-func (m *LoginHistoryMutation) Where(ps ...predicate.LoginHistory) {
-	m.predicates = append(m.predicates, ps...)
+// SetDedupAction sets the "dedup_action" field.
+func (m *EnvMutation) SetDedupAction(s string) {
+	m.dedup_action = &s
 }
 
-// WhereP appends storage-level predicates to the LoginHistoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LoginHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LoginHistory, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// DedupAction returns the value of the "dedup_action" field in the mutation.
+func (m *EnvMutation) DedupAction() (r string, exists bool) {
+	v := m.dedup_action
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *LoginHistoryMutation) Op() Op {
-	return m.op
+// OldDedupAction returns the old "dedup_action" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldDedupAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDedupAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDedupAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDedupAction: %w", err)
+	}
+	return oldValue.DedupAction, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *LoginHistoryMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (LoginHistory).
-func (m *LoginHistoryMutation) Type() string {
-	return m.typ
+// ResetDedupAction resets all changes to the "dedup_action" field.
+func (m *EnvMutation) ResetDedupAction() {
+	m.dedup_action = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *LoginHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, loginhistory.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, loginhistory.FieldUpdatedAt)
-	}
-	if m.ip != nil {
-		fields = append(fields, loginhistory.FieldIP)
-	}
-	if m.address != nil {
-		fields = append(fields, loginhistory.FieldAddress)
+// AddPanelIDs adds the "panels" edge to the Panel entity by ids.
+func (m *EnvMutation) AddPanelIDs(ids ...int64) {
+	if m.panels == nil {
+		m.panels = make(map[int64]struct{})
 	}
-	if m.state != nil {
-		fields = append(fields, loginhistory.FieldState)
+	for i := range ids {
+		m.panels[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *LoginHistoryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case loginhistory.FieldCreatedAt:
-		return m.CreatedAt()
-	case loginhistory.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case loginhistory.FieldIP:
-		return m.IP()
-	case loginhistory.FieldAddress:
-		return m.Address()
-	case loginhistory.FieldState:
-		return m.State()
-	}
-	return nil, false
+// ClearPanels clears the "panels" edge to the Panel entity.
+func (m *EnvMutation) ClearPanels() {
+	m.clearedpanels = true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *LoginHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case loginhistory.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case loginhistory.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case loginhistory.FieldIP:
-		return m.OldIP(ctx)
-	case loginhistory.FieldAddress:
-		return m.OldAddress(ctx)
-	case loginhistory.FieldState:
-		return m.OldState(ctx)
+// PanelsCleared reports if the "panels" edge to the Panel entity was cleared.
+func (m *EnvMutation) PanelsCleared() bool {
+	return m.clearedpanels
+}
+
+// RemovePanelIDs removes the "panels" edge to the Panel entity by IDs.
+func (m *EnvMutation) RemovePanelIDs(ids ...int64) {
+	if m.removedpanels == nil {
+		m.removedpanels = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.panels, ids[i])
+		m.removedpanels[ids[i]] = struct{}{}
 	}
-	return nil, fmt.Errorf("unknown LoginHistory field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *LoginHistoryMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case loginhistory.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case loginhistory.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case loginhistory.FieldIP:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIP(v)
-		return nil
-	case loginhistory.FieldAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAddress(v)
-		return nil
-	case loginhistory.FieldState:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetState(v)
-		return nil
+// RemovedPanels returns the removed IDs of the "panels" edge to the Panel entity.
+func (m *EnvMutation) RemovedPanelsIDs() (ids []int64) {
+	for id := range m.removedpanels {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown LoginHistory field %s", name)
+	return
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *LoginHistoryMutation) AddedFields() []string {
-	return nil
+// PanelsIDs returns the "panels" edge IDs in the mutation.
+func (m *EnvMutation) PanelsIDs() (ids []int64) {
+	for id := range m.panels {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *LoginHistoryMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetPanels resets all changes to the "panels" edge.
+func (m *EnvMutation) ResetPanels() {
+	m.panels = nil
+	m.clearedpanels = false
+	m.removedpanels = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *LoginHistoryMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddEnvPluginIDs adds the "env_plugins" edge to the EnvPlugin entity by ids.
+func (m *EnvMutation) AddEnvPluginIDs(ids ...int64) {
+	if m.env_plugins == nil {
+		m.env_plugins = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.env_plugins[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown LoginHistory numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *LoginHistoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(loginhistory.FieldAddress) {
-		fields = append(fields, loginhistory.FieldAddress)
-	}
-	return fields
+// ClearEnvPlugins clears the "env_plugins" edge to the EnvPlugin entity.
+func (m *EnvMutation) ClearEnvPlugins() {
+	m.clearedenv_plugins = true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *LoginHistoryMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// EnvPluginsCleared reports if the "env_plugins" edge to the EnvPlugin entity was cleared.
+func (m *EnvMutation) EnvPluginsCleared() bool {
+	return m.clearedenv_plugins
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *LoginHistoryMutation) ClearField(name string) error {
-	switch name {
-	case loginhistory.FieldAddress:
-		m.ClearAddress()
-		return nil
+// RemoveEnvPluginIDs removes the "env_plugins" edge to the EnvPlugin entity by IDs.
+func (m *EnvMutation) RemoveEnvPluginIDs(ids ...int64) {
+	if m.removedenv_plugins == nil {
+		m.removedenv_plugins = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.env_plugins, ids[i])
+		m.removedenv_plugins[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown LoginHistory nullable field %s", name)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *LoginHistoryMutation) ResetField(name string) error {
-	switch name {
-	case loginhistory.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case loginhistory.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case loginhistory.FieldIP:
-		m.ResetIP()
-		return nil
-	case loginhistory.FieldAddress:
-		m.ResetAddress()
-		return nil
-	case loginhistory.FieldState:
-		m.ResetState()
-		return nil
+// RemovedEnvPlugins returns the removed IDs of the "env_plugins" edge to the EnvPlugin entity.
+func (m *EnvMutation) RemovedEnvPluginsIDs() (ids []int64) {
+	for id := range m.removedenv_plugins {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown LoginHistory field %s", name)
+	return
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LoginHistoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// EnvPluginsIDs returns the "env_plugins" edge IDs in the mutation.
+func (m *EnvMutation) EnvPluginsIDs() (ids []int64) {
+	for id := range m.env_plugins {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *LoginHistoryMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// ResetEnvPlugins resets all changes to the "env_plugins" edge.
+func (m *EnvMutation) ResetEnvPlugins() {
+	m.env_plugins = nil
+	m.clearedenv_plugins = false
+	m.removedenv_plugins = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LoginHistoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AddCronTriggerIDs adds the "cron_triggers" edge to the EnvCronTrigger entity by ids.
+func (m *EnvMutation) AddCronTriggerIDs(ids ...int64) {
+	if m.cron_triggers == nil {
+		m.cron_triggers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.cron_triggers[ids[i]] = struct{}{}
+	}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *LoginHistoryMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ClearCronTriggers clears the "cron_triggers" edge to the EnvCronTrigger entity.
+func (m *EnvMutation) ClearCronTriggers() {
+	m.clearedcron_triggers = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LoginHistoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// CronTriggersCleared reports if the "cron_triggers" edge to the EnvCronTrigger entity was cleared.
+func (m *EnvMutation) CronTriggersCleared() bool {
+	return m.clearedcron_triggers
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *LoginHistoryMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *LoginHistoryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown LoginHistory unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *LoginHistoryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown LoginHistory edge %s", name)
-}
-
-// PanelMutation represents an operation that mutates the Panel nodes in the graph.
-type PanelMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int64
-	created_at    *time.Time
-	updated_at    *time.Time
-	name          *string
-	url           *string
-	client_id     *string
-	client_secret *string
-	is_enable     *bool
-	token         *string
-	params        *int32
-	addparams     *int32
-	clearedFields map[string]struct{}
-	envs          map[int64]struct{}
-	removedenvs   map[int64]struct{}
-	clearedenvs   bool
-	done          bool
-	oldValue      func(context.Context) (*Panel, error)
-	predicates    []predicate.Panel
-}
-
-var _ ent.Mutation = (*PanelMutation)(nil)
-
-// panelOption allows management of the mutation configuration using functional options.
-type panelOption func(*PanelMutation)
-
-// newPanelMutation creates new mutation for the Panel entity.
-func newPanelMutation(c config, op Op, opts ...panelOption) *PanelMutation {
-	m := &PanelMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePanel,
-		clearedFields: make(map[string]struct{}),
+// RemoveCronTriggerIDs removes the "cron_triggers" edge to the EnvCronTrigger entity by IDs.
+func (m *EnvMutation) RemoveCronTriggerIDs(ids ...int64) {
+	if m.removedcron_triggers == nil {
+		m.removedcron_triggers = make(map[int64]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.cron_triggers, ids[i])
+		m.removedcron_triggers[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withPanelID sets the ID field of the mutation.
-func withPanelID(id int64) panelOption {
-	return func(m *PanelMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Panel
-		)
-		m.oldValue = func(ctx context.Context) (*Panel, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Panel.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedCronTriggers returns the removed IDs of the "cron_triggers" edge to the EnvCronTrigger entity.
+func (m *EnvMutation) RemovedCronTriggersIDs() (ids []int64) {
+	for id := range m.removedcron_triggers {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withPanel sets the old Panel of the mutation.
-func withPanel(node *Panel) panelOption {
-	return func(m *PanelMutation) {
-		m.oldValue = func(context.Context) (*Panel, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// CronTriggersIDs returns the "cron_triggers" edge IDs in the mutation.
+func (m *EnvMutation) CronTriggersIDs() (ids []int64) {
+	for id := range m.cron_triggers {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PanelMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PanelMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetCronTriggers resets all changes to the "cron_triggers" edge.
+func (m *EnvMutation) ResetCronTriggers() {
+	m.cron_triggers = nil
+	m.clearedcron_triggers = false
+	m.removedcron_triggers = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Panel entities.
-func (m *PanelMutation) SetID(id int64) {
-	m.id = &id
+// Where appends a list predicates to the EnvMutation builder.
+func (m *EnvMutation) Where(ps ...predicate.Env) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PanelMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
+// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Env, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *m.id, true
+	m.Where(p...)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PanelMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Panel.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// Op returns the operation name.
+func (m *EnvMutation) Op() Op {
+	return m.op
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PanelMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOp allows setting the mutation operation.
+func (m *EnvMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PanelMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Env).
+func (m *EnvMutation) Type() string {
+	return m.typ
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.created_at != nil {
+		fields = append(fields, env.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, env.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.name != nil {
+		fields = append(fields, env.FieldName)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PanelMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PanelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PanelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+	if m.remarks != nil {
+		fields = append(fields, env.FieldRemarks)
 	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	if m.quantity != nil {
+		fields = append(fields, env.FieldQuantity)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.regex != nil {
+		fields = append(fields, env.FieldRegex)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.mode != nil {
+		fields = append(fields, env.FieldMode)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PanelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetName sets the "name" field.
-func (m *PanelMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *PanelMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+	if m.regex_update != nil {
+		fields = append(fields, env.FieldRegexUpdate)
 	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	if m.is_auto_env_enable != nil {
+		fields = append(fields, env.FieldIsAutoEnvEnable)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+	if m.enable_key != nil {
+		fields = append(fields, env.FieldEnableKey)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	if m.cdk_limit != nil {
+		fields = append(fields, env.FieldCdkLimit)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *PanelMutation) ResetName() {
+	if m.is_prompt != nil {
+		fields = append(fields, env.FieldIsPrompt)
+	}
+	if m.prompt_level != nil {
+		fields = append(fields, env.FieldPromptLevel)
+	}
+	if m.prompt_content != nil {
+		fields = append(fields, env.FieldPromptContent)
+	}
+	if m.is_enable != nil {
+		fields = append(fields, env.FieldIsEnable)
+	}
+	if m.select_strategy != nil {
+		fields = append(fields, env.FieldSelectStrategy)
+	}
+	if m.replicas != nil {
+		fields = append(fields, env.FieldReplicas)
+	}
+	if m.update_scope != nil {
+		fields = append(fields, env.FieldUpdateScope)
+	}
+	if m.value_template != nil {
+		fields = append(fields, env.FieldValueTemplate)
+	}
+	if m.match_key_template != nil {
+		fields = append(fields, env.FieldMatchKeyTemplate)
+	}
+	if m.split_by != nil {
+		fields = append(fields, env.FieldSplitBy)
+	}
+	if m.dedup_by != nil {
+		fields = append(fields, env.FieldDedupBy)
+	}
+	if m.dedup_action != nil {
+		fields = append(fields, env.FieldDedupAction)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case env.FieldCreatedAt:
+		return m.CreatedAt()
+	case env.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case env.FieldName:
+		return m.Name()
+	case env.FieldRemarks:
+		return m.Remarks()
+	case env.FieldQuantity:
+		return m.Quantity()
+	case env.FieldRegex:
+		return m.Regex()
+	case env.FieldMode:
+		return m.Mode()
+	case env.FieldRegexUpdate:
+		return m.RegexUpdate()
+	case env.FieldIsAutoEnvEnable:
+		return m.IsAutoEnvEnable()
+	case env.FieldEnableKey:
+		return m.EnableKey()
+	case env.FieldCdkLimit:
+		return m.CdkLimit()
+	case env.FieldIsPrompt:
+		return m.IsPrompt()
+	case env.FieldPromptLevel:
+		return m.PromptLevel()
+	case env.FieldPromptContent:
+		return m.PromptContent()
+	case env.FieldIsEnable:
+		return m.IsEnable()
+	case env.FieldSelectStrategy:
+		return m.SelectStrategy()
+	case env.FieldReplicas:
+		return m.Replicas()
+	case env.FieldUpdateScope:
+		return m.UpdateScope()
+	case env.FieldValueTemplate:
+		return m.ValueTemplate()
+	case env.FieldMatchKeyTemplate:
+		return m.MatchKeyTemplate()
+	case env.FieldSplitBy:
+		return m.SplitBy()
+	case env.FieldDedupBy:
+		return m.DedupBy()
+	case env.FieldDedupAction:
+		return m.DedupAction()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case env.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case env.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case env.FieldName:
+		return m.OldName(ctx)
+	case env.FieldRemarks:
+		return m.OldRemarks(ctx)
+	case env.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case env.FieldRegex:
+		return m.OldRegex(ctx)
+	case env.FieldMode:
+		return m.OldMode(ctx)
+	case env.FieldRegexUpdate:
+		return m.OldRegexUpdate(ctx)
+	case env.FieldIsAutoEnvEnable:
+		return m.OldIsAutoEnvEnable(ctx)
+	case env.FieldEnableKey:
+		return m.OldEnableKey(ctx)
+	case env.FieldCdkLimit:
+		return m.OldCdkLimit(ctx)
+	case env.FieldIsPrompt:
+		return m.OldIsPrompt(ctx)
+	case env.FieldPromptLevel:
+		return m.OldPromptLevel(ctx)
+	case env.FieldPromptContent:
+		return m.OldPromptContent(ctx)
+	case env.FieldIsEnable:
+		return m.OldIsEnable(ctx)
+	case env.FieldSelectStrategy:
+		return m.OldSelectStrategy(ctx)
+	case env.FieldReplicas:
+		return m.OldReplicas(ctx)
+	case env.FieldUpdateScope:
+		return m.OldUpdateScope(ctx)
+	case env.FieldValueTemplate:
+		return m.OldValueTemplate(ctx)
+	case env.FieldMatchKeyTemplate:
+		return m.OldMatchKeyTemplate(ctx)
+	case env.FieldSplitBy:
+		return m.OldSplitBy(ctx)
+	case env.FieldDedupBy:
+		return m.OldDedupBy(ctx)
+	case env.FieldDedupAction:
+		return m.OldDedupAction(ctx)
+	}
+	return nil, fmt.Errorf("unknown Env field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case env.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case env.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case env.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case env.FieldRemarks:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemarks(v)
+		return nil
+	case env.FieldQuantity:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case env.FieldRegex:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegex(v)
+		return nil
+	case env.FieldMode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case env.FieldRegexUpdate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegexUpdate(v)
+		return nil
+	case env.FieldIsAutoEnvEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAutoEnvEnable(v)
+		return nil
+	case env.FieldEnableKey:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnableKey(v)
+		return nil
+	case env.FieldCdkLimit:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCdkLimit(v)
+		return nil
+	case env.FieldIsPrompt:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrompt(v)
+		return nil
+	case env.FieldPromptLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptLevel(v)
+		return nil
+	case env.FieldPromptContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptContent(v)
+		return nil
+	case env.FieldIsEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnable(v)
+		return nil
+	case env.FieldSelectStrategy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectStrategy(v)
+		return nil
+	case env.FieldReplicas:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicas(v)
+		return nil
+	case env.FieldUpdateScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateScope(v)
+		return nil
+	case env.FieldValueTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueTemplate(v)
+		return nil
+	case env.FieldMatchKeyTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchKeyTemplate(v)
+		return nil
+	case env.FieldSplitBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitBy(v)
+		return nil
+	case env.FieldDedupBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedupBy(v)
+		return nil
+	case env.FieldDedupAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedupAction(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Env field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, env.FieldQuantity)
+	}
+	if m.addmode != nil {
+		fields = append(fields, env.FieldMode)
+	}
+	if m.addcdk_limit != nil {
+		fields = append(fields, env.FieldCdkLimit)
+	}
+	if m.addreplicas != nil {
+		fields = append(fields, env.FieldReplicas)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case env.FieldQuantity:
+		return m.AddedQuantity()
+	case env.FieldMode:
+		return m.AddedMode()
+	case env.FieldCdkLimit:
+		return m.AddedCdkLimit()
+	case env.FieldReplicas:
+		return m.AddedReplicas()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case env.FieldQuantity:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case env.FieldMode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMode(v)
+		return nil
+	case env.FieldCdkLimit:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCdkLimit(v)
+		return nil
+	case env.FieldReplicas:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplicas(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Env numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(env.FieldRemarks) {
+		fields = append(fields, env.FieldRemarks)
+	}
+	if m.FieldCleared(env.FieldRegex) {
+		fields = append(fields, env.FieldRegex)
+	}
+	if m.FieldCleared(env.FieldRegexUpdate) {
+		fields = append(fields, env.FieldRegexUpdate)
+	}
+	if m.FieldCleared(env.FieldPromptLevel) {
+		fields = append(fields, env.FieldPromptLevel)
+	}
+	if m.FieldCleared(env.FieldPromptContent) {
+		fields = append(fields, env.FieldPromptContent)
+	}
+	if m.FieldCleared(env.FieldValueTemplate) {
+		fields = append(fields, env.FieldValueTemplate)
+	}
+	if m.FieldCleared(env.FieldMatchKeyTemplate) {
+		fields = append(fields, env.FieldMatchKeyTemplate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvMutation) ClearField(name string) error {
+	switch name {
+	case env.FieldRemarks:
+		m.ClearRemarks()
+		return nil
+	case env.FieldRegex:
+		m.ClearRegex()
+		return nil
+	case env.FieldRegexUpdate:
+		m.ClearRegexUpdate()
+		return nil
+	case env.FieldPromptLevel:
+		m.ClearPromptLevel()
+		return nil
+	case env.FieldPromptContent:
+		m.ClearPromptContent()
+		return nil
+	case env.FieldValueTemplate:
+		m.ClearValueTemplate()
+		return nil
+	case env.FieldMatchKeyTemplate:
+		m.ClearMatchKeyTemplate()
+		return nil
+	}
+	return fmt.Errorf("unknown Env nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvMutation) ResetField(name string) error {
+	switch name {
+	case env.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case env.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case env.FieldName:
+		m.ResetName()
+		return nil
+	case env.FieldRemarks:
+		m.ResetRemarks()
+		return nil
+	case env.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case env.FieldRegex:
+		m.ResetRegex()
+		return nil
+	case env.FieldMode:
+		m.ResetMode()
+		return nil
+	case env.FieldRegexUpdate:
+		m.ResetRegexUpdate()
+		return nil
+	case env.FieldIsAutoEnvEnable:
+		m.ResetIsAutoEnvEnable()
+		return nil
+	case env.FieldEnableKey:
+		m.ResetEnableKey()
+		return nil
+	case env.FieldCdkLimit:
+		m.ResetCdkLimit()
+		return nil
+	case env.FieldIsPrompt:
+		m.ResetIsPrompt()
+		return nil
+	case env.FieldPromptLevel:
+		m.ResetPromptLevel()
+		return nil
+	case env.FieldPromptContent:
+		m.ResetPromptContent()
+		return nil
+	case env.FieldIsEnable:
+		m.ResetIsEnable()
+		return nil
+	case env.FieldSelectStrategy:
+		m.ResetSelectStrategy()
+		return nil
+	case env.FieldReplicas:
+		m.ResetReplicas()
+		return nil
+	case env.FieldUpdateScope:
+		m.ResetUpdateScope()
+		return nil
+	case env.FieldValueTemplate:
+		m.ResetValueTemplate()
+		return nil
+	case env.FieldMatchKeyTemplate:
+		m.ResetMatchKeyTemplate()
+		return nil
+	case env.FieldSplitBy:
+		m.ResetSplitBy()
+		return nil
+	case env.FieldDedupBy:
+		m.ResetDedupBy()
+		return nil
+	case env.FieldDedupAction:
+		m.ResetDedupAction()
+		return nil
+	}
+	return fmt.Errorf("unknown Env field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.panels != nil {
+		edges = append(edges, env.EdgePanels)
+	}
+	if m.env_plugins != nil {
+		edges = append(edges, env.EdgeEnvPlugins)
+	}
+	if m.cron_triggers != nil {
+		edges = append(edges, env.EdgeCronTriggers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case env.EdgePanels:
+		ids := make([]ent.Value, 0, len(m.panels))
+		for id := range m.panels {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeEnvPlugins:
+		ids := make([]ent.Value, 0, len(m.env_plugins))
+		for id := range m.env_plugins {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeCronTriggers:
+		ids := make([]ent.Value, 0, len(m.cron_triggers))
+		for id := range m.cron_triggers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpanels != nil {
+		edges = append(edges, env.EdgePanels)
+	}
+	if m.removedenv_plugins != nil {
+		edges = append(edges, env.EdgeEnvPlugins)
+	}
+	if m.removedcron_triggers != nil {
+		edges = append(edges, env.EdgeCronTriggers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case env.EdgePanels:
+		ids := make([]ent.Value, 0, len(m.removedpanels))
+		for id := range m.removedpanels {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeEnvPlugins:
+		ids := make([]ent.Value, 0, len(m.removedenv_plugins))
+		for id := range m.removedenv_plugins {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeCronTriggers:
+		ids := make([]ent.Value, 0, len(m.removedcron_triggers))
+		for id := range m.removedcron_triggers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpanels {
+		edges = append(edges, env.EdgePanels)
+	}
+	if m.clearedenv_plugins {
+		edges = append(edges, env.EdgeEnvPlugins)
+	}
+	if m.clearedcron_triggers {
+		edges = append(edges, env.EdgeCronTriggers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvMutation) EdgeCleared(name string) bool {
+	switch name {
+	case env.EdgePanels:
+		return m.clearedpanels
+	case env.EdgeEnvPlugins:
+		return m.clearedenv_plugins
+	case env.EdgeCronTriggers:
+		return m.clearedcron_triggers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Env unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvMutation) ResetEdge(name string) error {
+	switch name {
+	case env.EdgePanels:
+		m.ResetPanels()
+		return nil
+	case env.EdgeEnvPlugins:
+		m.ResetEnvPlugins()
+		return nil
+	case env.EdgeCronTriggers:
+		m.ResetCronTriggers()
+		return nil
+	}
+	return fmt.Errorf("unknown Env edge %s", name)
+}
+
+// EnvCronTriggerMutation represents an operation that mutates the EnvCronTrigger nodes in the graph.
+type EnvCronTriggerMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	panel_id      *int64
+	addpanel_id   *int64
+	cron_id       *int
+	addcron_id    *int
+	name_pattern  *string
+	is_enable     *bool
+	clearedFields map[string]struct{}
+	env           *int64
+	clearedenv    bool
+	done          bool
+	oldValue      func(context.Context) (*EnvCronTrigger, error)
+	predicates    []predicate.EnvCronTrigger
+}
+
+var _ ent.Mutation = (*EnvCronTriggerMutation)(nil)
+
+// envcrontriggerOption allows management of the mutation configuration using functional options.
+type envcrontriggerOption func(*EnvCronTriggerMutation)
+
+// newEnvCronTriggerMutation creates new mutation for the EnvCronTrigger entity.
+func newEnvCronTriggerMutation(c config, op Op, opts ...envcrontriggerOption) *EnvCronTriggerMutation {
+	m := &EnvCronTriggerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvCronTrigger,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvCronTriggerID sets the ID field of the mutation.
+func withEnvCronTriggerID(id int64) envcrontriggerOption {
+	return func(m *EnvCronTriggerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvCronTrigger
+		)
+		m.oldValue = func(ctx context.Context) (*EnvCronTrigger, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvCronTrigger.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvCronTrigger sets the old EnvCronTrigger of the mutation.
+func withEnvCronTrigger(node *EnvCronTrigger) envcrontriggerOption {
+	return func(m *EnvCronTriggerMutation) {
+		m.oldValue = func(context.Context) (*EnvCronTrigger, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvCronTriggerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvCronTriggerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvCronTrigger entities.
+func (m *EnvCronTriggerMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvCronTriggerMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvCronTriggerMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvCronTrigger.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvCronTriggerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvCronTriggerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvCronTriggerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvCronTriggerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvCronTriggerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvCronTriggerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvCronTriggerMutation) SetEnvID(i int64) {
+	m.env = &i
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvCronTriggerMutation) EnvID() (r int64, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldEnvID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvCronTriggerMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetPanelID sets the "panel_id" field.
+func (m *EnvCronTriggerMutation) SetPanelID(i int64) {
+	m.panel_id = &i
+	m.addpanel_id = nil
+}
+
+// PanelID returns the value of the "panel_id" field in the mutation.
+func (m *EnvCronTriggerMutation) PanelID() (r int64, exists bool) {
+	v := m.panel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPanelID returns the old "panel_id" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldPanelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPanelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPanelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPanelID: %w", err)
+	}
+	return oldValue.PanelID, nil
+}
+
+// AddPanelID adds i to the "panel_id" field.
+func (m *EnvCronTriggerMutation) AddPanelID(i int64) {
+	if m.addpanel_id != nil {
+		*m.addpanel_id += i
+	} else {
+		m.addpanel_id = &i
+	}
+}
+
+// AddedPanelID returns the value that was added to the "panel_id" field in this mutation.
+func (m *EnvCronTriggerMutation) AddedPanelID() (r int64, exists bool) {
+	v := m.addpanel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPanelID resets all changes to the "panel_id" field.
+func (m *EnvCronTriggerMutation) ResetPanelID() {
+	m.panel_id = nil
+	m.addpanel_id = nil
+}
+
+// SetCronID sets the "cron_id" field.
+func (m *EnvCronTriggerMutation) SetCronID(i int) {
+	m.cron_id = &i
+	m.addcron_id = nil
+}
+
+// CronID returns the value of the "cron_id" field in the mutation.
+func (m *EnvCronTriggerMutation) CronID() (r int, exists bool) {
+	v := m.cron_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCronID returns the old "cron_id" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldCronID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCronID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCronID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCronID: %w", err)
+	}
+	return oldValue.CronID, nil
+}
+
+// AddCronID adds i to the "cron_id" field.
+func (m *EnvCronTriggerMutation) AddCronID(i int) {
+	if m.addcron_id != nil {
+		*m.addcron_id += i
+	} else {
+		m.addcron_id = &i
+	}
+}
+
+// AddedCronID returns the value that was added to the "cron_id" field in this mutation.
+func (m *EnvCronTriggerMutation) AddedCronID() (r int, exists bool) {
+	v := m.addcron_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCronID clears the value of the "cron_id" field.
+func (m *EnvCronTriggerMutation) ClearCronID() {
+	m.cron_id = nil
+	m.addcron_id = nil
+	m.clearedFields[envcrontrigger.FieldCronID] = struct{}{}
+}
+
+// CronIDCleared returns if the "cron_id" field was cleared in this mutation.
+func (m *EnvCronTriggerMutation) CronIDCleared() bool {
+	_, ok := m.clearedFields[envcrontrigger.FieldCronID]
+	return ok
+}
+
+// ResetCronID resets all changes to the "cron_id" field.
+func (m *EnvCronTriggerMutation) ResetCronID() {
+	m.cron_id = nil
+	m.addcron_id = nil
+	delete(m.clearedFields, envcrontrigger.FieldCronID)
+}
+
+// SetNamePattern sets the "name_pattern" field.
+func (m *EnvCronTriggerMutation) SetNamePattern(s string) {
+	m.name_pattern = &s
+}
+
+// NamePattern returns the value of the "name_pattern" field in the mutation.
+func (m *EnvCronTriggerMutation) NamePattern() (r string, exists bool) {
+	v := m.name_pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamePattern returns the old "name_pattern" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldNamePattern(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamePattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamePattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamePattern: %w", err)
+	}
+	return oldValue.NamePattern, nil
+}
+
+// ClearNamePattern clears the value of the "name_pattern" field.
+func (m *EnvCronTriggerMutation) ClearNamePattern() {
+	m.name_pattern = nil
+	m.clearedFields[envcrontrigger.FieldNamePattern] = struct{}{}
+}
+
+// NamePatternCleared returns if the "name_pattern" field was cleared in this mutation.
+func (m *EnvCronTriggerMutation) NamePatternCleared() bool {
+	_, ok := m.clearedFields[envcrontrigger.FieldNamePattern]
+	return ok
+}
+
+// ResetNamePattern resets all changes to the "name_pattern" field.
+func (m *EnvCronTriggerMutation) ResetNamePattern() {
+	m.name_pattern = nil
+	delete(m.clearedFields, envcrontrigger.FieldNamePattern)
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (m *EnvCronTriggerMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
+}
+
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *EnvCronTriggerMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnable returns the old "is_enable" field's value of the EnvCronTrigger entity.
+// If the EnvCronTrigger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvCronTriggerMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+	}
+	return oldValue.IsEnable, nil
+}
+
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *EnvCronTriggerMutation) ResetIsEnable() {
+	m.is_enable = nil
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvCronTriggerMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envcrontrigger.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvCronTriggerMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvCronTriggerMutation) EnvIDs() (ids []int64) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvCronTriggerMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the EnvCronTriggerMutation builder.
+func (m *EnvCronTriggerMutation) Where(ps ...predicate.EnvCronTrigger) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvCronTriggerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvCronTriggerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvCronTrigger, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvCronTriggerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvCronTriggerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvCronTrigger).
+func (m *EnvCronTriggerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvCronTriggerMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, envcrontrigger.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envcrontrigger.FieldUpdatedAt)
+	}
+	if m.env != nil {
+		fields = append(fields, envcrontrigger.FieldEnvID)
+	}
+	if m.panel_id != nil {
+		fields = append(fields, envcrontrigger.FieldPanelID)
+	}
+	if m.cron_id != nil {
+		fields = append(fields, envcrontrigger.FieldCronID)
+	}
+	if m.name_pattern != nil {
+		fields = append(fields, envcrontrigger.FieldNamePattern)
+	}
+	if m.is_enable != nil {
+		fields = append(fields, envcrontrigger.FieldIsEnable)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvCronTriggerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envcrontrigger.FieldCreatedAt:
+		return m.CreatedAt()
+	case envcrontrigger.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envcrontrigger.FieldEnvID:
+		return m.EnvID()
+	case envcrontrigger.FieldPanelID:
+		return m.PanelID()
+	case envcrontrigger.FieldCronID:
+		return m.CronID()
+	case envcrontrigger.FieldNamePattern:
+		return m.NamePattern()
+	case envcrontrigger.FieldIsEnable:
+		return m.IsEnable()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvCronTriggerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envcrontrigger.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envcrontrigger.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envcrontrigger.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envcrontrigger.FieldPanelID:
+		return m.OldPanelID(ctx)
+	case envcrontrigger.FieldCronID:
+		return m.OldCronID(ctx)
+	case envcrontrigger.FieldNamePattern:
+		return m.OldNamePattern(ctx)
+	case envcrontrigger.FieldIsEnable:
+		return m.OldIsEnable(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvCronTrigger field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvCronTriggerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envcrontrigger.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envcrontrigger.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envcrontrigger.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envcrontrigger.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPanelID(v)
+		return nil
+	case envcrontrigger.FieldCronID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCronID(v)
+		return nil
+	case envcrontrigger.FieldNamePattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamePattern(v)
+		return nil
+	case envcrontrigger.FieldIsEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnable(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvCronTrigger field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvCronTriggerMutation) AddedFields() []string {
+	var fields []string
+	if m.addpanel_id != nil {
+		fields = append(fields, envcrontrigger.FieldPanelID)
+	}
+	if m.addcron_id != nil {
+		fields = append(fields, envcrontrigger.FieldCronID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvCronTriggerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envcrontrigger.FieldPanelID:
+		return m.AddedPanelID()
+	case envcrontrigger.FieldCronID:
+		return m.AddedCronID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvCronTriggerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envcrontrigger.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPanelID(v)
+		return nil
+	case envcrontrigger.FieldCronID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCronID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvCronTrigger numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvCronTriggerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envcrontrigger.FieldCronID) {
+		fields = append(fields, envcrontrigger.FieldCronID)
+	}
+	if m.FieldCleared(envcrontrigger.FieldNamePattern) {
+		fields = append(fields, envcrontrigger.FieldNamePattern)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvCronTriggerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvCronTriggerMutation) ClearField(name string) error {
+	switch name {
+	case envcrontrigger.FieldCronID:
+		m.ClearCronID()
+		return nil
+	case envcrontrigger.FieldNamePattern:
+		m.ClearNamePattern()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvCronTrigger nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvCronTriggerMutation) ResetField(name string) error {
+	switch name {
+	case envcrontrigger.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envcrontrigger.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envcrontrigger.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envcrontrigger.FieldPanelID:
+		m.ResetPanelID()
+		return nil
+	case envcrontrigger.FieldCronID:
+		m.ResetCronID()
+		return nil
+	case envcrontrigger.FieldNamePattern:
+		m.ResetNamePattern()
+		return nil
+	case envcrontrigger.FieldIsEnable:
+		m.ResetIsEnable()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvCronTrigger field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvCronTriggerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, envcrontrigger.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvCronTriggerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envcrontrigger.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvCronTriggerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvCronTriggerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvCronTriggerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, envcrontrigger.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvCronTriggerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envcrontrigger.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvCronTriggerMutation) ClearEdge(name string) error {
+	switch name {
+	case envcrontrigger.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvCronTrigger unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvCronTriggerMutation) ResetEdge(name string) error {
+	switch name {
+	case envcrontrigger.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvCronTrigger edge %s", name)
+}
+
+// EnvPanelMutation represents an operation that mutates the EnvPanel nodes in the graph.
+type EnvPanelMutation struct {
+	config
+	op            Op
+	typ           string
+	max_count     *int32
+	addmax_count  *int32
+	weight        *int
+	addweight     *int
+	is_enable     *bool
+	clearedFields map[string]struct{}
+	env           *int64
+	clearedenv    bool
+	panel         *int64
+	clearedpanel  bool
+	done          bool
+	oldValue      func(context.Context) (*EnvPanel, error)
+	predicates    []predicate.EnvPanel
+}
+
+var _ ent.Mutation = (*EnvPanelMutation)(nil)
+
+// envpanelOption allows management of the mutation configuration using functional options.
+type envpanelOption func(*EnvPanelMutation)
+
+// newEnvPanelMutation creates new mutation for the EnvPanel entity.
+func newEnvPanelMutation(c config, op Op, opts ...envpanelOption) *EnvPanelMutation {
+	m := &EnvPanelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvPanel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvPanelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvPanelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvPanelMutation) SetEnvID(i int64) {
+	m.env = &i
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvPanelMutation) EnvID() (r int64, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvPanelMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetPanelID sets the "panel_id" field.
+func (m *EnvPanelMutation) SetPanelID(i int64) {
+	m.panel = &i
+}
+
+// PanelID returns the value of the "panel_id" field in the mutation.
+func (m *EnvPanelMutation) PanelID() (r int64, exists bool) {
+	v := m.panel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPanelID resets all changes to the "panel_id" field.
+func (m *EnvPanelMutation) ResetPanelID() {
+	m.panel = nil
+}
+
+// SetMaxCount sets the "max_count" field.
+func (m *EnvPanelMutation) SetMaxCount(i int32) {
+	m.max_count = &i
+	m.addmax_count = nil
+}
+
+// MaxCount returns the value of the "max_count" field in the mutation.
+func (m *EnvPanelMutation) MaxCount() (r int32, exists bool) {
+	v := m.max_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddMaxCount adds i to the "max_count" field.
+func (m *EnvPanelMutation) AddMaxCount(i int32) {
+	if m.addmax_count != nil {
+		*m.addmax_count += i
+	} else {
+		m.addmax_count = &i
+	}
+}
+
+// AddedMaxCount returns the value that was added to the "max_count" field in this mutation.
+func (m *EnvPanelMutation) AddedMaxCount() (r int32, exists bool) {
+	v := m.addmax_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxCount resets all changes to the "max_count" field.
+func (m *EnvPanelMutation) ResetMaxCount() {
+	m.max_count = nil
+	m.addmax_count = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *EnvPanelMutation) SetWeight(i int) {
+	m.weight = &i
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *EnvPanelMutation) Weight() (r int, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddWeight adds i to the "weight" field.
+func (m *EnvPanelMutation) AddWeight(i int) {
+	if m.addweight != nil {
+		*m.addweight += i
+	} else {
+		m.addweight = &i
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *EnvPanelMutation) AddedWeight() (r int, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (m *EnvPanelMutation) ClearWeight() {
+	m.weight = nil
+	m.addweight = nil
+	m.clearedFields[envpanel.FieldWeight] = struct{}{}
+}
+
+// WeightCleared returns if the "weight" field was cleared in this mutation.
+func (m *EnvPanelMutation) WeightCleared() bool {
+	_, ok := m.clearedFields[envpanel.FieldWeight]
+	return ok
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *EnvPanelMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+	delete(m.clearedFields, envpanel.FieldWeight)
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (m *EnvPanelMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
+}
+
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *EnvPanelMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *EnvPanelMutation) ResetIsEnable() {
+	m.is_enable = nil
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvPanelMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envpanel.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvPanelMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvPanelMutation) EnvIDs() (ids []int64) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvPanelMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// ClearPanel clears the "panel" edge to the Panel entity.
+func (m *EnvPanelMutation) ClearPanel() {
+	m.clearedpanel = true
+	m.clearedFields[envpanel.FieldPanelID] = struct{}{}
+}
+
+// PanelCleared reports if the "panel" edge to the Panel entity was cleared.
+func (m *EnvPanelMutation) PanelCleared() bool {
+	return m.clearedpanel
+}
+
+// PanelIDs returns the "panel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PanelID instead. It exists only for internal usage by the builders.
+func (m *EnvPanelMutation) PanelIDs() (ids []int64) {
+	if id := m.panel; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPanel resets all changes to the "panel" edge.
+func (m *EnvPanelMutation) ResetPanel() {
+	m.panel = nil
+	m.clearedpanel = false
+}
+
+// Where appends a list predicates to the EnvPanelMutation builder.
+func (m *EnvPanelMutation) Where(ps ...predicate.EnvPanel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvPanelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvPanelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvPanel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvPanelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvPanelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvPanel).
+func (m *EnvPanelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvPanelMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.env != nil {
+		fields = append(fields, envpanel.FieldEnvID)
+	}
+	if m.panel != nil {
+		fields = append(fields, envpanel.FieldPanelID)
+	}
+	if m.max_count != nil {
+		fields = append(fields, envpanel.FieldMaxCount)
+	}
+	if m.weight != nil {
+		fields = append(fields, envpanel.FieldWeight)
+	}
+	if m.is_enable != nil {
+		fields = append(fields, envpanel.FieldIsEnable)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvPanelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envpanel.FieldEnvID:
+		return m.EnvID()
+	case envpanel.FieldPanelID:
+		return m.PanelID()
+	case envpanel.FieldMaxCount:
+		return m.MaxCount()
+	case envpanel.FieldWeight:
+		return m.Weight()
+	case envpanel.FieldIsEnable:
+		return m.IsEnable()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvPanelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("edge schema EnvPanel does not support getting old values")
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvPanelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envpanel.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envpanel.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPanelID(v)
+		return nil
+	case envpanel.FieldMaxCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCount(v)
+		return nil
+	case envpanel.FieldWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case envpanel.FieldIsEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnable(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPanel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvPanelMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_count != nil {
+		fields = append(fields, envpanel.FieldMaxCount)
+	}
+	if m.addweight != nil {
+		fields = append(fields, envpanel.FieldWeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvPanelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envpanel.FieldMaxCount:
+		return m.AddedMaxCount()
+	case envpanel.FieldWeight:
+		return m.AddedWeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvPanelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envpanel.FieldMaxCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCount(v)
+		return nil
+	case envpanel.FieldWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPanel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvPanelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envpanel.FieldWeight) {
+		fields = append(fields, envpanel.FieldWeight)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvPanelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvPanelMutation) ClearField(name string) error {
+	switch name {
+	case envpanel.FieldWeight:
+		m.ClearWeight()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPanel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvPanelMutation) ResetField(name string) error {
+	switch name {
+	case envpanel.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envpanel.FieldPanelID:
+		m.ResetPanelID()
+		return nil
+	case envpanel.FieldMaxCount:
+		m.ResetMaxCount()
+		return nil
+	case envpanel.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case envpanel.FieldIsEnable:
+		m.ResetIsEnable()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPanel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvPanelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.env != nil {
+		edges = append(edges, envpanel.EdgeEnv)
+	}
+	if m.panel != nil {
+		edges = append(edges, envpanel.EdgePanel)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvPanelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envpanel.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	case envpanel.EdgePanel:
+		if id := m.panel; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvPanelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvPanelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvPanelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedenv {
+		edges = append(edges, envpanel.EdgeEnv)
+	}
+	if m.clearedpanel {
+		edges = append(edges, envpanel.EdgePanel)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvPanelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envpanel.EdgeEnv:
+		return m.clearedenv
+	case envpanel.EdgePanel:
+		return m.clearedpanel
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvPanelMutation) ClearEdge(name string) error {
+	switch name {
+	case envpanel.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	case envpanel.EdgePanel:
+		m.ClearPanel()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPanel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvPanelMutation) ResetEdge(name string) error {
+	switch name {
+	case envpanel.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	case envpanel.EdgePanel:
+		m.ResetPanel()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPanel edge %s", name)
+}
+
+// EnvPluginMutation represents an operation that mutates the EnvPlugin nodes in the graph.
+type EnvPluginMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int64
+	created_at              *time.Time
+	updated_at              *time.Time
+	is_enable               *bool
+	execution_order         *int32
+	addexecution_order      *int32
+	_config                 *string
+	failure_policy          *string
+	failure_threshold       *int32
+	addfailure_threshold    *int32
+	cooldown_seconds        *int32
+	addcooldown_seconds     *int32
+	consecutive_failures    *int32
+	addconsecutive_failures *int32
+	disabled_until          *time.Time
+	disabled_reason         *string
+	clearedFields           map[string]struct{}
+	env                     *int64
+	clearedenv              bool
+	plugin                  *int64
+	clearedplugin           bool
+	done                    bool
+	oldValue                func(context.Context) (*EnvPlugin, error)
+	predicates              []predicate.EnvPlugin
+}
+
+var _ ent.Mutation = (*EnvPluginMutation)(nil)
+
+// envpluginOption allows management of the mutation configuration using functional options.
+type envpluginOption func(*EnvPluginMutation)
+
+// newEnvPluginMutation creates new mutation for the EnvPlugin entity.
+func newEnvPluginMutation(c config, op Op, opts ...envpluginOption) *EnvPluginMutation {
+	m := &EnvPluginMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvPlugin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvPluginID sets the ID field of the mutation.
+func withEnvPluginID(id int64) envpluginOption {
+	return func(m *EnvPluginMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvPlugin
+		)
+		m.oldValue = func(ctx context.Context) (*EnvPlugin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvPlugin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvPlugin sets the old EnvPlugin of the mutation.
+func withEnvPlugin(node *EnvPlugin) envpluginOption {
+	return func(m *EnvPluginMutation) {
+		m.oldValue = func(context.Context) (*EnvPlugin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvPluginMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvPluginMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvPlugin entities.
+func (m *EnvPluginMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvPluginMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvPluginMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvPlugin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvPluginMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvPluginMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvPluginMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvPluginMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvPluginMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvPluginMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvPluginMutation) SetEnvID(i int64) {
+	m.env = &i
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvPluginMutation) EnvID() (r int64, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldEnvID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvPluginMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetPluginID sets the "plugin_id" field.
+func (m *EnvPluginMutation) SetPluginID(i int64) {
+	m.plugin = &i
+}
+
+// PluginID returns the value of the "plugin_id" field in the mutation.
+func (m *EnvPluginMutation) PluginID() (r int64, exists bool) {
+	v := m.plugin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPluginID returns the old "plugin_id" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldPluginID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPluginID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPluginID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPluginID: %w", err)
+	}
+	return oldValue.PluginID, nil
+}
+
+// ResetPluginID resets all changes to the "plugin_id" field.
+func (m *EnvPluginMutation) ResetPluginID() {
+	m.plugin = nil
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (m *EnvPluginMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
+}
+
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *EnvPluginMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnable returns the old "is_enable" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+	}
+	return oldValue.IsEnable, nil
+}
+
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *EnvPluginMutation) ResetIsEnable() {
+	m.is_enable = nil
+}
+
+// SetExecutionOrder sets the "execution_order" field.
+func (m *EnvPluginMutation) SetExecutionOrder(i int32) {
+	m.execution_order = &i
+	m.addexecution_order = nil
+}
+
+// ExecutionOrder returns the value of the "execution_order" field in the mutation.
+func (m *EnvPluginMutation) ExecutionOrder() (r int32, exists bool) {
+	v := m.execution_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutionOrder returns the old "execution_order" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldExecutionOrder(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutionOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutionOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutionOrder: %w", err)
+	}
+	return oldValue.ExecutionOrder, nil
+}
+
+// AddExecutionOrder adds i to the "execution_order" field.
+func (m *EnvPluginMutation) AddExecutionOrder(i int32) {
+	if m.addexecution_order != nil {
+		*m.addexecution_order += i
+	} else {
+		m.addexecution_order = &i
+	}
+}
+
+// AddedExecutionOrder returns the value that was added to the "execution_order" field in this mutation.
+func (m *EnvPluginMutation) AddedExecutionOrder() (r int32, exists bool) {
+	v := m.addexecution_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExecutionOrder resets all changes to the "execution_order" field.
+func (m *EnvPluginMutation) ResetExecutionOrder() {
+	m.execution_order = nil
+	m.addexecution_order = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *EnvPluginMutation) SetConfig(s string) {
+	m._config = &s
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *EnvPluginMutation) Config() (r string, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldConfig(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ClearConfig clears the value of the "config" field.
+func (m *EnvPluginMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[envplugin.FieldConfig] = struct{}{}
+}
+
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *EnvPluginMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[envplugin.FieldConfig]
+	return ok
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *EnvPluginMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, envplugin.FieldConfig)
+}
+
+// SetFailurePolicy sets the "failure_policy" field.
+func (m *EnvPluginMutation) SetFailurePolicy(s string) {
+	m.failure_policy = &s
+}
+
+// FailurePolicy returns the value of the "failure_policy" field in the mutation.
+func (m *EnvPluginMutation) FailurePolicy() (r string, exists bool) {
+	v := m.failure_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailurePolicy returns the old "failure_policy" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldFailurePolicy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailurePolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailurePolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailurePolicy: %w", err)
+	}
+	return oldValue.FailurePolicy, nil
+}
+
+// ResetFailurePolicy resets all changes to the "failure_policy" field.
+func (m *EnvPluginMutation) ResetFailurePolicy() {
+	m.failure_policy = nil
+}
+
+// SetFailureThreshold sets the "failure_threshold" field.
+func (m *EnvPluginMutation) SetFailureThreshold(i int32) {
+	m.failure_threshold = &i
+	m.addfailure_threshold = nil
+}
+
+// FailureThreshold returns the value of the "failure_threshold" field in the mutation.
+func (m *EnvPluginMutation) FailureThreshold() (r int32, exists bool) {
+	v := m.failure_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureThreshold returns the old "failure_threshold" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldFailureThreshold(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureThreshold: %w", err)
+	}
+	return oldValue.FailureThreshold, nil
+}
+
+// AddFailureThreshold adds i to the "failure_threshold" field.
+func (m *EnvPluginMutation) AddFailureThreshold(i int32) {
+	if m.addfailure_threshold != nil {
+		*m.addfailure_threshold += i
+	} else {
+		m.addfailure_threshold = &i
+	}
+}
+
+// AddedFailureThreshold returns the value that was added to the "failure_threshold" field in this mutation.
+func (m *EnvPluginMutation) AddedFailureThreshold() (r int32, exists bool) {
+	v := m.addfailure_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailureThreshold resets all changes to the "failure_threshold" field.
+func (m *EnvPluginMutation) ResetFailureThreshold() {
+	m.failure_threshold = nil
+	m.addfailure_threshold = nil
+}
+
+// SetCooldownSeconds sets the "cooldown_seconds" field.
+func (m *EnvPluginMutation) SetCooldownSeconds(i int32) {
+	m.cooldown_seconds = &i
+	m.addcooldown_seconds = nil
+}
+
+// CooldownSeconds returns the value of the "cooldown_seconds" field in the mutation.
+func (m *EnvPluginMutation) CooldownSeconds() (r int32, exists bool) {
+	v := m.cooldown_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCooldownSeconds returns the old "cooldown_seconds" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldCooldownSeconds(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCooldownSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCooldownSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCooldownSeconds: %w", err)
+	}
+	return oldValue.CooldownSeconds, nil
+}
+
+// AddCooldownSeconds adds i to the "cooldown_seconds" field.
+func (m *EnvPluginMutation) AddCooldownSeconds(i int32) {
+	if m.addcooldown_seconds != nil {
+		*m.addcooldown_seconds += i
+	} else {
+		m.addcooldown_seconds = &i
+	}
+}
+
+// AddedCooldownSeconds returns the value that was added to the "cooldown_seconds" field in this mutation.
+func (m *EnvPluginMutation) AddedCooldownSeconds() (r int32, exists bool) {
+	v := m.addcooldown_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCooldownSeconds resets all changes to the "cooldown_seconds" field.
+func (m *EnvPluginMutation) ResetCooldownSeconds() {
+	m.cooldown_seconds = nil
+	m.addcooldown_seconds = nil
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *EnvPluginMutation) SetConsecutiveFailures(i int32) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *EnvPluginMutation) ConsecutiveFailures() (r int32, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldConsecutiveFailures(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *EnvPluginMutation) AddConsecutiveFailures(i int32) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
+}
+
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *EnvPluginMutation) AddedConsecutiveFailures() (r int32, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *EnvPluginMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
+}
+
+// SetDisabledUntil sets the "disabled_until" field.
+func (m *EnvPluginMutation) SetDisabledUntil(t time.Time) {
+	m.disabled_until = &t
+}
+
+// DisabledUntil returns the value of the "disabled_until" field in the mutation.
+func (m *EnvPluginMutation) DisabledUntil() (r time.Time, exists bool) {
+	v := m.disabled_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisabledUntil returns the old "disabled_until" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldDisabledUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabledUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabledUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabledUntil: %w", err)
+	}
+	return oldValue.DisabledUntil, nil
+}
+
+// ClearDisabledUntil clears the value of the "disabled_until" field.
+func (m *EnvPluginMutation) ClearDisabledUntil() {
+	m.disabled_until = nil
+	m.clearedFields[envplugin.FieldDisabledUntil] = struct{}{}
+}
+
+// DisabledUntilCleared returns if the "disabled_until" field was cleared in this mutation.
+func (m *EnvPluginMutation) DisabledUntilCleared() bool {
+	_, ok := m.clearedFields[envplugin.FieldDisabledUntil]
+	return ok
+}
+
+// ResetDisabledUntil resets all changes to the "disabled_until" field.
+func (m *EnvPluginMutation) ResetDisabledUntil() {
+	m.disabled_until = nil
+	delete(m.clearedFields, envplugin.FieldDisabledUntil)
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (m *EnvPluginMutation) SetDisabledReason(s string) {
+	m.disabled_reason = &s
+}
+
+// DisabledReason returns the value of the "disabled_reason" field in the mutation.
+func (m *EnvPluginMutation) DisabledReason() (r string, exists bool) {
+	v := m.disabled_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisabledReason returns the old "disabled_reason" field's value of the EnvPlugin entity.
+// If the EnvPlugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvPluginMutation) OldDisabledReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabledReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabledReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabledReason: %w", err)
+	}
+	return oldValue.DisabledReason, nil
+}
+
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (m *EnvPluginMutation) ClearDisabledReason() {
+	m.disabled_reason = nil
+	m.clearedFields[envplugin.FieldDisabledReason] = struct{}{}
+}
+
+// DisabledReasonCleared returns if the "disabled_reason" field was cleared in this mutation.
+func (m *EnvPluginMutation) DisabledReasonCleared() bool {
+	_, ok := m.clearedFields[envplugin.FieldDisabledReason]
+	return ok
+}
+
+// ResetDisabledReason resets all changes to the "disabled_reason" field.
+func (m *EnvPluginMutation) ResetDisabledReason() {
+	m.disabled_reason = nil
+	delete(m.clearedFields, envplugin.FieldDisabledReason)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvPluginMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envplugin.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvPluginMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvPluginMutation) EnvIDs() (ids []int64) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvPluginMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// ClearPlugin clears the "plugin" edge to the Plugin entity.
+func (m *EnvPluginMutation) ClearPlugin() {
+	m.clearedplugin = true
+	m.clearedFields[envplugin.FieldPluginID] = struct{}{}
+}
+
+// PluginCleared reports if the "plugin" edge to the Plugin entity was cleared.
+func (m *EnvPluginMutation) PluginCleared() bool {
+	return m.clearedplugin
+}
+
+// PluginIDs returns the "plugin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PluginID instead. It exists only for internal usage by the builders.
+func (m *EnvPluginMutation) PluginIDs() (ids []int64) {
+	if id := m.plugin; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlugin resets all changes to the "plugin" edge.
+func (m *EnvPluginMutation) ResetPlugin() {
+	m.plugin = nil
+	m.clearedplugin = false
+}
+
+// Where appends a list predicates to the EnvPluginMutation builder.
+func (m *EnvPluginMutation) Where(ps ...predicate.EnvPlugin) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvPluginMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvPluginMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvPlugin, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvPluginMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvPluginMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvPlugin).
+func (m *EnvPluginMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvPluginMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, envplugin.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envplugin.FieldUpdatedAt)
+	}
+	if m.env != nil {
+		fields = append(fields, envplugin.FieldEnvID)
+	}
+	if m.plugin != nil {
+		fields = append(fields, envplugin.FieldPluginID)
+	}
+	if m.is_enable != nil {
+		fields = append(fields, envplugin.FieldIsEnable)
+	}
+	if m.execution_order != nil {
+		fields = append(fields, envplugin.FieldExecutionOrder)
+	}
+	if m._config != nil {
+		fields = append(fields, envplugin.FieldConfig)
+	}
+	if m.failure_policy != nil {
+		fields = append(fields, envplugin.FieldFailurePolicy)
+	}
+	if m.failure_threshold != nil {
+		fields = append(fields, envplugin.FieldFailureThreshold)
+	}
+	if m.cooldown_seconds != nil {
+		fields = append(fields, envplugin.FieldCooldownSeconds)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, envplugin.FieldConsecutiveFailures)
+	}
+	if m.disabled_until != nil {
+		fields = append(fields, envplugin.FieldDisabledUntil)
+	}
+	if m.disabled_reason != nil {
+		fields = append(fields, envplugin.FieldDisabledReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvPluginMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envplugin.FieldCreatedAt:
+		return m.CreatedAt()
+	case envplugin.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envplugin.FieldEnvID:
+		return m.EnvID()
+	case envplugin.FieldPluginID:
+		return m.PluginID()
+	case envplugin.FieldIsEnable:
+		return m.IsEnable()
+	case envplugin.FieldExecutionOrder:
+		return m.ExecutionOrder()
+	case envplugin.FieldConfig:
+		return m.Config()
+	case envplugin.FieldFailurePolicy:
+		return m.FailurePolicy()
+	case envplugin.FieldFailureThreshold:
+		return m.FailureThreshold()
+	case envplugin.FieldCooldownSeconds:
+		return m.CooldownSeconds()
+	case envplugin.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case envplugin.FieldDisabledUntil:
+		return m.DisabledUntil()
+	case envplugin.FieldDisabledReason:
+		return m.DisabledReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvPluginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envplugin.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envplugin.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envplugin.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envplugin.FieldPluginID:
+		return m.OldPluginID(ctx)
+	case envplugin.FieldIsEnable:
+		return m.OldIsEnable(ctx)
+	case envplugin.FieldExecutionOrder:
+		return m.OldExecutionOrder(ctx)
+	case envplugin.FieldConfig:
+		return m.OldConfig(ctx)
+	case envplugin.FieldFailurePolicy:
+		return m.OldFailurePolicy(ctx)
+	case envplugin.FieldFailureThreshold:
+		return m.OldFailureThreshold(ctx)
+	case envplugin.FieldCooldownSeconds:
+		return m.OldCooldownSeconds(ctx)
+	case envplugin.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case envplugin.FieldDisabledUntil:
+		return m.OldDisabledUntil(ctx)
+	case envplugin.FieldDisabledReason:
+		return m.OldDisabledReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvPlugin field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvPluginMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envplugin.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envplugin.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envplugin.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envplugin.FieldPluginID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPluginID(v)
+		return nil
+	case envplugin.FieldIsEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnable(v)
+		return nil
+	case envplugin.FieldExecutionOrder:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionOrder(v)
+		return nil
+	case envplugin.FieldConfig:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case envplugin.FieldFailurePolicy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailurePolicy(v)
+		return nil
+	case envplugin.FieldFailureThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureThreshold(v)
+		return nil
+	case envplugin.FieldCooldownSeconds:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCooldownSeconds(v)
+		return nil
+	case envplugin.FieldConsecutiveFailures:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case envplugin.FieldDisabledUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabledUntil(v)
+		return nil
+	case envplugin.FieldDisabledReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabledReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPlugin field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvPluginMutation) AddedFields() []string {
+	var fields []string
+	if m.addexecution_order != nil {
+		fields = append(fields, envplugin.FieldExecutionOrder)
+	}
+	if m.addfailure_threshold != nil {
+		fields = append(fields, envplugin.FieldFailureThreshold)
+	}
+	if m.addcooldown_seconds != nil {
+		fields = append(fields, envplugin.FieldCooldownSeconds)
+	}
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, envplugin.FieldConsecutiveFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvPluginMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envplugin.FieldExecutionOrder:
+		return m.AddedExecutionOrder()
+	case envplugin.FieldFailureThreshold:
+		return m.AddedFailureThreshold()
+	case envplugin.FieldCooldownSeconds:
+		return m.AddedCooldownSeconds()
+	case envplugin.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvPluginMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envplugin.FieldExecutionOrder:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExecutionOrder(v)
+		return nil
+	case envplugin.FieldFailureThreshold:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailureThreshold(v)
+		return nil
+	case envplugin.FieldCooldownSeconds:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCooldownSeconds(v)
+		return nil
+	case envplugin.FieldConsecutiveFailures:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPlugin numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvPluginMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envplugin.FieldConfig) {
+		fields = append(fields, envplugin.FieldConfig)
+	}
+	if m.FieldCleared(envplugin.FieldDisabledUntil) {
+		fields = append(fields, envplugin.FieldDisabledUntil)
+	}
+	if m.FieldCleared(envplugin.FieldDisabledReason) {
+		fields = append(fields, envplugin.FieldDisabledReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvPluginMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvPluginMutation) ClearField(name string) error {
+	switch name {
+	case envplugin.FieldConfig:
+		m.ClearConfig()
+		return nil
+	case envplugin.FieldDisabledUntil:
+		m.ClearDisabledUntil()
+		return nil
+	case envplugin.FieldDisabledReason:
+		m.ClearDisabledReason()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPlugin nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvPluginMutation) ResetField(name string) error {
+	switch name {
+	case envplugin.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envplugin.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envplugin.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envplugin.FieldPluginID:
+		m.ResetPluginID()
+		return nil
+	case envplugin.FieldIsEnable:
+		m.ResetIsEnable()
+		return nil
+	case envplugin.FieldExecutionOrder:
+		m.ResetExecutionOrder()
+		return nil
+	case envplugin.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case envplugin.FieldFailurePolicy:
+		m.ResetFailurePolicy()
+		return nil
+	case envplugin.FieldFailureThreshold:
+		m.ResetFailureThreshold()
+		return nil
+	case envplugin.FieldCooldownSeconds:
+		m.ResetCooldownSeconds()
+		return nil
+	case envplugin.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case envplugin.FieldDisabledUntil:
+		m.ResetDisabledUntil()
+		return nil
+	case envplugin.FieldDisabledReason:
+		m.ResetDisabledReason()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPlugin field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvPluginMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.env != nil {
+		edges = append(edges, envplugin.EdgeEnv)
+	}
+	if m.plugin != nil {
+		edges = append(edges, envplugin.EdgePlugin)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvPluginMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envplugin.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	case envplugin.EdgePlugin:
+		if id := m.plugin; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvPluginMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvPluginMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvPluginMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedenv {
+		edges = append(edges, envplugin.EdgeEnv)
+	}
+	if m.clearedplugin {
+		edges = append(edges, envplugin.EdgePlugin)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvPluginMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envplugin.EdgeEnv:
+		return m.clearedenv
+	case envplugin.EdgePlugin:
+		return m.clearedplugin
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvPluginMutation) ClearEdge(name string) error {
+	switch name {
+	case envplugin.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	case envplugin.EdgePlugin:
+		m.ClearPlugin()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPlugin unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvPluginMutation) ResetEdge(name string) error {
+	switch name {
+	case envplugin.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	case envplugin.EdgePlugin:
+		m.ResetPlugin()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvPlugin edge %s", name)
+}
+
+// EnvReplicaMutation represents an operation that mutates the EnvReplica nodes in the graph.
+type EnvReplicaMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	created_at      *time.Time
+	updated_at      *time.Time
+	env_id          *int64
+	addenv_id       *int64
+	group_id        *string
+	panel_id        *int64
+	addpanel_id     *int64
+	panel_env_id    *int
+	addpanel_env_id *int
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*EnvReplica, error)
+	predicates      []predicate.EnvReplica
+}
+
+var _ ent.Mutation = (*EnvReplicaMutation)(nil)
+
+// envreplicaOption allows management of the mutation configuration using functional options.
+type envreplicaOption func(*EnvReplicaMutation)
+
+// newEnvReplicaMutation creates new mutation for the EnvReplica entity.
+func newEnvReplicaMutation(c config, op Op, opts ...envreplicaOption) *EnvReplicaMutation {
+	m := &EnvReplicaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvReplica,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvReplicaID sets the ID field of the mutation.
+func withEnvReplicaID(id int64) envreplicaOption {
+	return func(m *EnvReplicaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvReplica
+		)
+		m.oldValue = func(ctx context.Context) (*EnvReplica, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvReplica.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvReplica sets the old EnvReplica of the mutation.
+func withEnvReplica(node *EnvReplica) envreplicaOption {
+	return func(m *EnvReplicaMutation) {
+		m.oldValue = func(context.Context) (*EnvReplica, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvReplicaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvReplicaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvReplica entities.
+func (m *EnvReplicaMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvReplicaMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvReplicaMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvReplica.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvReplicaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvReplicaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvReplica entity.
+// If the EnvReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvReplicaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvReplicaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvReplicaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvReplicaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvReplica entity.
+// If the EnvReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvReplicaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvReplicaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvReplicaMutation) SetEnvID(i int64) {
+	m.env_id = &i
+	m.addenv_id = nil
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvReplicaMutation) EnvID() (r int64, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvReplica entity.
+// If the EnvReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvReplicaMutation) OldEnvID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// AddEnvID adds i to the "env_id" field.
+func (m *EnvReplicaMutation) AddEnvID(i int64) {
+	if m.addenv_id != nil {
+		*m.addenv_id += i
+	} else {
+		m.addenv_id = &i
+	}
+}
+
+// AddedEnvID returns the value that was added to the "env_id" field in this mutation.
+func (m *EnvReplicaMutation) AddedEnvID() (r int64, exists bool) {
+	v := m.addenv_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvReplicaMutation) ResetEnvID() {
+	m.env_id = nil
+	m.addenv_id = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *EnvReplicaMutation) SetGroupID(s string) {
+	m.group_id = &s
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *EnvReplicaMutation) GroupID() (r string, exists bool) {
+	v := m.group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the EnvReplica entity.
+// If the EnvReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvReplicaMutation) OldGroupID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *EnvReplicaMutation) ResetGroupID() {
+	m.group_id = nil
+}
+
+// SetPanelID sets the "panel_id" field.
+func (m *EnvReplicaMutation) SetPanelID(i int64) {
+	m.panel_id = &i
+	m.addpanel_id = nil
+}
+
+// PanelID returns the value of the "panel_id" field in the mutation.
+func (m *EnvReplicaMutation) PanelID() (r int64, exists bool) {
+	v := m.panel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPanelID returns the old "panel_id" field's value of the EnvReplica entity.
+// If the EnvReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvReplicaMutation) OldPanelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPanelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPanelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPanelID: %w", err)
+	}
+	return oldValue.PanelID, nil
+}
+
+// AddPanelID adds i to the "panel_id" field.
+func (m *EnvReplicaMutation) AddPanelID(i int64) {
+	if m.addpanel_id != nil {
+		*m.addpanel_id += i
+	} else {
+		m.addpanel_id = &i
+	}
+}
+
+// AddedPanelID returns the value that was added to the "panel_id" field in this mutation.
+func (m *EnvReplicaMutation) AddedPanelID() (r int64, exists bool) {
+	v := m.addpanel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPanelID resets all changes to the "panel_id" field.
+func (m *EnvReplicaMutation) ResetPanelID() {
+	m.panel_id = nil
+	m.addpanel_id = nil
+}
+
+// SetPanelEnvID sets the "panel_env_id" field.
+func (m *EnvReplicaMutation) SetPanelEnvID(i int) {
+	m.panel_env_id = &i
+	m.addpanel_env_id = nil
+}
+
+// PanelEnvID returns the value of the "panel_env_id" field in the mutation.
+func (m *EnvReplicaMutation) PanelEnvID() (r int, exists bool) {
+	v := m.panel_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPanelEnvID returns the old "panel_env_id" field's value of the EnvReplica entity.
+// If the EnvReplica object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvReplicaMutation) OldPanelEnvID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPanelEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPanelEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPanelEnvID: %w", err)
+	}
+	return oldValue.PanelEnvID, nil
+}
+
+// AddPanelEnvID adds i to the "panel_env_id" field.
+func (m *EnvReplicaMutation) AddPanelEnvID(i int) {
+	if m.addpanel_env_id != nil {
+		*m.addpanel_env_id += i
+	} else {
+		m.addpanel_env_id = &i
+	}
+}
+
+// AddedPanelEnvID returns the value that was added to the "panel_env_id" field in this mutation.
+func (m *EnvReplicaMutation) AddedPanelEnvID() (r int, exists bool) {
+	v := m.addpanel_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPanelEnvID resets all changes to the "panel_env_id" field.
+func (m *EnvReplicaMutation) ResetPanelEnvID() {
+	m.panel_env_id = nil
+	m.addpanel_env_id = nil
+}
+
+// Where appends a list predicates to the EnvReplicaMutation builder.
+func (m *EnvReplicaMutation) Where(ps ...predicate.EnvReplica) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvReplicaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvReplicaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvReplica, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvReplicaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvReplicaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvReplica).
+func (m *EnvReplicaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvReplicaMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, envreplica.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envreplica.FieldUpdatedAt)
+	}
+	if m.env_id != nil {
+		fields = append(fields, envreplica.FieldEnvID)
+	}
+	if m.group_id != nil {
+		fields = append(fields, envreplica.FieldGroupID)
+	}
+	if m.panel_id != nil {
+		fields = append(fields, envreplica.FieldPanelID)
+	}
+	if m.panel_env_id != nil {
+		fields = append(fields, envreplica.FieldPanelEnvID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvReplicaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envreplica.FieldCreatedAt:
+		return m.CreatedAt()
+	case envreplica.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envreplica.FieldEnvID:
+		return m.EnvID()
+	case envreplica.FieldGroupID:
+		return m.GroupID()
+	case envreplica.FieldPanelID:
+		return m.PanelID()
+	case envreplica.FieldPanelEnvID:
+		return m.PanelEnvID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvReplicaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envreplica.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envreplica.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envreplica.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envreplica.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case envreplica.FieldPanelID:
+		return m.OldPanelID(ctx)
+	case envreplica.FieldPanelEnvID:
+		return m.OldPanelEnvID(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvReplica field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvReplicaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envreplica.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envreplica.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envreplica.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envreplica.FieldGroupID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case envreplica.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPanelID(v)
+		return nil
+	case envreplica.FieldPanelEnvID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPanelEnvID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvReplica field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvReplicaMutation) AddedFields() []string {
+	var fields []string
+	if m.addenv_id != nil {
+		fields = append(fields, envreplica.FieldEnvID)
+	}
+	if m.addpanel_id != nil {
+		fields = append(fields, envreplica.FieldPanelID)
+	}
+	if m.addpanel_env_id != nil {
+		fields = append(fields, envreplica.FieldPanelEnvID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvReplicaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envreplica.FieldEnvID:
+		return m.AddedEnvID()
+	case envreplica.FieldPanelID:
+		return m.AddedPanelID()
+	case envreplica.FieldPanelEnvID:
+		return m.AddedPanelEnvID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvReplicaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envreplica.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnvID(v)
+		return nil
+	case envreplica.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPanelID(v)
+		return nil
+	case envreplica.FieldPanelEnvID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPanelEnvID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvReplica numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvReplicaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvReplicaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvReplicaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EnvReplica nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvReplicaMutation) ResetField(name string) error {
+	switch name {
+	case envreplica.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envreplica.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envreplica.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envreplica.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case envreplica.FieldPanelID:
+		m.ResetPanelID()
+		return nil
+	case envreplica.FieldPanelEnvID:
+		m.ResetPanelEnvID()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvReplica field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvReplicaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvReplicaMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvReplicaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvReplicaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvReplicaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvReplicaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvReplicaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EnvReplica unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvReplicaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EnvReplica edge %s", name)
+}
+
+// IdempotencyRecordMutation represents an operation that mutates the IdempotencyRecord nodes in the graph.
+type IdempotencyRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	key           *string
+	request_hash  *string
+	owner         *string
+	status        *string
+	response      *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyRecord, error)
+	predicates    []predicate.IdempotencyRecord
+}
+
+var _ ent.Mutation = (*IdempotencyRecordMutation)(nil)
+
+// idempotencyrecordOption allows management of the mutation configuration using functional options.
+type idempotencyrecordOption func(*IdempotencyRecordMutation)
+
+// newIdempotencyRecordMutation creates new mutation for the IdempotencyRecord entity.
+func newIdempotencyRecordMutation(c config, op Op, opts ...idempotencyrecordOption) *IdempotencyRecordMutation {
+	m := &IdempotencyRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyRecordID sets the ID field of the mutation.
+func withIdempotencyRecordID(id int64) idempotencyrecordOption {
+	return func(m *IdempotencyRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyRecord
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyRecord sets the old IdempotencyRecord of the mutation.
+func withIdempotencyRecord(node *IdempotencyRecord) idempotencyrecordOption {
+	return func(m *IdempotencyRecordMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyRecord entities.
+func (m *IdempotencyRecordMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyRecordMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyRecordMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyRecordMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyRecordMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyRecordMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyRecordMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyRecordMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyRecordMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *IdempotencyRecordMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *IdempotencyRecordMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *IdempotencyRecordMutation) ResetOwner() {
+	m.owner = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *IdempotencyRecordMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IdempotencyRecordMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IdempotencyRecordMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetResponse sets the "response" field.
+func (m *IdempotencyRecordMutation) SetResponse(s string) {
+	m.response = &s
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *IdempotencyRecordMutation) Response() (r string, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldResponse(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *IdempotencyRecordMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[idempotencyrecord.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *IdempotencyRecordMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[idempotencyrecord.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *IdempotencyRecordMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, idempotencyrecord.FieldResponse)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyRecordMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyRecordMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyRecord entity.
+// If the IdempotencyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyRecordMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyRecordMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyRecordMutation builder.
+func (m *IdempotencyRecordMutation) Where(ps ...predicate.IdempotencyRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyRecord).
+func (m *IdempotencyRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyRecordMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, idempotencyrecord.FieldCreatedAt)
+	}
+	if m.key != nil {
+		fields = append(fields, idempotencyrecord.FieldKey)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencyrecord.FieldRequestHash)
+	}
+	if m.owner != nil {
+		fields = append(fields, idempotencyrecord.FieldOwner)
+	}
+	if m.status != nil {
+		fields = append(fields, idempotencyrecord.FieldStatus)
+	}
+	if m.response != nil {
+		fields = append(fields, idempotencyrecord.FieldResponse)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencyrecord.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencyrecord.FieldCreatedAt:
+		return m.CreatedAt()
+	case idempotencyrecord.FieldKey:
+		return m.Key()
+	case idempotencyrecord.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencyrecord.FieldOwner:
+		return m.Owner()
+	case idempotencyrecord.FieldStatus:
+		return m.Status()
+	case idempotencyrecord.FieldResponse:
+		return m.Response()
+	case idempotencyrecord.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencyrecord.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case idempotencyrecord.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencyrecord.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencyrecord.FieldOwner:
+		return m.OldOwner(ctx)
+	case idempotencyrecord.FieldStatus:
+		return m.OldStatus(ctx)
+	case idempotencyrecord.FieldResponse:
+		return m.OldResponse(ctx)
+	case idempotencyrecord.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencyrecord.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case idempotencyrecord.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencyrecord.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencyrecord.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case idempotencyrecord.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case idempotencyrecord.FieldResponse:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case idempotencyrecord.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyRecordMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyRecordMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencyrecord.FieldResponse) {
+		fields = append(fields, idempotencyrecord.FieldResponse)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyRecordMutation) ClearField(name string) error {
+	switch name {
+	case idempotencyrecord.FieldResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyRecordMutation) ResetField(name string) error {
+	switch name {
+	case idempotencyrecord.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case idempotencyrecord.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencyrecord.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencyrecord.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case idempotencyrecord.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case idempotencyrecord.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case idempotencyrecord.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyRecord edge %s", name)
+}
+
+// LoginHistoryMutation represents an operation that mutates the LoginHistory nodes in the graph.
+type LoginHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	ip            *string
+	address       *string
+	state         *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LoginHistory, error)
+	predicates    []predicate.LoginHistory
+}
+
+var _ ent.Mutation = (*LoginHistoryMutation)(nil)
+
+// loginhistoryOption allows management of the mutation configuration using functional options.
+type loginhistoryOption func(*LoginHistoryMutation)
+
+// newLoginHistoryMutation creates new mutation for the LoginHistory entity.
+func newLoginHistoryMutation(c config, op Op, opts ...loginhistoryOption) *LoginHistoryMutation {
+	m := &LoginHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginHistoryID sets the ID field of the mutation.
+func withLoginHistoryID(id int64) loginhistoryOption {
+	return func(m *LoginHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginHistory
+		)
+		m.oldValue = func(ctx context.Context) (*LoginHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginHistory sets the old LoginHistory of the mutation.
+func withLoginHistory(node *LoginHistory) loginhistoryOption {
+	return func(m *LoginHistoryMutation) {
+		m.oldValue = func(context.Context) (*LoginHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoginHistory entities.
+func (m *LoginHistoryMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginHistoryMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginHistoryMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoginHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoginHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoginHistory entity.
+// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoginHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LoginHistoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LoginHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LoginHistory entity.
+// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LoginHistoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetIP sets the "ip" field.
+func (m *LoginHistoryMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *LoginHistoryMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the LoginHistory entity.
+// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginHistoryMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *LoginHistoryMutation) ResetIP() {
+	m.ip = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *LoginHistoryMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *LoginHistoryMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the LoginHistory entity.
+// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginHistoryMutation) OldAddress(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *LoginHistoryMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[loginhistory.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *LoginHistoryMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[loginhistory.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *LoginHistoryMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, loginhistory.FieldAddress)
+}
+
+// SetState sets the "state" field.
+func (m *LoginHistoryMutation) SetState(b bool) {
+	m.state = &b
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *LoginHistoryMutation) State() (r bool, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the LoginHistory entity.
+// If the LoginHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginHistoryMutation) OldState(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *LoginHistoryMutation) ResetState() {
+	m.state = nil
+}
+
+// Where appends a list predicates to the LoginHistoryMutation builder.
+func (m *LoginHistoryMutation) Where(ps ...predicate.LoginHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoginHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoginHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoginHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoginHistory).
+func (m *LoginHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, loginhistory.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, loginhistory.FieldUpdatedAt)
+	}
+	if m.ip != nil {
+		fields = append(fields, loginhistory.FieldIP)
+	}
+	if m.address != nil {
+		fields = append(fields, loginhistory.FieldAddress)
+	}
+	if m.state != nil {
+		fields = append(fields, loginhistory.FieldState)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	case loginhistory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case loginhistory.FieldIP:
+		return m.IP()
+	case loginhistory.FieldAddress:
+		return m.Address()
+	case loginhistory.FieldState:
+		return m.State()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case loginhistory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case loginhistory.FieldIP:
+		return m.OldIP(ctx)
+	case loginhistory.FieldAddress:
+		return m.OldAddress(ctx)
+	case loginhistory.FieldState:
+		return m.OldState(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case loginhistory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case loginhistory.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case loginhistory.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case loginhistory.FieldState:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginHistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LoginHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loginhistory.FieldAddress) {
+		fields = append(fields, loginhistory.FieldAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginHistoryMutation) ClearField(name string) error {
+	switch name {
+	case loginhistory.FieldAddress:
+		m.ClearAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginHistoryMutation) ResetField(name string) error {
+	switch name {
+	case loginhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case loginhistory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case loginhistory.FieldIP:
+		m.ResetIP()
+		return nil
+	case loginhistory.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case loginhistory.FieldState:
+		m.ResetState()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginHistory edge %s", name)
+}
+
+// PanelMutation represents an operation that mutates the Panel nodes in the graph.
+type PanelMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int64
+	created_at              *time.Time
+	updated_at              *time.Time
+	name                    *string
+	url                     *string
+	client_id               *string
+	client_secret           *string
+	is_enable               *bool
+	token                   *string
+	params                  *int32
+	addparams               *int32
+	ql_version              *string
+	node_version            *string
+	uptime                  *int64
+	adduptime               *int64
+	system_checked_at       *time.Time
+	health_status           *string
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	health_checked_at       *time.Time
+	auto_disabled           *bool
+	clearedFields           map[string]struct{}
+	envs                    map[int64]struct{}
+	removedenvs             map[int64]struct{}
+	clearedenvs             bool
+	done                    bool
+	oldValue                func(context.Context) (*Panel, error)
+	predicates              []predicate.Panel
+}
+
+var _ ent.Mutation = (*PanelMutation)(nil)
+
+// panelOption allows management of the mutation configuration using functional options.
+type panelOption func(*PanelMutation)
+
+// newPanelMutation creates new mutation for the Panel entity.
+func newPanelMutation(c config, op Op, opts ...panelOption) *PanelMutation {
+	m := &PanelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePanel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPanelID sets the ID field of the mutation.
+func withPanelID(id int64) panelOption {
+	return func(m *PanelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Panel
+		)
+		m.oldValue = func(ctx context.Context) (*Panel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Panel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPanel sets the old Panel of the mutation.
+func withPanel(node *Panel) panelOption {
+	return func(m *PanelMutation) {
+		m.oldValue = func(context.Context) (*Panel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PanelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PanelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Panel entities.
+func (m *PanelMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PanelMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PanelMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Panel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PanelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PanelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PanelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PanelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PanelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PanelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *PanelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PanelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PanelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *PanelMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *PanelMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *PanelMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *PanelMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *PanelMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *PanelMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (m *PanelMutation) SetClientSecret(s string) {
+	m.client_secret = &s
+}
+
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *PanelMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "client_secret" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
+}
+
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *PanelMutation) ResetClientSecret() {
+	m.client_secret = nil
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (m *PanelMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
+}
+
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *PanelMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnable returns the old "is_enable" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+	}
+	return oldValue.IsEnable, nil
+}
+
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *PanelMutation) ResetIsEnable() {
+	m.is_enable = nil
+}
+
+// SetToken sets the "token" field.
+func (m *PanelMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *PanelMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *PanelMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetParams sets the "params" field.
+func (m *PanelMutation) SetParams(i int32) {
+	m.params = &i
+	m.addparams = nil
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *PanelMutation) Params() (r int32, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldParams(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// AddParams adds i to the "params" field.
+func (m *PanelMutation) AddParams(i int32) {
+	if m.addparams != nil {
+		*m.addparams += i
+	} else {
+		m.addparams = &i
+	}
+}
+
+// AddedParams returns the value that was added to the "params" field in this mutation.
+func (m *PanelMutation) AddedParams() (r int32, exists bool) {
+	v := m.addparams
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *PanelMutation) ResetParams() {
+	m.params = nil
+	m.addparams = nil
+}
+
+// SetQlVersion sets the "ql_version" field.
+func (m *PanelMutation) SetQlVersion(s string) {
+	m.ql_version = &s
+}
+
+// QlVersion returns the value of the "ql_version" field in the mutation.
+func (m *PanelMutation) QlVersion() (r string, exists bool) {
+	v := m.ql_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQlVersion returns the old "ql_version" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldQlVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQlVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQlVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQlVersion: %w", err)
+	}
+	return oldValue.QlVersion, nil
+}
+
+// ClearQlVersion clears the value of the "ql_version" field.
+func (m *PanelMutation) ClearQlVersion() {
+	m.ql_version = nil
+	m.clearedFields[panel.FieldQlVersion] = struct{}{}
+}
+
+// QlVersionCleared returns if the "ql_version" field was cleared in this mutation.
+func (m *PanelMutation) QlVersionCleared() bool {
+	_, ok := m.clearedFields[panel.FieldQlVersion]
+	return ok
+}
+
+// ResetQlVersion resets all changes to the "ql_version" field.
+func (m *PanelMutation) ResetQlVersion() {
+	m.ql_version = nil
+	delete(m.clearedFields, panel.FieldQlVersion)
+}
+
+// SetNodeVersion sets the "node_version" field.
+func (m *PanelMutation) SetNodeVersion(s string) {
+	m.node_version = &s
+}
+
+// NodeVersion returns the value of the "node_version" field in the mutation.
+func (m *PanelMutation) NodeVersion() (r string, exists bool) {
+	v := m.node_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeVersion returns the old "node_version" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldNodeVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeVersion: %w", err)
+	}
+	return oldValue.NodeVersion, nil
+}
+
+// ClearNodeVersion clears the value of the "node_version" field.
+func (m *PanelMutation) ClearNodeVersion() {
+	m.node_version = nil
+	m.clearedFields[panel.FieldNodeVersion] = struct{}{}
+}
+
+// NodeVersionCleared returns if the "node_version" field was cleared in this mutation.
+func (m *PanelMutation) NodeVersionCleared() bool {
+	_, ok := m.clearedFields[panel.FieldNodeVersion]
+	return ok
+}
+
+// ResetNodeVersion resets all changes to the "node_version" field.
+func (m *PanelMutation) ResetNodeVersion() {
+	m.node_version = nil
+	delete(m.clearedFields, panel.FieldNodeVersion)
+}
+
+// SetUptime sets the "uptime" field.
+func (m *PanelMutation) SetUptime(i int64) {
+	m.uptime = &i
+	m.adduptime = nil
+}
+
+// Uptime returns the value of the "uptime" field in the mutation.
+func (m *PanelMutation) Uptime() (r int64, exists bool) {
+	v := m.uptime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUptime returns the old "uptime" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldUptime(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUptime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUptime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUptime: %w", err)
+	}
+	return oldValue.Uptime, nil
+}
+
+// AddUptime adds i to the "uptime" field.
+func (m *PanelMutation) AddUptime(i int64) {
+	if m.adduptime != nil {
+		*m.adduptime += i
+	} else {
+		m.adduptime = &i
+	}
+}
+
+// AddedUptime returns the value that was added to the "uptime" field in this mutation.
+func (m *PanelMutation) AddedUptime() (r int64, exists bool) {
+	v := m.adduptime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUptime clears the value of the "uptime" field.
+func (m *PanelMutation) ClearUptime() {
+	m.uptime = nil
+	m.adduptime = nil
+	m.clearedFields[panel.FieldUptime] = struct{}{}
+}
+
+// UptimeCleared returns if the "uptime" field was cleared in this mutation.
+func (m *PanelMutation) UptimeCleared() bool {
+	_, ok := m.clearedFields[panel.FieldUptime]
+	return ok
+}
+
+// ResetUptime resets all changes to the "uptime" field.
+func (m *PanelMutation) ResetUptime() {
+	m.uptime = nil
+	m.adduptime = nil
+	delete(m.clearedFields, panel.FieldUptime)
+}
+
+// SetSystemCheckedAt sets the "system_checked_at" field.
+func (m *PanelMutation) SetSystemCheckedAt(t time.Time) {
+	m.system_checked_at = &t
+}
+
+// SystemCheckedAt returns the value of the "system_checked_at" field in the mutation.
+func (m *PanelMutation) SystemCheckedAt() (r time.Time, exists bool) {
+	v := m.system_checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSystemCheckedAt returns the old "system_checked_at" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldSystemCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSystemCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSystemCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSystemCheckedAt: %w", err)
+	}
+	return oldValue.SystemCheckedAt, nil
+}
+
+// ClearSystemCheckedAt clears the value of the "system_checked_at" field.
+func (m *PanelMutation) ClearSystemCheckedAt() {
+	m.system_checked_at = nil
+	m.clearedFields[panel.FieldSystemCheckedAt] = struct{}{}
+}
+
+// SystemCheckedAtCleared returns if the "system_checked_at" field was cleared in this mutation.
+func (m *PanelMutation) SystemCheckedAtCleared() bool {
+	_, ok := m.clearedFields[panel.FieldSystemCheckedAt]
+	return ok
+}
+
+// ResetSystemCheckedAt resets all changes to the "system_checked_at" field.
+func (m *PanelMutation) ResetSystemCheckedAt() {
+	m.system_checked_at = nil
+	delete(m.clearedFields, panel.FieldSystemCheckedAt)
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (m *PanelMutation) SetHealthStatus(s string) {
+	m.health_status = &s
+}
+
+// HealthStatus returns the value of the "health_status" field in the mutation.
+func (m *PanelMutation) HealthStatus() (r string, exists bool) {
+	v := m.health_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHealthStatus returns the old "health_status" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldHealthStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHealthStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHealthStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHealthStatus: %w", err)
+	}
+	return oldValue.HealthStatus, nil
+}
+
+// ResetHealthStatus resets all changes to the "health_status" field.
+func (m *PanelMutation) ResetHealthStatus() {
+	m.health_status = nil
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *PanelMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *PanelMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *PanelMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
+}
+
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *PanelMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *PanelMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (m *PanelMutation) SetHealthCheckedAt(t time.Time) {
+	m.health_checked_at = &t
+}
+
+// HealthCheckedAt returns the value of the "health_checked_at" field in the mutation.
+func (m *PanelMutation) HealthCheckedAt() (r time.Time, exists bool) {
+	v := m.health_checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHealthCheckedAt returns the old "health_checked_at" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldHealthCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHealthCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHealthCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHealthCheckedAt: %w", err)
+	}
+	return oldValue.HealthCheckedAt, nil
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (m *PanelMutation) ClearHealthCheckedAt() {
+	m.health_checked_at = nil
+	m.clearedFields[panel.FieldHealthCheckedAt] = struct{}{}
+}
+
+// HealthCheckedAtCleared returns if the "health_checked_at" field was cleared in this mutation.
+func (m *PanelMutation) HealthCheckedAtCleared() bool {
+	_, ok := m.clearedFields[panel.FieldHealthCheckedAt]
+	return ok
+}
+
+// ResetHealthCheckedAt resets all changes to the "health_checked_at" field.
+func (m *PanelMutation) ResetHealthCheckedAt() {
+	m.health_checked_at = nil
+	delete(m.clearedFields, panel.FieldHealthCheckedAt)
+}
+
+// SetAutoDisabled sets the "auto_disabled" field.
+func (m *PanelMutation) SetAutoDisabled(b bool) {
+	m.auto_disabled = &b
+}
+
+// AutoDisabled returns the value of the "auto_disabled" field in the mutation.
+func (m *PanelMutation) AutoDisabled() (r bool, exists bool) {
+	v := m.auto_disabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoDisabled returns the old "auto_disabled" field's value of the Panel entity.
+// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelMutation) OldAutoDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoDisabled: %w", err)
+	}
+	return oldValue.AutoDisabled, nil
+}
+
+// ResetAutoDisabled resets all changes to the "auto_disabled" field.
+func (m *PanelMutation) ResetAutoDisabled() {
+	m.auto_disabled = nil
+}
+
+// AddEnvIDs adds the "envs" edge to the Env entity by ids.
+func (m *PanelMutation) AddEnvIDs(ids ...int64) {
+	if m.envs == nil {
+		m.envs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.envs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvs clears the "envs" edge to the Env entity.
+func (m *PanelMutation) ClearEnvs() {
+	m.clearedenvs = true
+}
+
+// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
+func (m *PanelMutation) EnvsCleared() bool {
+	return m.clearedenvs
+}
+
+// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
+func (m *PanelMutation) RemoveEnvIDs(ids ...int64) {
+	if m.removedenvs == nil {
+		m.removedenvs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.envs, ids[i])
+		m.removedenvs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
+func (m *PanelMutation) RemovedEnvsIDs() (ids []int64) {
+	for id := range m.removedenvs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvsIDs returns the "envs" edge IDs in the mutation.
+func (m *PanelMutation) EnvsIDs() (ids []int64) {
+	for id := range m.envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvs resets all changes to the "envs" edge.
+func (m *PanelMutation) ResetEnvs() {
+	m.envs = nil
+	m.clearedenvs = false
+	m.removedenvs = nil
+}
+
+// Where appends a list predicates to the PanelMutation builder.
+func (m *PanelMutation) Where(ps ...predicate.Panel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PanelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PanelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Panel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PanelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PanelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Panel).
+func (m *PanelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PanelMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.created_at != nil {
+		fields = append(fields, panel.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, panel.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, panel.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, panel.FieldURL)
+	}
+	if m.client_id != nil {
+		fields = append(fields, panel.FieldClientID)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, panel.FieldClientSecret)
+	}
+	if m.is_enable != nil {
+		fields = append(fields, panel.FieldIsEnable)
+	}
+	if m.token != nil {
+		fields = append(fields, panel.FieldToken)
+	}
+	if m.params != nil {
+		fields = append(fields, panel.FieldParams)
+	}
+	if m.ql_version != nil {
+		fields = append(fields, panel.FieldQlVersion)
+	}
+	if m.node_version != nil {
+		fields = append(fields, panel.FieldNodeVersion)
+	}
+	if m.uptime != nil {
+		fields = append(fields, panel.FieldUptime)
+	}
+	if m.system_checked_at != nil {
+		fields = append(fields, panel.FieldSystemCheckedAt)
+	}
+	if m.health_status != nil {
+		fields = append(fields, panel.FieldHealthStatus)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, panel.FieldConsecutiveFailures)
+	}
+	if m.health_checked_at != nil {
+		fields = append(fields, panel.FieldHealthCheckedAt)
+	}
+	if m.auto_disabled != nil {
+		fields = append(fields, panel.FieldAutoDisabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PanelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case panel.FieldCreatedAt:
+		return m.CreatedAt()
+	case panel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case panel.FieldName:
+		return m.Name()
+	case panel.FieldURL:
+		return m.URL()
+	case panel.FieldClientID:
+		return m.ClientID()
+	case panel.FieldClientSecret:
+		return m.ClientSecret()
+	case panel.FieldIsEnable:
+		return m.IsEnable()
+	case panel.FieldToken:
+		return m.Token()
+	case panel.FieldParams:
+		return m.Params()
+	case panel.FieldQlVersion:
+		return m.QlVersion()
+	case panel.FieldNodeVersion:
+		return m.NodeVersion()
+	case panel.FieldUptime:
+		return m.Uptime()
+	case panel.FieldSystemCheckedAt:
+		return m.SystemCheckedAt()
+	case panel.FieldHealthStatus:
+		return m.HealthStatus()
+	case panel.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case panel.FieldHealthCheckedAt:
+		return m.HealthCheckedAt()
+	case panel.FieldAutoDisabled:
+		return m.AutoDisabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PanelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case panel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case panel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case panel.FieldName:
+		return m.OldName(ctx)
+	case panel.FieldURL:
+		return m.OldURL(ctx)
+	case panel.FieldClientID:
+		return m.OldClientID(ctx)
+	case panel.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case panel.FieldIsEnable:
+		return m.OldIsEnable(ctx)
+	case panel.FieldToken:
+		return m.OldToken(ctx)
+	case panel.FieldParams:
+		return m.OldParams(ctx)
+	case panel.FieldQlVersion:
+		return m.OldQlVersion(ctx)
+	case panel.FieldNodeVersion:
+		return m.OldNodeVersion(ctx)
+	case panel.FieldUptime:
+		return m.OldUptime(ctx)
+	case panel.FieldSystemCheckedAt:
+		return m.OldSystemCheckedAt(ctx)
+	case panel.FieldHealthStatus:
+		return m.OldHealthStatus(ctx)
+	case panel.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case panel.FieldHealthCheckedAt:
+		return m.OldHealthCheckedAt(ctx)
+	case panel.FieldAutoDisabled:
+		return m.OldAutoDisabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown Panel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PanelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case panel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case panel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case panel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case panel.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case panel.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case panel.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case panel.FieldIsEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnable(v)
+		return nil
+	case panel.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case panel.FieldParams:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case panel.FieldQlVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQlVersion(v)
+		return nil
+	case panel.FieldNodeVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeVersion(v)
+		return nil
+	case panel.FieldUptime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUptime(v)
+		return nil
+	case panel.FieldSystemCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSystemCheckedAt(v)
+		return nil
+	case panel.FieldHealthStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHealthStatus(v)
+		return nil
+	case panel.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case panel.FieldHealthCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHealthCheckedAt(v)
+		return nil
+	case panel.FieldAutoDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoDisabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Panel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PanelMutation) AddedFields() []string {
+	var fields []string
+	if m.addparams != nil {
+		fields = append(fields, panel.FieldParams)
+	}
+	if m.adduptime != nil {
+		fields = append(fields, panel.FieldUptime)
+	}
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, panel.FieldConsecutiveFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PanelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case panel.FieldParams:
+		return m.AddedParams()
+	case panel.FieldUptime:
+		return m.AddedUptime()
+	case panel.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PanelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case panel.FieldParams:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParams(v)
+		return nil
+	case panel.FieldUptime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUptime(v)
+		return nil
+	case panel.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Panel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PanelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(panel.FieldQlVersion) {
+		fields = append(fields, panel.FieldQlVersion)
+	}
+	if m.FieldCleared(panel.FieldNodeVersion) {
+		fields = append(fields, panel.FieldNodeVersion)
+	}
+	if m.FieldCleared(panel.FieldUptime) {
+		fields = append(fields, panel.FieldUptime)
+	}
+	if m.FieldCleared(panel.FieldSystemCheckedAt) {
+		fields = append(fields, panel.FieldSystemCheckedAt)
+	}
+	if m.FieldCleared(panel.FieldHealthCheckedAt) {
+		fields = append(fields, panel.FieldHealthCheckedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PanelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PanelMutation) ClearField(name string) error {
+	switch name {
+	case panel.FieldQlVersion:
+		m.ClearQlVersion()
+		return nil
+	case panel.FieldNodeVersion:
+		m.ClearNodeVersion()
+		return nil
+	case panel.FieldUptime:
+		m.ClearUptime()
+		return nil
+	case panel.FieldSystemCheckedAt:
+		m.ClearSystemCheckedAt()
+		return nil
+	case panel.FieldHealthCheckedAt:
+		m.ClearHealthCheckedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Panel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PanelMutation) ResetField(name string) error {
+	switch name {
+	case panel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case panel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case panel.FieldName:
+		m.ResetName()
+		return nil
+	case panel.FieldURL:
+		m.ResetURL()
+		return nil
+	case panel.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case panel.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case panel.FieldIsEnable:
+		m.ResetIsEnable()
+		return nil
+	case panel.FieldToken:
+		m.ResetToken()
+		return nil
+	case panel.FieldParams:
+		m.ResetParams()
+		return nil
+	case panel.FieldQlVersion:
+		m.ResetQlVersion()
+		return nil
+	case panel.FieldNodeVersion:
+		m.ResetNodeVersion()
+		return nil
+	case panel.FieldUptime:
+		m.ResetUptime()
+		return nil
+	case panel.FieldSystemCheckedAt:
+		m.ResetSystemCheckedAt()
+		return nil
+	case panel.FieldHealthStatus:
+		m.ResetHealthStatus()
+		return nil
+	case panel.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case panel.FieldHealthCheckedAt:
+		m.ResetHealthCheckedAt()
+		return nil
+	case panel.FieldAutoDisabled:
+		m.ResetAutoDisabled()
+		return nil
+	}
+	return fmt.Errorf("unknown Panel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PanelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.envs != nil {
+		edges = append(edges, panel.EdgeEnvs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PanelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case panel.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.envs))
+		for id := range m.envs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PanelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedenvs != nil {
+		edges = append(edges, panel.EdgeEnvs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PanelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case panel.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.removedenvs))
+		for id := range m.removedenvs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PanelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenvs {
+		edges = append(edges, panel.EdgeEnvs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PanelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case panel.EdgeEnvs:
+		return m.clearedenvs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PanelMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Panel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PanelMutation) ResetEdge(name string) error {
+	switch name {
+	case panel.EdgeEnvs:
+		m.ResetEnvs()
+		return nil
+	}
+	return fmt.Errorf("unknown Panel edge %s", name)
+}
+
+// PanelHealthMutation represents an operation that mutates the PanelHealth nodes in the graph.
+type PanelHealthMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	panel_id      *int64
+	addpanel_id   *int64
+	status        *string
+	token_valid   *bool
+	latency       *int64
+	addlatency    *int64
+	env_count     *int32
+	addenv_count  *int32
+	message       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PanelHealth, error)
+	predicates    []predicate.PanelHealth
+}
+
+var _ ent.Mutation = (*PanelHealthMutation)(nil)
+
+// panelhealthOption allows management of the mutation configuration using functional options.
+type panelhealthOption func(*PanelHealthMutation)
+
+// newPanelHealthMutation creates new mutation for the PanelHealth entity.
+func newPanelHealthMutation(c config, op Op, opts ...panelhealthOption) *PanelHealthMutation {
+	m := &PanelHealthMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePanelHealth,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPanelHealthID sets the ID field of the mutation.
+func withPanelHealthID(id int64) panelhealthOption {
+	return func(m *PanelHealthMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PanelHealth
+		)
+		m.oldValue = func(ctx context.Context) (*PanelHealth, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PanelHealth.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPanelHealth sets the old PanelHealth of the mutation.
+func withPanelHealth(node *PanelHealth) panelhealthOption {
+	return func(m *PanelHealthMutation) {
+		m.oldValue = func(context.Context) (*PanelHealth, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PanelHealthMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PanelHealthMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PanelHealth entities.
+func (m *PanelHealthMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PanelHealthMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PanelHealthMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PanelHealth.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PanelHealthMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PanelHealthMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PanelHealthMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPanelID sets the "panel_id" field.
+func (m *PanelHealthMutation) SetPanelID(i int64) {
+	m.panel_id = &i
+	m.addpanel_id = nil
+}
+
+// PanelID returns the value of the "panel_id" field in the mutation.
+func (m *PanelHealthMutation) PanelID() (r int64, exists bool) {
+	v := m.panel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPanelID returns the old "panel_id" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldPanelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPanelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPanelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPanelID: %w", err)
+	}
+	return oldValue.PanelID, nil
+}
+
+// AddPanelID adds i to the "panel_id" field.
+func (m *PanelHealthMutation) AddPanelID(i int64) {
+	if m.addpanel_id != nil {
+		*m.addpanel_id += i
+	} else {
+		m.addpanel_id = &i
+	}
+}
+
+// AddedPanelID returns the value that was added to the "panel_id" field in this mutation.
+func (m *PanelHealthMutation) AddedPanelID() (r int64, exists bool) {
+	v := m.addpanel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPanelID resets all changes to the "panel_id" field.
+func (m *PanelHealthMutation) ResetPanelID() {
+	m.panel_id = nil
+	m.addpanel_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PanelHealthMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PanelHealthMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PanelHealthMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTokenValid sets the "token_valid" field.
+func (m *PanelHealthMutation) SetTokenValid(b bool) {
+	m.token_valid = &b
+}
+
+// TokenValid returns the value of the "token_valid" field in the mutation.
+func (m *PanelHealthMutation) TokenValid() (r bool, exists bool) {
+	v := m.token_valid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenValid returns the old "token_valid" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldTokenValid(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenValid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenValid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenValid: %w", err)
+	}
+	return oldValue.TokenValid, nil
+}
+
+// ResetTokenValid resets all changes to the "token_valid" field.
+func (m *PanelHealthMutation) ResetTokenValid() {
+	m.token_valid = nil
+}
+
+// SetLatency sets the "latency" field.
+func (m *PanelHealthMutation) SetLatency(i int64) {
+	m.latency = &i
+	m.addlatency = nil
+}
+
+// Latency returns the value of the "latency" field in the mutation.
+func (m *PanelHealthMutation) Latency() (r int64, exists bool) {
+	v := m.latency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatency returns the old "latency" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldLatency(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatency: %w", err)
+	}
+	return oldValue.Latency, nil
+}
+
+// AddLatency adds i to the "latency" field.
+func (m *PanelHealthMutation) AddLatency(i int64) {
+	if m.addlatency != nil {
+		*m.addlatency += i
+	} else {
+		m.addlatency = &i
+	}
+}
+
+// AddedLatency returns the value that was added to the "latency" field in this mutation.
+func (m *PanelHealthMutation) AddedLatency() (r int64, exists bool) {
+	v := m.addlatency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatency resets all changes to the "latency" field.
+func (m *PanelHealthMutation) ResetLatency() {
+	m.latency = nil
+	m.addlatency = nil
+}
+
+// SetEnvCount sets the "env_count" field.
+func (m *PanelHealthMutation) SetEnvCount(i int32) {
+	m.env_count = &i
+	m.addenv_count = nil
+}
+
+// EnvCount returns the value of the "env_count" field in the mutation.
+func (m *PanelHealthMutation) EnvCount() (r int32, exists bool) {
+	v := m.env_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvCount returns the old "env_count" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldEnvCount(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvCount: %w", err)
+	}
+	return oldValue.EnvCount, nil
+}
+
+// AddEnvCount adds i to the "env_count" field.
+func (m *PanelHealthMutation) AddEnvCount(i int32) {
+	if m.addenv_count != nil {
+		*m.addenv_count += i
+	} else {
+		m.addenv_count = &i
+	}
+}
+
+// AddedEnvCount returns the value that was added to the "env_count" field in this mutation.
+func (m *PanelHealthMutation) AddedEnvCount() (r int32, exists bool) {
+	v := m.addenv_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEnvCount clears the value of the "env_count" field.
+func (m *PanelHealthMutation) ClearEnvCount() {
+	m.env_count = nil
+	m.addenv_count = nil
+	m.clearedFields[panelhealth.FieldEnvCount] = struct{}{}
+}
+
+// EnvCountCleared returns if the "env_count" field was cleared in this mutation.
+func (m *PanelHealthMutation) EnvCountCleared() bool {
+	_, ok := m.clearedFields[panelhealth.FieldEnvCount]
+	return ok
+}
+
+// ResetEnvCount resets all changes to the "env_count" field.
+func (m *PanelHealthMutation) ResetEnvCount() {
+	m.env_count = nil
+	m.addenv_count = nil
+	delete(m.clearedFields, panelhealth.FieldEnvCount)
+}
+
+// SetMessage sets the "message" field.
+func (m *PanelHealthMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *PanelHealthMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the PanelHealth entity.
+// If the PanelHealth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PanelHealthMutation) OldMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *PanelHealthMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[panelhealth.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *PanelHealthMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[panelhealth.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *PanelHealthMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, panelhealth.FieldMessage)
+}
+
+// Where appends a list predicates to the PanelHealthMutation builder.
+func (m *PanelHealthMutation) Where(ps ...predicate.PanelHealth) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PanelHealthMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PanelHealthMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PanelHealth, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PanelHealthMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PanelHealthMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PanelHealth).
+func (m *PanelHealthMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PanelHealthMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, panelhealth.FieldCreatedAt)
+	}
+	if m.panel_id != nil {
+		fields = append(fields, panelhealth.FieldPanelID)
+	}
+	if m.status != nil {
+		fields = append(fields, panelhealth.FieldStatus)
+	}
+	if m.token_valid != nil {
+		fields = append(fields, panelhealth.FieldTokenValid)
+	}
+	if m.latency != nil {
+		fields = append(fields, panelhealth.FieldLatency)
+	}
+	if m.env_count != nil {
+		fields = append(fields, panelhealth.FieldEnvCount)
+	}
+	if m.message != nil {
+		fields = append(fields, panelhealth.FieldMessage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PanelHealthMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case panelhealth.FieldCreatedAt:
+		return m.CreatedAt()
+	case panelhealth.FieldPanelID:
+		return m.PanelID()
+	case panelhealth.FieldStatus:
+		return m.Status()
+	case panelhealth.FieldTokenValid:
+		return m.TokenValid()
+	case panelhealth.FieldLatency:
+		return m.Latency()
+	case panelhealth.FieldEnvCount:
+		return m.EnvCount()
+	case panelhealth.FieldMessage:
+		return m.Message()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PanelHealthMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case panelhealth.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case panelhealth.FieldPanelID:
+		return m.OldPanelID(ctx)
+	case panelhealth.FieldStatus:
+		return m.OldStatus(ctx)
+	case panelhealth.FieldTokenValid:
+		return m.OldTokenValid(ctx)
+	case panelhealth.FieldLatency:
+		return m.OldLatency(ctx)
+	case panelhealth.FieldEnvCount:
+		return m.OldEnvCount(ctx)
+	case panelhealth.FieldMessage:
+		return m.OldMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown PanelHealth field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PanelHealthMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case panelhealth.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case panelhealth.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPanelID(v)
+		return nil
+	case panelhealth.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case panelhealth.FieldTokenValid:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenValid(v)
+		return nil
+	case panelhealth.FieldLatency:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatency(v)
+		return nil
+	case panelhealth.FieldEnvCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvCount(v)
+		return nil
+	case panelhealth.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PanelHealth field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PanelHealthMutation) AddedFields() []string {
+	var fields []string
+	if m.addpanel_id != nil {
+		fields = append(fields, panelhealth.FieldPanelID)
+	}
+	if m.addlatency != nil {
+		fields = append(fields, panelhealth.FieldLatency)
+	}
+	if m.addenv_count != nil {
+		fields = append(fields, panelhealth.FieldEnvCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PanelHealthMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case panelhealth.FieldPanelID:
+		return m.AddedPanelID()
+	case panelhealth.FieldLatency:
+		return m.AddedLatency()
+	case panelhealth.FieldEnvCount:
+		return m.AddedEnvCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PanelHealthMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case panelhealth.FieldPanelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPanelID(v)
+		return nil
+	case panelhealth.FieldLatency:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatency(v)
+		return nil
+	case panelhealth.FieldEnvCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnvCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PanelHealth numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PanelHealthMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(panelhealth.FieldEnvCount) {
+		fields = append(fields, panelhealth.FieldEnvCount)
+	}
+	if m.FieldCleared(panelhealth.FieldMessage) {
+		fields = append(fields, panelhealth.FieldMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PanelHealthMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PanelHealthMutation) ClearField(name string) error {
+	switch name {
+	case panelhealth.FieldEnvCount:
+		m.ClearEnvCount()
+		return nil
+	case panelhealth.FieldMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown PanelHealth nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PanelHealthMutation) ResetField(name string) error {
+	switch name {
+	case panelhealth.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case panelhealth.FieldPanelID:
+		m.ResetPanelID()
+		return nil
+	case panelhealth.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case panelhealth.FieldTokenValid:
+		m.ResetTokenValid()
+		return nil
+	case panelhealth.FieldLatency:
+		m.ResetLatency()
+		return nil
+	case panelhealth.FieldEnvCount:
+		m.ResetEnvCount()
+		return nil
+	case panelhealth.FieldMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown PanelHealth field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PanelHealthMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PanelHealthMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PanelHealthMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PanelHealthMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PanelHealthMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PanelHealthMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PanelHealthMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PanelHealth unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PanelHealthMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PanelHealth edge %s", name)
+}
+
+// PluginMutation represents an operation that mutates the Plugin nodes in the graph.
+type PluginMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int64
+	created_at            *time.Time
+	updated_at            *time.Time
+	name                  *string
+	description           *string
+	version               *string
+	author                *string
+	script_content        *string
+	is_enable             *bool
+	execution_timeout     *int32
+	addexecution_timeout  *int32
+	trigger_event         *string
+	priority              *int32
+	addpriority           *int32
+	require_tests_pass    *bool
+	clearedFields         map[string]struct{}
+	env_plugins           map[int64]struct{}
+	removedenv_plugins    map[int64]struct{}
+	clearedenv_plugins    bool
+	execution_logs        map[int64]struct{}
+	removedexecution_logs map[int64]struct{}
+	clearedexecution_logs bool
+	test_cases            map[int64]struct{}
+	removedtest_cases     map[int64]struct{}
+	clearedtest_cases     bool
+	done                  bool
+	oldValue              func(context.Context) (*Plugin, error)
+	predicates            []predicate.Plugin
+}
+
+var _ ent.Mutation = (*PluginMutation)(nil)
+
+// pluginOption allows management of the mutation configuration using functional options.
+type pluginOption func(*PluginMutation)
+
+// newPluginMutation creates new mutation for the Plugin entity.
+func newPluginMutation(c config, op Op, opts ...pluginOption) *PluginMutation {
+	m := &PluginMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlugin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPluginID sets the ID field of the mutation.
+func withPluginID(id int64) pluginOption {
+	return func(m *PluginMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Plugin
+		)
+		m.oldValue = func(ctx context.Context) (*Plugin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Plugin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlugin sets the old Plugin of the mutation.
+func withPlugin(node *Plugin) pluginOption {
+	return func(m *PluginMutation) {
+		m.oldValue = func(context.Context) (*Plugin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PluginMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PluginMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Plugin entities.
+func (m *PluginMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PluginMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PluginMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Plugin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PluginMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PluginMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PluginMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PluginMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PluginMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PluginMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *PluginMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PluginMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PluginMutation) ResetName() {
 	m.name = nil
 }
 
-// SetURL sets the "url" field.
-func (m *PanelMutation) SetURL(s string) {
-	m.url = &s
+// SetDescription sets the "description" field.
+func (m *PluginMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PluginMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PluginMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[plugin.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PluginMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[plugin.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PluginMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, plugin.FieldDescription)
+}
+
+// SetVersion sets the "version" field.
+func (m *PluginMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PluginMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PluginMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *PluginMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *PluginMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldAuthor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *PluginMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[plugin.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *PluginMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[plugin.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *PluginMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, plugin.FieldAuthor)
+}
+
+// SetScriptContent sets the "script_content" field.
+func (m *PluginMutation) SetScriptContent(s string) {
+	m.script_content = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *PanelMutation) URL() (r string, exists bool) {
-	v := m.url
+// ScriptContent returns the value of the "script_content" field in the mutation.
+func (m *PluginMutation) ScriptContent() (r string, exists bool) {
+	v := m.script_content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// OldScriptContent returns the old "script_content" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *PluginMutation) OldScriptContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldScriptContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldScriptContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldScriptContent: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.ScriptContent, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *PanelMutation) ResetURL() {
-	m.url = nil
+// ResetScriptContent resets all changes to the "script_content" field.
+func (m *PluginMutation) ResetScriptContent() {
+	m.script_content = nil
 }
 
-// SetClientID sets the "client_id" field.
-func (m *PanelMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetIsEnable sets the "is_enable" field.
+func (m *PluginMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *PanelMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *PluginMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// OldIsEnable returns the old "is_enable" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *PluginMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldIsEnable requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.IsEnable, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *PanelMutation) ResetClientID() {
-	m.client_id = nil
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *PluginMutation) ResetIsEnable() {
+	m.is_enable = nil
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *PanelMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// SetExecutionTimeout sets the "execution_timeout" field.
+func (m *PluginMutation) SetExecutionTimeout(i int32) {
+	m.execution_timeout = &i
+	m.addexecution_timeout = nil
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *PanelMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// ExecutionTimeout returns the value of the "execution_timeout" field in the mutation.
+func (m *PluginMutation) ExecutionTimeout() (r int32, exists bool) {
+	v := m.execution_timeout
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// OldExecutionTimeout returns the old "execution_timeout" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *PluginMutation) OldExecutionTimeout(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldExecutionTimeout is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldExecutionTimeout requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldExecutionTimeout: %w", err)
 	}
-	return oldValue.ClientSecret, nil
+	return oldValue.ExecutionTimeout, nil
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *PanelMutation) ResetClientSecret() {
-	m.client_secret = nil
+// AddExecutionTimeout adds i to the "execution_timeout" field.
+func (m *PluginMutation) AddExecutionTimeout(i int32) {
+	if m.addexecution_timeout != nil {
+		*m.addexecution_timeout += i
+	} else {
+		m.addexecution_timeout = &i
+	}
 }
 
-// SetIsEnable sets the "is_enable" field.
-func (m *PanelMutation) SetIsEnable(b bool) {
-	m.is_enable = &b
+// AddedExecutionTimeout returns the value that was added to the "execution_timeout" field in this mutation.
+func (m *PluginMutation) AddedExecutionTimeout() (r int32, exists bool) {
+	v := m.addexecution_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// IsEnable returns the value of the "is_enable" field in the mutation.
-func (m *PanelMutation) IsEnable() (r bool, exists bool) {
-	v := m.is_enable
+// ResetExecutionTimeout resets all changes to the "execution_timeout" field.
+func (m *PluginMutation) ResetExecutionTimeout() {
+	m.execution_timeout = nil
+	m.addexecution_timeout = nil
+}
+
+// SetTriggerEvent sets the "trigger_event" field.
+func (m *PluginMutation) SetTriggerEvent(s string) {
+	m.trigger_event = &s
+}
+
+// TriggerEvent returns the value of the "trigger_event" field in the mutation.
+func (m *PluginMutation) TriggerEvent() (r string, exists bool) {
+	v := m.trigger_event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsEnable returns the old "is_enable" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggerEvent returns the old "trigger_event" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+func (m *PluginMutation) OldTriggerEvent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggerEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnable requires an ID field in the mutation")
+		return v, errors.New("OldTriggerEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggerEvent: %w", err)
+	}
+	return oldValue.TriggerEvent, nil
+}
+
+// ResetTriggerEvent resets all changes to the "trigger_event" field.
+func (m *PluginMutation) ResetTriggerEvent() {
+	m.trigger_event = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *PluginMutation) SetPriority(i int32) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *PluginMutation) Priority() (r int32, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldPriority(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *PluginMutation) AddPriority(i int32) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *PluginMutation) AddedPriority() (r int32, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *PluginMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetRequireTestsPass sets the "require_tests_pass" field.
+func (m *PluginMutation) SetRequireTestsPass(b bool) {
+	m.require_tests_pass = &b
+}
+
+// RequireTestsPass returns the value of the "require_tests_pass" field in the mutation.
+func (m *PluginMutation) RequireTestsPass() (r bool, exists bool) {
+	v := m.require_tests_pass
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequireTestsPass returns the old "require_tests_pass" field's value of the Plugin entity.
+// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginMutation) OldRequireTestsPass(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequireTestsPass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequireTestsPass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequireTestsPass: %w", err)
+	}
+	return oldValue.RequireTestsPass, nil
+}
+
+// ResetRequireTestsPass resets all changes to the "require_tests_pass" field.
+func (m *PluginMutation) ResetRequireTestsPass() {
+	m.require_tests_pass = nil
+}
+
+// AddEnvPluginIDs adds the "env_plugins" edge to the EnvPlugin entity by ids.
+func (m *PluginMutation) AddEnvPluginIDs(ids ...int64) {
+	if m.env_plugins == nil {
+		m.env_plugins = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.env_plugins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvPlugins clears the "env_plugins" edge to the EnvPlugin entity.
+func (m *PluginMutation) ClearEnvPlugins() {
+	m.clearedenv_plugins = true
+}
+
+// EnvPluginsCleared reports if the "env_plugins" edge to the EnvPlugin entity was cleared.
+func (m *PluginMutation) EnvPluginsCleared() bool {
+	return m.clearedenv_plugins
+}
+
+// RemoveEnvPluginIDs removes the "env_plugins" edge to the EnvPlugin entity by IDs.
+func (m *PluginMutation) RemoveEnvPluginIDs(ids ...int64) {
+	if m.removedenv_plugins == nil {
+		m.removedenv_plugins = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.env_plugins, ids[i])
+		m.removedenv_plugins[ids[i]] = struct{}{}
 	}
-	return oldValue.IsEnable, nil
 }
 
-// ResetIsEnable resets all changes to the "is_enable" field.
-func (m *PanelMutation) ResetIsEnable() {
-	m.is_enable = nil
+// RemovedEnvPlugins returns the removed IDs of the "env_plugins" edge to the EnvPlugin entity.
+func (m *PluginMutation) RemovedEnvPluginsIDs() (ids []int64) {
+	for id := range m.removedenv_plugins {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetToken sets the "token" field.
-func (m *PanelMutation) SetToken(s string) {
-	m.token = &s
+// EnvPluginsIDs returns the "env_plugins" edge IDs in the mutation.
+func (m *PluginMutation) EnvPluginsIDs() (ids []int64) {
+	for id := range m.env_plugins {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *PanelMutation) Token() (r string, exists bool) {
-	v := m.token
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEnvPlugins resets all changes to the "env_plugins" edge.
+func (m *PluginMutation) ResetEnvPlugins() {
+	m.env_plugins = nil
+	m.clearedenv_plugins = false
+	m.removedenv_plugins = nil
 }
 
-// OldToken returns the old "token" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+// AddExecutionLogIDs adds the "execution_logs" edge to the PluginExecutionLog entity by ids.
+func (m *PluginMutation) AddExecutionLogIDs(ids ...int64) {
+	if m.execution_logs == nil {
+		m.execution_logs = make(map[int64]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	for i := range ids {
+		m.execution_logs[ids[i]] = struct{}{}
 	}
-	return oldValue.Token, nil
-}
-
-// ResetToken resets all changes to the "token" field.
-func (m *PanelMutation) ResetToken() {
-	m.token = nil
 }
 
-// SetParams sets the "params" field.
-func (m *PanelMutation) SetParams(i int32) {
-	m.params = &i
-	m.addparams = nil
+// ClearExecutionLogs clears the "execution_logs" edge to the PluginExecutionLog entity.
+func (m *PluginMutation) ClearExecutionLogs() {
+	m.clearedexecution_logs = true
 }
 
-// Params returns the value of the "params" field in the mutation.
-func (m *PanelMutation) Params() (r int32, exists bool) {
-	v := m.params
-	if v == nil {
-		return
-	}
-	return *v, true
+// ExecutionLogsCleared reports if the "execution_logs" edge to the PluginExecutionLog entity was cleared.
+func (m *PluginMutation) ExecutionLogsCleared() bool {
+	return m.clearedexecution_logs
 }
 
-// OldParams returns the old "params" field's value of the Panel entity.
-// If the Panel object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PanelMutation) OldParams(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParams is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParams requires an ID field in the mutation")
+// RemoveExecutionLogIDs removes the "execution_logs" edge to the PluginExecutionLog entity by IDs.
+func (m *PluginMutation) RemoveExecutionLogIDs(ids ...int64) {
+	if m.removedexecution_logs == nil {
+		m.removedexecution_logs = make(map[int64]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	for i := range ids {
+		delete(m.execution_logs, ids[i])
+		m.removedexecution_logs[ids[i]] = struct{}{}
 	}
-	return oldValue.Params, nil
 }
 
-// AddParams adds i to the "params" field.
-func (m *PanelMutation) AddParams(i int32) {
-	if m.addparams != nil {
-		*m.addparams += i
-	} else {
-		m.addparams = &i
+// RemovedExecutionLogs returns the removed IDs of the "execution_logs" edge to the PluginExecutionLog entity.
+func (m *PluginMutation) RemovedExecutionLogsIDs() (ids []int64) {
+	for id := range m.removedexecution_logs {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedParams returns the value that was added to the "params" field in this mutation.
-func (m *PanelMutation) AddedParams() (r int32, exists bool) {
-	v := m.addparams
-	if v == nil {
-		return
+// ExecutionLogsIDs returns the "execution_logs" edge IDs in the mutation.
+func (m *PluginMutation) ExecutionLogsIDs() (ids []int64) {
+	for id := range m.execution_logs {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetParams resets all changes to the "params" field.
-func (m *PanelMutation) ResetParams() {
-	m.params = nil
-	m.addparams = nil
+// ResetExecutionLogs resets all changes to the "execution_logs" edge.
+func (m *PluginMutation) ResetExecutionLogs() {
+	m.execution_logs = nil
+	m.clearedexecution_logs = false
+	m.removedexecution_logs = nil
 }
 
-// AddEnvIDs adds the "envs" edge to the Env entity by ids.
-func (m *PanelMutation) AddEnvIDs(ids ...int64) {
-	if m.envs == nil {
-		m.envs = make(map[int64]struct{})
+// AddTestCaseIDs adds the "test_cases" edge to the PluginTestCase entity by ids.
+func (m *PluginMutation) AddTestCaseIDs(ids ...int64) {
+	if m.test_cases == nil {
+		m.test_cases = make(map[int64]struct{})
 	}
 	for i := range ids {
-		m.envs[ids[i]] = struct{}{}
+		m.test_cases[ids[i]] = struct{}{}
 	}
 }
 
-// ClearEnvs clears the "envs" edge to the Env entity.
-func (m *PanelMutation) ClearEnvs() {
-	m.clearedenvs = true
+// ClearTestCases clears the "test_cases" edge to the PluginTestCase entity.
+func (m *PluginMutation) ClearTestCases() {
+	m.clearedtest_cases = true
 }
 
-// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
-func (m *PanelMutation) EnvsCleared() bool {
-	return m.clearedenvs
+// TestCasesCleared reports if the "test_cases" edge to the PluginTestCase entity was cleared.
+func (m *PluginMutation) TestCasesCleared() bool {
+	return m.clearedtest_cases
 }
 
-// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
-func (m *PanelMutation) RemoveEnvIDs(ids ...int64) {
-	if m.removedenvs == nil {
-		m.removedenvs = make(map[int64]struct{})
+// RemoveTestCaseIDs removes the "test_cases" edge to the PluginTestCase entity by IDs.
+func (m *PluginMutation) RemoveTestCaseIDs(ids ...int64) {
+	if m.removedtest_cases == nil {
+		m.removedtest_cases = make(map[int64]struct{})
 	}
 	for i := range ids {
-		delete(m.envs, ids[i])
-		m.removedenvs[ids[i]] = struct{}{}
+		delete(m.test_cases, ids[i])
+		m.removedtest_cases[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
-func (m *PanelMutation) RemovedEnvsIDs() (ids []int64) {
-	for id := range m.removedenvs {
+// RemovedTestCases returns the removed IDs of the "test_cases" edge to the PluginTestCase entity.
+func (m *PluginMutation) RemovedTestCasesIDs() (ids []int64) {
+	for id := range m.removedtest_cases {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// EnvsIDs returns the "envs" edge IDs in the mutation.
-func (m *PanelMutation) EnvsIDs() (ids []int64) {
-	for id := range m.envs {
+// TestCasesIDs returns the "test_cases" edge IDs in the mutation.
+func (m *PluginMutation) TestCasesIDs() (ids []int64) {
+	for id := range m.test_cases {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEnvs resets all changes to the "envs" edge.
-func (m *PanelMutation) ResetEnvs() {
-	m.envs = nil
-	m.clearedenvs = false
-	m.removedenvs = nil
+// ResetTestCases resets all changes to the "test_cases" edge.
+func (m *PluginMutation) ResetTestCases() {
+	m.test_cases = nil
+	m.clearedtest_cases = false
+	m.removedtest_cases = nil
 }
 
-// Where appends a list predicates to the PanelMutation builder.
-func (m *PanelMutation) Where(ps ...predicate.Panel) {
+// Where appends a list predicates to the PluginMutation builder.
+func (m *PluginMutation) Where(ps ...predicate.Plugin) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PanelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PluginMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PanelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Panel, len(ps))
+func (m *PluginMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Plugin, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4016,51 +12195,60 @@ func (m *PanelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PanelMutation) Op() Op {
+func (m *PluginMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PanelMutation) SetOp(op Op) {
+func (m *PluginMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Panel).
-func (m *PanelMutation) Type() string {
+// Type returns the node type of this mutation (Plugin).
+func (m *PluginMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PanelMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *PluginMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
-		fields = append(fields, panel.FieldCreatedAt)
+		fields = append(fields, plugin.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, panel.FieldUpdatedAt)
+		fields = append(fields, plugin.FieldUpdatedAt)
 	}
 	if m.name != nil {
-		fields = append(fields, panel.FieldName)
+		fields = append(fields, plugin.FieldName)
 	}
-	if m.url != nil {
-		fields = append(fields, panel.FieldURL)
+	if m.description != nil {
+		fields = append(fields, plugin.FieldDescription)
 	}
-	if m.client_id != nil {
-		fields = append(fields, panel.FieldClientID)
+	if m.version != nil {
+		fields = append(fields, plugin.FieldVersion)
 	}
-	if m.client_secret != nil {
-		fields = append(fields, panel.FieldClientSecret)
+	if m.author != nil {
+		fields = append(fields, plugin.FieldAuthor)
+	}
+	if m.script_content != nil {
+		fields = append(fields, plugin.FieldScriptContent)
 	}
 	if m.is_enable != nil {
-		fields = append(fields, panel.FieldIsEnable)
+		fields = append(fields, plugin.FieldIsEnable)
 	}
-	if m.token != nil {
-		fields = append(fields, panel.FieldToken)
+	if m.execution_timeout != nil {
+		fields = append(fields, plugin.FieldExecutionTimeout)
 	}
-	if m.params != nil {
-		fields = append(fields, panel.FieldParams)
+	if m.trigger_event != nil {
+		fields = append(fields, plugin.FieldTriggerEvent)
+	}
+	if m.priority != nil {
+		fields = append(fields, plugin.FieldPriority)
+	}
+	if m.require_tests_pass != nil {
+		fields = append(fields, plugin.FieldRequireTestsPass)
 	}
 	return fields
 }
@@ -4068,26 +12256,32 @@ func (m *PanelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PanelMutation) Field(name string) (ent.Value, bool) {
+func (m *PluginMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case panel.FieldCreatedAt:
+	case plugin.FieldCreatedAt:
 		return m.CreatedAt()
-	case panel.FieldUpdatedAt:
+	case plugin.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case panel.FieldName:
+	case plugin.FieldName:
 		return m.Name()
-	case panel.FieldURL:
-		return m.URL()
-	case panel.FieldClientID:
-		return m.ClientID()
-	case panel.FieldClientSecret:
-		return m.ClientSecret()
-	case panel.FieldIsEnable:
+	case plugin.FieldDescription:
+		return m.Description()
+	case plugin.FieldVersion:
+		return m.Version()
+	case plugin.FieldAuthor:
+		return m.Author()
+	case plugin.FieldScriptContent:
+		return m.ScriptContent()
+	case plugin.FieldIsEnable:
 		return m.IsEnable()
-	case panel.FieldToken:
-		return m.Token()
-	case panel.FieldParams:
-		return m.Params()
+	case plugin.FieldExecutionTimeout:
+		return m.ExecutionTimeout()
+	case plugin.FieldTriggerEvent:
+		return m.TriggerEvent()
+	case plugin.FieldPriority:
+		return m.Priority()
+	case plugin.FieldRequireTestsPass:
+		return m.RequireTestsPass()
 	}
 	return nil, false
 }
@@ -4095,108 +12289,138 @@ func (m *PanelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PanelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PluginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case panel.FieldCreatedAt:
+	case plugin.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case panel.FieldUpdatedAt:
+	case plugin.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case panel.FieldName:
-		return m.OldName(ctx)
-	case panel.FieldURL:
-		return m.OldURL(ctx)
-	case panel.FieldClientID:
-		return m.OldClientID(ctx)
-	case panel.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case panel.FieldIsEnable:
+	case plugin.FieldName:
+		return m.OldName(ctx)
+	case plugin.FieldDescription:
+		return m.OldDescription(ctx)
+	case plugin.FieldVersion:
+		return m.OldVersion(ctx)
+	case plugin.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case plugin.FieldScriptContent:
+		return m.OldScriptContent(ctx)
+	case plugin.FieldIsEnable:
 		return m.OldIsEnable(ctx)
-	case panel.FieldToken:
-		return m.OldToken(ctx)
-	case panel.FieldParams:
-		return m.OldParams(ctx)
+	case plugin.FieldExecutionTimeout:
+		return m.OldExecutionTimeout(ctx)
+	case plugin.FieldTriggerEvent:
+		return m.OldTriggerEvent(ctx)
+	case plugin.FieldPriority:
+		return m.OldPriority(ctx)
+	case plugin.FieldRequireTestsPass:
+		return m.OldRequireTestsPass(ctx)
 	}
-	return nil, fmt.Errorf("unknown Panel field %s", name)
+	return nil, fmt.Errorf("unknown Plugin field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PanelMutation) SetField(name string, value ent.Value) error {
+func (m *PluginMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case panel.FieldCreatedAt:
+	case plugin.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case panel.FieldUpdatedAt:
+	case plugin.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case panel.FieldName:
+	case plugin.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case panel.FieldURL:
+	case plugin.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetDescription(v)
 		return nil
-	case panel.FieldClientID:
+	case plugin.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetVersion(v)
 		return nil
-	case panel.FieldClientSecret:
+	case plugin.FieldAuthor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSecret(v)
+		m.SetAuthor(v)
 		return nil
-	case panel.FieldIsEnable:
+	case plugin.FieldScriptContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScriptContent(v)
+		return nil
+	case plugin.FieldIsEnable:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIsEnable(v)
 		return nil
-	case panel.FieldToken:
+	case plugin.FieldExecutionTimeout:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionTimeout(v)
+		return nil
+	case plugin.FieldTriggerEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetTriggerEvent(v)
 		return nil
-	case panel.FieldParams:
+	case plugin.FieldPriority:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParams(v)
+		m.SetPriority(v)
+		return nil
+	case plugin.FieldRequireTestsPass:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequireTestsPass(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Panel field %s", name)
+	return fmt.Errorf("unknown Plugin field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PanelMutation) AddedFields() []string {
+func (m *PluginMutation) AddedFields() []string {
 	var fields []string
-	if m.addparams != nil {
-		fields = append(fields, panel.FieldParams)
+	if m.addexecution_timeout != nil {
+		fields = append(fields, plugin.FieldExecutionTimeout)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, plugin.FieldPriority)
 	}
 	return fields
 }
@@ -4204,10 +12428,12 @@ func (m *PanelMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PanelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PluginMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case panel.FieldParams:
-		return m.AddedParams()
+	case plugin.FieldExecutionTimeout:
+		return m.AddedExecutionTimeout()
+	case plugin.FieldPriority:
+		return m.AddedPriority()
 	}
 	return nil, false
 }
@@ -4215,89 +12441,138 @@ func (m *PanelMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PanelMutation) AddField(name string, value ent.Value) error {
+func (m *PluginMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case panel.FieldParams:
+	case plugin.FieldExecutionTimeout:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddParams(v)
+		m.AddExecutionTimeout(v)
+		return nil
+	case plugin.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Panel numeric field %s", name)
+	return fmt.Errorf("unknown Plugin numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PanelMutation) ClearedFields() []string {
-	return nil
+func (m *PluginMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(plugin.FieldDescription) {
+		fields = append(fields, plugin.FieldDescription)
+	}
+	if m.FieldCleared(plugin.FieldAuthor) {
+		fields = append(fields, plugin.FieldAuthor)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PanelMutation) FieldCleared(name string) bool {
+func (m *PluginMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PanelMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Panel nullable field %s", name)
+func (m *PluginMutation) ClearField(name string) error {
+	switch name {
+	case plugin.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case plugin.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown Plugin nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PanelMutation) ResetField(name string) error {
+func (m *PluginMutation) ResetField(name string) error {
 	switch name {
-	case panel.FieldCreatedAt:
+	case plugin.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case panel.FieldUpdatedAt:
+	case plugin.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case panel.FieldName:
+	case plugin.FieldName:
 		m.ResetName()
 		return nil
-	case panel.FieldURL:
-		m.ResetURL()
+	case plugin.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case panel.FieldClientID:
-		m.ResetClientID()
+	case plugin.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case panel.FieldClientSecret:
-		m.ResetClientSecret()
+	case plugin.FieldAuthor:
+		m.ResetAuthor()
 		return nil
-	case panel.FieldIsEnable:
+	case plugin.FieldScriptContent:
+		m.ResetScriptContent()
+		return nil
+	case plugin.FieldIsEnable:
 		m.ResetIsEnable()
 		return nil
-	case panel.FieldToken:
-		m.ResetToken()
+	case plugin.FieldExecutionTimeout:
+		m.ResetExecutionTimeout()
 		return nil
-	case panel.FieldParams:
-		m.ResetParams()
+	case plugin.FieldTriggerEvent:
+		m.ResetTriggerEvent()
+		return nil
+	case plugin.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case plugin.FieldRequireTestsPass:
+		m.ResetRequireTestsPass()
 		return nil
 	}
-	return fmt.Errorf("unknown Panel field %s", name)
+	return fmt.Errorf("unknown Plugin field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PanelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.envs != nil {
-		edges = append(edges, panel.EdgeEnvs)
+func (m *PluginMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.env_plugins != nil {
+		edges = append(edges, plugin.EdgeEnvPlugins)
+	}
+	if m.execution_logs != nil {
+		edges = append(edges, plugin.EdgeExecutionLogs)
+	}
+	if m.test_cases != nil {
+		edges = append(edges, plugin.EdgeTestCases)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PanelMutation) AddedIDs(name string) []ent.Value {
+func (m *PluginMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case panel.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.envs))
-		for id := range m.envs {
+	case plugin.EdgeEnvPlugins:
+		ids := make([]ent.Value, 0, len(m.env_plugins))
+		for id := range m.env_plugins {
+			ids = append(ids, id)
+		}
+		return ids
+	case plugin.EdgeExecutionLogs:
+		ids := make([]ent.Value, 0, len(m.execution_logs))
+		for id := range m.execution_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case plugin.EdgeTestCases:
+		ids := make([]ent.Value, 0, len(m.test_cases))
+		for id := range m.test_cases {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4306,21 +12581,39 @@ func (m *PanelMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PanelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedenvs != nil {
-		edges = append(edges, panel.EdgeEnvs)
+func (m *PluginMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedenv_plugins != nil {
+		edges = append(edges, plugin.EdgeEnvPlugins)
+	}
+	if m.removedexecution_logs != nil {
+		edges = append(edges, plugin.EdgeExecutionLogs)
+	}
+	if m.removedtest_cases != nil {
+		edges = append(edges, plugin.EdgeTestCases)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PanelMutation) RemovedIDs(name string) []ent.Value {
+func (m *PluginMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case panel.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.removedenvs))
-		for id := range m.removedenvs {
+	case plugin.EdgeEnvPlugins:
+		ids := make([]ent.Value, 0, len(m.removedenv_plugins))
+		for id := range m.removedenv_plugins {
+			ids = append(ids, id)
+		}
+		return ids
+	case plugin.EdgeExecutionLogs:
+		ids := make([]ent.Value, 0, len(m.removedexecution_logs))
+		for id := range m.removedexecution_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case plugin.EdgeTestCases:
+		ids := make([]ent.Value, 0, len(m.removedtest_cases))
+		for id := range m.removedtest_cases {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4329,85 +12622,94 @@ func (m *PanelMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PanelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenvs {
-		edges = append(edges, panel.EdgeEnvs)
+func (m *PluginMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedenv_plugins {
+		edges = append(edges, plugin.EdgeEnvPlugins)
+	}
+	if m.clearedexecution_logs {
+		edges = append(edges, plugin.EdgeExecutionLogs)
+	}
+	if m.clearedtest_cases {
+		edges = append(edges, plugin.EdgeTestCases)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PanelMutation) EdgeCleared(name string) bool {
+func (m *PluginMutation) EdgeCleared(name string) bool {
 	switch name {
-	case panel.EdgeEnvs:
-		return m.clearedenvs
+	case plugin.EdgeEnvPlugins:
+		return m.clearedenv_plugins
+	case plugin.EdgeExecutionLogs:
+		return m.clearedexecution_logs
+	case plugin.EdgeTestCases:
+		return m.clearedtest_cases
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PanelMutation) ClearEdge(name string) error {
+func (m *PluginMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Panel unique edge %s", name)
+	return fmt.Errorf("unknown Plugin unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PanelMutation) ResetEdge(name string) error {
+func (m *PluginMutation) ResetEdge(name string) error {
 	switch name {
-	case panel.EdgeEnvs:
-		m.ResetEnvs()
+	case plugin.EdgeEnvPlugins:
+		m.ResetEnvPlugins()
+		return nil
+	case plugin.EdgeExecutionLogs:
+		m.ResetExecutionLogs()
+		return nil
+	case plugin.EdgeTestCases:
+		m.ResetTestCases()
 		return nil
 	}
-	return fmt.Errorf("unknown Panel edge %s", name)
+	return fmt.Errorf("unknown Plugin edge %s", name)
 }
 
-// PluginMutation represents an operation that mutates the Plugin nodes in the graph.
-type PluginMutation struct {
+// PluginExecutionLogMutation represents an operation that mutates the PluginExecutionLog nodes in the graph.
+type PluginExecutionLogMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int64
-	created_at            *time.Time
-	updated_at            *time.Time
-	name                  *string
-	description           *string
-	version               *string
-	author                *string
-	script_content        *string
-	is_enable             *bool
-	execution_timeout     *int32
-	addexecution_timeout  *int32
-	trigger_event         *string
-	priority              *int32
-	addpriority           *int32
-	clearedFields         map[string]struct{}
-	env_plugins           map[int64]struct{}
-	removedenv_plugins    map[int64]struct{}
-	clearedenv_plugins    bool
-	execution_logs        map[int64]struct{}
-	removedexecution_logs map[int64]struct{}
-	clearedexecution_logs bool
-	done                  bool
-	oldValue              func(context.Context) (*Plugin, error)
-	predicates            []predicate.Plugin
+	op                Op
+	typ               string
+	id                *int64
+	created_at        *time.Time
+	env_id            *int64
+	addenv_id         *int64
+	execution_status  *string
+	execution_time    *int32
+	addexecution_time *int32
+	input_data        *string
+	output_data       *string
+	error_message     *string
+	stack_trace       *string
+	clearedFields     map[string]struct{}
+	plugin            *int64
+	clearedplugin     bool
+	done              bool
+	oldValue          func(context.Context) (*PluginExecutionLog, error)
+	predicates        []predicate.PluginExecutionLog
 }
 
-var _ ent.Mutation = (*PluginMutation)(nil)
+var _ ent.Mutation = (*PluginExecutionLogMutation)(nil)
 
-// pluginOption allows management of the mutation configuration using functional options.
-type pluginOption func(*PluginMutation)
+// pluginexecutionlogOption allows management of the mutation configuration using functional options.
+type pluginexecutionlogOption func(*PluginExecutionLogMutation)
 
-// newPluginMutation creates new mutation for the Plugin entity.
-func newPluginMutation(c config, op Op, opts ...pluginOption) *PluginMutation {
-	m := &PluginMutation{
+// newPluginExecutionLogMutation creates new mutation for the PluginExecutionLog entity.
+func newPluginExecutionLogMutation(c config, op Op, opts ...pluginexecutionlogOption) *PluginExecutionLogMutation {
+	m := &PluginExecutionLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlugin,
+		typ:           TypePluginExecutionLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4416,20 +12718,20 @@ func newPluginMutation(c config, op Op, opts ...pluginOption) *PluginMutation {
 	return m
 }
 
-// withPluginID sets the ID field of the mutation.
-func withPluginID(id int64) pluginOption {
-	return func(m *PluginMutation) {
+// withPluginExecutionLogID sets the ID field of the mutation.
+func withPluginExecutionLogID(id int64) pluginexecutionlogOption {
+	return func(m *PluginExecutionLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Plugin
+			value *PluginExecutionLog
 		)
-		m.oldValue = func(ctx context.Context) (*Plugin, error) {
+		m.oldValue = func(ctx context.Context) (*PluginExecutionLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Plugin.Get(ctx, id)
+					value, err = m.Client().PluginExecutionLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4438,10 +12740,10 @@ func withPluginID(id int64) pluginOption {
 	}
 }
 
-// withPlugin sets the old Plugin of the mutation.
-func withPlugin(node *Plugin) pluginOption {
-	return func(m *PluginMutation) {
-		m.oldValue = func(context.Context) (*Plugin, error) {
+// withPluginExecutionLog sets the old PluginExecutionLog of the mutation.
+func withPluginExecutionLog(node *PluginExecutionLog) pluginexecutionlogOption {
+	return func(m *PluginExecutionLogMutation) {
+		m.oldValue = func(context.Context) (*PluginExecutionLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4450,1660 +12752,2122 @@ func withPlugin(node *Plugin) pluginOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PluginMutation) Client() *Client {
+func (m PluginExecutionLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PluginMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PluginExecutionLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PluginExecutionLog entities.
+func (m *PluginExecutionLogMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PluginExecutionLogMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PluginExecutionLogMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PluginExecutionLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PluginExecutionLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PluginExecutionLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginExecutionLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PluginExecutionLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPluginID sets the "plugin_id" field.
+func (m *PluginExecutionLogMutation) SetPluginID(i int64) {
+	m.plugin = &i
+}
+
+// PluginID returns the value of the "plugin_id" field in the mutation.
+func (m *PluginExecutionLogMutation) PluginID() (r int64, exists bool) {
+	v := m.plugin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPluginID returns the old "plugin_id" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginExecutionLogMutation) OldPluginID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPluginID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPluginID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPluginID: %w", err)
+	}
+	return oldValue.PluginID, nil
+}
+
+// ResetPluginID resets all changes to the "plugin_id" field.
+func (m *PluginExecutionLogMutation) ResetPluginID() {
+	m.plugin = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *PluginExecutionLogMutation) SetEnvID(i int64) {
+	m.env_id = &i
+	m.addenv_id = nil
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *PluginExecutionLogMutation) EnvID() (r int64, exists bool) {
+	v := m.env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginExecutionLogMutation) OldEnvID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// AddEnvID adds i to the "env_id" field.
+func (m *PluginExecutionLogMutation) AddEnvID(i int64) {
+	if m.addenv_id != nil {
+		*m.addenv_id += i
+	} else {
+		m.addenv_id = &i
+	}
+}
+
+// AddedEnvID returns the value that was added to the "env_id" field in this mutation.
+func (m *PluginExecutionLogMutation) AddedEnvID() (r int64, exists bool) {
+	v := m.addenv_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *PluginExecutionLogMutation) ResetEnvID() {
+	m.env_id = nil
+	m.addenv_id = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Plugin entities.
-func (m *PluginMutation) SetID(id int64) {
-	m.id = &id
+// SetExecutionStatus sets the "execution_status" field.
+func (m *PluginExecutionLogMutation) SetExecutionStatus(s string) {
+	m.execution_status = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PluginMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// ExecutionStatus returns the value of the "execution_status" field in the mutation.
+func (m *PluginExecutionLogMutation) ExecutionStatus() (r string, exists bool) {
+	v := m.execution_status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PluginMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Plugin.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldExecutionStatus returns the old "execution_status" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginExecutionLogMutation) OldExecutionStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutionStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutionStatus requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutionStatus: %w", err)
+	}
+	return oldValue.ExecutionStatus, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PluginMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetExecutionStatus resets all changes to the "execution_status" field.
+func (m *PluginExecutionLogMutation) ResetExecutionStatus() {
+	m.execution_status = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PluginMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetExecutionTime sets the "execution_time" field.
+func (m *PluginExecutionLogMutation) SetExecutionTime(i int32) {
+	m.execution_time = &i
+	m.addexecution_time = nil
+}
+
+// ExecutionTime returns the value of the "execution_time" field in the mutation.
+func (m *PluginExecutionLogMutation) ExecutionTime() (r int32, exists bool) {
+	v := m.execution_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// OldExecutionTime returns the old "execution_time" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PluginExecutionLogMutation) OldExecutionTime(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExecutionTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExecutionTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExecutionTime: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ExecutionTime, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PluginMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddExecutionTime adds i to the "execution_time" field.
+func (m *PluginExecutionLogMutation) AddExecutionTime(i int32) {
+	if m.addexecution_time != nil {
+		*m.addexecution_time += i
+	} else {
+		m.addexecution_time = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PluginMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedExecutionTime returns the value that was added to the "execution_time" field in this mutation.
+func (m *PluginExecutionLogMutation) AddedExecutionTime() (r int32, exists bool) {
+	v := m.addexecution_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PluginMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetExecutionTime resets all changes to the "execution_time" field.
+func (m *PluginExecutionLogMutation) ResetExecutionTime() {
+	m.execution_time = nil
+	m.addexecution_time = nil
+}
+
+// SetInputData sets the "input_data" field.
+func (m *PluginExecutionLogMutation) SetInputData(s string) {
+	m.input_data = &s
+}
+
+// InputData returns the value of the "input_data" field in the mutation.
+func (m *PluginExecutionLogMutation) InputData() (r string, exists bool) {
+	v := m.input_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// OldInputData returns the old "input_data" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PluginExecutionLogMutation) OldInputData(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldInputData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldInputData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldInputData: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.InputData, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PluginMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearInputData clears the value of the "input_data" field.
+func (m *PluginExecutionLogMutation) ClearInputData() {
+	m.input_data = nil
+	m.clearedFields[pluginexecutionlog.FieldInputData] = struct{}{}
 }
 
-// SetName sets the "name" field.
-func (m *PluginMutation) SetName(s string) {
-	m.name = &s
+// InputDataCleared returns if the "input_data" field was cleared in this mutation.
+func (m *PluginExecutionLogMutation) InputDataCleared() bool {
+	_, ok := m.clearedFields[pluginexecutionlog.FieldInputData]
+	return ok
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PluginMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetInputData resets all changes to the "input_data" field.
+func (m *PluginExecutionLogMutation) ResetInputData() {
+	m.input_data = nil
+	delete(m.clearedFields, pluginexecutionlog.FieldInputData)
+}
+
+// SetOutputData sets the "output_data" field.
+func (m *PluginExecutionLogMutation) SetOutputData(s string) {
+	m.output_data = &s
+}
+
+// OutputData returns the value of the "output_data" field in the mutation.
+func (m *PluginExecutionLogMutation) OutputData() (r string, exists bool) {
+	v := m.output_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// OldOutputData returns the old "output_data" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PluginExecutionLogMutation) OldOutputData(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutputData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOutputData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutputData: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OutputData, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PluginMutation) ResetName() {
-	m.name = nil
+// ClearOutputData clears the value of the "output_data" field.
+func (m *PluginExecutionLogMutation) ClearOutputData() {
+	m.output_data = nil
+	m.clearedFields[pluginexecutionlog.FieldOutputData] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *PluginMutation) SetDescription(s string) {
-	m.description = &s
+// OutputDataCleared returns if the "output_data" field was cleared in this mutation.
+func (m *PluginExecutionLogMutation) OutputDataCleared() bool {
+	_, ok := m.clearedFields[pluginexecutionlog.FieldOutputData]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PluginMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetOutputData resets all changes to the "output_data" field.
+func (m *PluginExecutionLogMutation) ResetOutputData() {
+	m.output_data = nil
+	delete(m.clearedFields, pluginexecutionlog.FieldOutputData)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *PluginExecutionLogMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *PluginExecutionLogMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *PluginExecutionLogMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *PluginMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[plugin.FieldDescription] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *PluginExecutionLogMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[pluginexecutionlog.FieldErrorMessage] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PluginMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[plugin.FieldDescription]
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *PluginExecutionLogMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[pluginexecutionlog.FieldErrorMessage]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PluginMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, plugin.FieldDescription)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *PluginExecutionLogMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, pluginexecutionlog.FieldErrorMessage)
 }
 
-// SetVersion sets the "version" field.
-func (m *PluginMutation) SetVersion(s string) {
-	m.version = &s
+// SetStackTrace sets the "stack_trace" field.
+func (m *PluginExecutionLogMutation) SetStackTrace(s string) {
+	m.stack_trace = &s
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *PluginMutation) Version() (r string, exists bool) {
-	v := m.version
+// StackTrace returns the value of the "stack_trace" field in the mutation.
+func (m *PluginExecutionLogMutation) StackTrace() (r string, exists bool) {
+	v := m.stack_trace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
+// OldStackTrace returns the old "stack_trace" field's value of the PluginExecutionLog entity.
+// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldVersion(ctx context.Context) (v string, err error) {
+func (m *PluginExecutionLogMutation) OldStackTrace(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldStackTrace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldStackTrace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldStackTrace: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.StackTrace, nil
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *PluginMutation) ResetVersion() {
-	m.version = nil
+// ClearStackTrace clears the value of the "stack_trace" field.
+func (m *PluginExecutionLogMutation) ClearStackTrace() {
+	m.stack_trace = nil
+	m.clearedFields[pluginexecutionlog.FieldStackTrace] = struct{}{}
 }
 
-// SetAuthor sets the "author" field.
-func (m *PluginMutation) SetAuthor(s string) {
-	m.author = &s
+// StackTraceCleared returns if the "stack_trace" field was cleared in this mutation.
+func (m *PluginExecutionLogMutation) StackTraceCleared() bool {
+	_, ok := m.clearedFields[pluginexecutionlog.FieldStackTrace]
+	return ok
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *PluginMutation) Author() (r string, exists bool) {
-	v := m.author
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetStackTrace resets all changes to the "stack_trace" field.
+func (m *PluginExecutionLogMutation) ResetStackTrace() {
+	m.stack_trace = nil
+	delete(m.clearedFields, pluginexecutionlog.FieldStackTrace)
 }
 
-// OldAuthor returns the old "author" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldAuthor(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+// ClearPlugin clears the "plugin" edge to the Plugin entity.
+func (m *PluginExecutionLogMutation) ClearPlugin() {
+	m.clearedplugin = true
+	m.clearedFields[pluginexecutionlog.FieldPluginID] = struct{}{}
+}
+
+// PluginCleared reports if the "plugin" edge to the Plugin entity was cleared.
+func (m *PluginExecutionLogMutation) PluginCleared() bool {
+	return m.clearedplugin
+}
+
+// PluginIDs returns the "plugin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PluginID instead. It exists only for internal usage by the builders.
+func (m *PluginExecutionLogMutation) PluginIDs() (ids []int64) {
+	if id := m.plugin; id != nil {
+		ids = append(ids, *id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	return
+}
+
+// ResetPlugin resets all changes to the "plugin" edge.
+func (m *PluginExecutionLogMutation) ResetPlugin() {
+	m.plugin = nil
+	m.clearedplugin = false
+}
+
+// Where appends a list predicates to the PluginExecutionLogMutation builder.
+func (m *PluginExecutionLogMutation) Where(ps ...predicate.PluginExecutionLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PluginExecutionLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PluginExecutionLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PluginExecutionLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Author, nil
+	m.Where(p...)
 }
 
-// ClearAuthor clears the value of the "author" field.
-func (m *PluginMutation) ClearAuthor() {
-	m.author = nil
-	m.clearedFields[plugin.FieldAuthor] = struct{}{}
+// Op returns the operation name.
+func (m *PluginExecutionLogMutation) Op() Op {
+	return m.op
 }
 
-// AuthorCleared returns if the "author" field was cleared in this mutation.
-func (m *PluginMutation) AuthorCleared() bool {
-	_, ok := m.clearedFields[plugin.FieldAuthor]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *PluginExecutionLogMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *PluginMutation) ResetAuthor() {
-	m.author = nil
-	delete(m.clearedFields, plugin.FieldAuthor)
+// Type returns the node type of this mutation (PluginExecutionLog).
+func (m *PluginExecutionLogMutation) Type() string {
+	return m.typ
 }
 
-// SetScriptContent sets the "script_content" field.
-func (m *PluginMutation) SetScriptContent(s string) {
-	m.script_content = &s
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PluginExecutionLogMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, pluginexecutionlog.FieldCreatedAt)
+	}
+	if m.plugin != nil {
+		fields = append(fields, pluginexecutionlog.FieldPluginID)
+	}
+	if m.env_id != nil {
+		fields = append(fields, pluginexecutionlog.FieldEnvID)
+	}
+	if m.execution_status != nil {
+		fields = append(fields, pluginexecutionlog.FieldExecutionStatus)
+	}
+	if m.execution_time != nil {
+		fields = append(fields, pluginexecutionlog.FieldExecutionTime)
+	}
+	if m.input_data != nil {
+		fields = append(fields, pluginexecutionlog.FieldInputData)
+	}
+	if m.output_data != nil {
+		fields = append(fields, pluginexecutionlog.FieldOutputData)
+	}
+	if m.error_message != nil {
+		fields = append(fields, pluginexecutionlog.FieldErrorMessage)
+	}
+	if m.stack_trace != nil {
+		fields = append(fields, pluginexecutionlog.FieldStackTrace)
+	}
+	return fields
 }
 
-// ScriptContent returns the value of the "script_content" field in the mutation.
-func (m *PluginMutation) ScriptContent() (r string, exists bool) {
-	v := m.script_content
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PluginExecutionLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pluginexecutionlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case pluginexecutionlog.FieldPluginID:
+		return m.PluginID()
+	case pluginexecutionlog.FieldEnvID:
+		return m.EnvID()
+	case pluginexecutionlog.FieldExecutionStatus:
+		return m.ExecutionStatus()
+	case pluginexecutionlog.FieldExecutionTime:
+		return m.ExecutionTime()
+	case pluginexecutionlog.FieldInputData:
+		return m.InputData()
+	case pluginexecutionlog.FieldOutputData:
+		return m.OutputData()
+	case pluginexecutionlog.FieldErrorMessage:
+		return m.ErrorMessage()
+	case pluginexecutionlog.FieldStackTrace:
+		return m.StackTrace()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PluginExecutionLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pluginexecutionlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pluginexecutionlog.FieldPluginID:
+		return m.OldPluginID(ctx)
+	case pluginexecutionlog.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case pluginexecutionlog.FieldExecutionStatus:
+		return m.OldExecutionStatus(ctx)
+	case pluginexecutionlog.FieldExecutionTime:
+		return m.OldExecutionTime(ctx)
+	case pluginexecutionlog.FieldInputData:
+		return m.OldInputData(ctx)
+	case pluginexecutionlog.FieldOutputData:
+		return m.OldOutputData(ctx)
+	case pluginexecutionlog.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case pluginexecutionlog.FieldStackTrace:
+		return m.OldStackTrace(ctx)
+	}
+	return nil, fmt.Errorf("unknown PluginExecutionLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PluginExecutionLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pluginexecutionlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pluginexecutionlog.FieldPluginID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPluginID(v)
+		return nil
+	case pluginexecutionlog.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case pluginexecutionlog.FieldExecutionStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionStatus(v)
+		return nil
+	case pluginexecutionlog.FieldExecutionTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionTime(v)
+		return nil
+	case pluginexecutionlog.FieldInputData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputData(v)
+		return nil
+	case pluginexecutionlog.FieldOutputData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputData(v)
+		return nil
+	case pluginexecutionlog.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case pluginexecutionlog.FieldStackTrace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStackTrace(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PluginExecutionLog field %s", name)
 }
 
-// OldScriptContent returns the old "script_content" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldScriptContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScriptContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScriptContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScriptContent: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PluginExecutionLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addenv_id != nil {
+		fields = append(fields, pluginexecutionlog.FieldEnvID)
 	}
-	return oldValue.ScriptContent, nil
-}
-
-// ResetScriptContent resets all changes to the "script_content" field.
-func (m *PluginMutation) ResetScriptContent() {
-	m.script_content = nil
-}
-
-// SetIsEnable sets the "is_enable" field.
-func (m *PluginMutation) SetIsEnable(b bool) {
-	m.is_enable = &b
-}
-
-// IsEnable returns the value of the "is_enable" field in the mutation.
-func (m *PluginMutation) IsEnable() (r bool, exists bool) {
-	v := m.is_enable
-	if v == nil {
-		return
+	if m.addexecution_time != nil {
+		fields = append(fields, pluginexecutionlog.FieldExecutionTime)
 	}
-	return *v, true
+	return fields
 }
 
-// OldIsEnable returns the old "is_enable" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnable requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PluginExecutionLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pluginexecutionlog.FieldEnvID:
+		return m.AddedEnvID()
+	case pluginexecutionlog.FieldExecutionTime:
+		return m.AddedExecutionTime()
 	}
-	return oldValue.IsEnable, nil
-}
-
-// ResetIsEnable resets all changes to the "is_enable" field.
-func (m *PluginMutation) ResetIsEnable() {
-	m.is_enable = nil
-}
-
-// SetExecutionTimeout sets the "execution_timeout" field.
-func (m *PluginMutation) SetExecutionTimeout(i int32) {
-	m.execution_timeout = &i
-	m.addexecution_timeout = nil
+	return nil, false
 }
 
-// ExecutionTimeout returns the value of the "execution_timeout" field in the mutation.
-func (m *PluginMutation) ExecutionTimeout() (r int32, exists bool) {
-	v := m.execution_timeout
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PluginExecutionLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pluginexecutionlog.FieldEnvID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnvID(v)
+		return nil
+	case pluginexecutionlog.FieldExecutionTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExecutionTime(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PluginExecutionLog numeric field %s", name)
 }
 
-// OldExecutionTimeout returns the old "execution_timeout" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldExecutionTimeout(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionTimeout is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionTimeout requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PluginExecutionLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pluginexecutionlog.FieldInputData) {
+		fields = append(fields, pluginexecutionlog.FieldInputData)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionTimeout: %w", err)
+	if m.FieldCleared(pluginexecutionlog.FieldOutputData) {
+		fields = append(fields, pluginexecutionlog.FieldOutputData)
 	}
-	return oldValue.ExecutionTimeout, nil
-}
-
-// AddExecutionTimeout adds i to the "execution_timeout" field.
-func (m *PluginMutation) AddExecutionTimeout(i int32) {
-	if m.addexecution_timeout != nil {
-		*m.addexecution_timeout += i
-	} else {
-		m.addexecution_timeout = &i
+	if m.FieldCleared(pluginexecutionlog.FieldErrorMessage) {
+		fields = append(fields, pluginexecutionlog.FieldErrorMessage)
 	}
-}
-
-// AddedExecutionTimeout returns the value that was added to the "execution_timeout" field in this mutation.
-func (m *PluginMutation) AddedExecutionTimeout() (r int32, exists bool) {
-	v := m.addexecution_timeout
-	if v == nil {
-		return
+	if m.FieldCleared(pluginexecutionlog.FieldStackTrace) {
+		fields = append(fields, pluginexecutionlog.FieldStackTrace)
 	}
-	return *v, true
-}
-
-// ResetExecutionTimeout resets all changes to the "execution_timeout" field.
-func (m *PluginMutation) ResetExecutionTimeout() {
-	m.execution_timeout = nil
-	m.addexecution_timeout = nil
+	return fields
 }
 
-// SetTriggerEvent sets the "trigger_event" field.
-func (m *PluginMutation) SetTriggerEvent(s string) {
-	m.trigger_event = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PluginExecutionLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// TriggerEvent returns the value of the "trigger_event" field in the mutation.
-func (m *PluginMutation) TriggerEvent() (r string, exists bool) {
-	v := m.trigger_event
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PluginExecutionLogMutation) ClearField(name string) error {
+	switch name {
+	case pluginexecutionlog.FieldInputData:
+		m.ClearInputData()
+		return nil
+	case pluginexecutionlog.FieldOutputData:
+		m.ClearOutputData()
+		return nil
+	case pluginexecutionlog.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case pluginexecutionlog.FieldStackTrace:
+		m.ClearStackTrace()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PluginExecutionLog nullable field %s", name)
 }
 
-// OldTriggerEvent returns the old "trigger_event" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldTriggerEvent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggerEvent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggerEvent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggerEvent: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PluginExecutionLogMutation) ResetField(name string) error {
+	switch name {
+	case pluginexecutionlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pluginexecutionlog.FieldPluginID:
+		m.ResetPluginID()
+		return nil
+	case pluginexecutionlog.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case pluginexecutionlog.FieldExecutionStatus:
+		m.ResetExecutionStatus()
+		return nil
+	case pluginexecutionlog.FieldExecutionTime:
+		m.ResetExecutionTime()
+		return nil
+	case pluginexecutionlog.FieldInputData:
+		m.ResetInputData()
+		return nil
+	case pluginexecutionlog.FieldOutputData:
+		m.ResetOutputData()
+		return nil
+	case pluginexecutionlog.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case pluginexecutionlog.FieldStackTrace:
+		m.ResetStackTrace()
+		return nil
 	}
-	return oldValue.TriggerEvent, nil
-}
-
-// ResetTriggerEvent resets all changes to the "trigger_event" field.
-func (m *PluginMutation) ResetTriggerEvent() {
-	m.trigger_event = nil
-}
-
-// SetPriority sets the "priority" field.
-func (m *PluginMutation) SetPriority(i int32) {
-	m.priority = &i
-	m.addpriority = nil
+	return fmt.Errorf("unknown PluginExecutionLog field %s", name)
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *PluginMutation) Priority() (r int32, exists bool) {
-	v := m.priority
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PluginExecutionLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.plugin != nil {
+		edges = append(edges, pluginexecutionlog.EdgePlugin)
 	}
-	return *v, true
+	return edges
 }
 
-// OldPriority returns the old "priority" field's value of the Plugin entity.
-// If the Plugin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginMutation) OldPriority(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PluginExecutionLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pluginexecutionlog.EdgePlugin:
+		if id := m.plugin; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Priority, nil
+	return nil
 }
 
-// AddPriority adds i to the "priority" field.
-func (m *PluginMutation) AddPriority(i int32) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PluginExecutionLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *PluginMutation) AddedPriority() (r int32, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PluginExecutionLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *PluginMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PluginExecutionLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplugin {
+		edges = append(edges, pluginexecutionlog.EdgePlugin)
+	}
+	return edges
 }
 
-// AddEnvPluginIDs adds the "env_plugins" edge to the EnvPlugin entity by ids.
-func (m *PluginMutation) AddEnvPluginIDs(ids ...int64) {
-	if m.env_plugins == nil {
-		m.env_plugins = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.env_plugins[ids[i]] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PluginExecutionLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pluginexecutionlog.EdgePlugin:
+		return m.clearedplugin
 	}
+	return false
 }
 
-// ClearEnvPlugins clears the "env_plugins" edge to the EnvPlugin entity.
-func (m *PluginMutation) ClearEnvPlugins() {
-	m.clearedenv_plugins = true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PluginExecutionLogMutation) ClearEdge(name string) error {
+	switch name {
+	case pluginexecutionlog.EdgePlugin:
+		m.ClearPlugin()
+		return nil
+	}
+	return fmt.Errorf("unknown PluginExecutionLog unique edge %s", name)
 }
 
-// EnvPluginsCleared reports if the "env_plugins" edge to the EnvPlugin entity was cleared.
-func (m *PluginMutation) EnvPluginsCleared() bool {
-	return m.clearedenv_plugins
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PluginExecutionLogMutation) ResetEdge(name string) error {
+	switch name {
+	case pluginexecutionlog.EdgePlugin:
+		m.ResetPlugin()
+		return nil
+	}
+	return fmt.Errorf("unknown PluginExecutionLog edge %s", name)
 }
 
-// RemoveEnvPluginIDs removes the "env_plugins" edge to the EnvPlugin entity by IDs.
-func (m *PluginMutation) RemoveEnvPluginIDs(ids ...int64) {
-	if m.removedenv_plugins == nil {
-		m.removedenv_plugins = make(map[int64]struct{})
+// PluginTestCaseMutation represents an operation that mutates the PluginTestCase nodes in the graph.
+type PluginTestCaseMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	created_at     *time.Time
+	updated_at     *time.Time
+	name           *string
+	input_value    *string
+	_config        *string
+	expected_bool  *bool
+	expected_env   *string
+	expected_error *string
+	is_enable      *bool
+	clearedFields  map[string]struct{}
+	plugin         *int64
+	clearedplugin  bool
+	done           bool
+	oldValue       func(context.Context) (*PluginTestCase, error)
+	predicates     []predicate.PluginTestCase
+}
+
+var _ ent.Mutation = (*PluginTestCaseMutation)(nil)
+
+// plugintestcaseOption allows management of the mutation configuration using functional options.
+type plugintestcaseOption func(*PluginTestCaseMutation)
+
+// newPluginTestCaseMutation creates new mutation for the PluginTestCase entity.
+func newPluginTestCaseMutation(c config, op Op, opts ...plugintestcaseOption) *PluginTestCaseMutation {
+	m := &PluginTestCaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePluginTestCase,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.env_plugins, ids[i])
-		m.removedenv_plugins[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedEnvPlugins returns the removed IDs of the "env_plugins" edge to the EnvPlugin entity.
-func (m *PluginMutation) RemovedEnvPluginsIDs() (ids []int64) {
-	for id := range m.removedenv_plugins {
-		ids = append(ids, id)
+// withPluginTestCaseID sets the ID field of the mutation.
+func withPluginTestCaseID(id int64) plugintestcaseOption {
+	return func(m *PluginTestCaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PluginTestCase
+		)
+		m.oldValue = func(ctx context.Context) (*PluginTestCase, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PluginTestCase.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// EnvPluginsIDs returns the "env_plugins" edge IDs in the mutation.
-func (m *PluginMutation) EnvPluginsIDs() (ids []int64) {
-	for id := range m.env_plugins {
-		ids = append(ids, id)
+// withPluginTestCase sets the old PluginTestCase of the mutation.
+func withPluginTestCase(node *PluginTestCase) plugintestcaseOption {
+	return func(m *PluginTestCaseMutation) {
+		m.oldValue = func(context.Context) (*PluginTestCase, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetEnvPlugins resets all changes to the "env_plugins" edge.
-func (m *PluginMutation) ResetEnvPlugins() {
-	m.env_plugins = nil
-	m.clearedenv_plugins = false
-	m.removedenv_plugins = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PluginTestCaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddExecutionLogIDs adds the "execution_logs" edge to the PluginExecutionLog entity by ids.
-func (m *PluginMutation) AddExecutionLogIDs(ids ...int64) {
-	if m.execution_logs == nil {
-		m.execution_logs = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.execution_logs[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PluginTestCaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearExecutionLogs clears the "execution_logs" edge to the PluginExecutionLog entity.
-func (m *PluginMutation) ClearExecutionLogs() {
-	m.clearedexecution_logs = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PluginTestCase entities.
+func (m *PluginTestCaseMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// ExecutionLogsCleared reports if the "execution_logs" edge to the PluginExecutionLog entity was cleared.
-func (m *PluginMutation) ExecutionLogsCleared() bool {
-	return m.clearedexecution_logs
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PluginTestCaseMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// RemoveExecutionLogIDs removes the "execution_logs" edge to the PluginExecutionLog entity by IDs.
-func (m *PluginMutation) RemoveExecutionLogIDs(ids ...int64) {
-	if m.removedexecution_logs == nil {
-		m.removedexecution_logs = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.execution_logs, ids[i])
-		m.removedexecution_logs[ids[i]] = struct{}{}
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PluginTestCaseMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PluginTestCase.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// RemovedExecutionLogs returns the removed IDs of the "execution_logs" edge to the PluginExecutionLog entity.
-func (m *PluginMutation) RemovedExecutionLogsIDs() (ids []int64) {
-	for id := range m.removedexecution_logs {
-		ids = append(ids, id)
+// SetCreatedAt sets the "created_at" field.
+func (m *PluginTestCaseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PluginTestCaseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ExecutionLogsIDs returns the "execution_logs" edge IDs in the mutation.
-func (m *PluginMutation) ExecutionLogsIDs() (ids []int64) {
-	for id := range m.execution_logs {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetExecutionLogs resets all changes to the "execution_logs" edge.
-func (m *PluginMutation) ResetExecutionLogs() {
-	m.execution_logs = nil
-	m.clearedexecution_logs = false
-	m.removedexecution_logs = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PluginTestCaseMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the PluginMutation builder.
-func (m *PluginMutation) Where(ps ...predicate.Plugin) {
-	m.predicates = append(m.predicates, ps...)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PluginTestCaseMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// WhereP appends storage-level predicates to the PluginMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PluginMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Plugin, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PluginTestCaseMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PluginMutation) Op() Op {
-	return m.op
+// OldUpdatedAt returns the old "updated_at" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PluginMutation) SetOp(op Op) {
-	m.op = op
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PluginTestCaseMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Type returns the node type of this mutation (Plugin).
-func (m *PluginMutation) Type() string {
-	return m.typ
+// SetPluginID sets the "plugin_id" field.
+func (m *PluginTestCaseMutation) SetPluginID(i int64) {
+	m.plugin = &i
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PluginMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.created_at != nil {
-		fields = append(fields, plugin.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, plugin.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, plugin.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, plugin.FieldDescription)
-	}
-	if m.version != nil {
-		fields = append(fields, plugin.FieldVersion)
-	}
-	if m.author != nil {
-		fields = append(fields, plugin.FieldAuthor)
-	}
-	if m.script_content != nil {
-		fields = append(fields, plugin.FieldScriptContent)
-	}
-	if m.is_enable != nil {
-		fields = append(fields, plugin.FieldIsEnable)
+// PluginID returns the value of the "plugin_id" field in the mutation.
+func (m *PluginTestCaseMutation) PluginID() (r int64, exists bool) {
+	v := m.plugin
+	if v == nil {
+		return
 	}
-	if m.execution_timeout != nil {
-		fields = append(fields, plugin.FieldExecutionTimeout)
+	return *v, true
+}
+
+// OldPluginID returns the old "plugin_id" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldPluginID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPluginID is only allowed on UpdateOne operations")
 	}
-	if m.trigger_event != nil {
-		fields = append(fields, plugin.FieldTriggerEvent)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPluginID requires an ID field in the mutation")
 	}
-	if m.priority != nil {
-		fields = append(fields, plugin.FieldPriority)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPluginID: %w", err)
 	}
-	return fields
+	return oldValue.PluginID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PluginMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case plugin.FieldCreatedAt:
-		return m.CreatedAt()
-	case plugin.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case plugin.FieldName:
-		return m.Name()
-	case plugin.FieldDescription:
-		return m.Description()
-	case plugin.FieldVersion:
-		return m.Version()
-	case plugin.FieldAuthor:
-		return m.Author()
-	case plugin.FieldScriptContent:
-		return m.ScriptContent()
-	case plugin.FieldIsEnable:
-		return m.IsEnable()
-	case plugin.FieldExecutionTimeout:
-		return m.ExecutionTimeout()
-	case plugin.FieldTriggerEvent:
-		return m.TriggerEvent()
-	case plugin.FieldPriority:
-		return m.Priority()
+// ResetPluginID resets all changes to the "plugin_id" field.
+func (m *PluginTestCaseMutation) ResetPluginID() {
+	m.plugin = nil
+}
+
+// SetName sets the "name" field.
+func (m *PluginTestCaseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PluginTestCaseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PluginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case plugin.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case plugin.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case plugin.FieldName:
-		return m.OldName(ctx)
-	case plugin.FieldDescription:
-		return m.OldDescription(ctx)
-	case plugin.FieldVersion:
-		return m.OldVersion(ctx)
-	case plugin.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case plugin.FieldScriptContent:
-		return m.OldScriptContent(ctx)
-	case plugin.FieldIsEnable:
-		return m.OldIsEnable(ctx)
-	case plugin.FieldExecutionTimeout:
-		return m.OldExecutionTimeout(ctx)
-	case plugin.FieldTriggerEvent:
-		return m.OldTriggerEvent(ctx)
-	case plugin.FieldPriority:
-		return m.OldPriority(ctx)
+// OldName returns the old "name" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Plugin field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PluginMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case plugin.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case plugin.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case plugin.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case plugin.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case plugin.FieldVersion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVersion(v)
-		return nil
-	case plugin.FieldAuthor:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthor(v)
-		return nil
-	case plugin.FieldScriptContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScriptContent(v)
-		return nil
-	case plugin.FieldIsEnable:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsEnable(v)
-		return nil
-	case plugin.FieldExecutionTimeout:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionTimeout(v)
-		return nil
-	case plugin.FieldTriggerEvent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTriggerEvent(v)
-		return nil
-	case plugin.FieldPriority:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
+// ResetName resets all changes to the "name" field.
+func (m *PluginTestCaseMutation) ResetName() {
+	m.name = nil
+}
+
+// SetInputValue sets the "input_value" field.
+func (m *PluginTestCaseMutation) SetInputValue(s string) {
+	m.input_value = &s
+}
+
+// InputValue returns the value of the "input_value" field in the mutation.
+func (m *PluginTestCaseMutation) InputValue() (r string, exists bool) {
+	v := m.input_value
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Plugin field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PluginMutation) AddedFields() []string {
-	var fields []string
-	if m.addexecution_timeout != nil {
-		fields = append(fields, plugin.FieldExecutionTimeout)
+// OldInputValue returns the old "input_value" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldInputValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputValue is only allowed on UpdateOne operations")
 	}
-	if m.addpriority != nil {
-		fields = append(fields, plugin.FieldPriority)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputValue requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputValue: %w", err)
+	}
+	return oldValue.InputValue, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PluginMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case plugin.FieldExecutionTimeout:
-		return m.AddedExecutionTimeout()
-	case plugin.FieldPriority:
-		return m.AddedPriority()
-	}
-	return nil, false
+// ResetInputValue resets all changes to the "input_value" field.
+func (m *PluginTestCaseMutation) ResetInputValue() {
+	m.input_value = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PluginMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case plugin.FieldExecutionTimeout:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExecutionTimeout(v)
-		return nil
-	case plugin.FieldPriority:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
+// SetConfig sets the "config" field.
+func (m *PluginTestCaseMutation) SetConfig(s string) {
+	m._config = &s
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *PluginTestCaseMutation) Config() (r string, exists bool) {
+	v := m._config
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Plugin numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PluginMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(plugin.FieldDescription) {
-		fields = append(fields, plugin.FieldDescription)
+// OldConfig returns the old "config" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldConfig(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(plugin.FieldAuthor) {
-		fields = append(fields, plugin.FieldAuthor)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PluginMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearConfig clears the value of the "config" field.
+func (m *PluginTestCaseMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[plugintestcase.FieldConfig] = struct{}{}
+}
+
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *PluginTestCaseMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[plugintestcase.FieldConfig]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PluginMutation) ClearField(name string) error {
-	switch name {
-	case plugin.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case plugin.FieldAuthor:
-		m.ClearAuthor()
-		return nil
+// ResetConfig resets all changes to the "config" field.
+func (m *PluginTestCaseMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, plugintestcase.FieldConfig)
+}
+
+// SetExpectedBool sets the "expected_bool" field.
+func (m *PluginTestCaseMutation) SetExpectedBool(b bool) {
+	m.expected_bool = &b
+}
+
+// ExpectedBool returns the value of the "expected_bool" field in the mutation.
+func (m *PluginTestCaseMutation) ExpectedBool() (r bool, exists bool) {
+	v := m.expected_bool
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Plugin nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PluginMutation) ResetField(name string) error {
-	switch name {
-	case plugin.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case plugin.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case plugin.FieldName:
-		m.ResetName()
-		return nil
-	case plugin.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case plugin.FieldVersion:
-		m.ResetVersion()
-		return nil
-	case plugin.FieldAuthor:
-		m.ResetAuthor()
-		return nil
-	case plugin.FieldScriptContent:
-		m.ResetScriptContent()
-		return nil
-	case plugin.FieldIsEnable:
-		m.ResetIsEnable()
-		return nil
-	case plugin.FieldExecutionTimeout:
-		m.ResetExecutionTimeout()
-		return nil
-	case plugin.FieldTriggerEvent:
-		m.ResetTriggerEvent()
-		return nil
-	case plugin.FieldPriority:
-		m.ResetPriority()
-		return nil
+// OldExpectedBool returns the old "expected_bool" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldExpectedBool(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedBool is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Plugin field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedBool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedBool: %w", err)
+	}
+	return oldValue.ExpectedBool, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PluginMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.env_plugins != nil {
-		edges = append(edges, plugin.EdgeEnvPlugins)
+// ClearExpectedBool clears the value of the "expected_bool" field.
+func (m *PluginTestCaseMutation) ClearExpectedBool() {
+	m.expected_bool = nil
+	m.clearedFields[plugintestcase.FieldExpectedBool] = struct{}{}
+}
+
+// ExpectedBoolCleared returns if the "expected_bool" field was cleared in this mutation.
+func (m *PluginTestCaseMutation) ExpectedBoolCleared() bool {
+	_, ok := m.clearedFields[plugintestcase.FieldExpectedBool]
+	return ok
+}
+
+// ResetExpectedBool resets all changes to the "expected_bool" field.
+func (m *PluginTestCaseMutation) ResetExpectedBool() {
+	m.expected_bool = nil
+	delete(m.clearedFields, plugintestcase.FieldExpectedBool)
+}
+
+// SetExpectedEnv sets the "expected_env" field.
+func (m *PluginTestCaseMutation) SetExpectedEnv(s string) {
+	m.expected_env = &s
+}
+
+// ExpectedEnv returns the value of the "expected_env" field in the mutation.
+func (m *PluginTestCaseMutation) ExpectedEnv() (r string, exists bool) {
+	v := m.expected_env
+	if v == nil {
+		return
 	}
-	if m.execution_logs != nil {
-		edges = append(edges, plugin.EdgeExecutionLogs)
+	return *v, true
+}
+
+// OldExpectedEnv returns the old "expected_env" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldExpectedEnv(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedEnv is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedEnv requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedEnv: %w", err)
+	}
+	return oldValue.ExpectedEnv, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PluginMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case plugin.EdgeEnvPlugins:
-		ids := make([]ent.Value, 0, len(m.env_plugins))
-		for id := range m.env_plugins {
-			ids = append(ids, id)
-		}
-		return ids
-	case plugin.EdgeExecutionLogs:
-		ids := make([]ent.Value, 0, len(m.execution_logs))
-		for id := range m.execution_logs {
-			ids = append(ids, id)
-		}
-		return ids
+// ClearExpectedEnv clears the value of the "expected_env" field.
+func (m *PluginTestCaseMutation) ClearExpectedEnv() {
+	m.expected_env = nil
+	m.clearedFields[plugintestcase.FieldExpectedEnv] = struct{}{}
+}
+
+// ExpectedEnvCleared returns if the "expected_env" field was cleared in this mutation.
+func (m *PluginTestCaseMutation) ExpectedEnvCleared() bool {
+	_, ok := m.clearedFields[plugintestcase.FieldExpectedEnv]
+	return ok
+}
+
+// ResetExpectedEnv resets all changes to the "expected_env" field.
+func (m *PluginTestCaseMutation) ResetExpectedEnv() {
+	m.expected_env = nil
+	delete(m.clearedFields, plugintestcase.FieldExpectedEnv)
+}
+
+// SetExpectedError sets the "expected_error" field.
+func (m *PluginTestCaseMutation) SetExpectedError(s string) {
+	m.expected_error = &s
+}
+
+// ExpectedError returns the value of the "expected_error" field in the mutation.
+func (m *PluginTestCaseMutation) ExpectedError() (r string, exists bool) {
+	v := m.expected_error
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PluginMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedenv_plugins != nil {
-		edges = append(edges, plugin.EdgeEnvPlugins)
+// OldExpectedError returns the old "expected_error" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldExpectedError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedError is only allowed on UpdateOne operations")
 	}
-	if m.removedexecution_logs != nil {
-		edges = append(edges, plugin.EdgeExecutionLogs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedError requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedError: %w", err)
+	}
+	return oldValue.ExpectedError, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PluginMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case plugin.EdgeEnvPlugins:
-		ids := make([]ent.Value, 0, len(m.removedenv_plugins))
-		for id := range m.removedenv_plugins {
-			ids = append(ids, id)
-		}
-		return ids
-	case plugin.EdgeExecutionLogs:
-		ids := make([]ent.Value, 0, len(m.removedexecution_logs))
-		for id := range m.removedexecution_logs {
-			ids = append(ids, id)
-		}
-		return ids
+// ClearExpectedError clears the value of the "expected_error" field.
+func (m *PluginTestCaseMutation) ClearExpectedError() {
+	m.expected_error = nil
+	m.clearedFields[plugintestcase.FieldExpectedError] = struct{}{}
+}
+
+// ExpectedErrorCleared returns if the "expected_error" field was cleared in this mutation.
+func (m *PluginTestCaseMutation) ExpectedErrorCleared() bool {
+	_, ok := m.clearedFields[plugintestcase.FieldExpectedError]
+	return ok
+}
+
+// ResetExpectedError resets all changes to the "expected_error" field.
+func (m *PluginTestCaseMutation) ResetExpectedError() {
+	m.expected_error = nil
+	delete(m.clearedFields, plugintestcase.FieldExpectedError)
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (m *PluginTestCaseMutation) SetIsEnable(b bool) {
+	m.is_enable = &b
+}
+
+// IsEnable returns the value of the "is_enable" field in the mutation.
+func (m *PluginTestCaseMutation) IsEnable() (r bool, exists bool) {
+	v := m.is_enable
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PluginMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedenv_plugins {
-		edges = append(edges, plugin.EdgeEnvPlugins)
+// OldIsEnable returns the old "is_enable" field's value of the PluginTestCase entity.
+// If the PluginTestCase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PluginTestCaseMutation) OldIsEnable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnable is only allowed on UpdateOne operations")
 	}
-	if m.clearedexecution_logs {
-		edges = append(edges, plugin.EdgeExecutionLogs)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnable requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnable: %w", err)
+	}
+	return oldValue.IsEnable, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PluginMutation) EdgeCleared(name string) bool {
-	switch name {
-	case plugin.EdgeEnvPlugins:
-		return m.clearedenv_plugins
-	case plugin.EdgeExecutionLogs:
-		return m.clearedexecution_logs
-	}
-	return false
+// ResetIsEnable resets all changes to the "is_enable" field.
+func (m *PluginTestCaseMutation) ResetIsEnable() {
+	m.is_enable = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PluginMutation) ClearEdge(name string) error {
-	switch name {
+// ClearPlugin clears the "plugin" edge to the Plugin entity.
+func (m *PluginTestCaseMutation) ClearPlugin() {
+	m.clearedplugin = true
+	m.clearedFields[plugintestcase.FieldPluginID] = struct{}{}
+}
+
+// PluginCleared reports if the "plugin" edge to the Plugin entity was cleared.
+func (m *PluginTestCaseMutation) PluginCleared() bool {
+	return m.clearedplugin
+}
+
+// PluginIDs returns the "plugin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PluginID instead. It exists only for internal usage by the builders.
+func (m *PluginTestCaseMutation) PluginIDs() (ids []int64) {
+	if id := m.plugin; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Plugin unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PluginMutation) ResetEdge(name string) error {
-	switch name {
-	case plugin.EdgeEnvPlugins:
-		m.ResetEnvPlugins()
-		return nil
-	case plugin.EdgeExecutionLogs:
-		m.ResetExecutionLogs()
-		return nil
+// ResetPlugin resets all changes to the "plugin" edge.
+func (m *PluginTestCaseMutation) ResetPlugin() {
+	m.plugin = nil
+	m.clearedplugin = false
+}
+
+// Where appends a list predicates to the PluginTestCaseMutation builder.
+func (m *PluginTestCaseMutation) Where(ps ...predicate.PluginTestCase) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PluginTestCaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PluginTestCaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PluginTestCase, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return fmt.Errorf("unknown Plugin edge %s", name)
+	m.Where(p...)
 }
 
-// PluginExecutionLogMutation represents an operation that mutates the PluginExecutionLog nodes in the graph.
-type PluginExecutionLogMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *int64
-	created_at        *time.Time
-	env_id            *int64
-	addenv_id         *int64
-	execution_status  *string
-	execution_time    *int32
-	addexecution_time *int32
-	input_data        *string
-	output_data       *string
-	error_message     *string
-	stack_trace       *string
-	clearedFields     map[string]struct{}
-	plugin            *int64
-	clearedplugin     bool
-	done              bool
-	oldValue          func(context.Context) (*PluginExecutionLog, error)
-	predicates        []predicate.PluginExecutionLog
+// Op returns the operation name.
+func (m *PluginTestCaseMutation) Op() Op {
+	return m.op
 }
 
-var _ ent.Mutation = (*PluginExecutionLogMutation)(nil)
+// SetOp allows setting the mutation operation.
+func (m *PluginTestCaseMutation) SetOp(op Op) {
+	m.op = op
+}
 
-// pluginexecutionlogOption allows management of the mutation configuration using functional options.
-type pluginexecutionlogOption func(*PluginExecutionLogMutation)
+// Type returns the node type of this mutation (PluginTestCase).
+func (m *PluginTestCaseMutation) Type() string {
+	return m.typ
+}
 
-// newPluginExecutionLogMutation creates new mutation for the PluginExecutionLog entity.
-func newPluginExecutionLogMutation(c config, op Op, opts ...pluginexecutionlogOption) *PluginExecutionLogMutation {
-	m := &PluginExecutionLogMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePluginExecutionLog,
-		clearedFields: make(map[string]struct{}),
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PluginTestCaseMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, plugintestcase.FieldCreatedAt)
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.updated_at != nil {
+		fields = append(fields, plugintestcase.FieldUpdatedAt)
 	}
-	return m
-}
-
-// withPluginExecutionLogID sets the ID field of the mutation.
-func withPluginExecutionLogID(id int64) pluginexecutionlogOption {
-	return func(m *PluginExecutionLogMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PluginExecutionLog
-		)
-		m.oldValue = func(ctx context.Context) (*PluginExecutionLog, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PluginExecutionLog.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	if m.plugin != nil {
+		fields = append(fields, plugintestcase.FieldPluginID)
+	}
+	if m.name != nil {
+		fields = append(fields, plugintestcase.FieldName)
+	}
+	if m.input_value != nil {
+		fields = append(fields, plugintestcase.FieldInputValue)
+	}
+	if m._config != nil {
+		fields = append(fields, plugintestcase.FieldConfig)
 	}
+	if m.expected_bool != nil {
+		fields = append(fields, plugintestcase.FieldExpectedBool)
+	}
+	if m.expected_env != nil {
+		fields = append(fields, plugintestcase.FieldExpectedEnv)
+	}
+	if m.expected_error != nil {
+		fields = append(fields, plugintestcase.FieldExpectedError)
+	}
+	if m.is_enable != nil {
+		fields = append(fields, plugintestcase.FieldIsEnable)
+	}
+	return fields
 }
 
-// withPluginExecutionLog sets the old PluginExecutionLog of the mutation.
-func withPluginExecutionLog(node *PluginExecutionLog) pluginexecutionlogOption {
-	return func(m *PluginExecutionLogMutation) {
-		m.oldValue = func(context.Context) (*PluginExecutionLog, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PluginTestCaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case plugintestcase.FieldCreatedAt:
+		return m.CreatedAt()
+	case plugintestcase.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case plugintestcase.FieldPluginID:
+		return m.PluginID()
+	case plugintestcase.FieldName:
+		return m.Name()
+	case plugintestcase.FieldInputValue:
+		return m.InputValue()
+	case plugintestcase.FieldConfig:
+		return m.Config()
+	case plugintestcase.FieldExpectedBool:
+		return m.ExpectedBool()
+	case plugintestcase.FieldExpectedEnv:
+		return m.ExpectedEnv()
+	case plugintestcase.FieldExpectedError:
+		return m.ExpectedError()
+	case plugintestcase.FieldIsEnable:
+		return m.IsEnable()
 	}
+	return nil, false
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PluginExecutionLogMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PluginTestCaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case plugintestcase.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case plugintestcase.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case plugintestcase.FieldPluginID:
+		return m.OldPluginID(ctx)
+	case plugintestcase.FieldName:
+		return m.OldName(ctx)
+	case plugintestcase.FieldInputValue:
+		return m.OldInputValue(ctx)
+	case plugintestcase.FieldConfig:
+		return m.OldConfig(ctx)
+	case plugintestcase.FieldExpectedBool:
+		return m.OldExpectedBool(ctx)
+	case plugintestcase.FieldExpectedEnv:
+		return m.OldExpectedEnv(ctx)
+	case plugintestcase.FieldExpectedError:
+		return m.OldExpectedError(ctx)
+	case plugintestcase.FieldIsEnable:
+		return m.OldIsEnable(ctx)
+	}
+	return nil, fmt.Errorf("unknown PluginTestCase field %s", name)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PluginExecutionLogMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PluginTestCaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case plugintestcase.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case plugintestcase.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case plugintestcase.FieldPluginID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPluginID(v)
+		return nil
+	case plugintestcase.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case plugintestcase.FieldInputValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputValue(v)
+		return nil
+	case plugintestcase.FieldConfig:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case plugintestcase.FieldExpectedBool:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedBool(v)
+		return nil
+	case plugintestcase.FieldExpectedEnv:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedEnv(v)
+		return nil
+	case plugintestcase.FieldExpectedError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedError(v)
+		return nil
+	case plugintestcase.FieldIsEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnable(v)
+		return nil
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return fmt.Errorf("unknown PluginTestCase field %s", name)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PluginExecutionLog entities.
-func (m *PluginExecutionLogMutation) SetID(id int64) {
-	m.id = &id
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PluginTestCaseMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PluginExecutionLogMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PluginTestCaseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	return *m.id, true
+	return nil, false
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PluginExecutionLogMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PluginExecutionLog.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PluginTestCaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown PluginTestCase numeric field %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PluginExecutionLogMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PluginExecutionLogMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PluginTestCaseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(plugintestcase.FieldConfig) {
+		fields = append(fields, plugintestcase.FieldConfig)
 	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	if m.FieldCleared(plugintestcase.FieldExpectedBool) {
+		fields = append(fields, plugintestcase.FieldExpectedBool)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.FieldCleared(plugintestcase.FieldExpectedEnv) {
+		fields = append(fields, plugintestcase.FieldExpectedEnv)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(plugintestcase.FieldExpectedError) {
+		fields = append(fields, plugintestcase.FieldExpectedError)
 	}
-	return oldValue.CreatedAt, nil
+	return fields
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PluginExecutionLogMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PluginTestCaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetPluginID sets the "plugin_id" field.
-func (m *PluginExecutionLogMutation) SetPluginID(i int64) {
-	m.plugin = &i
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PluginTestCaseMutation) ClearField(name string) error {
+	switch name {
+	case plugintestcase.FieldConfig:
+		m.ClearConfig()
+		return nil
+	case plugintestcase.FieldExpectedBool:
+		m.ClearExpectedBool()
+		return nil
+	case plugintestcase.FieldExpectedEnv:
+		m.ClearExpectedEnv()
+		return nil
+	case plugintestcase.FieldExpectedError:
+		m.ClearExpectedError()
+		return nil
+	}
+	return fmt.Errorf("unknown PluginTestCase nullable field %s", name)
 }
 
-// PluginID returns the value of the "plugin_id" field in the mutation.
-func (m *PluginExecutionLogMutation) PluginID() (r int64, exists bool) {
-	v := m.plugin
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PluginTestCaseMutation) ResetField(name string) error {
+	switch name {
+	case plugintestcase.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case plugintestcase.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case plugintestcase.FieldPluginID:
+		m.ResetPluginID()
+		return nil
+	case plugintestcase.FieldName:
+		m.ResetName()
+		return nil
+	case plugintestcase.FieldInputValue:
+		m.ResetInputValue()
+		return nil
+	case plugintestcase.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case plugintestcase.FieldExpectedBool:
+		m.ResetExpectedBool()
+		return nil
+	case plugintestcase.FieldExpectedEnv:
+		m.ResetExpectedEnv()
+		return nil
+	case plugintestcase.FieldExpectedError:
+		m.ResetExpectedError()
+		return nil
+	case plugintestcase.FieldIsEnable:
+		m.ResetIsEnable()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PluginTestCase field %s", name)
 }
 
-// OldPluginID returns the old "plugin_id" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldPluginID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPluginID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPluginID requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PluginTestCaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.plugin != nil {
+		edges = append(edges, plugintestcase.EdgePlugin)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPluginID: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PluginTestCaseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case plugintestcase.EdgePlugin:
+		if id := m.plugin; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.PluginID, nil
+	return nil
 }
 
-// ResetPluginID resets all changes to the "plugin_id" field.
-func (m *PluginExecutionLogMutation) ResetPluginID() {
-	m.plugin = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PluginTestCaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *PluginExecutionLogMutation) SetEnvID(i int64) {
-	m.env_id = &i
-	m.addenv_id = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PluginTestCaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *PluginExecutionLogMutation) EnvID() (r int64, exists bool) {
-	v := m.env_id
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PluginTestCaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplugin {
+		edges = append(edges, plugintestcase.EdgePlugin)
 	}
-	return *v, true
+	return edges
 }
 
-// OldEnvID returns the old "env_id" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldEnvID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PluginTestCaseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case plugintestcase.EdgePlugin:
+		return m.clearedplugin
 	}
-	return oldValue.EnvID, nil
+	return false
 }
 
-// AddEnvID adds i to the "env_id" field.
-func (m *PluginExecutionLogMutation) AddEnvID(i int64) {
-	if m.addenv_id != nil {
-		*m.addenv_id += i
-	} else {
-		m.addenv_id = &i
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PluginTestCaseMutation) ClearEdge(name string) error {
+	switch name {
+	case plugintestcase.EdgePlugin:
+		m.ClearPlugin()
+		return nil
 	}
+	return fmt.Errorf("unknown PluginTestCase unique edge %s", name)
 }
 
-// AddedEnvID returns the value that was added to the "env_id" field in this mutation.
-func (m *PluginExecutionLogMutation) AddedEnvID() (r int64, exists bool) {
-	v := m.addenv_id
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PluginTestCaseMutation) ResetEdge(name string) error {
+	switch name {
+	case plugintestcase.EdgePlugin:
+		m.ResetPlugin()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PluginTestCase edge %s", name)
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *PluginExecutionLogMutation) ResetEnvID() {
-	m.env_id = nil
-	m.addenv_id = nil
+// ResourceLockMutation represents an operation that mutates the ResourceLock nodes in the graph.
+type ResourceLockMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	name          *string
+	owner         *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ResourceLock, error)
+	predicates    []predicate.ResourceLock
 }
 
-// SetExecutionStatus sets the "execution_status" field.
-func (m *PluginExecutionLogMutation) SetExecutionStatus(s string) {
-	m.execution_status = &s
-}
+var _ ent.Mutation = (*ResourceLockMutation)(nil)
 
-// ExecutionStatus returns the value of the "execution_status" field in the mutation.
-func (m *PluginExecutionLogMutation) ExecutionStatus() (r string, exists bool) {
-	v := m.execution_status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// resourcelockOption allows management of the mutation configuration using functional options.
+type resourcelockOption func(*ResourceLockMutation)
 
-// OldExecutionStatus returns the old "execution_status" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldExecutionStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionStatus is only allowed on UpdateOne operations")
+// newResourceLockMutation creates new mutation for the ResourceLock entity.
+func newResourceLockMutation(c config, op Op, opts ...resourcelockOption) *ResourceLockMutation {
+	m := &ResourceLockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResourceLock,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionStatus requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionStatus: %w", err)
+	return m
+}
+
+// withResourceLockID sets the ID field of the mutation.
+func withResourceLockID(id int64) resourcelockOption {
+	return func(m *ResourceLockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResourceLock
+		)
+		m.oldValue = func(ctx context.Context) (*ResourceLock, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResourceLock.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.ExecutionStatus, nil
 }
 
-// ResetExecutionStatus resets all changes to the "execution_status" field.
-func (m *PluginExecutionLogMutation) ResetExecutionStatus() {
-	m.execution_status = nil
-}
-
-// SetExecutionTime sets the "execution_time" field.
-func (m *PluginExecutionLogMutation) SetExecutionTime(i int32) {
-	m.execution_time = &i
-	m.addexecution_time = nil
+// withResourceLock sets the old ResourceLock of the mutation.
+func withResourceLock(node *ResourceLock) resourcelockOption {
+	return func(m *ResourceLockMutation) {
+		m.oldValue = func(context.Context) (*ResourceLock, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ExecutionTime returns the value of the "execution_time" field in the mutation.
-func (m *PluginExecutionLogMutation) ExecutionTime() (r int32, exists bool) {
-	v := m.execution_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResourceLockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldExecutionTime returns the old "execution_time" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldExecutionTime(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionTime: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResourceLockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.ExecutionTime, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddExecutionTime adds i to the "execution_time" field.
-func (m *PluginExecutionLogMutation) AddExecutionTime(i int32) {
-	if m.addexecution_time != nil {
-		*m.addexecution_time += i
-	} else {
-		m.addexecution_time = &i
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ResourceLock entities.
+func (m *ResourceLockMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// AddedExecutionTime returns the value that was added to the "execution_time" field in this mutation.
-func (m *PluginExecutionLogMutation) AddedExecutionTime() (r int32, exists bool) {
-	v := m.addexecution_time
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResourceLockMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetExecutionTime resets all changes to the "execution_time" field.
-func (m *PluginExecutionLogMutation) ResetExecutionTime() {
-	m.execution_time = nil
-	m.addexecution_time = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResourceLockMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResourceLock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetInputData sets the "input_data" field.
-func (m *PluginExecutionLogMutation) SetInputData(s string) {
-	m.input_data = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ResourceLockMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// InputData returns the value of the "input_data" field in the mutation.
-func (m *PluginExecutionLogMutation) InputData() (r string, exists bool) {
-	v := m.input_data
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResourceLockMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputData returns the old "input_data" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ResourceLock entity.
+// If the ResourceLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldInputData(ctx context.Context) (v *string, err error) {
+func (m *ResourceLockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputData is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputData requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputData: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.InputData, nil
-}
-
-// ClearInputData clears the value of the "input_data" field.
-func (m *PluginExecutionLogMutation) ClearInputData() {
-	m.input_data = nil
-	m.clearedFields[pluginexecutionlog.FieldInputData] = struct{}{}
-}
-
-// InputDataCleared returns if the "input_data" field was cleared in this mutation.
-func (m *PluginExecutionLogMutation) InputDataCleared() bool {
-	_, ok := m.clearedFields[pluginexecutionlog.FieldInputData]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetInputData resets all changes to the "input_data" field.
-func (m *PluginExecutionLogMutation) ResetInputData() {
-	m.input_data = nil
-	delete(m.clearedFields, pluginexecutionlog.FieldInputData)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResourceLockMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetOutputData sets the "output_data" field.
-func (m *PluginExecutionLogMutation) SetOutputData(s string) {
-	m.output_data = &s
+// SetName sets the "name" field.
+func (m *ResourceLockMutation) SetName(s string) {
+	m.name = &s
 }
 
-// OutputData returns the value of the "output_data" field in the mutation.
-func (m *PluginExecutionLogMutation) OutputData() (r string, exists bool) {
-	v := m.output_data
+// Name returns the value of the "name" field in the mutation.
+func (m *ResourceLockMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputData returns the old "output_data" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ResourceLock entity.
+// If the ResourceLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldOutputData(ctx context.Context) (v *string, err error) {
+func (m *ResourceLockMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputData is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputData requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputData: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.OutputData, nil
-}
-
-// ClearOutputData clears the value of the "output_data" field.
-func (m *PluginExecutionLogMutation) ClearOutputData() {
-	m.output_data = nil
-	m.clearedFields[pluginexecutionlog.FieldOutputData] = struct{}{}
-}
-
-// OutputDataCleared returns if the "output_data" field was cleared in this mutation.
-func (m *PluginExecutionLogMutation) OutputDataCleared() bool {
-	_, ok := m.clearedFields[pluginexecutionlog.FieldOutputData]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetOutputData resets all changes to the "output_data" field.
-func (m *PluginExecutionLogMutation) ResetOutputData() {
-	m.output_data = nil
-	delete(m.clearedFields, pluginexecutionlog.FieldOutputData)
+// ResetName resets all changes to the "name" field.
+func (m *ResourceLockMutation) ResetName() {
+	m.name = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *PluginExecutionLogMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetOwner sets the "owner" field.
+func (m *ResourceLockMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *PluginExecutionLogMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// Owner returns the value of the "owner" field in the mutation.
+func (m *ResourceLockMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the ResourceLock entity.
+// If the ResourceLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+func (m *ResourceLockMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *PluginExecutionLogMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[pluginexecutionlog.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *PluginExecutionLogMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[pluginexecutionlog.FieldErrorMessage]
-	return ok
+	return oldValue.Owner, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *PluginExecutionLogMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, pluginexecutionlog.FieldErrorMessage)
+// ResetOwner resets all changes to the "owner" field.
+func (m *ResourceLockMutation) ResetOwner() {
+	m.owner = nil
 }
 
-// SetStackTrace sets the "stack_trace" field.
-func (m *PluginExecutionLogMutation) SetStackTrace(s string) {
-	m.stack_trace = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *ResourceLockMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// StackTrace returns the value of the "stack_trace" field in the mutation.
-func (m *PluginExecutionLogMutation) StackTrace() (r string, exists bool) {
-	v := m.stack_trace
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ResourceLockMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStackTrace returns the old "stack_trace" field's value of the PluginExecutionLog entity.
-// If the PluginExecutionLog object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the ResourceLock entity.
+// If the ResourceLock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PluginExecutionLogMutation) OldStackTrace(ctx context.Context) (v *string, err error) {
+func (m *ResourceLockMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStackTrace is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStackTrace requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStackTrace: %w", err)
-	}
-	return oldValue.StackTrace, nil
-}
-
-// ClearStackTrace clears the value of the "stack_trace" field.
-func (m *PluginExecutionLogMutation) ClearStackTrace() {
-	m.stack_trace = nil
-	m.clearedFields[pluginexecutionlog.FieldStackTrace] = struct{}{}
-}
-
-// StackTraceCleared returns if the "stack_trace" field was cleared in this mutation.
-func (m *PluginExecutionLogMutation) StackTraceCleared() bool {
-	_, ok := m.clearedFields[pluginexecutionlog.FieldStackTrace]
-	return ok
-}
-
-// ResetStackTrace resets all changes to the "stack_trace" field.
-func (m *PluginExecutionLogMutation) ResetStackTrace() {
-	m.stack_trace = nil
-	delete(m.clearedFields, pluginexecutionlog.FieldStackTrace)
-}
-
-// ClearPlugin clears the "plugin" edge to the Plugin entity.
-func (m *PluginExecutionLogMutation) ClearPlugin() {
-	m.clearedplugin = true
-	m.clearedFields[pluginexecutionlog.FieldPluginID] = struct{}{}
-}
-
-// PluginCleared reports if the "plugin" edge to the Plugin entity was cleared.
-func (m *PluginExecutionLogMutation) PluginCleared() bool {
-	return m.clearedplugin
-}
-
-// PluginIDs returns the "plugin" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PluginID instead. It exists only for internal usage by the builders.
-func (m *PluginExecutionLogMutation) PluginIDs() (ids []int64) {
-	if id := m.plugin; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return
-}
-
-// ResetPlugin resets all changes to the "plugin" edge.
-func (m *PluginExecutionLogMutation) ResetPlugin() {
-	m.plugin = nil
-	m.clearedplugin = false
+	return oldValue.ExpiresAt, nil
 }
 
-// Where appends a list predicates to the PluginExecutionLogMutation builder.
-func (m *PluginExecutionLogMutation) Where(ps ...predicate.PluginExecutionLog) {
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ResourceLockMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the ResourceLockMutation builder.
+func (m *ResourceLockMutation) Where(ps ...predicate.ResourceLock) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PluginExecutionLogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ResourceLockMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PluginExecutionLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PluginExecutionLog, len(ps))
+func (m *ResourceLockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResourceLock, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6111,51 +14875,36 @@ func (m *PluginExecutionLogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PluginExecutionLogMutation) Op() Op {
+func (m *ResourceLockMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PluginExecutionLogMutation) SetOp(op Op) {
+func (m *ResourceLockMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PluginExecutionLog).
-func (m *PluginExecutionLogMutation) Type() string {
+// Type returns the node type of this mutation (ResourceLock).
+func (m *ResourceLockMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PluginExecutionLogMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *ResourceLockMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.created_at != nil {
-		fields = append(fields, pluginexecutionlog.FieldCreatedAt)
-	}
-	if m.plugin != nil {
-		fields = append(fields, pluginexecutionlog.FieldPluginID)
-	}
-	if m.env_id != nil {
-		fields = append(fields, pluginexecutionlog.FieldEnvID)
-	}
-	if m.execution_status != nil {
-		fields = append(fields, pluginexecutionlog.FieldExecutionStatus)
+		fields = append(fields, resourcelock.FieldCreatedAt)
 	}
-	if m.execution_time != nil {
-		fields = append(fields, pluginexecutionlog.FieldExecutionTime)
-	}
-	if m.input_data != nil {
-		fields = append(fields, pluginexecutionlog.FieldInputData)
-	}
-	if m.output_data != nil {
-		fields = append(fields, pluginexecutionlog.FieldOutputData)
+	if m.name != nil {
+		fields = append(fields, resourcelock.FieldName)
 	}
-	if m.error_message != nil {
-		fields = append(fields, pluginexecutionlog.FieldErrorMessage)
+	if m.owner != nil {
+		fields = append(fields, resourcelock.FieldOwner)
 	}
-	if m.stack_trace != nil {
-		fields = append(fields, pluginexecutionlog.FieldStackTrace)
+	if m.expires_at != nil {
+		fields = append(fields, resourcelock.FieldExpiresAt)
 	}
 	return fields
 }
@@ -6163,26 +14912,16 @@ func (m *PluginExecutionLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PluginExecutionLogMutation) Field(name string) (ent.Value, bool) {
+func (m *ResourceLockMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pluginexecutionlog.FieldCreatedAt:
+	case resourcelock.FieldCreatedAt:
 		return m.CreatedAt()
-	case pluginexecutionlog.FieldPluginID:
-		return m.PluginID()
-	case pluginexecutionlog.FieldEnvID:
-		return m.EnvID()
-	case pluginexecutionlog.FieldExecutionStatus:
-		return m.ExecutionStatus()
-	case pluginexecutionlog.FieldExecutionTime:
-		return m.ExecutionTime()
-	case pluginexecutionlog.FieldInputData:
-		return m.InputData()
-	case pluginexecutionlog.FieldOutputData:
-		return m.OutputData()
-	case pluginexecutionlog.FieldErrorMessage:
-		return m.ErrorMessage()
-	case pluginexecutionlog.FieldStackTrace:
-		return m.StackTrace()
+	case resourcelock.FieldName:
+		return m.Name()
+	case resourcelock.FieldOwner:
+		return m.Owner()
+	case resourcelock.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -6190,304 +14929,164 @@ func (m *PluginExecutionLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PluginExecutionLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ResourceLockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pluginexecutionlog.FieldCreatedAt:
+	case resourcelock.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pluginexecutionlog.FieldPluginID:
-		return m.OldPluginID(ctx)
-	case pluginexecutionlog.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case pluginexecutionlog.FieldExecutionStatus:
-		return m.OldExecutionStatus(ctx)
-	case pluginexecutionlog.FieldExecutionTime:
-		return m.OldExecutionTime(ctx)
-	case pluginexecutionlog.FieldInputData:
-		return m.OldInputData(ctx)
-	case pluginexecutionlog.FieldOutputData:
-		return m.OldOutputData(ctx)
-	case pluginexecutionlog.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case pluginexecutionlog.FieldStackTrace:
-		return m.OldStackTrace(ctx)
+	case resourcelock.FieldName:
+		return m.OldName(ctx)
+	case resourcelock.FieldOwner:
+		return m.OldOwner(ctx)
+	case resourcelock.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PluginExecutionLog field %s", name)
+	return nil, fmt.Errorf("unknown ResourceLock field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PluginExecutionLogMutation) SetField(name string, value ent.Value) error {
+func (m *ResourceLockMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pluginexecutionlog.FieldCreatedAt:
+	case resourcelock.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case pluginexecutionlog.FieldPluginID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPluginID(v)
-		return nil
-	case pluginexecutionlog.FieldEnvID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvID(v)
-		return nil
-	case pluginexecutionlog.FieldExecutionStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionStatus(v)
-		return nil
-	case pluginexecutionlog.FieldExecutionTime:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionTime(v)
-		return nil
-	case pluginexecutionlog.FieldInputData:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInputData(v)
-		return nil
-	case pluginexecutionlog.FieldOutputData:
+	case resourcelock.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutputData(v)
+		m.SetName(v)
 		return nil
-	case pluginexecutionlog.FieldErrorMessage:
+	case resourcelock.FieldOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetOwner(v)
 		return nil
-	case pluginexecutionlog.FieldStackTrace:
-		v, ok := value.(string)
+	case resourcelock.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStackTrace(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PluginExecutionLog field %s", name)
+	return fmt.Errorf("unknown ResourceLock field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PluginExecutionLogMutation) AddedFields() []string {
-	var fields []string
-	if m.addenv_id != nil {
-		fields = append(fields, pluginexecutionlog.FieldEnvID)
-	}
-	if m.addexecution_time != nil {
-		fields = append(fields, pluginexecutionlog.FieldExecutionTime)
-	}
-	return fields
+func (m *ResourceLockMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PluginExecutionLogMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case pluginexecutionlog.FieldEnvID:
-		return m.AddedEnvID()
-	case pluginexecutionlog.FieldExecutionTime:
-		return m.AddedExecutionTime()
-	}
+func (m *ResourceLockMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PluginExecutionLogMutation) AddField(name string, value ent.Value) error {
+func (m *ResourceLockMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case pluginexecutionlog.FieldEnvID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEnvID(v)
-		return nil
-	case pluginexecutionlog.FieldExecutionTime:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExecutionTime(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PluginExecutionLog numeric field %s", name)
+	return fmt.Errorf("unknown ResourceLock numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PluginExecutionLogMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(pluginexecutionlog.FieldInputData) {
-		fields = append(fields, pluginexecutionlog.FieldInputData)
-	}
-	if m.FieldCleared(pluginexecutionlog.FieldOutputData) {
-		fields = append(fields, pluginexecutionlog.FieldOutputData)
-	}
-	if m.FieldCleared(pluginexecutionlog.FieldErrorMessage) {
-		fields = append(fields, pluginexecutionlog.FieldErrorMessage)
-	}
-	if m.FieldCleared(pluginexecutionlog.FieldStackTrace) {
-		fields = append(fields, pluginexecutionlog.FieldStackTrace)
-	}
-	return fields
+func (m *ResourceLockMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PluginExecutionLogMutation) FieldCleared(name string) bool {
+func (m *ResourceLockMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PluginExecutionLogMutation) ClearField(name string) error {
-	switch name {
-	case pluginexecutionlog.FieldInputData:
-		m.ClearInputData()
-		return nil
-	case pluginexecutionlog.FieldOutputData:
-		m.ClearOutputData()
-		return nil
-	case pluginexecutionlog.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case pluginexecutionlog.FieldStackTrace:
-		m.ClearStackTrace()
-		return nil
-	}
-	return fmt.Errorf("unknown PluginExecutionLog nullable field %s", name)
+func (m *ResourceLockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ResourceLock nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PluginExecutionLogMutation) ResetField(name string) error {
+func (m *ResourceLockMutation) ResetField(name string) error {
 	switch name {
-	case pluginexecutionlog.FieldCreatedAt:
+	case resourcelock.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pluginexecutionlog.FieldPluginID:
-		m.ResetPluginID()
-		return nil
-	case pluginexecutionlog.FieldEnvID:
-		m.ResetEnvID()
-		return nil
-	case pluginexecutionlog.FieldExecutionStatus:
-		m.ResetExecutionStatus()
-		return nil
-	case pluginexecutionlog.FieldExecutionTime:
-		m.ResetExecutionTime()
-		return nil
-	case pluginexecutionlog.FieldInputData:
-		m.ResetInputData()
-		return nil
-	case pluginexecutionlog.FieldOutputData:
-		m.ResetOutputData()
+	case resourcelock.FieldName:
+		m.ResetName()
 		return nil
-	case pluginexecutionlog.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case resourcelock.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case pluginexecutionlog.FieldStackTrace:
-		m.ResetStackTrace()
+	case resourcelock.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PluginExecutionLog field %s", name)
+	return fmt.Errorf("unknown ResourceLock field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PluginExecutionLogMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.plugin != nil {
-		edges = append(edges, pluginexecutionlog.EdgePlugin)
-	}
+func (m *ResourceLockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PluginExecutionLogMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case pluginexecutionlog.EdgePlugin:
-		if id := m.plugin; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ResourceLockMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PluginExecutionLogMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ResourceLockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PluginExecutionLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *ResourceLockMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PluginExecutionLogMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedplugin {
-		edges = append(edges, pluginexecutionlog.EdgePlugin)
-	}
+func (m *ResourceLockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PluginExecutionLogMutation) EdgeCleared(name string) bool {
-	switch name {
-	case pluginexecutionlog.EdgePlugin:
-		return m.clearedplugin
-	}
+func (m *ResourceLockMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PluginExecutionLogMutation) ClearEdge(name string) error {
-	switch name {
-	case pluginexecutionlog.EdgePlugin:
-		m.ClearPlugin()
-		return nil
-	}
-	return fmt.Errorf("unknown PluginExecutionLog unique edge %s", name)
+func (m *ResourceLockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ResourceLock unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PluginExecutionLogMutation) ResetEdge(name string) error {
-	switch name {
-	case pluginexecutionlog.EdgePlugin:
-		m.ResetPlugin()
-		return nil
-	}
-	return fmt.Errorf("unknown PluginExecutionLog edge %s", name)
+func (m *ResourceLockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ResourceLock edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
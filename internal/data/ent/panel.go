@@ -36,6 +36,22 @@ type Panel struct {
 	Token string `json:"token,omitempty"`
 	// Params
 	Params int32 `json:"params,omitempty"`
+	// 青龙版本
+	QlVersion *string `json:"ql_version,omitempty"`
+	// Node版本
+	NodeVersion *string `json:"node_version,omitempty"`
+	// 运行时长(秒)
+	Uptime *int64 `json:"uptime,omitempty"`
+	// 系统信息更新时间
+	SystemCheckedAt *time.Time `json:"system_checked_at,omitempty"`
+	// 健康状态(unknown,healthy,degraded,down)
+	HealthStatus string `json:"health_status,omitempty"`
+	// 连续检查失败次数
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// 最近一次健康检查时间
+	HealthCheckedAt *time.Time `json:"health_checked_at,omitempty"`
+	// 是否因持续不可用被自动禁用
+	AutoDisabled bool `json:"auto_disabled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PanelQuery when eager-loading is set.
 	Edges        PanelEdges `json:"edges"`
@@ -46,9 +62,11 @@ type Panel struct {
 type PanelEdges struct {
 	// Envs holds the value of the envs edge.
 	Envs []*Env `json:"envs,omitempty"`
+	// EnvPanels holds the value of the env_panels edge.
+	EnvPanels []*EnvPanel `json:"env_panels,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // EnvsOrErr returns the Envs value or an error if the edge
@@ -60,18 +78,27 @@ func (e PanelEdges) EnvsOrErr() ([]*Env, error) {
 	return nil, &NotLoadedError{edge: "envs"}
 }
 
+// EnvPanelsOrErr returns the EnvPanels value or an error if the edge
+// was not loaded in eager-loading.
+func (e PanelEdges) EnvPanelsOrErr() ([]*EnvPanel, error) {
+	if e.loadedTypes[1] {
+		return e.EnvPanels, nil
+	}
+	return nil, &NotLoadedError{edge: "env_panels"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Panel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case panel.FieldIsEnable:
+		case panel.FieldIsEnable, panel.FieldAutoDisabled:
 			values[i] = new(sql.NullBool)
-		case panel.FieldID, panel.FieldParams:
+		case panel.FieldID, panel.FieldParams, panel.FieldUptime, panel.FieldConsecutiveFailures:
 			values[i] = new(sql.NullInt64)
-		case panel.FieldName, panel.FieldURL, panel.FieldClientID, panel.FieldClientSecret, panel.FieldToken:
+		case panel.FieldName, panel.FieldURL, panel.FieldClientID, panel.FieldClientSecret, panel.FieldToken, panel.FieldQlVersion, panel.FieldNodeVersion, panel.FieldHealthStatus:
 			values[i] = new(sql.NullString)
-		case panel.FieldCreatedAt, panel.FieldUpdatedAt:
+		case panel.FieldCreatedAt, panel.FieldUpdatedAt, panel.FieldSystemCheckedAt, panel.FieldHealthCheckedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -148,6 +175,59 @@ func (_m *Panel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Params = int32(value.Int64)
 			}
+		case panel.FieldQlVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ql_version", values[i])
+			} else if value.Valid {
+				_m.QlVersion = new(string)
+				*_m.QlVersion = value.String
+			}
+		case panel.FieldNodeVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_version", values[i])
+			} else if value.Valid {
+				_m.NodeVersion = new(string)
+				*_m.NodeVersion = value.String
+			}
+		case panel.FieldUptime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uptime", values[i])
+			} else if value.Valid {
+				_m.Uptime = new(int64)
+				*_m.Uptime = value.Int64
+			}
+		case panel.FieldSystemCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field system_checked_at", values[i])
+			} else if value.Valid {
+				_m.SystemCheckedAt = new(time.Time)
+				*_m.SystemCheckedAt = value.Time
+			}
+		case panel.FieldHealthStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health_status", values[i])
+			} else if value.Valid {
+				_m.HealthStatus = value.String
+			}
+		case panel.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case panel.FieldHealthCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field health_checked_at", values[i])
+			} else if value.Valid {
+				_m.HealthCheckedAt = new(time.Time)
+				*_m.HealthCheckedAt = value.Time
+			}
+		case panel.FieldAutoDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_disabled", values[i])
+			} else if value.Valid {
+				_m.AutoDisabled = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -166,6 +246,11 @@ func (_m *Panel) QueryEnvs() *EnvQuery {
 	return NewPanelClient(_m.config).QueryEnvs(_m)
 }
 
+// QueryEnvPanels queries the "env_panels" edge of the Panel entity.
+func (_m *Panel) QueryEnvPanels() *EnvPanelQuery {
+	return NewPanelClient(_m.config).QueryEnvPanels(_m)
+}
+
 // Update returns a builder for updating this Panel.
 // Note that you need to call Panel.Unwrap() before calling this method if this Panel
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -215,6 +300,40 @@ func (_m *Panel) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("params=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Params))
+	builder.WriteString(", ")
+	if v := _m.QlVersion; v != nil {
+		builder.WriteString("ql_version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NodeVersion; v != nil {
+		builder.WriteString("node_version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Uptime; v != nil {
+		builder.WriteString("uptime=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SystemCheckedAt; v != nil {
+		builder.WriteString("system_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("health_status=")
+	builder.WriteString(_m.HealthStatus)
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.HealthCheckedAt; v != nil {
+		builder.WriteString("health_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_disabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoDisabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
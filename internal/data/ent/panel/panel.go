@@ -32,8 +32,26 @@ const (
 	FieldToken = "token"
 	// FieldParams holds the string denoting the params field in the database.
 	FieldParams = "params"
+	// FieldQlVersion holds the string denoting the ql_version field in the database.
+	FieldQlVersion = "ql_version"
+	// FieldNodeVersion holds the string denoting the node_version field in the database.
+	FieldNodeVersion = "node_version"
+	// FieldUptime holds the string denoting the uptime field in the database.
+	FieldUptime = "uptime"
+	// FieldSystemCheckedAt holds the string denoting the system_checked_at field in the database.
+	FieldSystemCheckedAt = "system_checked_at"
+	// FieldHealthStatus holds the string denoting the health_status field in the database.
+	FieldHealthStatus = "health_status"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldHealthCheckedAt holds the string denoting the health_checked_at field in the database.
+	FieldHealthCheckedAt = "health_checked_at"
+	// FieldAutoDisabled holds the string denoting the auto_disabled field in the database.
+	FieldAutoDisabled = "auto_disabled"
 	// EdgeEnvs holds the string denoting the envs edge name in mutations.
 	EdgeEnvs = "envs"
+	// EdgeEnvPanels holds the string denoting the env_panels edge name in mutations.
+	EdgeEnvPanels = "env_panels"
 	// Table holds the table name of the panel in the database.
 	Table = "panels"
 	// EnvsTable is the table that holds the envs relation/edge. The primary key declared below.
@@ -41,6 +59,13 @@ const (
 	// EnvsInverseTable is the table name for the Env entity.
 	// It exists in this package in order to avoid circular dependency with the "env" package.
 	EnvsInverseTable = "envs"
+	// EnvPanelsTable is the table that holds the env_panels relation/edge.
+	EnvPanelsTable = "env_panels"
+	// EnvPanelsInverseTable is the table name for the EnvPanel entity.
+	// It exists in this package in order to avoid circular dependency with the "envpanel" package.
+	EnvPanelsInverseTable = "env_panels"
+	// EnvPanelsColumn is the table column denoting the env_panels relation/edge.
+	EnvPanelsColumn = "panel_id"
 )
 
 // Columns holds all SQL columns for panel fields.
@@ -55,6 +80,14 @@ var Columns = []string{
 	FieldIsEnable,
 	FieldToken,
 	FieldParams,
+	FieldQlVersion,
+	FieldNodeVersion,
+	FieldUptime,
+	FieldSystemCheckedAt,
+	FieldHealthStatus,
+	FieldConsecutiveFailures,
+	FieldHealthCheckedAt,
+	FieldAutoDisabled,
 }
 
 var (
@@ -90,6 +123,12 @@ var (
 	ClientSecretValidator func(string) error
 	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
 	TokenValidator func(string) error
+	// DefaultHealthStatus holds the default value on creation for the "health_status" field.
+	DefaultHealthStatus string
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// DefaultAutoDisabled holds the default value on creation for the "auto_disabled" field.
+	DefaultAutoDisabled bool
 )
 
 // OrderOption defines the ordering options for the Panel queries.
@@ -145,6 +184,46 @@ func ByParams(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParams, opts...).ToFunc()
 }
 
+// ByQlVersion orders the results by the ql_version field.
+func ByQlVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQlVersion, opts...).ToFunc()
+}
+
+// ByNodeVersion orders the results by the node_version field.
+func ByNodeVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeVersion, opts...).ToFunc()
+}
+
+// ByUptime orders the results by the uptime field.
+func ByUptime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUptime, opts...).ToFunc()
+}
+
+// BySystemCheckedAt orders the results by the system_checked_at field.
+func BySystemCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSystemCheckedAt, opts...).ToFunc()
+}
+
+// ByHealthStatus orders the results by the health_status field.
+func ByHealthStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthStatus, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByHealthCheckedAt orders the results by the health_checked_at field.
+func ByHealthCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthCheckedAt, opts...).ToFunc()
+}
+
+// ByAutoDisabled orders the results by the auto_disabled field.
+func ByAutoDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoDisabled, opts...).ToFunc()
+}
+
 // ByEnvsCount orders the results by envs count.
 func ByEnvsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -158,6 +237,20 @@ func ByEnvs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEnvsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByEnvPanelsCount orders the results by env_panels count.
+func ByEnvPanelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnvPanelsStep(), opts...)
+	}
+}
+
+// ByEnvPanels orders the results by env_panels terms.
+func ByEnvPanels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvPanelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -165,3 +258,10 @@ func newEnvsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, EnvsTable, EnvsPrimaryKey...),
 	)
 }
+func newEnvPanelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvPanelsInverseTable, EnvPanelsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, EnvPanelsTable, EnvPanelsColumn),
+	)
+}
@@ -100,6 +100,46 @@ func Params(v int32) predicate.Panel {
 	return predicate.Panel(sql.FieldEQ(FieldParams, v))
 }
 
+// QlVersion applies equality check predicate on the "ql_version" field. It's identical to QlVersionEQ.
+func QlVersion(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldQlVersion, v))
+}
+
+// NodeVersion applies equality check predicate on the "node_version" field. It's identical to NodeVersionEQ.
+func NodeVersion(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldNodeVersion, v))
+}
+
+// Uptime applies equality check predicate on the "uptime" field. It's identical to UptimeEQ.
+func Uptime(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldUptime, v))
+}
+
+// SystemCheckedAt applies equality check predicate on the "system_checked_at" field. It's identical to SystemCheckedAtEQ.
+func SystemCheckedAt(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldSystemCheckedAt, v))
+}
+
+// HealthStatus applies equality check predicate on the "health_status" field. It's identical to HealthStatusEQ.
+func HealthStatus(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldHealthStatus, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// HealthCheckedAt applies equality check predicate on the "health_checked_at" field. It's identical to HealthCheckedAtEQ.
+func HealthCheckedAt(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldHealthCheckedAt, v))
+}
+
+// AutoDisabled applies equality check predicate on the "auto_disabled" field. It's identical to AutoDisabledEQ.
+func AutoDisabled(v bool) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldAutoDisabled, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Panel {
 	return predicate.Panel(sql.FieldEQ(FieldCreatedAt, v))
@@ -555,6 +595,421 @@ func ParamsLTE(v int32) predicate.Panel {
 	return predicate.Panel(sql.FieldLTE(FieldParams, v))
 }
 
+// QlVersionEQ applies the EQ predicate on the "ql_version" field.
+func QlVersionEQ(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldQlVersion, v))
+}
+
+// QlVersionNEQ applies the NEQ predicate on the "ql_version" field.
+func QlVersionNEQ(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldQlVersion, v))
+}
+
+// QlVersionIn applies the In predicate on the "ql_version" field.
+func QlVersionIn(vs ...string) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldQlVersion, vs...))
+}
+
+// QlVersionNotIn applies the NotIn predicate on the "ql_version" field.
+func QlVersionNotIn(vs ...string) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldQlVersion, vs...))
+}
+
+// QlVersionGT applies the GT predicate on the "ql_version" field.
+func QlVersionGT(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldQlVersion, v))
+}
+
+// QlVersionGTE applies the GTE predicate on the "ql_version" field.
+func QlVersionGTE(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldQlVersion, v))
+}
+
+// QlVersionLT applies the LT predicate on the "ql_version" field.
+func QlVersionLT(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldQlVersion, v))
+}
+
+// QlVersionLTE applies the LTE predicate on the "ql_version" field.
+func QlVersionLTE(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldQlVersion, v))
+}
+
+// QlVersionContains applies the Contains predicate on the "ql_version" field.
+func QlVersionContains(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldContains(FieldQlVersion, v))
+}
+
+// QlVersionHasPrefix applies the HasPrefix predicate on the "ql_version" field.
+func QlVersionHasPrefix(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldHasPrefix(FieldQlVersion, v))
+}
+
+// QlVersionHasSuffix applies the HasSuffix predicate on the "ql_version" field.
+func QlVersionHasSuffix(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldHasSuffix(FieldQlVersion, v))
+}
+
+// QlVersionIsNil applies the IsNil predicate on the "ql_version" field.
+func QlVersionIsNil() predicate.Panel {
+	return predicate.Panel(sql.FieldIsNull(FieldQlVersion))
+}
+
+// QlVersionNotNil applies the NotNil predicate on the "ql_version" field.
+func QlVersionNotNil() predicate.Panel {
+	return predicate.Panel(sql.FieldNotNull(FieldQlVersion))
+}
+
+// QlVersionEqualFold applies the EqualFold predicate on the "ql_version" field.
+func QlVersionEqualFold(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEqualFold(FieldQlVersion, v))
+}
+
+// QlVersionContainsFold applies the ContainsFold predicate on the "ql_version" field.
+func QlVersionContainsFold(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldContainsFold(FieldQlVersion, v))
+}
+
+// NodeVersionEQ applies the EQ predicate on the "node_version" field.
+func NodeVersionEQ(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldNodeVersion, v))
+}
+
+// NodeVersionNEQ applies the NEQ predicate on the "node_version" field.
+func NodeVersionNEQ(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldNodeVersion, v))
+}
+
+// NodeVersionIn applies the In predicate on the "node_version" field.
+func NodeVersionIn(vs ...string) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldNodeVersion, vs...))
+}
+
+// NodeVersionNotIn applies the NotIn predicate on the "node_version" field.
+func NodeVersionNotIn(vs ...string) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldNodeVersion, vs...))
+}
+
+// NodeVersionGT applies the GT predicate on the "node_version" field.
+func NodeVersionGT(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldNodeVersion, v))
+}
+
+// NodeVersionGTE applies the GTE predicate on the "node_version" field.
+func NodeVersionGTE(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldNodeVersion, v))
+}
+
+// NodeVersionLT applies the LT predicate on the "node_version" field.
+func NodeVersionLT(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldNodeVersion, v))
+}
+
+// NodeVersionLTE applies the LTE predicate on the "node_version" field.
+func NodeVersionLTE(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldNodeVersion, v))
+}
+
+// NodeVersionContains applies the Contains predicate on the "node_version" field.
+func NodeVersionContains(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldContains(FieldNodeVersion, v))
+}
+
+// NodeVersionHasPrefix applies the HasPrefix predicate on the "node_version" field.
+func NodeVersionHasPrefix(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldHasPrefix(FieldNodeVersion, v))
+}
+
+// NodeVersionHasSuffix applies the HasSuffix predicate on the "node_version" field.
+func NodeVersionHasSuffix(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldHasSuffix(FieldNodeVersion, v))
+}
+
+// NodeVersionIsNil applies the IsNil predicate on the "node_version" field.
+func NodeVersionIsNil() predicate.Panel {
+	return predicate.Panel(sql.FieldIsNull(FieldNodeVersion))
+}
+
+// NodeVersionNotNil applies the NotNil predicate on the "node_version" field.
+func NodeVersionNotNil() predicate.Panel {
+	return predicate.Panel(sql.FieldNotNull(FieldNodeVersion))
+}
+
+// NodeVersionEqualFold applies the EqualFold predicate on the "node_version" field.
+func NodeVersionEqualFold(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEqualFold(FieldNodeVersion, v))
+}
+
+// NodeVersionContainsFold applies the ContainsFold predicate on the "node_version" field.
+func NodeVersionContainsFold(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldContainsFold(FieldNodeVersion, v))
+}
+
+// UptimeEQ applies the EQ predicate on the "uptime" field.
+func UptimeEQ(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldUptime, v))
+}
+
+// UptimeNEQ applies the NEQ predicate on the "uptime" field.
+func UptimeNEQ(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldUptime, v))
+}
+
+// UptimeIn applies the In predicate on the "uptime" field.
+func UptimeIn(vs ...int64) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldUptime, vs...))
+}
+
+// UptimeNotIn applies the NotIn predicate on the "uptime" field.
+func UptimeNotIn(vs ...int64) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldUptime, vs...))
+}
+
+// UptimeGT applies the GT predicate on the "uptime" field.
+func UptimeGT(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldUptime, v))
+}
+
+// UptimeGTE applies the GTE predicate on the "uptime" field.
+func UptimeGTE(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldUptime, v))
+}
+
+// UptimeLT applies the LT predicate on the "uptime" field.
+func UptimeLT(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldUptime, v))
+}
+
+// UptimeLTE applies the LTE predicate on the "uptime" field.
+func UptimeLTE(v int64) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldUptime, v))
+}
+
+// UptimeIsNil applies the IsNil predicate on the "uptime" field.
+func UptimeIsNil() predicate.Panel {
+	return predicate.Panel(sql.FieldIsNull(FieldUptime))
+}
+
+// UptimeNotNil applies the NotNil predicate on the "uptime" field.
+func UptimeNotNil() predicate.Panel {
+	return predicate.Panel(sql.FieldNotNull(FieldUptime))
+}
+
+// SystemCheckedAtEQ applies the EQ predicate on the "system_checked_at" field.
+func SystemCheckedAtEQ(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldSystemCheckedAt, v))
+}
+
+// SystemCheckedAtNEQ applies the NEQ predicate on the "system_checked_at" field.
+func SystemCheckedAtNEQ(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldSystemCheckedAt, v))
+}
+
+// SystemCheckedAtIn applies the In predicate on the "system_checked_at" field.
+func SystemCheckedAtIn(vs ...time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldSystemCheckedAt, vs...))
+}
+
+// SystemCheckedAtNotIn applies the NotIn predicate on the "system_checked_at" field.
+func SystemCheckedAtNotIn(vs ...time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldSystemCheckedAt, vs...))
+}
+
+// SystemCheckedAtGT applies the GT predicate on the "system_checked_at" field.
+func SystemCheckedAtGT(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldSystemCheckedAt, v))
+}
+
+// SystemCheckedAtGTE applies the GTE predicate on the "system_checked_at" field.
+func SystemCheckedAtGTE(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldSystemCheckedAt, v))
+}
+
+// SystemCheckedAtLT applies the LT predicate on the "system_checked_at" field.
+func SystemCheckedAtLT(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldSystemCheckedAt, v))
+}
+
+// SystemCheckedAtLTE applies the LTE predicate on the "system_checked_at" field.
+func SystemCheckedAtLTE(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldSystemCheckedAt, v))
+}
+
+// SystemCheckedAtIsNil applies the IsNil predicate on the "system_checked_at" field.
+func SystemCheckedAtIsNil() predicate.Panel {
+	return predicate.Panel(sql.FieldIsNull(FieldSystemCheckedAt))
+}
+
+// SystemCheckedAtNotNil applies the NotNil predicate on the "system_checked_at" field.
+func SystemCheckedAtNotNil() predicate.Panel {
+	return predicate.Panel(sql.FieldNotNull(FieldSystemCheckedAt))
+}
+
+// HealthStatusEQ applies the EQ predicate on the "health_status" field.
+func HealthStatusEQ(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldHealthStatus, v))
+}
+
+// HealthStatusNEQ applies the NEQ predicate on the "health_status" field.
+func HealthStatusNEQ(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldHealthStatus, v))
+}
+
+// HealthStatusIn applies the In predicate on the "health_status" field.
+func HealthStatusIn(vs ...string) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldHealthStatus, vs...))
+}
+
+// HealthStatusNotIn applies the NotIn predicate on the "health_status" field.
+func HealthStatusNotIn(vs ...string) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldHealthStatus, vs...))
+}
+
+// HealthStatusGT applies the GT predicate on the "health_status" field.
+func HealthStatusGT(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldHealthStatus, v))
+}
+
+// HealthStatusGTE applies the GTE predicate on the "health_status" field.
+func HealthStatusGTE(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldHealthStatus, v))
+}
+
+// HealthStatusLT applies the LT predicate on the "health_status" field.
+func HealthStatusLT(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldHealthStatus, v))
+}
+
+// HealthStatusLTE applies the LTE predicate on the "health_status" field.
+func HealthStatusLTE(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldHealthStatus, v))
+}
+
+// HealthStatusContains applies the Contains predicate on the "health_status" field.
+func HealthStatusContains(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldContains(FieldHealthStatus, v))
+}
+
+// HealthStatusHasPrefix applies the HasPrefix predicate on the "health_status" field.
+func HealthStatusHasPrefix(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldHasPrefix(FieldHealthStatus, v))
+}
+
+// HealthStatusHasSuffix applies the HasSuffix predicate on the "health_status" field.
+func HealthStatusHasSuffix(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldHasSuffix(FieldHealthStatus, v))
+}
+
+// HealthStatusEqualFold applies the EqualFold predicate on the "health_status" field.
+func HealthStatusEqualFold(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldEqualFold(FieldHealthStatus, v))
+}
+
+// HealthStatusContainsFold applies the ContainsFold predicate on the "health_status" field.
+func HealthStatusContainsFold(v string) predicate.Panel {
+	return predicate.Panel(sql.FieldContainsFold(FieldHealthStatus, v))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// HealthCheckedAtEQ applies the EQ predicate on the "health_checked_at" field.
+func HealthCheckedAtEQ(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtNEQ applies the NEQ predicate on the "health_checked_at" field.
+func HealthCheckedAtNEQ(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtIn applies the In predicate on the "health_checked_at" field.
+func HealthCheckedAtIn(vs ...time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldIn(FieldHealthCheckedAt, vs...))
+}
+
+// HealthCheckedAtNotIn applies the NotIn predicate on the "health_checked_at" field.
+func HealthCheckedAtNotIn(vs ...time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldNotIn(FieldHealthCheckedAt, vs...))
+}
+
+// HealthCheckedAtGT applies the GT predicate on the "health_checked_at" field.
+func HealthCheckedAtGT(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldGT(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtGTE applies the GTE predicate on the "health_checked_at" field.
+func HealthCheckedAtGTE(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldGTE(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtLT applies the LT predicate on the "health_checked_at" field.
+func HealthCheckedAtLT(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldLT(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtLTE applies the LTE predicate on the "health_checked_at" field.
+func HealthCheckedAtLTE(v time.Time) predicate.Panel {
+	return predicate.Panel(sql.FieldLTE(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtIsNil applies the IsNil predicate on the "health_checked_at" field.
+func HealthCheckedAtIsNil() predicate.Panel {
+	return predicate.Panel(sql.FieldIsNull(FieldHealthCheckedAt))
+}
+
+// HealthCheckedAtNotNil applies the NotNil predicate on the "health_checked_at" field.
+func HealthCheckedAtNotNil() predicate.Panel {
+	return predicate.Panel(sql.FieldNotNull(FieldHealthCheckedAt))
+}
+
+// AutoDisabledEQ applies the EQ predicate on the "auto_disabled" field.
+func AutoDisabledEQ(v bool) predicate.Panel {
+	return predicate.Panel(sql.FieldEQ(FieldAutoDisabled, v))
+}
+
+// AutoDisabledNEQ applies the NEQ predicate on the "auto_disabled" field.
+func AutoDisabledNEQ(v bool) predicate.Panel {
+	return predicate.Panel(sql.FieldNEQ(FieldAutoDisabled, v))
+}
+
 // HasEnvs applies the HasEdge predicate on the "envs" edge.
 func HasEnvs() predicate.Panel {
 	return predicate.Panel(func(s *sql.Selector) {
@@ -578,6 +1033,29 @@ func HasEnvsWith(preds ...predicate.Env) predicate.Panel {
 	})
 }
 
+// HasEnvPanels applies the HasEdge predicate on the "env_panels" edge.
+func HasEnvPanels() predicate.Panel {
+	return predicate.Panel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EnvPanelsTable, EnvPanelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvPanelsWith applies the HasEdge predicate on the "env_panels" edge with a given conditions (other predicates).
+func HasEnvPanelsWith(preds ...predicate.EnvPanel) predicate.Panel {
+	return predicate.Panel(func(s *sql.Selector) {
+		step := newEnvPanelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Panel) predicate.Panel {
 	return predicate.Panel(sql.AndPredicates(predicates...))
@@ -91,6 +91,118 @@ func (_c *PanelCreate) SetParams(v int32) *PanelCreate {
 	return _c
 }
 
+// SetQlVersion sets the "ql_version" field.
+func (_c *PanelCreate) SetQlVersion(v string) *PanelCreate {
+	_c.mutation.SetQlVersion(v)
+	return _c
+}
+
+// SetNillableQlVersion sets the "ql_version" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableQlVersion(v *string) *PanelCreate {
+	if v != nil {
+		_c.SetQlVersion(*v)
+	}
+	return _c
+}
+
+// SetNodeVersion sets the "node_version" field.
+func (_c *PanelCreate) SetNodeVersion(v string) *PanelCreate {
+	_c.mutation.SetNodeVersion(v)
+	return _c
+}
+
+// SetNillableNodeVersion sets the "node_version" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableNodeVersion(v *string) *PanelCreate {
+	if v != nil {
+		_c.SetNodeVersion(*v)
+	}
+	return _c
+}
+
+// SetUptime sets the "uptime" field.
+func (_c *PanelCreate) SetUptime(v int64) *PanelCreate {
+	_c.mutation.SetUptime(v)
+	return _c
+}
+
+// SetNillableUptime sets the "uptime" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableUptime(v *int64) *PanelCreate {
+	if v != nil {
+		_c.SetUptime(*v)
+	}
+	return _c
+}
+
+// SetSystemCheckedAt sets the "system_checked_at" field.
+func (_c *PanelCreate) SetSystemCheckedAt(v time.Time) *PanelCreate {
+	_c.mutation.SetSystemCheckedAt(v)
+	return _c
+}
+
+// SetNillableSystemCheckedAt sets the "system_checked_at" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableSystemCheckedAt(v *time.Time) *PanelCreate {
+	if v != nil {
+		_c.SetSystemCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_c *PanelCreate) SetHealthStatus(v string) *PanelCreate {
+	_c.mutation.SetHealthStatus(v)
+	return _c
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableHealthStatus(v *string) *PanelCreate {
+	if v != nil {
+		_c.SetHealthStatus(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *PanelCreate) SetConsecutiveFailures(v int) *PanelCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableConsecutiveFailures(v *int) *PanelCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_c *PanelCreate) SetHealthCheckedAt(v time.Time) *PanelCreate {
+	_c.mutation.SetHealthCheckedAt(v)
+	return _c
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableHealthCheckedAt(v *time.Time) *PanelCreate {
+	if v != nil {
+		_c.SetHealthCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetAutoDisabled sets the "auto_disabled" field.
+func (_c *PanelCreate) SetAutoDisabled(v bool) *PanelCreate {
+	_c.mutation.SetAutoDisabled(v)
+	return _c
+}
+
+// SetNillableAutoDisabled sets the "auto_disabled" field if the given value is not nil.
+func (_c *PanelCreate) SetNillableAutoDisabled(v *bool) *PanelCreate {
+	if v != nil {
+		_c.SetAutoDisabled(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PanelCreate) SetID(v int64) *PanelCreate {
 	_c.mutation.SetID(v)
@@ -155,6 +267,18 @@ func (_c *PanelCreate) defaults() {
 		v := panel.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.HealthStatus(); !ok {
+		v := panel.DefaultHealthStatus
+		_c.mutation.SetHealthStatus(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := panel.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := _c.mutation.AutoDisabled(); !ok {
+		v := panel.DefaultAutoDisabled
+		_c.mutation.SetAutoDisabled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -211,6 +335,15 @@ func (_c *PanelCreate) check() error {
 	if _, ok := _c.mutation.Params(); !ok {
 		return &ValidationError{Name: "params", err: errors.New(`ent: missing required field "Panel.params"`)}
 	}
+	if _, ok := _c.mutation.HealthStatus(); !ok {
+		return &ValidationError{Name: "health_status", err: errors.New(`ent: missing required field "Panel.health_status"`)}
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "Panel.consecutive_failures"`)}
+	}
+	if _, ok := _c.mutation.AutoDisabled(); !ok {
+		return &ValidationError{Name: "auto_disabled", err: errors.New(`ent: missing required field "Panel.auto_disabled"`)}
+	}
 	return nil
 }
 
@@ -279,6 +412,38 @@ func (_c *PanelCreate) createSpec() (*Panel, *sqlgraph.CreateSpec) {
 		_spec.SetField(panel.FieldParams, field.TypeInt32, value)
 		_node.Params = value
 	}
+	if value, ok := _c.mutation.QlVersion(); ok {
+		_spec.SetField(panel.FieldQlVersion, field.TypeString, value)
+		_node.QlVersion = &value
+	}
+	if value, ok := _c.mutation.NodeVersion(); ok {
+		_spec.SetField(panel.FieldNodeVersion, field.TypeString, value)
+		_node.NodeVersion = &value
+	}
+	if value, ok := _c.mutation.Uptime(); ok {
+		_spec.SetField(panel.FieldUptime, field.TypeInt64, value)
+		_node.Uptime = &value
+	}
+	if value, ok := _c.mutation.SystemCheckedAt(); ok {
+		_spec.SetField(panel.FieldSystemCheckedAt, field.TypeTime, value)
+		_node.SystemCheckedAt = &value
+	}
+	if value, ok := _c.mutation.HealthStatus(); ok {
+		_spec.SetField(panel.FieldHealthStatus, field.TypeString, value)
+		_node.HealthStatus = value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(panel.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(panel.FieldHealthCheckedAt, field.TypeTime, value)
+		_node.HealthCheckedAt = &value
+	}
+	if value, ok := _c.mutation.AutoDisabled(); ok {
+		_spec.SetField(panel.FieldAutoDisabled, field.TypeBool, value)
+		_node.AutoDisabled = value
+	}
 	if nodes := _c.mutation.EnvsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -293,6 +458,10 @@ func (_c *PanelCreate) createSpec() (*Panel, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _c.config, mutation: newEnvPanelMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
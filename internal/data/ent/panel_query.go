@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
 )
@@ -20,11 +21,12 @@ import (
 // PanelQuery is the builder for querying Panel entities.
 type PanelQuery struct {
 	config
-	ctx        *QueryContext
-	order      []panel.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Panel
-	withEnvs   *EnvQuery
+	ctx           *QueryContext
+	order         []panel.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Panel
+	withEnvs      *EnvQuery
+	withEnvPanels *EnvPanelQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (_q *PanelQuery) QueryEnvs() *EnvQuery {
 	return query
 }
 
+// QueryEnvPanels chains the current query on the "env_panels" edge.
+func (_q *PanelQuery) QueryEnvPanels() *EnvPanelQuery {
+	query := (&EnvPanelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(panel.Table, panel.FieldID, selector),
+			sqlgraph.To(envpanel.Table, envpanel.PanelColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, panel.EnvPanelsTable, panel.EnvPanelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Panel entity from the query.
 // Returns a *NotFoundError when no Panel was found.
 func (_q *PanelQuery) First(ctx context.Context) (*Panel, error) {
@@ -270,12 +294,13 @@ func (_q *PanelQuery) Clone() *PanelQuery {
 		return nil
 	}
 	return &PanelQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]panel.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Panel{}, _q.predicates...),
-		withEnvs:   _q.withEnvs.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]panel.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Panel{}, _q.predicates...),
+		withEnvs:      _q.withEnvs.Clone(),
+		withEnvPanels: _q.withEnvPanels.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +318,17 @@ func (_q *PanelQuery) WithEnvs(opts ...func(*EnvQuery)) *PanelQuery {
 	return _q
 }
 
+// WithEnvPanels tells the query-builder to eager-load the nodes that are connected to
+// the "env_panels" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PanelQuery) WithEnvPanels(opts ...func(*EnvPanelQuery)) *PanelQuery {
+	query := (&EnvPanelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEnvPanels = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (_q *PanelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Panel,
 	var (
 		nodes       = []*Panel{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withEnvs != nil,
+			_q.withEnvPanels != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (_q *PanelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Panel,
 			return nil, err
 		}
 	}
+	if query := _q.withEnvPanels; query != nil {
+		if err := _q.loadEnvPanels(ctx, query, nodes,
+			func(n *Panel) { n.Edges.EnvPanels = []*EnvPanel{} },
+			func(n *Panel, e *EnvPanel) { n.Edges.EnvPanels = append(n.Edges.EnvPanels, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -464,6 +508,36 @@ func (_q *PanelQuery) loadEnvs(ctx context.Context, query *EnvQuery, nodes []*Pa
 	}
 	return nil
 }
+func (_q *PanelQuery) loadEnvPanels(ctx context.Context, query *EnvPanelQuery, nodes []*Panel, init func(*Panel), assign func(*Panel, *EnvPanel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Panel)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(envpanel.FieldPanelID)
+	}
+	query.Where(predicate.EnvPanel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(panel.EnvPanelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PanelID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "panel_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PanelQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -140,6 +140,162 @@ func (_u *PanelUpdate) AddParams(v int32) *PanelUpdate {
 	return _u
 }
 
+// SetQlVersion sets the "ql_version" field.
+func (_u *PanelUpdate) SetQlVersion(v string) *PanelUpdate {
+	_u.mutation.SetQlVersion(v)
+	return _u
+}
+
+// SetNillableQlVersion sets the "ql_version" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableQlVersion(v *string) *PanelUpdate {
+	if v != nil {
+		_u.SetQlVersion(*v)
+	}
+	return _u
+}
+
+// ClearQlVersion clears the value of the "ql_version" field.
+func (_u *PanelUpdate) ClearQlVersion() *PanelUpdate {
+	_u.mutation.ClearQlVersion()
+	return _u
+}
+
+// SetNodeVersion sets the "node_version" field.
+func (_u *PanelUpdate) SetNodeVersion(v string) *PanelUpdate {
+	_u.mutation.SetNodeVersion(v)
+	return _u
+}
+
+// SetNillableNodeVersion sets the "node_version" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableNodeVersion(v *string) *PanelUpdate {
+	if v != nil {
+		_u.SetNodeVersion(*v)
+	}
+	return _u
+}
+
+// ClearNodeVersion clears the value of the "node_version" field.
+func (_u *PanelUpdate) ClearNodeVersion() *PanelUpdate {
+	_u.mutation.ClearNodeVersion()
+	return _u
+}
+
+// SetUptime sets the "uptime" field.
+func (_u *PanelUpdate) SetUptime(v int64) *PanelUpdate {
+	_u.mutation.ResetUptime()
+	_u.mutation.SetUptime(v)
+	return _u
+}
+
+// SetNillableUptime sets the "uptime" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableUptime(v *int64) *PanelUpdate {
+	if v != nil {
+		_u.SetUptime(*v)
+	}
+	return _u
+}
+
+// AddUptime adds value to the "uptime" field.
+func (_u *PanelUpdate) AddUptime(v int64) *PanelUpdate {
+	_u.mutation.AddUptime(v)
+	return _u
+}
+
+// ClearUptime clears the value of the "uptime" field.
+func (_u *PanelUpdate) ClearUptime() *PanelUpdate {
+	_u.mutation.ClearUptime()
+	return _u
+}
+
+// SetSystemCheckedAt sets the "system_checked_at" field.
+func (_u *PanelUpdate) SetSystemCheckedAt(v time.Time) *PanelUpdate {
+	_u.mutation.SetSystemCheckedAt(v)
+	return _u
+}
+
+// SetNillableSystemCheckedAt sets the "system_checked_at" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableSystemCheckedAt(v *time.Time) *PanelUpdate {
+	if v != nil {
+		_u.SetSystemCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearSystemCheckedAt clears the value of the "system_checked_at" field.
+func (_u *PanelUpdate) ClearSystemCheckedAt() *PanelUpdate {
+	_u.mutation.ClearSystemCheckedAt()
+	return _u
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_u *PanelUpdate) SetHealthStatus(v string) *PanelUpdate {
+	_u.mutation.SetHealthStatus(v)
+	return _u
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableHealthStatus(v *string) *PanelUpdate {
+	if v != nil {
+		_u.SetHealthStatus(*v)
+	}
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *PanelUpdate) SetConsecutiveFailures(v int) *PanelUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableConsecutiveFailures(v *int) *PanelUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *PanelUpdate) AddConsecutiveFailures(v int) *PanelUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_u *PanelUpdate) SetHealthCheckedAt(v time.Time) *PanelUpdate {
+	_u.mutation.SetHealthCheckedAt(v)
+	return _u
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableHealthCheckedAt(v *time.Time) *PanelUpdate {
+	if v != nil {
+		_u.SetHealthCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (_u *PanelUpdate) ClearHealthCheckedAt() *PanelUpdate {
+	_u.mutation.ClearHealthCheckedAt()
+	return _u
+}
+
+// SetAutoDisabled sets the "auto_disabled" field.
+func (_u *PanelUpdate) SetAutoDisabled(v bool) *PanelUpdate {
+	_u.mutation.SetAutoDisabled(v)
+	return _u
+}
+
+// SetNillableAutoDisabled sets the "auto_disabled" field if the given value is not nil.
+func (_u *PanelUpdate) SetNillableAutoDisabled(v *bool) *PanelUpdate {
+	if v != nil {
+		_u.SetAutoDisabled(*v)
+	}
+	return _u
+}
+
 // AddEnvIDs adds the "envs" edge to the Env entity by IDs.
 func (_u *PanelUpdate) AddEnvIDs(ids ...int64) *PanelUpdate {
 	_u.mutation.AddEnvIDs(ids...)
@@ -286,6 +442,51 @@ func (_u *PanelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedParams(); ok {
 		_spec.AddField(panel.FieldParams, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.QlVersion(); ok {
+		_spec.SetField(panel.FieldQlVersion, field.TypeString, value)
+	}
+	if _u.mutation.QlVersionCleared() {
+		_spec.ClearField(panel.FieldQlVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodeVersion(); ok {
+		_spec.SetField(panel.FieldNodeVersion, field.TypeString, value)
+	}
+	if _u.mutation.NodeVersionCleared() {
+		_spec.ClearField(panel.FieldNodeVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Uptime(); ok {
+		_spec.SetField(panel.FieldUptime, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUptime(); ok {
+		_spec.AddField(panel.FieldUptime, field.TypeInt64, value)
+	}
+	if _u.mutation.UptimeCleared() {
+		_spec.ClearField(panel.FieldUptime, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SystemCheckedAt(); ok {
+		_spec.SetField(panel.FieldSystemCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SystemCheckedAtCleared() {
+		_spec.ClearField(panel.FieldSystemCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HealthStatus(); ok {
+		_spec.SetField(panel.FieldHealthStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(panel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(panel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(panel.FieldHealthCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HealthCheckedAtCleared() {
+		_spec.ClearField(panel.FieldHealthCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoDisabled(); ok {
+		_spec.SetField(panel.FieldAutoDisabled, field.TypeBool, value)
+	}
 	if _u.mutation.EnvsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -297,6 +498,10 @@ func (_u *PanelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
 			},
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedEnvsIDs(); len(nodes) > 0 && !_u.mutation.EnvsCleared() {
@@ -313,6 +518,10 @@ func (_u *PanelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.EnvsIDs(); len(nodes) > 0 {
@@ -329,6 +538,10 @@ func (_u *PanelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
@@ -462,6 +675,162 @@ func (_u *PanelUpdateOne) AddParams(v int32) *PanelUpdateOne {
 	return _u
 }
 
+// SetQlVersion sets the "ql_version" field.
+func (_u *PanelUpdateOne) SetQlVersion(v string) *PanelUpdateOne {
+	_u.mutation.SetQlVersion(v)
+	return _u
+}
+
+// SetNillableQlVersion sets the "ql_version" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableQlVersion(v *string) *PanelUpdateOne {
+	if v != nil {
+		_u.SetQlVersion(*v)
+	}
+	return _u
+}
+
+// ClearQlVersion clears the value of the "ql_version" field.
+func (_u *PanelUpdateOne) ClearQlVersion() *PanelUpdateOne {
+	_u.mutation.ClearQlVersion()
+	return _u
+}
+
+// SetNodeVersion sets the "node_version" field.
+func (_u *PanelUpdateOne) SetNodeVersion(v string) *PanelUpdateOne {
+	_u.mutation.SetNodeVersion(v)
+	return _u
+}
+
+// SetNillableNodeVersion sets the "node_version" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableNodeVersion(v *string) *PanelUpdateOne {
+	if v != nil {
+		_u.SetNodeVersion(*v)
+	}
+	return _u
+}
+
+// ClearNodeVersion clears the value of the "node_version" field.
+func (_u *PanelUpdateOne) ClearNodeVersion() *PanelUpdateOne {
+	_u.mutation.ClearNodeVersion()
+	return _u
+}
+
+// SetUptime sets the "uptime" field.
+func (_u *PanelUpdateOne) SetUptime(v int64) *PanelUpdateOne {
+	_u.mutation.ResetUptime()
+	_u.mutation.SetUptime(v)
+	return _u
+}
+
+// SetNillableUptime sets the "uptime" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableUptime(v *int64) *PanelUpdateOne {
+	if v != nil {
+		_u.SetUptime(*v)
+	}
+	return _u
+}
+
+// AddUptime adds value to the "uptime" field.
+func (_u *PanelUpdateOne) AddUptime(v int64) *PanelUpdateOne {
+	_u.mutation.AddUptime(v)
+	return _u
+}
+
+// ClearUptime clears the value of the "uptime" field.
+func (_u *PanelUpdateOne) ClearUptime() *PanelUpdateOne {
+	_u.mutation.ClearUptime()
+	return _u
+}
+
+// SetSystemCheckedAt sets the "system_checked_at" field.
+func (_u *PanelUpdateOne) SetSystemCheckedAt(v time.Time) *PanelUpdateOne {
+	_u.mutation.SetSystemCheckedAt(v)
+	return _u
+}
+
+// SetNillableSystemCheckedAt sets the "system_checked_at" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableSystemCheckedAt(v *time.Time) *PanelUpdateOne {
+	if v != nil {
+		_u.SetSystemCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearSystemCheckedAt clears the value of the "system_checked_at" field.
+func (_u *PanelUpdateOne) ClearSystemCheckedAt() *PanelUpdateOne {
+	_u.mutation.ClearSystemCheckedAt()
+	return _u
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_u *PanelUpdateOne) SetHealthStatus(v string) *PanelUpdateOne {
+	_u.mutation.SetHealthStatus(v)
+	return _u
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableHealthStatus(v *string) *PanelUpdateOne {
+	if v != nil {
+		_u.SetHealthStatus(*v)
+	}
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *PanelUpdateOne) SetConsecutiveFailures(v int) *PanelUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableConsecutiveFailures(v *int) *PanelUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *PanelUpdateOne) AddConsecutiveFailures(v int) *PanelUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_u *PanelUpdateOne) SetHealthCheckedAt(v time.Time) *PanelUpdateOne {
+	_u.mutation.SetHealthCheckedAt(v)
+	return _u
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableHealthCheckedAt(v *time.Time) *PanelUpdateOne {
+	if v != nil {
+		_u.SetHealthCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (_u *PanelUpdateOne) ClearHealthCheckedAt() *PanelUpdateOne {
+	_u.mutation.ClearHealthCheckedAt()
+	return _u
+}
+
+// SetAutoDisabled sets the "auto_disabled" field.
+func (_u *PanelUpdateOne) SetAutoDisabled(v bool) *PanelUpdateOne {
+	_u.mutation.SetAutoDisabled(v)
+	return _u
+}
+
+// SetNillableAutoDisabled sets the "auto_disabled" field if the given value is not nil.
+func (_u *PanelUpdateOne) SetNillableAutoDisabled(v *bool) *PanelUpdateOne {
+	if v != nil {
+		_u.SetAutoDisabled(*v)
+	}
+	return _u
+}
+
 // AddEnvIDs adds the "envs" edge to the Env entity by IDs.
 func (_u *PanelUpdateOne) AddEnvIDs(ids ...int64) *PanelUpdateOne {
 	_u.mutation.AddEnvIDs(ids...)
@@ -638,6 +1007,51 @@ func (_u *PanelUpdateOne) sqlSave(ctx context.Context) (_node *Panel, err error)
 	if value, ok := _u.mutation.AddedParams(); ok {
 		_spec.AddField(panel.FieldParams, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.QlVersion(); ok {
+		_spec.SetField(panel.FieldQlVersion, field.TypeString, value)
+	}
+	if _u.mutation.QlVersionCleared() {
+		_spec.ClearField(panel.FieldQlVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodeVersion(); ok {
+		_spec.SetField(panel.FieldNodeVersion, field.TypeString, value)
+	}
+	if _u.mutation.NodeVersionCleared() {
+		_spec.ClearField(panel.FieldNodeVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Uptime(); ok {
+		_spec.SetField(panel.FieldUptime, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUptime(); ok {
+		_spec.AddField(panel.FieldUptime, field.TypeInt64, value)
+	}
+	if _u.mutation.UptimeCleared() {
+		_spec.ClearField(panel.FieldUptime, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SystemCheckedAt(); ok {
+		_spec.SetField(panel.FieldSystemCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SystemCheckedAtCleared() {
+		_spec.ClearField(panel.FieldSystemCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HealthStatus(); ok {
+		_spec.SetField(panel.FieldHealthStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(panel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(panel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(panel.FieldHealthCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HealthCheckedAtCleared() {
+		_spec.ClearField(panel.FieldHealthCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoDisabled(); ok {
+		_spec.SetField(panel.FieldAutoDisabled, field.TypeBool, value)
+	}
 	if _u.mutation.EnvsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -649,6 +1063,10 @@ func (_u *PanelUpdateOne) sqlSave(ctx context.Context) (_node *Panel, err error)
 				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeInt64),
 			},
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedEnvsIDs(); len(nodes) > 0 && !_u.mutation.EnvsCleared() {
@@ -665,6 +1083,10 @@ func (_u *PanelUpdateOne) sqlSave(ctx context.Context) (_node *Panel, err error)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.EnvsIDs(); len(nodes) > 0 {
@@ -681,6 +1103,10 @@ func (_u *PanelUpdateOne) sqlSave(ctx context.Context) (_node *Panel, err error)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &EnvPanelCreate{config: _u.config, mutation: newEnvPanelMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Panel{config: _u.config}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
+)
+
+// PanelHealth is the model entity for the PanelHealth schema.
+type PanelHealth struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID int64 `json:"id,omitempty"`
+	// 检查时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 面板ID
+	PanelID int64 `json:"panel_id,omitempty"`
+	// 健康状态(healthy,degraded,down)
+	Status string `json:"status,omitempty"`
+	// Token是否有效
+	TokenValid bool `json:"token_valid,omitempty"`
+	// 响应耗时(毫秒)
+	Latency int64 `json:"latency,omitempty"`
+	// 环境变量数量
+	EnvCount *int32 `json:"env_count,omitempty"`
+	// 检查结果说明
+	Message      *string `json:"message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PanelHealth) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case panelhealth.FieldTokenValid:
+			values[i] = new(sql.NullBool)
+		case panelhealth.FieldID, panelhealth.FieldPanelID, panelhealth.FieldLatency, panelhealth.FieldEnvCount:
+			values[i] = new(sql.NullInt64)
+		case panelhealth.FieldStatus, panelhealth.FieldMessage:
+			values[i] = new(sql.NullString)
+		case panelhealth.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PanelHealth fields.
+func (_m *PanelHealth) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case panelhealth.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case panelhealth.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case panelhealth.FieldPanelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field panel_id", values[i])
+			} else if value.Valid {
+				_m.PanelID = value.Int64
+			}
+		case panelhealth.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case panelhealth.FieldTokenValid:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field token_valid", values[i])
+			} else if value.Valid {
+				_m.TokenValid = value.Bool
+			}
+		case panelhealth.FieldLatency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency", values[i])
+			} else if value.Valid {
+				_m.Latency = value.Int64
+			}
+		case panelhealth.FieldEnvCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field env_count", values[i])
+			} else if value.Valid {
+				_m.EnvCount = new(int32)
+				*_m.EnvCount = int32(value.Int64)
+			}
+		case panelhealth.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = new(string)
+				*_m.Message = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PanelHealth.
+// This includes values selected through modifiers, order, etc.
+func (_m *PanelHealth) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PanelHealth.
+// Note that you need to call PanelHealth.Unwrap() before calling this method if this PanelHealth
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PanelHealth) Update() *PanelHealthUpdateOne {
+	return NewPanelHealthClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PanelHealth entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PanelHealth) Unwrap() *PanelHealth {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PanelHealth is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PanelHealth) String() string {
+	var builder strings.Builder
+	builder.WriteString("PanelHealth(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("panel_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PanelID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("token_valid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TokenValid))
+	builder.WriteString(", ")
+	builder.WriteString("latency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Latency))
+	builder.WriteString(", ")
+	if v := _m.EnvCount; v != nil {
+		builder.WriteString("env_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Message; v != nil {
+		builder.WriteString("message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PanelHealths is a parsable slice of PanelHealth.
+type PanelHealths []*PanelHealth
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package panelhealth
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the panelhealth type in the database.
+	Label = "panel_health"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPanelID holds the string denoting the panel_id field in the database.
+	FieldPanelID = "panel_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTokenValid holds the string denoting the token_valid field in the database.
+	FieldTokenValid = "token_valid"
+	// FieldLatency holds the string denoting the latency field in the database.
+	FieldLatency = "latency"
+	// FieldEnvCount holds the string denoting the env_count field in the database.
+	FieldEnvCount = "env_count"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// Table holds the table name of the panelhealth in the database.
+	Table = "panel_healths"
+)
+
+// Columns holds all SQL columns for panelhealth fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldPanelID,
+	FieldStatus,
+	FieldTokenValid,
+	FieldLatency,
+	FieldEnvCount,
+	FieldMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PanelHealth queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPanelID orders the results by the panel_id field.
+func ByPanelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPanelID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTokenValid orders the results by the token_valid field.
+func ByTokenValid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenValid, opts...).ToFunc()
+}
+
+// ByLatency orders the results by the latency field.
+func ByLatency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatency, opts...).ToFunc()
+}
+
+// ByEnvCount orders the results by the env_count field.
+func ByEnvCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvCount, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
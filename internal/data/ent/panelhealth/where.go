@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package panelhealth
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PanelID applies equality check predicate on the "panel_id" field. It's identical to PanelIDEQ.
+func PanelID(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldPanelID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldStatus, v))
+}
+
+// TokenValid applies equality check predicate on the "token_valid" field. It's identical to TokenValidEQ.
+func TokenValid(v bool) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldTokenValid, v))
+}
+
+// Latency applies equality check predicate on the "latency" field. It's identical to LatencyEQ.
+func Latency(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldLatency, v))
+}
+
+// EnvCount applies equality check predicate on the "env_count" field. It's identical to EnvCountEQ.
+func EnvCount(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldEnvCount, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PanelIDEQ applies the EQ predicate on the "panel_id" field.
+func PanelIDEQ(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldPanelID, v))
+}
+
+// PanelIDNEQ applies the NEQ predicate on the "panel_id" field.
+func PanelIDNEQ(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldPanelID, v))
+}
+
+// PanelIDIn applies the In predicate on the "panel_id" field.
+func PanelIDIn(vs ...int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldPanelID, vs...))
+}
+
+// PanelIDNotIn applies the NotIn predicate on the "panel_id" field.
+func PanelIDNotIn(vs ...int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldPanelID, vs...))
+}
+
+// PanelIDGT applies the GT predicate on the "panel_id" field.
+func PanelIDGT(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldPanelID, v))
+}
+
+// PanelIDGTE applies the GTE predicate on the "panel_id" field.
+func PanelIDGTE(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldPanelID, v))
+}
+
+// PanelIDLT applies the LT predicate on the "panel_id" field.
+func PanelIDLT(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldPanelID, v))
+}
+
+// PanelIDLTE applies the LTE predicate on the "panel_id" field.
+func PanelIDLTE(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldPanelID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// TokenValidEQ applies the EQ predicate on the "token_valid" field.
+func TokenValidEQ(v bool) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldTokenValid, v))
+}
+
+// TokenValidNEQ applies the NEQ predicate on the "token_valid" field.
+func TokenValidNEQ(v bool) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldTokenValid, v))
+}
+
+// LatencyEQ applies the EQ predicate on the "latency" field.
+func LatencyEQ(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldLatency, v))
+}
+
+// LatencyNEQ applies the NEQ predicate on the "latency" field.
+func LatencyNEQ(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldLatency, v))
+}
+
+// LatencyIn applies the In predicate on the "latency" field.
+func LatencyIn(vs ...int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldLatency, vs...))
+}
+
+// LatencyNotIn applies the NotIn predicate on the "latency" field.
+func LatencyNotIn(vs ...int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldLatency, vs...))
+}
+
+// LatencyGT applies the GT predicate on the "latency" field.
+func LatencyGT(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldLatency, v))
+}
+
+// LatencyGTE applies the GTE predicate on the "latency" field.
+func LatencyGTE(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldLatency, v))
+}
+
+// LatencyLT applies the LT predicate on the "latency" field.
+func LatencyLT(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldLatency, v))
+}
+
+// LatencyLTE applies the LTE predicate on the "latency" field.
+func LatencyLTE(v int64) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldLatency, v))
+}
+
+// EnvCountEQ applies the EQ predicate on the "env_count" field.
+func EnvCountEQ(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldEnvCount, v))
+}
+
+// EnvCountNEQ applies the NEQ predicate on the "env_count" field.
+func EnvCountNEQ(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldEnvCount, v))
+}
+
+// EnvCountIn applies the In predicate on the "env_count" field.
+func EnvCountIn(vs ...int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldEnvCount, vs...))
+}
+
+// EnvCountNotIn applies the NotIn predicate on the "env_count" field.
+func EnvCountNotIn(vs ...int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldEnvCount, vs...))
+}
+
+// EnvCountGT applies the GT predicate on the "env_count" field.
+func EnvCountGT(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldEnvCount, v))
+}
+
+// EnvCountGTE applies the GTE predicate on the "env_count" field.
+func EnvCountGTE(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldEnvCount, v))
+}
+
+// EnvCountLT applies the LT predicate on the "env_count" field.
+func EnvCountLT(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldEnvCount, v))
+}
+
+// EnvCountLTE applies the LTE predicate on the "env_count" field.
+func EnvCountLTE(v int32) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldEnvCount, v))
+}
+
+// EnvCountIsNil applies the IsNil predicate on the "env_count" field.
+func EnvCountIsNil() predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIsNull(FieldEnvCount))
+}
+
+// EnvCountNotNil applies the NotNil predicate on the "env_count" field.
+func EnvCountNotNil() predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotNull(FieldEnvCount))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PanelHealth) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PanelHealth) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PanelHealth) predicate.PanelHealth {
+	return predicate.PanelHealth(sql.NotPredicates(p))
+}
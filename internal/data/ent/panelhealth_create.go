@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
+)
+
+// PanelHealthCreate is the builder for creating a PanelHealth entity.
+type PanelHealthCreate struct {
+	config
+	mutation *PanelHealthMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PanelHealthCreate) SetCreatedAt(v time.Time) *PanelHealthCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PanelHealthCreate) SetNillableCreatedAt(v *time.Time) *PanelHealthCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_c *PanelHealthCreate) SetPanelID(v int64) *PanelHealthCreate {
+	_c.mutation.SetPanelID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PanelHealthCreate) SetStatus(v string) *PanelHealthCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetTokenValid sets the "token_valid" field.
+func (_c *PanelHealthCreate) SetTokenValid(v bool) *PanelHealthCreate {
+	_c.mutation.SetTokenValid(v)
+	return _c
+}
+
+// SetLatency sets the "latency" field.
+func (_c *PanelHealthCreate) SetLatency(v int64) *PanelHealthCreate {
+	_c.mutation.SetLatency(v)
+	return _c
+}
+
+// SetEnvCount sets the "env_count" field.
+func (_c *PanelHealthCreate) SetEnvCount(v int32) *PanelHealthCreate {
+	_c.mutation.SetEnvCount(v)
+	return _c
+}
+
+// SetNillableEnvCount sets the "env_count" field if the given value is not nil.
+func (_c *PanelHealthCreate) SetNillableEnvCount(v *int32) *PanelHealthCreate {
+	if v != nil {
+		_c.SetEnvCount(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *PanelHealthCreate) SetMessage(v string) *PanelHealthCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *PanelHealthCreate) SetNillableMessage(v *string) *PanelHealthCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PanelHealthCreate) SetID(v int64) *PanelHealthCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PanelHealthMutation object of the builder.
+func (_c *PanelHealthCreate) Mutation() *PanelHealthMutation {
+	return _c.mutation
+}
+
+// Save creates the PanelHealth in the database.
+func (_c *PanelHealthCreate) Save(ctx context.Context) (*PanelHealth, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PanelHealthCreate) SaveX(ctx context.Context) *PanelHealth {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PanelHealthCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PanelHealthCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PanelHealthCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := panelhealth.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PanelHealthCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PanelHealth.created_at"`)}
+	}
+	if _, ok := _c.mutation.PanelID(); !ok {
+		return &ValidationError{Name: "panel_id", err: errors.New(`ent: missing required field "PanelHealth.panel_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PanelHealth.status"`)}
+	}
+	if _, ok := _c.mutation.TokenValid(); !ok {
+		return &ValidationError{Name: "token_valid", err: errors.New(`ent: missing required field "PanelHealth.token_valid"`)}
+	}
+	if _, ok := _c.mutation.Latency(); !ok {
+		return &ValidationError{Name: "latency", err: errors.New(`ent: missing required field "PanelHealth.latency"`)}
+	}
+	return nil
+}
+
+func (_c *PanelHealthCreate) sqlSave(ctx context.Context) (*PanelHealth, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PanelHealthCreate) createSpec() (*PanelHealth, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PanelHealth{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(panelhealth.Table, sqlgraph.NewFieldSpec(panelhealth.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(panelhealth.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.PanelID(); ok {
+		_spec.SetField(panelhealth.FieldPanelID, field.TypeInt64, value)
+		_node.PanelID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(panelhealth.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TokenValid(); ok {
+		_spec.SetField(panelhealth.FieldTokenValid, field.TypeBool, value)
+		_node.TokenValid = value
+	}
+	if value, ok := _c.mutation.Latency(); ok {
+		_spec.SetField(panelhealth.FieldLatency, field.TypeInt64, value)
+		_node.Latency = value
+	}
+	if value, ok := _c.mutation.EnvCount(); ok {
+		_spec.SetField(panelhealth.FieldEnvCount, field.TypeInt32, value)
+		_node.EnvCount = &value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(panelhealth.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	return _node, _spec
+}
+
+// PanelHealthCreateBulk is the builder for creating many PanelHealth entities in bulk.
+type PanelHealthCreateBulk struct {
+	config
+	err      error
+	builders []*PanelHealthCreate
+}
+
+// Save creates the PanelHealth entities in the database.
+func (_c *PanelHealthCreateBulk) Save(ctx context.Context) ([]*PanelHealth, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PanelHealth, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PanelHealthMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PanelHealthCreateBulk) SaveX(ctx context.Context) []*PanelHealth {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PanelHealthCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PanelHealthCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
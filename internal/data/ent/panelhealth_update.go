@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// PanelHealthUpdate is the builder for updating PanelHealth entities.
+type PanelHealthUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PanelHealthMutation
+}
+
+// Where appends a list predicates to the PanelHealthUpdate builder.
+func (_u *PanelHealthUpdate) Where(ps ...predicate.PanelHealth) *PanelHealthUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *PanelHealthUpdate) SetPanelID(v int64) *PanelHealthUpdate {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *PanelHealthUpdate) SetNillablePanelID(v *int64) *PanelHealthUpdate {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *PanelHealthUpdate) AddPanelID(v int64) *PanelHealthUpdate {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PanelHealthUpdate) SetStatus(v string) *PanelHealthUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PanelHealthUpdate) SetNillableStatus(v *string) *PanelHealthUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTokenValid sets the "token_valid" field.
+func (_u *PanelHealthUpdate) SetTokenValid(v bool) *PanelHealthUpdate {
+	_u.mutation.SetTokenValid(v)
+	return _u
+}
+
+// SetNillableTokenValid sets the "token_valid" field if the given value is not nil.
+func (_u *PanelHealthUpdate) SetNillableTokenValid(v *bool) *PanelHealthUpdate {
+	if v != nil {
+		_u.SetTokenValid(*v)
+	}
+	return _u
+}
+
+// SetLatency sets the "latency" field.
+func (_u *PanelHealthUpdate) SetLatency(v int64) *PanelHealthUpdate {
+	_u.mutation.ResetLatency()
+	_u.mutation.SetLatency(v)
+	return _u
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (_u *PanelHealthUpdate) SetNillableLatency(v *int64) *PanelHealthUpdate {
+	if v != nil {
+		_u.SetLatency(*v)
+	}
+	return _u
+}
+
+// AddLatency adds value to the "latency" field.
+func (_u *PanelHealthUpdate) AddLatency(v int64) *PanelHealthUpdate {
+	_u.mutation.AddLatency(v)
+	return _u
+}
+
+// SetEnvCount sets the "env_count" field.
+func (_u *PanelHealthUpdate) SetEnvCount(v int32) *PanelHealthUpdate {
+	_u.mutation.ResetEnvCount()
+	_u.mutation.SetEnvCount(v)
+	return _u
+}
+
+// SetNillableEnvCount sets the "env_count" field if the given value is not nil.
+func (_u *PanelHealthUpdate) SetNillableEnvCount(v *int32) *PanelHealthUpdate {
+	if v != nil {
+		_u.SetEnvCount(*v)
+	}
+	return _u
+}
+
+// AddEnvCount adds value to the "env_count" field.
+func (_u *PanelHealthUpdate) AddEnvCount(v int32) *PanelHealthUpdate {
+	_u.mutation.AddEnvCount(v)
+	return _u
+}
+
+// ClearEnvCount clears the value of the "env_count" field.
+func (_u *PanelHealthUpdate) ClearEnvCount() *PanelHealthUpdate {
+	_u.mutation.ClearEnvCount()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *PanelHealthUpdate) SetMessage(v string) *PanelHealthUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *PanelHealthUpdate) SetNillableMessage(v *string) *PanelHealthUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *PanelHealthUpdate) ClearMessage() *PanelHealthUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Mutation returns the PanelHealthMutation object of the builder.
+func (_u *PanelHealthUpdate) Mutation() *PanelHealthMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PanelHealthUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PanelHealthUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PanelHealthUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PanelHealthUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PanelHealthUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(panelhealth.Table, panelhealth.Columns, sqlgraph.NewFieldSpec(panelhealth.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(panelhealth.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(panelhealth.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(panelhealth.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenValid(); ok {
+		_spec.SetField(panelhealth.FieldTokenValid, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Latency(); ok {
+		_spec.SetField(panelhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatency(); ok {
+		_spec.AddField(panelhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EnvCount(); ok {
+		_spec.SetField(panelhealth.FieldEnvCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedEnvCount(); ok {
+		_spec.AddField(panelhealth.FieldEnvCount, field.TypeInt32, value)
+	}
+	if _u.mutation.EnvCountCleared() {
+		_spec.ClearField(panelhealth.FieldEnvCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(panelhealth.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(panelhealth.FieldMessage, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{panelhealth.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PanelHealthUpdateOne is the builder for updating a single PanelHealth entity.
+type PanelHealthUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PanelHealthMutation
+}
+
+// SetPanelID sets the "panel_id" field.
+func (_u *PanelHealthUpdateOne) SetPanelID(v int64) *PanelHealthUpdateOne {
+	_u.mutation.ResetPanelID()
+	_u.mutation.SetPanelID(v)
+	return _u
+}
+
+// SetNillablePanelID sets the "panel_id" field if the given value is not nil.
+func (_u *PanelHealthUpdateOne) SetNillablePanelID(v *int64) *PanelHealthUpdateOne {
+	if v != nil {
+		_u.SetPanelID(*v)
+	}
+	return _u
+}
+
+// AddPanelID adds value to the "panel_id" field.
+func (_u *PanelHealthUpdateOne) AddPanelID(v int64) *PanelHealthUpdateOne {
+	_u.mutation.AddPanelID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PanelHealthUpdateOne) SetStatus(v string) *PanelHealthUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PanelHealthUpdateOne) SetNillableStatus(v *string) *PanelHealthUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTokenValid sets the "token_valid" field.
+func (_u *PanelHealthUpdateOne) SetTokenValid(v bool) *PanelHealthUpdateOne {
+	_u.mutation.SetTokenValid(v)
+	return _u
+}
+
+// SetNillableTokenValid sets the "token_valid" field if the given value is not nil.
+func (_u *PanelHealthUpdateOne) SetNillableTokenValid(v *bool) *PanelHealthUpdateOne {
+	if v != nil {
+		_u.SetTokenValid(*v)
+	}
+	return _u
+}
+
+// SetLatency sets the "latency" field.
+func (_u *PanelHealthUpdateOne) SetLatency(v int64) *PanelHealthUpdateOne {
+	_u.mutation.ResetLatency()
+	_u.mutation.SetLatency(v)
+	return _u
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (_u *PanelHealthUpdateOne) SetNillableLatency(v *int64) *PanelHealthUpdateOne {
+	if v != nil {
+		_u.SetLatency(*v)
+	}
+	return _u
+}
+
+// AddLatency adds value to the "latency" field.
+func (_u *PanelHealthUpdateOne) AddLatency(v int64) *PanelHealthUpdateOne {
+	_u.mutation.AddLatency(v)
+	return _u
+}
+
+// SetEnvCount sets the "env_count" field.
+func (_u *PanelHealthUpdateOne) SetEnvCount(v int32) *PanelHealthUpdateOne {
+	_u.mutation.ResetEnvCount()
+	_u.mutation.SetEnvCount(v)
+	return _u
+}
+
+// SetNillableEnvCount sets the "env_count" field if the given value is not nil.
+func (_u *PanelHealthUpdateOne) SetNillableEnvCount(v *int32) *PanelHealthUpdateOne {
+	if v != nil {
+		_u.SetEnvCount(*v)
+	}
+	return _u
+}
+
+// AddEnvCount adds value to the "env_count" field.
+func (_u *PanelHealthUpdateOne) AddEnvCount(v int32) *PanelHealthUpdateOne {
+	_u.mutation.AddEnvCount(v)
+	return _u
+}
+
+// ClearEnvCount clears the value of the "env_count" field.
+func (_u *PanelHealthUpdateOne) ClearEnvCount() *PanelHealthUpdateOne {
+	_u.mutation.ClearEnvCount()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *PanelHealthUpdateOne) SetMessage(v string) *PanelHealthUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *PanelHealthUpdateOne) SetNillableMessage(v *string) *PanelHealthUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *PanelHealthUpdateOne) ClearMessage() *PanelHealthUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// Mutation returns the PanelHealthMutation object of the builder.
+func (_u *PanelHealthUpdateOne) Mutation() *PanelHealthMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PanelHealthUpdate builder.
+func (_u *PanelHealthUpdateOne) Where(ps ...predicate.PanelHealth) *PanelHealthUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PanelHealthUpdateOne) Select(field string, fields ...string) *PanelHealthUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PanelHealth entity.
+func (_u *PanelHealthUpdateOne) Save(ctx context.Context) (*PanelHealth, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PanelHealthUpdateOne) SaveX(ctx context.Context) *PanelHealth {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PanelHealthUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PanelHealthUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PanelHealthUpdateOne) sqlSave(ctx context.Context) (_node *PanelHealth, err error) {
+	_spec := sqlgraph.NewUpdateSpec(panelhealth.Table, panelhealth.Columns, sqlgraph.NewFieldSpec(panelhealth.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PanelHealth.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, panelhealth.FieldID)
+		for _, f := range fields {
+			if !panelhealth.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != panelhealth.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PanelID(); ok {
+		_spec.SetField(panelhealth.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPanelID(); ok {
+		_spec.AddField(panelhealth.FieldPanelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(panelhealth.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenValid(); ok {
+		_spec.SetField(panelhealth.FieldTokenValid, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Latency(); ok {
+		_spec.SetField(panelhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatency(); ok {
+		_spec.AddField(panelhealth.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EnvCount(); ok {
+		_spec.SetField(panelhealth.FieldEnvCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedEnvCount(); ok {
+		_spec.AddField(panelhealth.FieldEnvCount, field.TypeInt32, value)
+	}
+	if _u.mutation.EnvCountCleared() {
+		_spec.ClearField(panelhealth.FieldEnvCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(panelhealth.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(panelhealth.FieldMessage, field.TypeString)
+	}
+	_node = &PanelHealth{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{panelhealth.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -40,6 +40,8 @@ type Plugin struct {
 	TriggerEvent string `json:"trigger_event,omitempty"`
 	// 执行优先级
 	Priority int32 `json:"priority,omitempty"`
+	// 保存前要求测试用例全部通过
+	RequireTestsPass bool `json:"require_tests_pass,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PluginQuery when eager-loading is set.
 	Edges        PluginEdges `json:"edges"`
@@ -52,9 +54,11 @@ type PluginEdges struct {
 	EnvPlugins []*EnvPlugin `json:"env_plugins,omitempty"`
 	// ExecutionLogs holds the value of the execution_logs edge.
 	ExecutionLogs []*PluginExecutionLog `json:"execution_logs,omitempty"`
+	// TestCases holds the value of the test_cases edge.
+	TestCases []*PluginTestCase `json:"test_cases,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // EnvPluginsOrErr returns the EnvPlugins value or an error if the edge
@@ -75,12 +79,21 @@ func (e PluginEdges) ExecutionLogsOrErr() ([]*PluginExecutionLog, error) {
 	return nil, &NotLoadedError{edge: "execution_logs"}
 }
 
+// TestCasesOrErr returns the TestCases value or an error if the edge
+// was not loaded in eager-loading.
+func (e PluginEdges) TestCasesOrErr() ([]*PluginTestCase, error) {
+	if e.loadedTypes[2] {
+		return e.TestCases, nil
+	}
+	return nil, &NotLoadedError{edge: "test_cases"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Plugin) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case plugin.FieldIsEnable:
+		case plugin.FieldIsEnable, plugin.FieldRequireTestsPass:
 			values[i] = new(sql.NullBool)
 		case plugin.FieldID, plugin.FieldExecutionTimeout, plugin.FieldPriority:
 			values[i] = new(sql.NullInt64)
@@ -177,6 +190,12 @@ func (_m *Plugin) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Priority = int32(value.Int64)
 			}
+		case plugin.FieldRequireTestsPass:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_tests_pass", values[i])
+			} else if value.Valid {
+				_m.RequireTestsPass = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -200,6 +219,11 @@ func (_m *Plugin) QueryExecutionLogs() *PluginExecutionLogQuery {
 	return NewPluginClient(_m.config).QueryExecutionLogs(_m)
 }
 
+// QueryTestCases queries the "test_cases" edge of the Plugin entity.
+func (_m *Plugin) QueryTestCases() *PluginTestCaseQuery {
+	return NewPluginClient(_m.config).QueryTestCases(_m)
+}
+
 // Update returns a builder for updating this Plugin.
 // Note that you need to call Plugin.Unwrap() before calling this method if this Plugin
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -259,6 +283,9 @@ func (_m *Plugin) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("require_tests_pass=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireTestsPass))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -36,10 +36,14 @@ const (
 	FieldTriggerEvent = "trigger_event"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
+	// FieldRequireTestsPass holds the string denoting the require_tests_pass field in the database.
+	FieldRequireTestsPass = "require_tests_pass"
 	// EdgeEnvPlugins holds the string denoting the env_plugins edge name in mutations.
 	EdgeEnvPlugins = "env_plugins"
 	// EdgeExecutionLogs holds the string denoting the execution_logs edge name in mutations.
 	EdgeExecutionLogs = "execution_logs"
+	// EdgeTestCases holds the string denoting the test_cases edge name in mutations.
+	EdgeTestCases = "test_cases"
 	// Table holds the table name of the plugin in the database.
 	Table = "plugins"
 	// EnvPluginsTable is the table that holds the env_plugins relation/edge.
@@ -56,6 +60,13 @@ const (
 	ExecutionLogsInverseTable = "plugin_execution_logs"
 	// ExecutionLogsColumn is the table column denoting the execution_logs relation/edge.
 	ExecutionLogsColumn = "plugin_id"
+	// TestCasesTable is the table that holds the test_cases relation/edge.
+	TestCasesTable = "plugin_test_cases"
+	// TestCasesInverseTable is the table name for the PluginTestCase entity.
+	// It exists in this package in order to avoid circular dependency with the "plugintestcase" package.
+	TestCasesInverseTable = "plugin_test_cases"
+	// TestCasesColumn is the table column denoting the test_cases relation/edge.
+	TestCasesColumn = "plugin_id"
 )
 
 // Columns holds all SQL columns for plugin fields.
@@ -72,6 +83,7 @@ var Columns = []string{
 	FieldExecutionTimeout,
 	FieldTriggerEvent,
 	FieldPriority,
+	FieldRequireTestsPass,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -105,6 +117,8 @@ var (
 	DefaultTriggerEvent string
 	// DefaultPriority holds the default value on creation for the "priority" field.
 	DefaultPriority int32
+	// DefaultRequireTestsPass holds the default value on creation for the "require_tests_pass" field.
+	DefaultRequireTestsPass bool
 )
 
 // OrderOption defines the ordering options for the Plugin queries.
@@ -170,6 +184,11 @@ func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
 }
 
+// ByRequireTestsPass orders the results by the require_tests_pass field.
+func ByRequireTestsPass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireTestsPass, opts...).ToFunc()
+}
+
 // ByEnvPluginsCount orders the results by env_plugins count.
 func ByEnvPluginsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -197,6 +216,20 @@ func ByExecutionLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newExecutionLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTestCasesCount orders the results by test_cases count.
+func ByTestCasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTestCasesStep(), opts...)
+	}
+}
+
+// ByTestCases orders the results by test_cases terms.
+func ByTestCases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestCasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvPluginsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -211,3 +244,10 @@ func newExecutionLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ExecutionLogsTable, ExecutionLogsColumn),
 	)
 }
+func newTestCasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestCasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TestCasesTable, TestCasesColumn),
+	)
+}
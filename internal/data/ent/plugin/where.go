@@ -110,6 +110,11 @@ func Priority(v int32) predicate.Plugin {
 	return predicate.Plugin(sql.FieldEQ(FieldPriority, v))
 }
 
+// RequireTestsPass applies equality check predicate on the "require_tests_pass" field. It's identical to RequireTestsPassEQ.
+func RequireTestsPass(v bool) predicate.Plugin {
+	return predicate.Plugin(sql.FieldEQ(FieldRequireTestsPass, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Plugin {
 	return predicate.Plugin(sql.FieldEQ(FieldCreatedAt, v))
@@ -690,6 +695,16 @@ func PriorityLTE(v int32) predicate.Plugin {
 	return predicate.Plugin(sql.FieldLTE(FieldPriority, v))
 }
 
+// RequireTestsPassEQ applies the EQ predicate on the "require_tests_pass" field.
+func RequireTestsPassEQ(v bool) predicate.Plugin {
+	return predicate.Plugin(sql.FieldEQ(FieldRequireTestsPass, v))
+}
+
+// RequireTestsPassNEQ applies the NEQ predicate on the "require_tests_pass" field.
+func RequireTestsPassNEQ(v bool) predicate.Plugin {
+	return predicate.Plugin(sql.FieldNEQ(FieldRequireTestsPass, v))
+}
+
 // HasEnvPlugins applies the HasEdge predicate on the "env_plugins" edge.
 func HasEnvPlugins() predicate.Plugin {
 	return predicate.Plugin(func(s *sql.Selector) {
@@ -736,6 +751,29 @@ func HasExecutionLogsWith(preds ...predicate.PluginExecutionLog) predicate.Plugi
 	})
 }
 
+// HasTestCases applies the HasEdge predicate on the "test_cases" edge.
+func HasTestCases() predicate.Plugin {
+	return predicate.Plugin(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TestCasesTable, TestCasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestCasesWith applies the HasEdge predicate on the "test_cases" edge with a given conditions (other predicates).
+func HasTestCasesWith(preds ...predicate.PluginTestCase) predicate.Plugin {
+	return predicate.Plugin(func(s *sql.Selector) {
+		step := newTestCasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Plugin) predicate.Plugin {
 	return predicate.Plugin(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
 )
 
 // PluginCreate is the builder for creating a Plugin entity.
@@ -160,6 +161,20 @@ func (_c *PluginCreate) SetNillablePriority(v *int32) *PluginCreate {
 	return _c
 }
 
+// SetRequireTestsPass sets the "require_tests_pass" field.
+func (_c *PluginCreate) SetRequireTestsPass(v bool) *PluginCreate {
+	_c.mutation.SetRequireTestsPass(v)
+	return _c
+}
+
+// SetNillableRequireTestsPass sets the "require_tests_pass" field if the given value is not nil.
+func (_c *PluginCreate) SetNillableRequireTestsPass(v *bool) *PluginCreate {
+	if v != nil {
+		_c.SetRequireTestsPass(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PluginCreate) SetID(v int64) *PluginCreate {
 	_c.mutation.SetID(v)
@@ -196,6 +211,21 @@ func (_c *PluginCreate) AddExecutionLogs(v ...*PluginExecutionLog) *PluginCreate
 	return _c.AddExecutionLogIDs(ids...)
 }
 
+// AddTestCaseIDs adds the "test_cases" edge to the PluginTestCase entity by IDs.
+func (_c *PluginCreate) AddTestCaseIDs(ids ...int64) *PluginCreate {
+	_c.mutation.AddTestCaseIDs(ids...)
+	return _c
+}
+
+// AddTestCases adds the "test_cases" edges to the PluginTestCase entity.
+func (_c *PluginCreate) AddTestCases(v ...*PluginTestCase) *PluginCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTestCaseIDs(ids...)
+}
+
 // Mutation returns the PluginMutation object of the builder.
 func (_c *PluginCreate) Mutation() *PluginMutation {
 	return _c.mutation
@@ -259,6 +289,10 @@ func (_c *PluginCreate) defaults() {
 		v := plugin.DefaultPriority
 		_c.mutation.SetPriority(v)
 	}
+	if _, ok := _c.mutation.RequireTestsPass(); !ok {
+		v := plugin.DefaultRequireTestsPass
+		_c.mutation.SetRequireTestsPass(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -300,6 +334,9 @@ func (_c *PluginCreate) check() error {
 	if _, ok := _c.mutation.Priority(); !ok {
 		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Plugin.priority"`)}
 	}
+	if _, ok := _c.mutation.RequireTestsPass(); !ok {
+		return &ValidationError{Name: "require_tests_pass", err: errors.New(`ent: missing required field "Plugin.require_tests_pass"`)}
+	}
 	return nil
 }
 
@@ -376,6 +413,10 @@ func (_c *PluginCreate) createSpec() (*Plugin, *sqlgraph.CreateSpec) {
 		_spec.SetField(plugin.FieldPriority, field.TypeInt32, value)
 		_node.Priority = value
 	}
+	if value, ok := _c.mutation.RequireTestsPass(); ok {
+		_spec.SetField(plugin.FieldRequireTestsPass, field.TypeBool, value)
+		_node.RequireTestsPass = value
+	}
 	if nodes := _c.mutation.EnvPluginsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -408,6 +449,22 @@ func (_c *PluginCreate) createSpec() (*Plugin, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TestCasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
 )
 
@@ -27,6 +28,7 @@ type PluginQuery struct {
 	predicates        []predicate.Plugin
 	withEnvPlugins    *EnvPluginQuery
 	withExecutionLogs *PluginExecutionLogQuery
+	withTestCases     *PluginTestCaseQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (_q *PluginQuery) QueryExecutionLogs() *PluginExecutionLogQuery {
 	return query
 }
 
+// QueryTestCases chains the current query on the "test_cases" edge.
+func (_q *PluginQuery) QueryTestCases() *PluginTestCaseQuery {
+	query := (&PluginTestCaseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plugin.Table, plugin.FieldID, selector),
+			sqlgraph.To(plugintestcase.Table, plugintestcase.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plugin.TestCasesTable, plugin.TestCasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Plugin entity from the query.
 // Returns a *NotFoundError when no Plugin was found.
 func (_q *PluginQuery) First(ctx context.Context) (*Plugin, error) {
@@ -301,6 +325,7 @@ func (_q *PluginQuery) Clone() *PluginQuery {
 		predicates:        append([]predicate.Plugin{}, _q.predicates...),
 		withEnvPlugins:    _q.withEnvPlugins.Clone(),
 		withExecutionLogs: _q.withExecutionLogs.Clone(),
+		withTestCases:     _q.withTestCases.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +354,17 @@ func (_q *PluginQuery) WithExecutionLogs(opts ...func(*PluginExecutionLogQuery))
 	return _q
 }
 
+// WithTestCases tells the query-builder to eager-load the nodes that are connected to
+// the "test_cases" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PluginQuery) WithTestCases(opts ...func(*PluginTestCaseQuery)) *PluginQuery {
+	query := (&PluginTestCaseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTestCases = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (_q *PluginQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Plugi
 	var (
 		nodes       = []*Plugin{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withEnvPlugins != nil,
 			_q.withExecutionLogs != nil,
+			_q.withTestCases != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,13 @@ func (_q *PluginQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Plugi
 			return nil, err
 		}
 	}
+	if query := _q.withTestCases; query != nil {
+		if err := _q.loadTestCases(ctx, query, nodes,
+			func(n *Plugin) { n.Edges.TestCases = []*PluginTestCase{} },
+			func(n *Plugin, e *PluginTestCase) { n.Edges.TestCases = append(n.Edges.TestCases, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +551,36 @@ func (_q *PluginQuery) loadExecutionLogs(ctx context.Context, query *PluginExecu
 	}
 	return nil
 }
+func (_q *PluginQuery) loadTestCases(ctx context.Context, query *PluginTestCaseQuery, nodes []*Plugin, init func(*Plugin), assign func(*Plugin, *PluginTestCase)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Plugin)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(plugintestcase.FieldPluginID)
+	}
+	query.Where(predicate.PluginTestCase(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(plugin.TestCasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PluginID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "plugin_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PluginQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -14,6 +14,7 @@ import (
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
 )
 
@@ -188,6 +189,20 @@ func (_u *PluginUpdate) AddPriority(v int32) *PluginUpdate {
 	return _u
 }
 
+// SetRequireTestsPass sets the "require_tests_pass" field.
+func (_u *PluginUpdate) SetRequireTestsPass(v bool) *PluginUpdate {
+	_u.mutation.SetRequireTestsPass(v)
+	return _u
+}
+
+// SetNillableRequireTestsPass sets the "require_tests_pass" field if the given value is not nil.
+func (_u *PluginUpdate) SetNillableRequireTestsPass(v *bool) *PluginUpdate {
+	if v != nil {
+		_u.SetRequireTestsPass(*v)
+	}
+	return _u
+}
+
 // AddEnvPluginIDs adds the "env_plugins" edge to the EnvPlugin entity by IDs.
 func (_u *PluginUpdate) AddEnvPluginIDs(ids ...int64) *PluginUpdate {
 	_u.mutation.AddEnvPluginIDs(ids...)
@@ -218,6 +233,21 @@ func (_u *PluginUpdate) AddExecutionLogs(v ...*PluginExecutionLog) *PluginUpdate
 	return _u.AddExecutionLogIDs(ids...)
 }
 
+// AddTestCaseIDs adds the "test_cases" edge to the PluginTestCase entity by IDs.
+func (_u *PluginUpdate) AddTestCaseIDs(ids ...int64) *PluginUpdate {
+	_u.mutation.AddTestCaseIDs(ids...)
+	return _u
+}
+
+// AddTestCases adds the "test_cases" edges to the PluginTestCase entity.
+func (_u *PluginUpdate) AddTestCases(v ...*PluginTestCase) *PluginUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTestCaseIDs(ids...)
+}
+
 // Mutation returns the PluginMutation object of the builder.
 func (_u *PluginUpdate) Mutation() *PluginMutation {
 	return _u.mutation
@@ -265,6 +295,27 @@ func (_u *PluginUpdate) RemoveExecutionLogs(v ...*PluginExecutionLog) *PluginUpd
 	return _u.RemoveExecutionLogIDs(ids...)
 }
 
+// ClearTestCases clears all "test_cases" edges to the PluginTestCase entity.
+func (_u *PluginUpdate) ClearTestCases() *PluginUpdate {
+	_u.mutation.ClearTestCases()
+	return _u
+}
+
+// RemoveTestCaseIDs removes the "test_cases" edge to PluginTestCase entities by IDs.
+func (_u *PluginUpdate) RemoveTestCaseIDs(ids ...int64) *PluginUpdate {
+	_u.mutation.RemoveTestCaseIDs(ids...)
+	return _u
+}
+
+// RemoveTestCases removes "test_cases" edges to PluginTestCase entities.
+func (_u *PluginUpdate) RemoveTestCases(v ...*PluginTestCase) *PluginUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTestCaseIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PluginUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -370,6 +421,9 @@ func (_u *PluginUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(plugin.FieldPriority, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.RequireTestsPass(); ok {
+		_spec.SetField(plugin.FieldRequireTestsPass, field.TypeBool, value)
+	}
 	if _u.mutation.EnvPluginsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -460,6 +514,51 @@ func (_u *PluginUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TestCasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTestCasesIDs(); len(nodes) > 0 && !_u.mutation.TestCasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TestCasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{plugin.Label}
@@ -638,6 +737,20 @@ func (_u *PluginUpdateOne) AddPriority(v int32) *PluginUpdateOne {
 	return _u
 }
 
+// SetRequireTestsPass sets the "require_tests_pass" field.
+func (_u *PluginUpdateOne) SetRequireTestsPass(v bool) *PluginUpdateOne {
+	_u.mutation.SetRequireTestsPass(v)
+	return _u
+}
+
+// SetNillableRequireTestsPass sets the "require_tests_pass" field if the given value is not nil.
+func (_u *PluginUpdateOne) SetNillableRequireTestsPass(v *bool) *PluginUpdateOne {
+	if v != nil {
+		_u.SetRequireTestsPass(*v)
+	}
+	return _u
+}
+
 // AddEnvPluginIDs adds the "env_plugins" edge to the EnvPlugin entity by IDs.
 func (_u *PluginUpdateOne) AddEnvPluginIDs(ids ...int64) *PluginUpdateOne {
 	_u.mutation.AddEnvPluginIDs(ids...)
@@ -668,6 +781,21 @@ func (_u *PluginUpdateOne) AddExecutionLogs(v ...*PluginExecutionLog) *PluginUpd
 	return _u.AddExecutionLogIDs(ids...)
 }
 
+// AddTestCaseIDs adds the "test_cases" edge to the PluginTestCase entity by IDs.
+func (_u *PluginUpdateOne) AddTestCaseIDs(ids ...int64) *PluginUpdateOne {
+	_u.mutation.AddTestCaseIDs(ids...)
+	return _u
+}
+
+// AddTestCases adds the "test_cases" edges to the PluginTestCase entity.
+func (_u *PluginUpdateOne) AddTestCases(v ...*PluginTestCase) *PluginUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTestCaseIDs(ids...)
+}
+
 // Mutation returns the PluginMutation object of the builder.
 func (_u *PluginUpdateOne) Mutation() *PluginMutation {
 	return _u.mutation
@@ -715,6 +843,27 @@ func (_u *PluginUpdateOne) RemoveExecutionLogs(v ...*PluginExecutionLog) *Plugin
 	return _u.RemoveExecutionLogIDs(ids...)
 }
 
+// ClearTestCases clears all "test_cases" edges to the PluginTestCase entity.
+func (_u *PluginUpdateOne) ClearTestCases() *PluginUpdateOne {
+	_u.mutation.ClearTestCases()
+	return _u
+}
+
+// RemoveTestCaseIDs removes the "test_cases" edge to PluginTestCase entities by IDs.
+func (_u *PluginUpdateOne) RemoveTestCaseIDs(ids ...int64) *PluginUpdateOne {
+	_u.mutation.RemoveTestCaseIDs(ids...)
+	return _u
+}
+
+// RemoveTestCases removes "test_cases" edges to PluginTestCase entities.
+func (_u *PluginUpdateOne) RemoveTestCases(v ...*PluginTestCase) *PluginUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTestCaseIDs(ids...)
+}
+
 // Where appends a list predicates to the PluginUpdate builder.
 func (_u *PluginUpdateOne) Where(ps ...predicate.Plugin) *PluginUpdateOne {
 	_u.mutation.Where(ps...)
@@ -850,6 +999,9 @@ func (_u *PluginUpdateOne) sqlSave(ctx context.Context) (_node *Plugin, err erro
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(plugin.FieldPriority, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.RequireTestsPass(); ok {
+		_spec.SetField(plugin.FieldRequireTestsPass, field.TypeBool, value)
+	}
 	if _u.mutation.EnvPluginsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -940,6 +1092,51 @@ func (_u *PluginUpdateOne) sqlSave(ctx context.Context) (_node *Plugin, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TestCasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTestCasesIDs(); len(nodes) > 0 && !_u.mutation.TestCasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TestCasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plugin.TestCasesTable,
+			Columns: []string{plugin.TestCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Plugin{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
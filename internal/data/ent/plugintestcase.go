@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+)
+
+// PluginTestCase is the model entity for the PluginTestCase schema.
+type PluginTestCase struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID int64 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 插件ID
+	PluginID int64 `json:"plugin_id,omitempty"`
+	// 用例名称
+	Name string `json:"name,omitempty"`
+	// 输入的变量值
+	InputValue string `json:"input_value,omitempty"`
+	// 插件配置参数
+	Config *string `json:"config,omitempty"`
+	// 期望返回的bool
+	ExpectedBool *bool `json:"expected_bool,omitempty"`
+	// 期望返回的env
+	ExpectedEnv *string `json:"expected_env,omitempty"`
+	// 期望的错误信息(包含匹配)
+	ExpectedError *string `json:"expected_error,omitempty"`
+	// 是否启用
+	IsEnable bool `json:"is_enable,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PluginTestCaseQuery when eager-loading is set.
+	Edges        PluginTestCaseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PluginTestCaseEdges holds the relations/edges for other nodes in the graph.
+type PluginTestCaseEdges struct {
+	// Plugin holds the value of the plugin edge.
+	Plugin *Plugin `json:"plugin,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PluginOrErr returns the Plugin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PluginTestCaseEdges) PluginOrErr() (*Plugin, error) {
+	if e.Plugin != nil {
+		return e.Plugin, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: plugin.Label}
+	}
+	return nil, &NotLoadedError{edge: "plugin"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PluginTestCase) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case plugintestcase.FieldExpectedBool, plugintestcase.FieldIsEnable:
+			values[i] = new(sql.NullBool)
+		case plugintestcase.FieldID, plugintestcase.FieldPluginID:
+			values[i] = new(sql.NullInt64)
+		case plugintestcase.FieldName, plugintestcase.FieldInputValue, plugintestcase.FieldConfig, plugintestcase.FieldExpectedEnv, plugintestcase.FieldExpectedError:
+			values[i] = new(sql.NullString)
+		case plugintestcase.FieldCreatedAt, plugintestcase.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PluginTestCase fields.
+func (_m *PluginTestCase) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case plugintestcase.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case plugintestcase.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case plugintestcase.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case plugintestcase.FieldPluginID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plugin_id", values[i])
+			} else if value.Valid {
+				_m.PluginID = value.Int64
+			}
+		case plugintestcase.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case plugintestcase.FieldInputValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field input_value", values[i])
+			} else if value.Valid {
+				_m.InputValue = value.String
+			}
+		case plugintestcase.FieldConfig:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field config", values[i])
+			} else if value.Valid {
+				_m.Config = new(string)
+				*_m.Config = value.String
+			}
+		case plugintestcase.FieldExpectedBool:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_bool", values[i])
+			} else if value.Valid {
+				_m.ExpectedBool = new(bool)
+				*_m.ExpectedBool = value.Bool
+			}
+		case plugintestcase.FieldExpectedEnv:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_env", values[i])
+			} else if value.Valid {
+				_m.ExpectedEnv = new(string)
+				*_m.ExpectedEnv = value.String
+			}
+		case plugintestcase.FieldExpectedError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_error", values[i])
+			} else if value.Valid {
+				_m.ExpectedError = new(string)
+				*_m.ExpectedError = value.String
+			}
+		case plugintestcase.FieldIsEnable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enable", values[i])
+			} else if value.Valid {
+				_m.IsEnable = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PluginTestCase.
+// This includes values selected through modifiers, order, etc.
+func (_m *PluginTestCase) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlugin queries the "plugin" edge of the PluginTestCase entity.
+func (_m *PluginTestCase) QueryPlugin() *PluginQuery {
+	return NewPluginTestCaseClient(_m.config).QueryPlugin(_m)
+}
+
+// Update returns a builder for updating this PluginTestCase.
+// Note that you need to call PluginTestCase.Unwrap() before calling this method if this PluginTestCase
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PluginTestCase) Update() *PluginTestCaseUpdateOne {
+	return NewPluginTestCaseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PluginTestCase entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PluginTestCase) Unwrap() *PluginTestCase {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PluginTestCase is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PluginTestCase) String() string {
+	var builder strings.Builder
+	builder.WriteString("PluginTestCase(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("plugin_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PluginID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("input_value=")
+	builder.WriteString(_m.InputValue)
+	builder.WriteString(", ")
+	if v := _m.Config; v != nil {
+		builder.WriteString("config=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpectedBool; v != nil {
+		builder.WriteString("expected_bool=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpectedEnv; v != nil {
+		builder.WriteString("expected_env=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpectedError; v != nil {
+		builder.WriteString("expected_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_enable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsEnable))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluginTestCases is a parsable slice of PluginTestCase.
+type PluginTestCases []*PluginTestCase
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plugintestcase
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the plugintestcase type in the database.
+	Label = "plugin_test_case"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPluginID holds the string denoting the plugin_id field in the database.
+	FieldPluginID = "plugin_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldInputValue holds the string denoting the input_value field in the database.
+	FieldInputValue = "input_value"
+	// FieldConfig holds the string denoting the config field in the database.
+	FieldConfig = "config"
+	// FieldExpectedBool holds the string denoting the expected_bool field in the database.
+	FieldExpectedBool = "expected_bool"
+	// FieldExpectedEnv holds the string denoting the expected_env field in the database.
+	FieldExpectedEnv = "expected_env"
+	// FieldExpectedError holds the string denoting the expected_error field in the database.
+	FieldExpectedError = "expected_error"
+	// FieldIsEnable holds the string denoting the is_enable field in the database.
+	FieldIsEnable = "is_enable"
+	// EdgePlugin holds the string denoting the plugin edge name in mutations.
+	EdgePlugin = "plugin"
+	// Table holds the table name of the plugintestcase in the database.
+	Table = "plugin_test_cases"
+	// PluginTable is the table that holds the plugin relation/edge.
+	PluginTable = "plugin_test_cases"
+	// PluginInverseTable is the table name for the Plugin entity.
+	// It exists in this package in order to avoid circular dependency with the "plugin" package.
+	PluginInverseTable = "plugins"
+	// PluginColumn is the table column denoting the plugin relation/edge.
+	PluginColumn = "plugin_id"
+)
+
+// Columns holds all SQL columns for plugintestcase fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPluginID,
+	FieldName,
+	FieldInputValue,
+	FieldConfig,
+	FieldExpectedBool,
+	FieldExpectedEnv,
+	FieldExpectedError,
+	FieldIsEnable,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultIsEnable holds the default value on creation for the "is_enable" field.
+	DefaultIsEnable bool
+)
+
+// OrderOption defines the ordering options for the PluginTestCase queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPluginID orders the results by the plugin_id field.
+func ByPluginID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPluginID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByInputValue orders the results by the input_value field.
+func ByInputValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputValue, opts...).ToFunc()
+}
+
+// ByConfig orders the results by the config field.
+func ByConfig(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfig, opts...).ToFunc()
+}
+
+// ByExpectedBool orders the results by the expected_bool field.
+func ByExpectedBool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedBool, opts...).ToFunc()
+}
+
+// ByExpectedEnv orders the results by the expected_env field.
+func ByExpectedEnv(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedEnv, opts...).ToFunc()
+}
+
+// ByExpectedError orders the results by the expected_error field.
+func ByExpectedError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedError, opts...).ToFunc()
+}
+
+// ByIsEnable orders the results by the is_enable field.
+func ByIsEnable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEnable, opts...).ToFunc()
+}
+
+// ByPluginField orders the results by plugin field.
+func ByPluginField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPluginStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPluginStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PluginInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PluginTable, PluginColumn),
+	)
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plugintestcase
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PluginID applies equality check predicate on the "plugin_id" field. It's identical to PluginIDEQ.
+func PluginID(v int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldPluginID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldName, v))
+}
+
+// InputValue applies equality check predicate on the "input_value" field. It's identical to InputValueEQ.
+func InputValue(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldInputValue, v))
+}
+
+// Config applies equality check predicate on the "config" field. It's identical to ConfigEQ.
+func Config(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldConfig, v))
+}
+
+// ExpectedBool applies equality check predicate on the "expected_bool" field. It's identical to ExpectedBoolEQ.
+func ExpectedBool(v bool) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldExpectedBool, v))
+}
+
+// ExpectedEnv applies equality check predicate on the "expected_env" field. It's identical to ExpectedEnvEQ.
+func ExpectedEnv(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldExpectedEnv, v))
+}
+
+// ExpectedError applies equality check predicate on the "expected_error" field. It's identical to ExpectedErrorEQ.
+func ExpectedError(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldExpectedError, v))
+}
+
+// IsEnable applies equality check predicate on the "is_enable" field. It's identical to IsEnableEQ.
+func IsEnable(v bool) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldIsEnable, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PluginIDEQ applies the EQ predicate on the "plugin_id" field.
+func PluginIDEQ(v int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldPluginID, v))
+}
+
+// PluginIDNEQ applies the NEQ predicate on the "plugin_id" field.
+func PluginIDNEQ(v int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldPluginID, v))
+}
+
+// PluginIDIn applies the In predicate on the "plugin_id" field.
+func PluginIDIn(vs ...int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldPluginID, vs...))
+}
+
+// PluginIDNotIn applies the NotIn predicate on the "plugin_id" field.
+func PluginIDNotIn(vs ...int64) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldPluginID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContainsFold(FieldName, v))
+}
+
+// InputValueEQ applies the EQ predicate on the "input_value" field.
+func InputValueEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldInputValue, v))
+}
+
+// InputValueNEQ applies the NEQ predicate on the "input_value" field.
+func InputValueNEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldInputValue, v))
+}
+
+// InputValueIn applies the In predicate on the "input_value" field.
+func InputValueIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldInputValue, vs...))
+}
+
+// InputValueNotIn applies the NotIn predicate on the "input_value" field.
+func InputValueNotIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldInputValue, vs...))
+}
+
+// InputValueGT applies the GT predicate on the "input_value" field.
+func InputValueGT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldInputValue, v))
+}
+
+// InputValueGTE applies the GTE predicate on the "input_value" field.
+func InputValueGTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldInputValue, v))
+}
+
+// InputValueLT applies the LT predicate on the "input_value" field.
+func InputValueLT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldInputValue, v))
+}
+
+// InputValueLTE applies the LTE predicate on the "input_value" field.
+func InputValueLTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldInputValue, v))
+}
+
+// InputValueContains applies the Contains predicate on the "input_value" field.
+func InputValueContains(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContains(FieldInputValue, v))
+}
+
+// InputValueHasPrefix applies the HasPrefix predicate on the "input_value" field.
+func InputValueHasPrefix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasPrefix(FieldInputValue, v))
+}
+
+// InputValueHasSuffix applies the HasSuffix predicate on the "input_value" field.
+func InputValueHasSuffix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasSuffix(FieldInputValue, v))
+}
+
+// InputValueEqualFold applies the EqualFold predicate on the "input_value" field.
+func InputValueEqualFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEqualFold(FieldInputValue, v))
+}
+
+// InputValueContainsFold applies the ContainsFold predicate on the "input_value" field.
+func InputValueContainsFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContainsFold(FieldInputValue, v))
+}
+
+// ConfigEQ applies the EQ predicate on the "config" field.
+func ConfigEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldConfig, v))
+}
+
+// ConfigNEQ applies the NEQ predicate on the "config" field.
+func ConfigNEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldConfig, v))
+}
+
+// ConfigIn applies the In predicate on the "config" field.
+func ConfigIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldConfig, vs...))
+}
+
+// ConfigNotIn applies the NotIn predicate on the "config" field.
+func ConfigNotIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldConfig, vs...))
+}
+
+// ConfigGT applies the GT predicate on the "config" field.
+func ConfigGT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldConfig, v))
+}
+
+// ConfigGTE applies the GTE predicate on the "config" field.
+func ConfigGTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldConfig, v))
+}
+
+// ConfigLT applies the LT predicate on the "config" field.
+func ConfigLT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldConfig, v))
+}
+
+// ConfigLTE applies the LTE predicate on the "config" field.
+func ConfigLTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldConfig, v))
+}
+
+// ConfigContains applies the Contains predicate on the "config" field.
+func ConfigContains(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContains(FieldConfig, v))
+}
+
+// ConfigHasPrefix applies the HasPrefix predicate on the "config" field.
+func ConfigHasPrefix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasPrefix(FieldConfig, v))
+}
+
+// ConfigHasSuffix applies the HasSuffix predicate on the "config" field.
+func ConfigHasSuffix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasSuffix(FieldConfig, v))
+}
+
+// ConfigIsNil applies the IsNil predicate on the "config" field.
+func ConfigIsNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIsNull(FieldConfig))
+}
+
+// ConfigNotNil applies the NotNil predicate on the "config" field.
+func ConfigNotNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotNull(FieldConfig))
+}
+
+// ConfigEqualFold applies the EqualFold predicate on the "config" field.
+func ConfigEqualFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEqualFold(FieldConfig, v))
+}
+
+// ConfigContainsFold applies the ContainsFold predicate on the "config" field.
+func ConfigContainsFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContainsFold(FieldConfig, v))
+}
+
+// ExpectedBoolEQ applies the EQ predicate on the "expected_bool" field.
+func ExpectedBoolEQ(v bool) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldExpectedBool, v))
+}
+
+// ExpectedBoolNEQ applies the NEQ predicate on the "expected_bool" field.
+func ExpectedBoolNEQ(v bool) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldExpectedBool, v))
+}
+
+// ExpectedBoolIsNil applies the IsNil predicate on the "expected_bool" field.
+func ExpectedBoolIsNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIsNull(FieldExpectedBool))
+}
+
+// ExpectedBoolNotNil applies the NotNil predicate on the "expected_bool" field.
+func ExpectedBoolNotNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotNull(FieldExpectedBool))
+}
+
+// ExpectedEnvEQ applies the EQ predicate on the "expected_env" field.
+func ExpectedEnvEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvNEQ applies the NEQ predicate on the "expected_env" field.
+func ExpectedEnvNEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvIn applies the In predicate on the "expected_env" field.
+func ExpectedEnvIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldExpectedEnv, vs...))
+}
+
+// ExpectedEnvNotIn applies the NotIn predicate on the "expected_env" field.
+func ExpectedEnvNotIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldExpectedEnv, vs...))
+}
+
+// ExpectedEnvGT applies the GT predicate on the "expected_env" field.
+func ExpectedEnvGT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvGTE applies the GTE predicate on the "expected_env" field.
+func ExpectedEnvGTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvLT applies the LT predicate on the "expected_env" field.
+func ExpectedEnvLT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvLTE applies the LTE predicate on the "expected_env" field.
+func ExpectedEnvLTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvContains applies the Contains predicate on the "expected_env" field.
+func ExpectedEnvContains(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContains(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvHasPrefix applies the HasPrefix predicate on the "expected_env" field.
+func ExpectedEnvHasPrefix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasPrefix(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvHasSuffix applies the HasSuffix predicate on the "expected_env" field.
+func ExpectedEnvHasSuffix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasSuffix(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvIsNil applies the IsNil predicate on the "expected_env" field.
+func ExpectedEnvIsNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIsNull(FieldExpectedEnv))
+}
+
+// ExpectedEnvNotNil applies the NotNil predicate on the "expected_env" field.
+func ExpectedEnvNotNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotNull(FieldExpectedEnv))
+}
+
+// ExpectedEnvEqualFold applies the EqualFold predicate on the "expected_env" field.
+func ExpectedEnvEqualFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEqualFold(FieldExpectedEnv, v))
+}
+
+// ExpectedEnvContainsFold applies the ContainsFold predicate on the "expected_env" field.
+func ExpectedEnvContainsFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContainsFold(FieldExpectedEnv, v))
+}
+
+// ExpectedErrorEQ applies the EQ predicate on the "expected_error" field.
+func ExpectedErrorEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldExpectedError, v))
+}
+
+// ExpectedErrorNEQ applies the NEQ predicate on the "expected_error" field.
+func ExpectedErrorNEQ(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldExpectedError, v))
+}
+
+// ExpectedErrorIn applies the In predicate on the "expected_error" field.
+func ExpectedErrorIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIn(FieldExpectedError, vs...))
+}
+
+// ExpectedErrorNotIn applies the NotIn predicate on the "expected_error" field.
+func ExpectedErrorNotIn(vs ...string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotIn(FieldExpectedError, vs...))
+}
+
+// ExpectedErrorGT applies the GT predicate on the "expected_error" field.
+func ExpectedErrorGT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGT(FieldExpectedError, v))
+}
+
+// ExpectedErrorGTE applies the GTE predicate on the "expected_error" field.
+func ExpectedErrorGTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldGTE(FieldExpectedError, v))
+}
+
+// ExpectedErrorLT applies the LT predicate on the "expected_error" field.
+func ExpectedErrorLT(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLT(FieldExpectedError, v))
+}
+
+// ExpectedErrorLTE applies the LTE predicate on the "expected_error" field.
+func ExpectedErrorLTE(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldLTE(FieldExpectedError, v))
+}
+
+// ExpectedErrorContains applies the Contains predicate on the "expected_error" field.
+func ExpectedErrorContains(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContains(FieldExpectedError, v))
+}
+
+// ExpectedErrorHasPrefix applies the HasPrefix predicate on the "expected_error" field.
+func ExpectedErrorHasPrefix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasPrefix(FieldExpectedError, v))
+}
+
+// ExpectedErrorHasSuffix applies the HasSuffix predicate on the "expected_error" field.
+func ExpectedErrorHasSuffix(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldHasSuffix(FieldExpectedError, v))
+}
+
+// ExpectedErrorIsNil applies the IsNil predicate on the "expected_error" field.
+func ExpectedErrorIsNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldIsNull(FieldExpectedError))
+}
+
+// ExpectedErrorNotNil applies the NotNil predicate on the "expected_error" field.
+func ExpectedErrorNotNil() predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNotNull(FieldExpectedError))
+}
+
+// ExpectedErrorEqualFold applies the EqualFold predicate on the "expected_error" field.
+func ExpectedErrorEqualFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEqualFold(FieldExpectedError, v))
+}
+
+// ExpectedErrorContainsFold applies the ContainsFold predicate on the "expected_error" field.
+func ExpectedErrorContainsFold(v string) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldContainsFold(FieldExpectedError, v))
+}
+
+// IsEnableEQ applies the EQ predicate on the "is_enable" field.
+func IsEnableEQ(v bool) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldEQ(FieldIsEnable, v))
+}
+
+// IsEnableNEQ applies the NEQ predicate on the "is_enable" field.
+func IsEnableNEQ(v bool) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.FieldNEQ(FieldIsEnable, v))
+}
+
+// HasPlugin applies the HasEdge predicate on the "plugin" edge.
+func HasPlugin() predicate.PluginTestCase {
+	return predicate.PluginTestCase(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PluginTable, PluginColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPluginWith applies the HasEdge predicate on the "plugin" edge with a given conditions (other predicates).
+func HasPluginWith(preds ...predicate.Plugin) predicate.PluginTestCase {
+	return predicate.PluginTestCase(func(s *sql.Selector) {
+		step := newPluginStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PluginTestCase) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PluginTestCase) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PluginTestCase) predicate.PluginTestCase {
+	return predicate.PluginTestCase(sql.NotPredicates(p))
+}
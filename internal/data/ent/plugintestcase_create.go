@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+)
+
+// PluginTestCaseCreate is the builder for creating a PluginTestCase entity.
+type PluginTestCaseCreate struct {
+	config
+	mutation *PluginTestCaseMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PluginTestCaseCreate) SetCreatedAt(v time.Time) *PluginTestCaseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableCreatedAt(v *time.Time) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PluginTestCaseCreate) SetUpdatedAt(v time.Time) *PluginTestCaseCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableUpdatedAt(v *time.Time) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPluginID sets the "plugin_id" field.
+func (_c *PluginTestCaseCreate) SetPluginID(v int64) *PluginTestCaseCreate {
+	_c.mutation.SetPluginID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PluginTestCaseCreate) SetName(v string) *PluginTestCaseCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetInputValue sets the "input_value" field.
+func (_c *PluginTestCaseCreate) SetInputValue(v string) *PluginTestCaseCreate {
+	_c.mutation.SetInputValue(v)
+	return _c
+}
+
+// SetConfig sets the "config" field.
+func (_c *PluginTestCaseCreate) SetConfig(v string) *PluginTestCaseCreate {
+	_c.mutation.SetConfig(v)
+	return _c
+}
+
+// SetNillableConfig sets the "config" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableConfig(v *string) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetConfig(*v)
+	}
+	return _c
+}
+
+// SetExpectedBool sets the "expected_bool" field.
+func (_c *PluginTestCaseCreate) SetExpectedBool(v bool) *PluginTestCaseCreate {
+	_c.mutation.SetExpectedBool(v)
+	return _c
+}
+
+// SetNillableExpectedBool sets the "expected_bool" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableExpectedBool(v *bool) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetExpectedBool(*v)
+	}
+	return _c
+}
+
+// SetExpectedEnv sets the "expected_env" field.
+func (_c *PluginTestCaseCreate) SetExpectedEnv(v string) *PluginTestCaseCreate {
+	_c.mutation.SetExpectedEnv(v)
+	return _c
+}
+
+// SetNillableExpectedEnv sets the "expected_env" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableExpectedEnv(v *string) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetExpectedEnv(*v)
+	}
+	return _c
+}
+
+// SetExpectedError sets the "expected_error" field.
+func (_c *PluginTestCaseCreate) SetExpectedError(v string) *PluginTestCaseCreate {
+	_c.mutation.SetExpectedError(v)
+	return _c
+}
+
+// SetNillableExpectedError sets the "expected_error" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableExpectedError(v *string) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetExpectedError(*v)
+	}
+	return _c
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_c *PluginTestCaseCreate) SetIsEnable(v bool) *PluginTestCaseCreate {
+	_c.mutation.SetIsEnable(v)
+	return _c
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_c *PluginTestCaseCreate) SetNillableIsEnable(v *bool) *PluginTestCaseCreate {
+	if v != nil {
+		_c.SetIsEnable(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PluginTestCaseCreate) SetID(v int64) *PluginTestCaseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPlugin sets the "plugin" edge to the Plugin entity.
+func (_c *PluginTestCaseCreate) SetPlugin(v *Plugin) *PluginTestCaseCreate {
+	return _c.SetPluginID(v.ID)
+}
+
+// Mutation returns the PluginTestCaseMutation object of the builder.
+func (_c *PluginTestCaseCreate) Mutation() *PluginTestCaseMutation {
+	return _c.mutation
+}
+
+// Save creates the PluginTestCase in the database.
+func (_c *PluginTestCaseCreate) Save(ctx context.Context) (*PluginTestCase, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PluginTestCaseCreate) SaveX(ctx context.Context) *PluginTestCase {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PluginTestCaseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PluginTestCaseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PluginTestCaseCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := plugintestcase.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := plugintestcase.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.IsEnable(); !ok {
+		v := plugintestcase.DefaultIsEnable
+		_c.mutation.SetIsEnable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PluginTestCaseCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PluginTestCase.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PluginTestCase.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PluginID(); !ok {
+		return &ValidationError{Name: "plugin_id", err: errors.New(`ent: missing required field "PluginTestCase.plugin_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PluginTestCase.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := plugintestcase.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PluginTestCase.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InputValue(); !ok {
+		return &ValidationError{Name: "input_value", err: errors.New(`ent: missing required field "PluginTestCase.input_value"`)}
+	}
+	if _, ok := _c.mutation.IsEnable(); !ok {
+		return &ValidationError{Name: "is_enable", err: errors.New(`ent: missing required field "PluginTestCase.is_enable"`)}
+	}
+	if len(_c.mutation.PluginIDs()) == 0 {
+		return &ValidationError{Name: "plugin", err: errors.New(`ent: missing required edge "PluginTestCase.plugin"`)}
+	}
+	return nil
+}
+
+func (_c *PluginTestCaseCreate) sqlSave(ctx context.Context) (*PluginTestCase, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PluginTestCaseCreate) createSpec() (*PluginTestCase, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PluginTestCase{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(plugintestcase.Table, sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(plugintestcase.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(plugintestcase.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(plugintestcase.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.InputValue(); ok {
+		_spec.SetField(plugintestcase.FieldInputValue, field.TypeString, value)
+		_node.InputValue = value
+	}
+	if value, ok := _c.mutation.Config(); ok {
+		_spec.SetField(plugintestcase.FieldConfig, field.TypeString, value)
+		_node.Config = &value
+	}
+	if value, ok := _c.mutation.ExpectedBool(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedBool, field.TypeBool, value)
+		_node.ExpectedBool = &value
+	}
+	if value, ok := _c.mutation.ExpectedEnv(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedEnv, field.TypeString, value)
+		_node.ExpectedEnv = &value
+	}
+	if value, ok := _c.mutation.ExpectedError(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedError, field.TypeString, value)
+		_node.ExpectedError = &value
+	}
+	if value, ok := _c.mutation.IsEnable(); ok {
+		_spec.SetField(plugintestcase.FieldIsEnable, field.TypeBool, value)
+		_node.IsEnable = value
+	}
+	if nodes := _c.mutation.PluginIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   plugintestcase.PluginTable,
+			Columns: []string{plugintestcase.PluginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugin.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PluginID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PluginTestCaseCreateBulk is the builder for creating many PluginTestCase entities in bulk.
+type PluginTestCaseCreateBulk struct {
+	config
+	err      error
+	builders []*PluginTestCaseCreate
+}
+
+// Save creates the PluginTestCase entities in the database.
+func (_c *PluginTestCaseCreateBulk) Save(ctx context.Context) ([]*PluginTestCase, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PluginTestCase, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PluginTestCaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PluginTestCaseCreateBulk) SaveX(ctx context.Context) []*PluginTestCase {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PluginTestCaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PluginTestCaseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
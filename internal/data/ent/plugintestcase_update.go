@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+)
+
+// PluginTestCaseUpdate is the builder for updating PluginTestCase entities.
+type PluginTestCaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PluginTestCaseMutation
+}
+
+// Where appends a list predicates to the PluginTestCaseUpdate builder.
+func (_u *PluginTestCaseUpdate) Where(ps ...predicate.PluginTestCase) *PluginTestCaseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PluginTestCaseUpdate) SetUpdatedAt(v time.Time) *PluginTestCaseUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPluginID sets the "plugin_id" field.
+func (_u *PluginTestCaseUpdate) SetPluginID(v int64) *PluginTestCaseUpdate {
+	_u.mutation.SetPluginID(v)
+	return _u
+}
+
+// SetNillablePluginID sets the "plugin_id" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillablePluginID(v *int64) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetPluginID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PluginTestCaseUpdate) SetName(v string) *PluginTestCaseUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableName(v *string) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetInputValue sets the "input_value" field.
+func (_u *PluginTestCaseUpdate) SetInputValue(v string) *PluginTestCaseUpdate {
+	_u.mutation.SetInputValue(v)
+	return _u
+}
+
+// SetNillableInputValue sets the "input_value" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableInputValue(v *string) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetInputValue(*v)
+	}
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *PluginTestCaseUpdate) SetConfig(v string) *PluginTestCaseUpdate {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// SetNillableConfig sets the "config" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableConfig(v *string) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetConfig(*v)
+	}
+	return _u
+}
+
+// ClearConfig clears the value of the "config" field.
+func (_u *PluginTestCaseUpdate) ClearConfig() *PluginTestCaseUpdate {
+	_u.mutation.ClearConfig()
+	return _u
+}
+
+// SetExpectedBool sets the "expected_bool" field.
+func (_u *PluginTestCaseUpdate) SetExpectedBool(v bool) *PluginTestCaseUpdate {
+	_u.mutation.SetExpectedBool(v)
+	return _u
+}
+
+// SetNillableExpectedBool sets the "expected_bool" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableExpectedBool(v *bool) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetExpectedBool(*v)
+	}
+	return _u
+}
+
+// ClearExpectedBool clears the value of the "expected_bool" field.
+func (_u *PluginTestCaseUpdate) ClearExpectedBool() *PluginTestCaseUpdate {
+	_u.mutation.ClearExpectedBool()
+	return _u
+}
+
+// SetExpectedEnv sets the "expected_env" field.
+func (_u *PluginTestCaseUpdate) SetExpectedEnv(v string) *PluginTestCaseUpdate {
+	_u.mutation.SetExpectedEnv(v)
+	return _u
+}
+
+// SetNillableExpectedEnv sets the "expected_env" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableExpectedEnv(v *string) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetExpectedEnv(*v)
+	}
+	return _u
+}
+
+// ClearExpectedEnv clears the value of the "expected_env" field.
+func (_u *PluginTestCaseUpdate) ClearExpectedEnv() *PluginTestCaseUpdate {
+	_u.mutation.ClearExpectedEnv()
+	return _u
+}
+
+// SetExpectedError sets the "expected_error" field.
+func (_u *PluginTestCaseUpdate) SetExpectedError(v string) *PluginTestCaseUpdate {
+	_u.mutation.SetExpectedError(v)
+	return _u
+}
+
+// SetNillableExpectedError sets the "expected_error" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableExpectedError(v *string) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetExpectedError(*v)
+	}
+	return _u
+}
+
+// ClearExpectedError clears the value of the "expected_error" field.
+func (_u *PluginTestCaseUpdate) ClearExpectedError() *PluginTestCaseUpdate {
+	_u.mutation.ClearExpectedError()
+	return _u
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_u *PluginTestCaseUpdate) SetIsEnable(v bool) *PluginTestCaseUpdate {
+	_u.mutation.SetIsEnable(v)
+	return _u
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_u *PluginTestCaseUpdate) SetNillableIsEnable(v *bool) *PluginTestCaseUpdate {
+	if v != nil {
+		_u.SetIsEnable(*v)
+	}
+	return _u
+}
+
+// SetPlugin sets the "plugin" edge to the Plugin entity.
+func (_u *PluginTestCaseUpdate) SetPlugin(v *Plugin) *PluginTestCaseUpdate {
+	return _u.SetPluginID(v.ID)
+}
+
+// Mutation returns the PluginTestCaseMutation object of the builder.
+func (_u *PluginTestCaseUpdate) Mutation() *PluginTestCaseMutation {
+	return _u.mutation
+}
+
+// ClearPlugin clears the "plugin" edge to the Plugin entity.
+func (_u *PluginTestCaseUpdate) ClearPlugin() *PluginTestCaseUpdate {
+	_u.mutation.ClearPlugin()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PluginTestCaseUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PluginTestCaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PluginTestCaseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PluginTestCaseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PluginTestCaseUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := plugintestcase.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PluginTestCaseUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := plugintestcase.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PluginTestCase.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PluginCleared() && len(_u.mutation.PluginIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PluginTestCase.plugin"`)
+	}
+	return nil
+}
+
+func (_u *PluginTestCaseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plugintestcase.Table, plugintestcase.Columns, sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plugintestcase.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plugintestcase.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputValue(); ok {
+		_spec.SetField(plugintestcase.FieldInputValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(plugintestcase.FieldConfig, field.TypeString, value)
+	}
+	if _u.mutation.ConfigCleared() {
+		_spec.ClearField(plugintestcase.FieldConfig, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedBool(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedBool, field.TypeBool, value)
+	}
+	if _u.mutation.ExpectedBoolCleared() {
+		_spec.ClearField(plugintestcase.FieldExpectedBool, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ExpectedEnv(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedEnv, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedEnvCleared() {
+		_spec.ClearField(plugintestcase.FieldExpectedEnv, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedError(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedError, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedErrorCleared() {
+		_spec.ClearField(plugintestcase.FieldExpectedError, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEnable(); ok {
+		_spec.SetField(plugintestcase.FieldIsEnable, field.TypeBool, value)
+	}
+	if _u.mutation.PluginCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   plugintestcase.PluginTable,
+			Columns: []string{plugintestcase.PluginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugin.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PluginIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   plugintestcase.PluginTable,
+			Columns: []string{plugintestcase.PluginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugin.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plugintestcase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PluginTestCaseUpdateOne is the builder for updating a single PluginTestCase entity.
+type PluginTestCaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PluginTestCaseMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PluginTestCaseUpdateOne) SetUpdatedAt(v time.Time) *PluginTestCaseUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPluginID sets the "plugin_id" field.
+func (_u *PluginTestCaseUpdateOne) SetPluginID(v int64) *PluginTestCaseUpdateOne {
+	_u.mutation.SetPluginID(v)
+	return _u
+}
+
+// SetNillablePluginID sets the "plugin_id" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillablePluginID(v *int64) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetPluginID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PluginTestCaseUpdateOne) SetName(v string) *PluginTestCaseUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableName(v *string) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetInputValue sets the "input_value" field.
+func (_u *PluginTestCaseUpdateOne) SetInputValue(v string) *PluginTestCaseUpdateOne {
+	_u.mutation.SetInputValue(v)
+	return _u
+}
+
+// SetNillableInputValue sets the "input_value" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableInputValue(v *string) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetInputValue(*v)
+	}
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *PluginTestCaseUpdateOne) SetConfig(v string) *PluginTestCaseUpdateOne {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// SetNillableConfig sets the "config" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableConfig(v *string) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetConfig(*v)
+	}
+	return _u
+}
+
+// ClearConfig clears the value of the "config" field.
+func (_u *PluginTestCaseUpdateOne) ClearConfig() *PluginTestCaseUpdateOne {
+	_u.mutation.ClearConfig()
+	return _u
+}
+
+// SetExpectedBool sets the "expected_bool" field.
+func (_u *PluginTestCaseUpdateOne) SetExpectedBool(v bool) *PluginTestCaseUpdateOne {
+	_u.mutation.SetExpectedBool(v)
+	return _u
+}
+
+// SetNillableExpectedBool sets the "expected_bool" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableExpectedBool(v *bool) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetExpectedBool(*v)
+	}
+	return _u
+}
+
+// ClearExpectedBool clears the value of the "expected_bool" field.
+func (_u *PluginTestCaseUpdateOne) ClearExpectedBool() *PluginTestCaseUpdateOne {
+	_u.mutation.ClearExpectedBool()
+	return _u
+}
+
+// SetExpectedEnv sets the "expected_env" field.
+func (_u *PluginTestCaseUpdateOne) SetExpectedEnv(v string) *PluginTestCaseUpdateOne {
+	_u.mutation.SetExpectedEnv(v)
+	return _u
+}
+
+// SetNillableExpectedEnv sets the "expected_env" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableExpectedEnv(v *string) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetExpectedEnv(*v)
+	}
+	return _u
+}
+
+// ClearExpectedEnv clears the value of the "expected_env" field.
+func (_u *PluginTestCaseUpdateOne) ClearExpectedEnv() *PluginTestCaseUpdateOne {
+	_u.mutation.ClearExpectedEnv()
+	return _u
+}
+
+// SetExpectedError sets the "expected_error" field.
+func (_u *PluginTestCaseUpdateOne) SetExpectedError(v string) *PluginTestCaseUpdateOne {
+	_u.mutation.SetExpectedError(v)
+	return _u
+}
+
+// SetNillableExpectedError sets the "expected_error" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableExpectedError(v *string) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetExpectedError(*v)
+	}
+	return _u
+}
+
+// ClearExpectedError clears the value of the "expected_error" field.
+func (_u *PluginTestCaseUpdateOne) ClearExpectedError() *PluginTestCaseUpdateOne {
+	_u.mutation.ClearExpectedError()
+	return _u
+}
+
+// SetIsEnable sets the "is_enable" field.
+func (_u *PluginTestCaseUpdateOne) SetIsEnable(v bool) *PluginTestCaseUpdateOne {
+	_u.mutation.SetIsEnable(v)
+	return _u
+}
+
+// SetNillableIsEnable sets the "is_enable" field if the given value is not nil.
+func (_u *PluginTestCaseUpdateOne) SetNillableIsEnable(v *bool) *PluginTestCaseUpdateOne {
+	if v != nil {
+		_u.SetIsEnable(*v)
+	}
+	return _u
+}
+
+// SetPlugin sets the "plugin" edge to the Plugin entity.
+func (_u *PluginTestCaseUpdateOne) SetPlugin(v *Plugin) *PluginTestCaseUpdateOne {
+	return _u.SetPluginID(v.ID)
+}
+
+// Mutation returns the PluginTestCaseMutation object of the builder.
+func (_u *PluginTestCaseUpdateOne) Mutation() *PluginTestCaseMutation {
+	return _u.mutation
+}
+
+// ClearPlugin clears the "plugin" edge to the Plugin entity.
+func (_u *PluginTestCaseUpdateOne) ClearPlugin() *PluginTestCaseUpdateOne {
+	_u.mutation.ClearPlugin()
+	return _u
+}
+
+// Where appends a list predicates to the PluginTestCaseUpdate builder.
+func (_u *PluginTestCaseUpdateOne) Where(ps ...predicate.PluginTestCase) *PluginTestCaseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PluginTestCaseUpdateOne) Select(field string, fields ...string) *PluginTestCaseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PluginTestCase entity.
+func (_u *PluginTestCaseUpdateOne) Save(ctx context.Context) (*PluginTestCase, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PluginTestCaseUpdateOne) SaveX(ctx context.Context) *PluginTestCase {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PluginTestCaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PluginTestCaseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PluginTestCaseUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := plugintestcase.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PluginTestCaseUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := plugintestcase.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PluginTestCase.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PluginCleared() && len(_u.mutation.PluginIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PluginTestCase.plugin"`)
+	}
+	return nil
+}
+
+func (_u *PluginTestCaseUpdateOne) sqlSave(ctx context.Context) (_node *PluginTestCase, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plugintestcase.Table, plugintestcase.Columns, sqlgraph.NewFieldSpec(plugintestcase.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PluginTestCase.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plugintestcase.FieldID)
+		for _, f := range fields {
+			if !plugintestcase.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != plugintestcase.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plugintestcase.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plugintestcase.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputValue(); ok {
+		_spec.SetField(plugintestcase.FieldInputValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(plugintestcase.FieldConfig, field.TypeString, value)
+	}
+	if _u.mutation.ConfigCleared() {
+		_spec.ClearField(plugintestcase.FieldConfig, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedBool(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedBool, field.TypeBool, value)
+	}
+	if _u.mutation.ExpectedBoolCleared() {
+		_spec.ClearField(plugintestcase.FieldExpectedBool, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ExpectedEnv(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedEnv, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedEnvCleared() {
+		_spec.ClearField(plugintestcase.FieldExpectedEnv, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpectedError(); ok {
+		_spec.SetField(plugintestcase.FieldExpectedError, field.TypeString, value)
+	}
+	if _u.mutation.ExpectedErrorCleared() {
+		_spec.ClearField(plugintestcase.FieldExpectedError, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEnable(); ok {
+		_spec.SetField(plugintestcase.FieldIsEnable, field.TypeBool, value)
+	}
+	if _u.mutation.PluginCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   plugintestcase.PluginTable,
+			Columns: []string{plugintestcase.PluginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugin.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PluginIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   plugintestcase.PluginTable,
+			Columns: []string{plugintestcase.PluginColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plugin.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PluginTestCase{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plugintestcase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
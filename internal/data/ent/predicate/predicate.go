@@ -9,23 +9,50 @@ import (
 // CdKey is the predicate function for cdkey builders.
 type CdKey func(*sql.Selector)
 
+// CdkLedger is the predicate function for cdkledger builders.
+type CdkLedger func(*sql.Selector)
+
+// CronTriggerLog is the predicate function for crontriggerlog builders.
+type CronTriggerLog func(*sql.Selector)
+
 // Env is the predicate function for env builders.
 type Env func(*sql.Selector)
 
+// EnvCronTrigger is the predicate function for envcrontrigger builders.
+type EnvCronTrigger func(*sql.Selector)
+
+// EnvPanel is the predicate function for envpanel builders.
+type EnvPanel func(*sql.Selector)
+
 // EnvPlugin is the predicate function for envplugin builders.
 type EnvPlugin func(*sql.Selector)
 
+// EnvReplica is the predicate function for envreplica builders.
+type EnvReplica func(*sql.Selector)
+
+// IdempotencyRecord is the predicate function for idempotencyrecord builders.
+type IdempotencyRecord func(*sql.Selector)
+
 // LoginHistory is the predicate function for loginhistory builders.
 type LoginHistory func(*sql.Selector)
 
 // Panel is the predicate function for panel builders.
 type Panel func(*sql.Selector)
 
+// PanelHealth is the predicate function for panelhealth builders.
+type PanelHealth func(*sql.Selector)
+
 // Plugin is the predicate function for plugin builders.
 type Plugin func(*sql.Selector)
 
 // PluginExecutionLog is the predicate function for pluginexecutionlog builders.
 type PluginExecutionLog func(*sql.Selector)
 
+// PluginTestCase is the predicate function for plugintestcase builders.
+type PluginTestCase func(*sql.Selector)
+
+// ResourceLock is the predicate function for resourcelock builders.
+type ResourceLock func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -6,12 +6,21 @@ import (
 	"time"
 
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkey"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/idempotencyrecord"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/loginhistory"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/resourcelock"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/schema"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/user"
 )
@@ -40,6 +49,22 @@ func init() {
 	cdkeyDescIsEnable := cdkeyFields[5].Descriptor()
 	// cdkey.DefaultIsEnable holds the default value on creation for the is_enable field.
 	cdkey.DefaultIsEnable = cdkeyDescIsEnable.Default.(bool)
+	cdkledgerFields := schema.CdkLedger{}.Fields()
+	_ = cdkledgerFields
+	// cdkledgerDescCreatedAt is the schema descriptor for created_at field.
+	cdkledgerDescCreatedAt := cdkledgerFields[1].Descriptor()
+	// cdkledger.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cdkledger.DefaultCreatedAt = cdkledgerDescCreatedAt.Default.(func() time.Time)
+	// cdkledgerDescSettled is the schema descriptor for settled field.
+	cdkledgerDescSettled := cdkledgerFields[8].Descriptor()
+	// cdkledger.DefaultSettled holds the default value on creation for the settled field.
+	cdkledger.DefaultSettled = cdkledgerDescSettled.Default.(bool)
+	crontriggerlogFields := schema.CronTriggerLog{}.Fields()
+	_ = crontriggerlogFields
+	// crontriggerlogDescCreatedAt is the schema descriptor for created_at field.
+	crontriggerlogDescCreatedAt := crontriggerlogFields[1].Descriptor()
+	// crontriggerlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	crontriggerlog.DefaultCreatedAt = crontriggerlogDescCreatedAt.Default.(func() time.Time)
 	envFields := schema.Env{}.Fields()
 	_ = envFields
 	// envDescCreatedAt is the schema descriptor for created_at field.
@@ -64,6 +89,60 @@ func init() {
 	envDescCdkLimit := envFields[11].Descriptor()
 	// env.DefaultCdkLimit holds the default value on creation for the cdk_limit field.
 	env.DefaultCdkLimit = envDescCdkLimit.Default.(int32)
+	// envDescSelectStrategy is the schema descriptor for select_strategy field.
+	envDescSelectStrategy := envFields[16].Descriptor()
+	// env.DefaultSelectStrategy holds the default value on creation for the select_strategy field.
+	env.DefaultSelectStrategy = envDescSelectStrategy.Default.(string)
+	// envDescReplicas is the schema descriptor for replicas field.
+	envDescReplicas := envFields[17].Descriptor()
+	// env.DefaultReplicas holds the default value on creation for the replicas field.
+	env.DefaultReplicas = envDescReplicas.Default.(int32)
+	// envDescUpdateScope is the schema descriptor for update_scope field.
+	envDescUpdateScope := envFields[18].Descriptor()
+	// env.DefaultUpdateScope holds the default value on creation for the update_scope field.
+	env.DefaultUpdateScope = envDescUpdateScope.Default.(string)
+	// envDescSplitBy is the schema descriptor for split_by field.
+	envDescSplitBy := envFields[21].Descriptor()
+	// env.DefaultSplitBy holds the default value on creation for the split_by field.
+	env.DefaultSplitBy = envDescSplitBy.Default.(string)
+	// envDescDedupBy is the schema descriptor for dedup_by field.
+	envDescDedupBy := envFields[22].Descriptor()
+	// env.DefaultDedupBy holds the default value on creation for the dedup_by field.
+	env.DefaultDedupBy = envDescDedupBy.Default.(string)
+	// envDescDedupAction is the schema descriptor for dedup_action field.
+	envDescDedupAction := envFields[23].Descriptor()
+	// env.DefaultDedupAction holds the default value on creation for the dedup_action field.
+	env.DefaultDedupAction = envDescDedupAction.Default.(string)
+	envcrontriggerFields := schema.EnvCronTrigger{}.Fields()
+	_ = envcrontriggerFields
+	// envcrontriggerDescCreatedAt is the schema descriptor for created_at field.
+	envcrontriggerDescCreatedAt := envcrontriggerFields[1].Descriptor()
+	// envcrontrigger.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envcrontrigger.DefaultCreatedAt = envcrontriggerDescCreatedAt.Default.(func() time.Time)
+	// envcrontriggerDescUpdatedAt is the schema descriptor for updated_at field.
+	envcrontriggerDescUpdatedAt := envcrontriggerFields[2].Descriptor()
+	// envcrontrigger.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	envcrontrigger.DefaultUpdatedAt = envcrontriggerDescUpdatedAt.Default.(func() time.Time)
+	// envcrontrigger.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	envcrontrigger.UpdateDefaultUpdatedAt = envcrontriggerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// envcrontriggerDescPanelID is the schema descriptor for panel_id field.
+	envcrontriggerDescPanelID := envcrontriggerFields[4].Descriptor()
+	// envcrontrigger.DefaultPanelID holds the default value on creation for the panel_id field.
+	envcrontrigger.DefaultPanelID = envcrontriggerDescPanelID.Default.(int64)
+	// envcrontriggerDescIsEnable is the schema descriptor for is_enable field.
+	envcrontriggerDescIsEnable := envcrontriggerFields[7].Descriptor()
+	// envcrontrigger.DefaultIsEnable holds the default value on creation for the is_enable field.
+	envcrontrigger.DefaultIsEnable = envcrontriggerDescIsEnable.Default.(bool)
+	envpanelFields := schema.EnvPanel{}.Fields()
+	_ = envpanelFields
+	// envpanelDescMaxCount is the schema descriptor for max_count field.
+	envpanelDescMaxCount := envpanelFields[2].Descriptor()
+	// envpanel.DefaultMaxCount holds the default value on creation for the max_count field.
+	envpanel.DefaultMaxCount = envpanelDescMaxCount.Default.(int32)
+	// envpanelDescIsEnable is the schema descriptor for is_enable field.
+	envpanelDescIsEnable := envpanelFields[4].Descriptor()
+	// envpanel.DefaultIsEnable holds the default value on creation for the is_enable field.
+	envpanel.DefaultIsEnable = envpanelDescIsEnable.Default.(bool)
 	envpluginFields := schema.EnvPlugin{}.Fields()
 	_ = envpluginFields
 	// envpluginDescCreatedAt is the schema descriptor for created_at field.
@@ -84,6 +163,52 @@ func init() {
 	envpluginDescExecutionOrder := envpluginFields[6].Descriptor()
 	// envplugin.DefaultExecutionOrder holds the default value on creation for the execution_order field.
 	envplugin.DefaultExecutionOrder = envpluginDescExecutionOrder.Default.(int32)
+	// envpluginDescFailurePolicy is the schema descriptor for failure_policy field.
+	envpluginDescFailurePolicy := envpluginFields[8].Descriptor()
+	// envplugin.DefaultFailurePolicy holds the default value on creation for the failure_policy field.
+	envplugin.DefaultFailurePolicy = envpluginDescFailurePolicy.Default.(string)
+	// envpluginDescFailureThreshold is the schema descriptor for failure_threshold field.
+	envpluginDescFailureThreshold := envpluginFields[9].Descriptor()
+	// envplugin.DefaultFailureThreshold holds the default value on creation for the failure_threshold field.
+	envplugin.DefaultFailureThreshold = envpluginDescFailureThreshold.Default.(int32)
+	// envpluginDescCooldownSeconds is the schema descriptor for cooldown_seconds field.
+	envpluginDescCooldownSeconds := envpluginFields[10].Descriptor()
+	// envplugin.DefaultCooldownSeconds holds the default value on creation for the cooldown_seconds field.
+	envplugin.DefaultCooldownSeconds = envpluginDescCooldownSeconds.Default.(int32)
+	// envpluginDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	envpluginDescConsecutiveFailures := envpluginFields[11].Descriptor()
+	// envplugin.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	envplugin.DefaultConsecutiveFailures = envpluginDescConsecutiveFailures.Default.(int32)
+	envreplicaFields := schema.EnvReplica{}.Fields()
+	_ = envreplicaFields
+	// envreplicaDescCreatedAt is the schema descriptor for created_at field.
+	envreplicaDescCreatedAt := envreplicaFields[1].Descriptor()
+	// envreplica.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envreplica.DefaultCreatedAt = envreplicaDescCreatedAt.Default.(func() time.Time)
+	// envreplicaDescUpdatedAt is the schema descriptor for updated_at field.
+	envreplicaDescUpdatedAt := envreplicaFields[2].Descriptor()
+	// envreplica.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	envreplica.DefaultUpdatedAt = envreplicaDescUpdatedAt.Default.(func() time.Time)
+	// envreplica.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	envreplica.UpdateDefaultUpdatedAt = envreplicaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	idempotencyrecordFields := schema.IdempotencyRecord{}.Fields()
+	_ = idempotencyrecordFields
+	// idempotencyrecordDescCreatedAt is the schema descriptor for created_at field.
+	idempotencyrecordDescCreatedAt := idempotencyrecordFields[1].Descriptor()
+	// idempotencyrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencyrecord.DefaultCreatedAt = idempotencyrecordDescCreatedAt.Default.(func() time.Time)
+	// idempotencyrecordDescKey is the schema descriptor for key field.
+	idempotencyrecordDescKey := idempotencyrecordFields[2].Descriptor()
+	// idempotencyrecord.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencyrecord.KeyValidator = idempotencyrecordDescKey.Validators[0].(func(string) error)
+	// idempotencyrecordDescRequestHash is the schema descriptor for request_hash field.
+	idempotencyrecordDescRequestHash := idempotencyrecordFields[3].Descriptor()
+	// idempotencyrecord.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencyrecord.RequestHashValidator = idempotencyrecordDescRequestHash.Validators[0].(func(string) error)
+	// idempotencyrecordDescOwner is the schema descriptor for owner field.
+	idempotencyrecordDescOwner := idempotencyrecordFields[4].Descriptor()
+	// idempotencyrecord.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	idempotencyrecord.OwnerValidator = idempotencyrecordDescOwner.Validators[0].(func(string) error)
 	loginhistoryFields := schema.LoginHistory{}.Fields()
 	_ = loginhistoryFields
 	// loginhistoryDescCreatedAt is the schema descriptor for created_at field.
@@ -132,6 +257,24 @@ func init() {
 	panelDescToken := panelFields[8].Descriptor()
 	// panel.TokenValidator is a validator for the "token" field. It is called by the builders before save.
 	panel.TokenValidator = panelDescToken.Validators[0].(func(string) error)
+	// panelDescHealthStatus is the schema descriptor for health_status field.
+	panelDescHealthStatus := panelFields[14].Descriptor()
+	// panel.DefaultHealthStatus holds the default value on creation for the health_status field.
+	panel.DefaultHealthStatus = panelDescHealthStatus.Default.(string)
+	// panelDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	panelDescConsecutiveFailures := panelFields[15].Descriptor()
+	// panel.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	panel.DefaultConsecutiveFailures = panelDescConsecutiveFailures.Default.(int)
+	// panelDescAutoDisabled is the schema descriptor for auto_disabled field.
+	panelDescAutoDisabled := panelFields[17].Descriptor()
+	// panel.DefaultAutoDisabled holds the default value on creation for the auto_disabled field.
+	panel.DefaultAutoDisabled = panelDescAutoDisabled.Default.(bool)
+	panelhealthFields := schema.PanelHealth{}.Fields()
+	_ = panelhealthFields
+	// panelhealthDescCreatedAt is the schema descriptor for created_at field.
+	panelhealthDescCreatedAt := panelhealthFields[1].Descriptor()
+	// panelhealth.DefaultCreatedAt holds the default value on creation for the created_at field.
+	panelhealth.DefaultCreatedAt = panelhealthDescCreatedAt.Default.(func() time.Time)
 	pluginFields := schema.Plugin{}.Fields()
 	_ = pluginFields
 	// pluginDescCreatedAt is the schema descriptor for created_at field.
@@ -172,12 +315,50 @@ func init() {
 	pluginDescPriority := pluginFields[11].Descriptor()
 	// plugin.DefaultPriority holds the default value on creation for the priority field.
 	plugin.DefaultPriority = pluginDescPriority.Default.(int32)
+	// pluginDescRequireTestsPass is the schema descriptor for require_tests_pass field.
+	pluginDescRequireTestsPass := pluginFields[12].Descriptor()
+	// plugin.DefaultRequireTestsPass holds the default value on creation for the require_tests_pass field.
+	plugin.DefaultRequireTestsPass = pluginDescRequireTestsPass.Default.(bool)
 	pluginexecutionlogFields := schema.PluginExecutionLog{}.Fields()
 	_ = pluginexecutionlogFields
 	// pluginexecutionlogDescCreatedAt is the schema descriptor for created_at field.
 	pluginexecutionlogDescCreatedAt := pluginexecutionlogFields[1].Descriptor()
 	// pluginexecutionlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	pluginexecutionlog.DefaultCreatedAt = pluginexecutionlogDescCreatedAt.Default.(func() time.Time)
+	plugintestcaseFields := schema.PluginTestCase{}.Fields()
+	_ = plugintestcaseFields
+	// plugintestcaseDescCreatedAt is the schema descriptor for created_at field.
+	plugintestcaseDescCreatedAt := plugintestcaseFields[1].Descriptor()
+	// plugintestcase.DefaultCreatedAt holds the default value on creation for the created_at field.
+	plugintestcase.DefaultCreatedAt = plugintestcaseDescCreatedAt.Default.(func() time.Time)
+	// plugintestcaseDescUpdatedAt is the schema descriptor for updated_at field.
+	plugintestcaseDescUpdatedAt := plugintestcaseFields[2].Descriptor()
+	// plugintestcase.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	plugintestcase.DefaultUpdatedAt = plugintestcaseDescUpdatedAt.Default.(func() time.Time)
+	// plugintestcase.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	plugintestcase.UpdateDefaultUpdatedAt = plugintestcaseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// plugintestcaseDescName is the schema descriptor for name field.
+	plugintestcaseDescName := plugintestcaseFields[4].Descriptor()
+	// plugintestcase.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	plugintestcase.NameValidator = plugintestcaseDescName.Validators[0].(func(string) error)
+	// plugintestcaseDescIsEnable is the schema descriptor for is_enable field.
+	plugintestcaseDescIsEnable := plugintestcaseFields[10].Descriptor()
+	// plugintestcase.DefaultIsEnable holds the default value on creation for the is_enable field.
+	plugintestcase.DefaultIsEnable = plugintestcaseDescIsEnable.Default.(bool)
+	resourcelockFields := schema.ResourceLock{}.Fields()
+	_ = resourcelockFields
+	// resourcelockDescCreatedAt is the schema descriptor for created_at field.
+	resourcelockDescCreatedAt := resourcelockFields[1].Descriptor()
+	// resourcelock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resourcelock.DefaultCreatedAt = resourcelockDescCreatedAt.Default.(func() time.Time)
+	// resourcelockDescName is the schema descriptor for name field.
+	resourcelockDescName := resourcelockFields[2].Descriptor()
+	// resourcelock.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	resourcelock.NameValidator = resourcelockDescName.Validators[0].(func(string) error)
+	// resourcelockDescOwner is the schema descriptor for owner field.
+	resourcelockDescOwner := resourcelockFields[3].Descriptor()
+	// resourcelock.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	resourcelock.OwnerValidator = resourcelockDescOwner.Validators[0].(func(string) error)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CdkLedger 卡密额度流水表
+type CdkLedger struct {
+	ent.Schema
+}
+
+// Fields of the CdkLedger.
+func (CdkLedger) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Int64("cdk_id").Comment("卡密ID"),
+		field.Int64("env_id").Comment("环境变量ID"),
+		field.String("type").Comment("流水类型(reserve,commit,release)"),
+		field.Int32("delta").Comment("额度变动"),
+		field.Int32("balance").Comment("变动后余额"),
+		field.Int64("reservation_id").Optional().Nillable().Comment("关联的预扣流水ID"),
+		field.Bool("settled").Default(false).Comment("预扣是否已结算(仅预扣流水有效)"),
+		field.Text("message").Optional().Nillable().Comment("说明"),
+	}
+}
+
+// Indexes of the CdkLedger.
+func (CdkLedger) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cdk_id", "created_at"),
+		index.Fields("type", "settled", "created_at"),
+		index.Fields("created_at"),
+	}
+}
+
+// Edges of the CdkLedger.
+func (CdkLedger) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CronTriggerLog 定时任务触发日志表
+type CronTriggerLog struct {
+	ent.Schema
+}
+
+// Fields of the CronTriggerLog.
+func (CronTriggerLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Int64("env_id").Comment("环境变量ID"),
+		field.Int64("panel_id").Comment("面板ID"),
+		field.Int64("trigger_id").Comment("触发配置ID"),
+		field.String("cron_ids").Optional().Nillable().Comment("已触发的定时任务ID(逗号分隔)"),
+		field.String("status").Comment("触发状态(success,error,skipped)"),
+		field.Text("message").Optional().Nillable().Comment("结果说明"),
+	}
+}
+
+// Indexes of the CronTriggerLog.
+func (CronTriggerLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("env_id"),
+		index.Fields("status"),
+	}
+}
+
+// Edges of the CronTriggerLog.
+func (CronTriggerLog) Edges() []ent.Edge {
+	return nil
+}
@@ -32,6 +32,14 @@ func (Env) Fields() []ent.Field {
 		field.String("prompt_level").Optional().Nillable().Comment("提示等级"),
 		field.Text("prompt_content").Optional().Nillable().Comment("提示内容"),
 		field.Bool("is_enable").Comment("是否启用"),
+		field.String("select_strategy").Default("least_loaded").Comment("新建模式面板选择策略"),
+		field.Int32("replicas").Default(0).Comment("副本模式写入的面板数量(0表示所有绑定面板)"),
+		field.String("update_scope").Default("first").Comment("更新模式的更新范围(first,all,dedupe)"),
+		field.Text("value_template").Optional().Nillable().Comment("变量值模板，使用匹配正则的命名分组重新组装变量值"),
+		field.Text("match_key_template").Optional().Nillable().Comment("匹配键模板，使用更新正则的命名分组组装更新模式下的变量标识"),
+		field.String("split_by").Default("").Comment("批量提交时的分隔符，按整个字符串拆分(为空时按换行分隔)"),
+		field.String("dedup_by").Default("none").Comment("新建模式重复检测方式(none,value,key)"),
+		field.String("dedup_action").Default("reject").Comment("检测到重复时的处理方式(reject,update,allow)"),
 	}
 }
 
@@ -39,7 +47,8 @@ func (Env) Fields() []ent.Field {
 func (Env) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("panels", Panel.Type).
-			StorageKey(edge.Table("env_panels"), edge.Columns("env_id", "panel_id")),
+			Through("env_panels", EnvPanel.Type),
 		edge.To("env_plugins", EnvPlugin.Type),
+		edge.To("cron_triggers", EnvCronTrigger.Type),
 	}
 }
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EnvCronTrigger 变量提交后触发的青龙定时任务
+type EnvCronTrigger struct {
+	ent.Schema
+}
+
+// Fields of the EnvCronTrigger.
+func (EnvCronTrigger) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+		field.Int64("env_id").Comment("环境变量ID"),
+		field.Int64("panel_id").Default(0).Comment("面板ID(0表示变量绑定的所有面板)"),
+		field.Int("cron_id").Optional().Nillable().Comment("定时任务ID"),
+		field.String("name_pattern").Optional().Nillable().Comment("定时任务名称匹配正则"),
+		field.Bool("is_enable").Default(true).Comment("是否启用"),
+	}
+}
+
+// Indexes of the EnvCronTrigger.
+func (EnvCronTrigger) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("env_id"),
+		index.Fields("panel_id"),
+	}
+}
+
+// Edges of the EnvCronTrigger.
+func (EnvCronTrigger) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("env", Env.Type).
+			Ref("cron_triggers").
+			Field("env_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EnvPanel 环境变量与面板的绑定关系
+type EnvPanel struct {
+	ent.Schema
+}
+
+// Annotations of the EnvPanel.
+func (EnvPanel) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("env_id", "panel_id"),
+	}
+}
+
+// Fields of the EnvPanel.
+func (EnvPanel) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("env_id").Comment("环境变量ID"),
+		field.Int64("panel_id").Comment("面板ID"),
+		field.Int32("max_count").Default(0).Comment("该面板上的最大数量(0表示不限制)"),
+		field.Int("weight").Optional().Nillable().Comment("绑定权重(为空时按1处理)"),
+		field.Bool("is_enable").Default(true).Comment("是否启用"),
+	}
+}
+
+// Edges of the EnvPanel.
+func (EnvPanel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("env", Env.Type).
+			Field("env_id").
+			Unique().
+			Required().
+			StorageKey(edge.Symbol("env_panels_env_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("panel", Panel.Type).
+			Field("panel_id").
+			Unique().
+			Required().
+			StorageKey(edge.Symbol("env_panels_panel_id")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
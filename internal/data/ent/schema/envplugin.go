@@ -25,6 +25,12 @@ func (EnvPlugin) Fields() []ent.Field {
 		field.Bool("is_enable").Default(true).Comment("是否启用"),
 		field.Int32("execution_order").Default(100).Comment("执行顺序(数字越小越先执行)"),
 		field.Text("config").Optional().Nillable().Comment("插件配置参数"),
+		field.String("failure_policy").Default("fail_closed").Comment("执行失败处理策略(fail_closed,fail_open,auto_disable)"),
+		field.Int32("failure_threshold").Default(5).Comment("连续失败熔断阈值"),
+		field.Int32("cooldown_seconds").Default(300).Comment("熔断冷却时长(秒)"),
+		field.Int32("consecutive_failures").Default(0).Comment("连续失败次数"),
+		field.Time("disabled_until").Optional().Nillable().Comment("熔断截止时间"),
+		field.String("disabled_reason").Optional().Nillable().Comment("熔断原因"),
 	}
 }
 
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EnvReplica 副本模式提交的变量在各面板上的副本
+type EnvReplica struct {
+	ent.Schema
+}
+
+// Fields of the EnvReplica.
+func (EnvReplica) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+		field.Int64("env_id").Comment("环境变量ID"),
+		field.String("group_id").Comment("副本组ID，同一次提交写入的副本属于同一组"),
+		field.Int64("panel_id").Comment("面板ID"),
+		field.Int("panel_env_id").Comment("青龙面板中的变量ID"),
+	}
+}
+
+// Indexes of the EnvReplica.
+func (EnvReplica) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("env_id", "group_id"),
+		index.Fields("panel_id", "panel_env_id").Unique(),
+	}
+}
+
+// Edges of the EnvReplica.
+func (EnvReplica) Edges() []ent.Edge {
+	return nil
+}
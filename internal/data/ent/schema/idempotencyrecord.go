@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyRecord 幂等请求记录
+type IdempotencyRecord struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyRecord.
+func (IdempotencyRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.String("key").NotEmpty().Comment("幂等键"),
+		field.String("request_hash").NotEmpty().Comment("请求内容摘要"),
+		field.String("owner").NotEmpty().Comment("处理中请求的持有者标识"),
+		field.String("status").Comment("状态(pending,done)"),
+		field.Text("response").Optional().Nillable().Comment("首次请求的响应内容(JSON)"),
+		field.Time("expires_at").Comment("到期时间，处理中为租约到期时间，完成后为重放窗口结束时间"),
+	}
+}
+
+// Indexes of the IdempotencyRecord.
+func (IdempotencyRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+// Edges of the IdempotencyRecord.
+func (IdempotencyRecord) Edges() []ent.Edge {
+	return nil
+}
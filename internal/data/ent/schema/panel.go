@@ -26,6 +26,14 @@ func (Panel) Fields() []ent.Field {
 		field.Bool("is_enable").Comment("是否启用"),
 		field.String("token").NotEmpty().Comment("Token"),
 		field.Int32("params").Comment("Params"),
+		field.String("ql_version").Optional().Nillable().Comment("青龙版本"),
+		field.String("node_version").Optional().Nillable().Comment("Node版本"),
+		field.Int64("uptime").Optional().Nillable().Comment("运行时长(秒)"),
+		field.Time("system_checked_at").Optional().Nillable().Comment("系统信息更新时间"),
+		field.String("health_status").Default("unknown").Comment("健康状态(unknown,healthy,degraded,down)"),
+		field.Int("consecutive_failures").Default(0).Comment("连续检查失败次数"),
+		field.Time("health_checked_at").Optional().Nillable().Comment("最近一次健康检查时间"),
+		field.Bool("auto_disabled").Default(false).Comment("是否因持续不可用被自动禁用"),
 	}
 }
 
@@ -33,6 +41,7 @@ func (Panel) Fields() []ent.Field {
 func (Panel) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("envs", Env.Type).
-			Ref("panels"),
+			Ref("panels").
+			Through("env_panels", EnvPanel.Type),
 	}
 }
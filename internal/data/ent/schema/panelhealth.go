@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PanelHealth 面板健康检查记录表
+type PanelHealth struct {
+	ent.Schema
+}
+
+// Fields of the PanelHealth.
+func (PanelHealth) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("检查时间"),
+		field.Int64("panel_id").Comment("面板ID"),
+		field.String("status").Comment("健康状态(healthy,degraded,down)"),
+		field.Bool("token_valid").Comment("Token是否有效"),
+		field.Int64("latency").Comment("响应耗时(毫秒)"),
+		field.Int32("env_count").Optional().Nillable().Comment("环境变量数量"),
+		field.Text("message").Optional().Nillable().Comment("检查结果说明"),
+	}
+}
+
+// Indexes of the PanelHealth.
+func (PanelHealth) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("panel_id", "created_at"),
+	}
+}
+
+// Edges of the PanelHealth.
+func (PanelHealth) Edges() []ent.Edge {
+	return nil
+}
@@ -29,6 +29,7 @@ func (Plugin) Fields() []ent.Field {
 		field.Int32("execution_timeout").Default(10000).Comment("执行超时时间(毫秒)"),
 		field.String("trigger_event").Default("before_submit").Comment("触发事件"),
 		field.Int32("priority").Default(10).Comment("执行优先级"),
+		field.Bool("require_tests_pass").Default(false).Comment("保存前要求测试用例全部通过"),
 	}
 }
 
@@ -45,5 +46,6 @@ func (Plugin) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("env_plugins", EnvPlugin.Type),
 		edge.To("execution_logs", PluginExecutionLog.Type),
+		edge.To("test_cases", PluginTestCase.Type),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PluginTestCase 插件测试用例表
+type PluginTestCase struct {
+	ent.Schema
+}
+
+// Fields of the PluginTestCase.
+func (PluginTestCase) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("更新时间"),
+		field.Int64("plugin_id").Comment("插件ID"),
+		field.String("name").NotEmpty().Comment("用例名称"),
+		field.Text("input_value").Comment("输入的变量值"),
+		field.Text("config").Optional().Nillable().Comment("插件配置参数"),
+		field.Bool("expected_bool").Optional().Nillable().Comment("期望返回的bool"),
+		field.Text("expected_env").Optional().Nillable().Comment("期望返回的env"),
+		field.Text("expected_error").Optional().Nillable().Comment("期望的错误信息(包含匹配)"),
+		field.Bool("is_enable").Default(true).Comment("是否启用"),
+	}
+}
+
+// Indexes of the PluginTestCase.
+func (PluginTestCase) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("plugin_id"),
+	}
+}
+
+// Edges of the PluginTestCase.
+func (PluginTestCase) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("plugin", Plugin.Type).
+			Ref("test_cases").
+			Field("plugin_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ResourceLock 跨实例资源锁表（租约到期后可被其他实例接管）
+type ResourceLock struct {
+	ent.Schema
+}
+
+// Fields of the ResourceLock.
+func (ResourceLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().Comment("主键ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("创建时间"),
+		field.String("name").NotEmpty().Comment("锁名称"),
+		field.String("owner").NotEmpty().Comment("持有者标识"),
+		field.Time("expires_at").Comment("租约到期时间"),
+	}
+}
+
+// Indexes of the ResourceLock.
+func (ResourceLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+	}
+}
+
+// Edges of the ResourceLock.
+func (ResourceLock) Edges() []ent.Edge {
+	return nil
+}
@@ -14,18 +14,36 @@ type Tx struct {
 	config
 	// CdKey is the client for interacting with the CdKey builders.
 	CdKey *CdKeyClient
+	// CdkLedger is the client for interacting with the CdkLedger builders.
+	CdkLedger *CdkLedgerClient
+	// CronTriggerLog is the client for interacting with the CronTriggerLog builders.
+	CronTriggerLog *CronTriggerLogClient
 	// Env is the client for interacting with the Env builders.
 	Env *EnvClient
+	// EnvCronTrigger is the client for interacting with the EnvCronTrigger builders.
+	EnvCronTrigger *EnvCronTriggerClient
+	// EnvPanel is the client for interacting with the EnvPanel builders.
+	EnvPanel *EnvPanelClient
 	// EnvPlugin is the client for interacting with the EnvPlugin builders.
 	EnvPlugin *EnvPluginClient
+	// EnvReplica is the client for interacting with the EnvReplica builders.
+	EnvReplica *EnvReplicaClient
+	// IdempotencyRecord is the client for interacting with the IdempotencyRecord builders.
+	IdempotencyRecord *IdempotencyRecordClient
 	// LoginHistory is the client for interacting with the LoginHistory builders.
 	LoginHistory *LoginHistoryClient
 	// Panel is the client for interacting with the Panel builders.
 	Panel *PanelClient
+	// PanelHealth is the client for interacting with the PanelHealth builders.
+	PanelHealth *PanelHealthClient
 	// Plugin is the client for interacting with the Plugin builders.
 	Plugin *PluginClient
 	// PluginExecutionLog is the client for interacting with the PluginExecutionLog builders.
 	PluginExecutionLog *PluginExecutionLogClient
+	// PluginTestCase is the client for interacting with the PluginTestCase builders.
+	PluginTestCase *PluginTestCaseClient
+	// ResourceLock is the client for interacting with the ResourceLock builders.
+	ResourceLock *ResourceLockClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -160,12 +178,21 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.CdKey = NewCdKeyClient(tx.config)
+	tx.CdkLedger = NewCdkLedgerClient(tx.config)
+	tx.CronTriggerLog = NewCronTriggerLogClient(tx.config)
 	tx.Env = NewEnvClient(tx.config)
+	tx.EnvCronTrigger = NewEnvCronTriggerClient(tx.config)
+	tx.EnvPanel = NewEnvPanelClient(tx.config)
 	tx.EnvPlugin = NewEnvPluginClient(tx.config)
+	tx.EnvReplica = NewEnvReplicaClient(tx.config)
+	tx.IdempotencyRecord = NewIdempotencyRecordClient(tx.config)
 	tx.LoginHistory = NewLoginHistoryClient(tx.config)
 	tx.Panel = NewPanelClient(tx.config)
+	tx.PanelHealth = NewPanelHealthClient(tx.config)
 	tx.Plugin = NewPluginClient(tx.config)
 	tx.PluginExecutionLog = NewPluginExecutionLogClient(tx.config)
+	tx.PluginTestCase = NewPluginTestCaseClient(tx.config)
+	tx.ResourceLock = NewResourceLockClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
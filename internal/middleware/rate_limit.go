@@ -54,6 +54,13 @@ func (tb *TokenBucket) TakeToken() bool {
 	return false
 }
 
+// ChargeTokens 额外扣除令牌，允许令牌数为负，欠下的令牌补足前后续请求都会被限速
+func (tb *TokenBucket) ChargeTokens(n int64) {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+	tb.tokens -= n
+}
+
 // RateLimiter 限速器结构
 type RateLimiter struct {
 	buckets    map[string]*TokenBucket // IP地址到令牌桶的映射
@@ -151,6 +158,14 @@ func SubmitAPIRateLimit() gin.HandlerFunc {
 	return RateLimitMiddleware(submitAPILimiter)
 }
 
+// ChargeSubmitTokens 按额外的提交次数扣除提交接口令牌，用于批量提交按变量值数量计入限速
+func ChargeSubmitTokens(clientIP string, n int64) {
+	if n <= 0 {
+		return
+	}
+	submitAPILimiter.GetBucket(clientIP).ChargeTokens(n)
+}
+
 // StartCleanupTask 启动清理任务（可选）
 func StartCleanupTask() {
 	go func() {
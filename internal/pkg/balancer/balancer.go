@@ -0,0 +1,123 @@
+// Package balancer 新建模式提交时的面板选择策略
+package balancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+)
+
+// 面板选择策略名称
+const (
+	LeastLoaded = "least_loaded" // 变量数量最少的面板（默认）
+	Weighted    = "weighted"     // 按面板权重分配
+	RoundRobin  = "round_robin"  // 轮流分配
+	FillFirst   = "fill_first"   // 填满一个面板再使用下一个
+	Random      = "random"       // 随机分配
+	Sticky      = "sticky"       // 同一提交者（卡密/IP）固定到同一面板
+)
+
+// ErrNoCandidate 没有可用的面板
+var ErrNoCandidate = errors.New("没有可用的面板")
+
+// Candidate 候选面板
+type Candidate struct {
+	PanelID  int64 // 面板ID
+	Used     int32 // 面板上该变量的数量
+	Weight   int   // 面板权重（小于1时按1处理）
+	Capacity int32 // 面板容量（0表示不限制，变量总负载数量由调用方校验）
+}
+
+// Request 选择请求
+type Request struct {
+	EnvID     int64  // 环境变量ID
+	Submitter string // 提交者标识（卡密或IP），为空时粘性策略退回最少负载
+}
+
+// PanelSource 候选面板来源
+type PanelSource interface {
+	// Candidates 返回可参与选择的面板及其负载，不可达的面板不应返回
+	Candidates(ctx context.Context, panelIDs []int64) ([]Candidate, error)
+}
+
+// Strategy 面板选择策略
+type Strategy interface {
+	// Name 策略名称
+	Name() string
+	// Pick 从候选面板中选择一个，candidates 已过滤掉达到容量的面板、按面板ID升序排列且不为空
+	Pick(req Request, candidates []Candidate) Candidate
+}
+
+var strategies = map[string]Strategy{
+	LeastLoaded: leastLoaded{},
+	Weighted:    weighted{},
+	RoundRobin:  newRoundRobin(),
+	FillFirst:   fillFirst{},
+	Random:      random{intN: rand.IntN},
+	Sticky:      sticky{},
+}
+
+// Get 按名称获取策略，名称为空时返回默认策略
+func Get(name string) (Strategy, error) {
+	if name == "" {
+		name = LeastLoaded
+	}
+	strategy, ok := strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("不支持的面板选择策略: %s", name)
+	}
+	return strategy, nil
+}
+
+// Names 返回所有策略名称
+func Names() []string {
+	names := make([]string, 0, len(strategies))
+	for name := range strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Select 从面板来源获取候选面板，过滤掉达到容量的面板后按策略选择
+// 所有候选面板都已达到容量时返回 ErrNoCandidate
+func Select(ctx context.Context, source PanelSource, strategy Strategy, req Request, panelIDs []int64) (Candidate, error) {
+	candidates, err := source.Candidates(ctx, panelIDs)
+	if err != nil {
+		return Candidate{}, err
+	}
+	if len(candidates) == 0 {
+		return Candidate{}, ErrNoCandidate
+	}
+
+	sorted := make([]Candidate, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].PanelID < sorted[j].PanelID })
+
+	available := notFull(sorted)
+	if len(available) == 0 {
+		return Candidate{}, ErrNoCandidate
+	}
+	return strategy.Pick(req, available), nil
+}
+
+// weightOf 面板权重，小于1时按1处理
+func weightOf(c Candidate) int {
+	if c.Weight < 1 {
+		return 1
+	}
+	return c.Weight
+}
+
+// notFull 过滤已达到容量的面板，未设置容量的面板不限制
+func notFull(candidates []Candidate) []Candidate {
+	available := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Capacity <= 0 || c.Used < c.Capacity {
+			available = append(available, c)
+		}
+	}
+	return available
+}
@@ -0,0 +1,364 @@
+package balancer
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// fakeSource 内存中的候选面板来源，按与请求相反的顺序返回候选面板以验证排序
+type fakeSource struct {
+	panels map[int64]*Candidate
+	err    error
+}
+
+func newFakeSource(candidates ...Candidate) *fakeSource {
+	src := &fakeSource{panels: make(map[int64]*Candidate, len(candidates))}
+	for _, c := range candidates {
+		src.panels[c.PanelID] = &c
+	}
+	return src
+}
+
+func (src *fakeSource) Candidates(_ context.Context, panelIDs []int64) ([]Candidate, error) {
+	if src.err != nil {
+		return nil, src.err
+	}
+	candidates := make([]Candidate, 0, len(panelIDs))
+	for i := len(panelIDs) - 1; i >= 0; i-- {
+		if c, ok := src.panels[panelIDs[i]]; ok {
+			candidates = append(candidates, *c)
+		}
+	}
+	return candidates, nil
+}
+
+func (src *fakeSource) ids() []int64 {
+	ids := make([]int64, 0, len(src.panels))
+	for id := range src.panels {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// place 连续选择 n 次，每次将选中面板的数量加1，返回各面板新增的数量
+func place(t *testing.T, src *fakeSource, strategy Strategy, req Request, n int) map[int64]int {
+	t.Helper()
+	placed := make(map[int64]int)
+	for i := 0; i < n; i++ {
+		best, err := Select(context.Background(), src, strategy, req, src.ids())
+		if err != nil {
+			t.Fatalf("第%d次选择失败: %v", i+1, err)
+		}
+		src.panels[best.PanelID].Used++
+		placed[best.PanelID]++
+	}
+	return placed
+}
+
+func mustGet(t *testing.T, name string) Strategy {
+	t.Helper()
+	strategy, err := Get(name)
+	if err != nil {
+		t.Fatalf("获取策略%s失败: %v", name, err)
+	}
+	return strategy
+}
+
+func TestGet(t *testing.T) {
+	strategy, err := Get("")
+	if err != nil || strategy.Name() != LeastLoaded {
+		t.Fatalf("空名称应返回默认策略 %s，实际 %v, %v", LeastLoaded, strategy, err)
+	}
+	for _, name := range Names() {
+		if got := mustGet(t, name).Name(); got != name {
+			t.Errorf("策略 %s 的名称为 %s", name, got)
+		}
+	}
+	if _, err := Get("unknown"); err == nil {
+		t.Error("不支持的策略应返回错误")
+	}
+}
+
+func TestLeastLoaded(t *testing.T) {
+	src := newFakeSource(
+		Candidate{PanelID: 1, Used: 5},
+		Candidate{PanelID: 2, Used: 2},
+		Candidate{PanelID: 3, Used: 2},
+	)
+	best, err := Select(context.Background(), src, mustGet(t, LeastLoaded), Request{}, src.ids())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if best.PanelID != 2 {
+		t.Errorf("应选择数量最少且ID最小的面板2，实际选择面板%d", best.PanelID)
+	}
+
+	// 达到容量的面板不参与选择
+	src = newFakeSource(
+		Candidate{PanelID: 1, Used: 3, Capacity: 3},
+		Candidate{PanelID: 2, Used: 8},
+	)
+	best, err = Select(context.Background(), src, mustGet(t, LeastLoaded), Request{}, src.ids())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if best.PanelID != 2 {
+		t.Errorf("面板1已满，应选择面板2，实际选择面板%d", best.PanelID)
+	}
+}
+
+func TestWeighted(t *testing.T) {
+	src := newFakeSource(
+		Candidate{PanelID: 1, Weight: 1},
+		Candidate{PanelID: 2, Weight: 3},
+		Candidate{PanelID: 3, Weight: 0}, // 小于1按1处理
+	)
+	placed := place(t, src, mustGet(t, Weighted), Request{}, 10)
+	want := map[int64]int{1: 2, 2: 6, 3: 2}
+	for id, n := range want {
+		if placed[id] != n {
+			t.Errorf("面板%d应分配%d个，实际%d个（%v）", id, n, placed[id], placed)
+		}
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	strategy := newRoundRobin()
+	src := newFakeSource(
+		Candidate{PanelID: 1},
+		Candidate{PanelID: 2},
+		Candidate{PanelID: 3},
+	)
+
+	var order []int64
+	for i := 0; i < 6; i++ {
+		best, err := Select(context.Background(), src, strategy, Request{EnvID: 1}, src.ids())
+		if err != nil {
+			t.Fatal(err)
+		}
+		order = append(order, best.PanelID)
+	}
+	want := []int64{1, 2, 3, 1, 2, 3}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("轮询顺序应为%v，实际%v", want, order)
+		}
+	}
+
+	// 不同变量使用独立计数
+	best, err := Select(context.Background(), src, strategy, Request{EnvID: 2}, src.ids())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if best.PanelID != 1 {
+		t.Errorf("新变量应从面板1开始，实际面板%d", best.PanelID)
+	}
+
+	// 达到容量的面板被跳过
+	src.panels[2].Used, src.panels[2].Capacity = 5, 5
+	placed := place(t, src, strategy, Request{EnvID: 3}, 4)
+	if placed[2] != 0 || placed[1] != 2 || placed[3] != 2 {
+		t.Errorf("面板2已满，应在面板1与面板3之间轮流分配，实际%v", placed)
+	}
+}
+
+func TestFillFirst(t *testing.T) {
+	src := newFakeSource(
+		Candidate{PanelID: 1, Capacity: 2},
+		Candidate{PanelID: 2, Capacity: 3},
+		Candidate{PanelID: 3, Capacity: 3},
+	)
+	var order []int64
+	for i := 0; i < 6; i++ {
+		for id := range place(t, src, mustGet(t, FillFirst), Request{}, 1) {
+			order = append(order, id)
+		}
+	}
+	want := []int64{1, 1, 2, 2, 2, 3}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("填充顺序应为%v，实际%v", want, order)
+		}
+	}
+}
+
+func TestFillFirstUncapped(t *testing.T) {
+	// 未设置容量的面板不限制，先填满有容量的面板，之后一直使用第一个未设置容量的面板
+	src := newFakeSource(
+		Candidate{PanelID: 1, Capacity: 2},
+		Candidate{PanelID: 2},
+		Candidate{PanelID: 3},
+	)
+	placed := place(t, src, mustGet(t, FillFirst), Request{}, 10)
+	want := map[int64]int{1: 2, 2: 8, 3: 0}
+	for id, n := range want {
+		if placed[id] != n {
+			t.Errorf("面板%d应分配%d个，实际%d个（%v）", id, n, placed[id], placed)
+		}
+	}
+}
+
+func TestRandom(t *testing.T) {
+	seeded := func() Strategy {
+		return random{intN: rand.New(rand.NewPCG(1, 2)).IntN}
+	}
+	src := newFakeSource(
+		Candidate{PanelID: 1},
+		Candidate{PanelID: 2},
+		Candidate{PanelID: 3, Used: 1, Capacity: 1},
+	)
+
+	// 相同种子得到相同的选择序列
+	first, second := seeded(), seeded()
+	for i := 0; i < 20; i++ {
+		a, err := Select(context.Background(), src, first, Request{}, src.ids())
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := Select(context.Background(), src, second, Request{}, src.ids())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.PanelID != b.PanelID {
+			t.Fatalf("第%d次选择结果不一致: %d != %d", i+1, a.PanelID, b.PanelID)
+		}
+	}
+
+	placed := place(t, src, seeded(), Request{}, 100)
+	if placed[3] != 0 {
+		t.Errorf("面板3已满，不应被选择，实际%v", placed)
+	}
+	if placed[1] == 0 || placed[2] == 0 {
+		t.Errorf("未满的面板都应被选择，实际%v", placed)
+	}
+}
+
+func TestSticky(t *testing.T) {
+	src := newFakeSource(
+		Candidate{PanelID: 1},
+		Candidate{PanelID: 2},
+		Candidate{PanelID: 3},
+		Candidate{PanelID: 4},
+	)
+	strategy := mustGet(t, Sticky)
+
+	// 同一提交者总是分配到同一面板
+	assigned := make(map[string]int64)
+	for _, submitter := range []string{"cdk-a", "cdk-b", "cdk-c", "1.2.3.4", "5.6.7.8"} {
+		for i := 0; i < 5; i++ {
+			best, err := Select(context.Background(), src, strategy, Request{Submitter: submitter}, src.ids())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if prev, ok := assigned[submitter]; ok && prev != best.PanelID {
+				t.Fatalf("提交者%s先后分配到面板%d与面板%d", submitter, prev, best.PanelID)
+			}
+			assigned[submitter] = best.PanelID
+		}
+	}
+
+	// 所在面板已满时改为分配到其他面板，其余提交者不受影响
+	full := assigned["cdk-a"]
+	src.panels[full].Used, src.panels[full].Capacity = 1, 1
+	for submitter, panelID := range assigned {
+		best, err := Select(context.Background(), src, strategy, Request{Submitter: submitter}, src.ids())
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case panelID == full && best.PanelID == full:
+			t.Errorf("面板%d已满，提交者%s不应再分配到该面板", full, submitter)
+		case panelID != full && best.PanelID != panelID:
+			t.Errorf("提交者%s应保持在面板%d，实际面板%d", submitter, panelID, best.PanelID)
+		}
+	}
+
+	// 没有提交者标识时退回最少负载
+	src.panels[1].Used, src.panels[2].Used, src.panels[3].Used, src.panels[4].Used = 3, 1, 2, 3
+	src.panels[full].Capacity = 0
+	best, err := Select(context.Background(), src, strategy, Request{}, src.ids())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if best.PanelID != 2 {
+		t.Errorf("没有提交者标识时应选择数量最少的面板2，实际面板%d", best.PanelID)
+	}
+}
+
+func TestSelectAllFull(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			// 显式容量全部用满
+			src := newFakeSource(
+				Candidate{PanelID: 1, Used: 2, Capacity: 2},
+				Candidate{PanelID: 2, Used: 5, Capacity: 3},
+			)
+			_, err := Select(context.Background(), src, mustGet(t, name), Request{Submitter: "cdk"}, src.ids())
+			if !errors.Is(err, ErrNoCandidate) {
+				t.Errorf("所有面板已满时应返回 ErrNoCandidate，实际 %v", err)
+			}
+
+			// 未设置容量的面板不限制，只在已满的面板之外选择
+			src = newFakeSource(
+				Candidate{PanelID: 1, Used: 2, Capacity: 2},
+				Candidate{PanelID: 2, Used: 100},
+			)
+			best, err := Select(context.Background(), src, mustGet(t, name), Request{Submitter: "cdk"}, src.ids())
+			if err != nil {
+				t.Fatalf("存在未设置容量的面板时不应返回错误，实际 %v", err)
+			}
+			if best.PanelID != 2 {
+				t.Errorf("面板1已满，应选择未设置容量的面板2，实际面板%d", best.PanelID)
+			}
+		})
+	}
+}
+
+func TestReplicaSelection(t *testing.T) {
+	// 副本模式下每次提交在每个面板各写入一份，按排除已选面板的方式连续选择
+	src := newFakeSource(
+		Candidate{PanelID: 1},
+		Candidate{PanelID: 2},
+		Candidate{PanelID: 3, Capacity: 3},
+	)
+	for _, name := range Names() {
+		for id := range src.panels {
+			src.panels[id].Used = 0
+		}
+		for i := 0; i < 8; i++ {
+			remaining := src.ids()
+			for n := 0; n < 2; n++ {
+				best, err := Select(context.Background(), src, mustGet(t, name), Request{EnvID: 1, Submitter: "cdk"}, remaining)
+				if err != nil {
+					t.Fatalf("%s: 第%d次提交的第%d个副本选择失败: %v", name, i+1, n+1, err)
+				}
+				src.panels[best.PanelID].Used++
+				remaining = slices.DeleteFunc(remaining, func(id int64) bool { return id == best.PanelID })
+			}
+		}
+		if used := src.panels[3].Used; used > 3 {
+			t.Errorf("%s: 面板3容量为3，实际写入%d个副本", name, used)
+		}
+		if total := src.panels[1].Used + src.panels[2].Used + src.panels[3].Used; total != 16 {
+			t.Errorf("%s: 8次提交应写入16个副本，实际%d个", name, total)
+		}
+	}
+}
+
+func TestSelectSourceErrors(t *testing.T) {
+	strategy := mustGet(t, LeastLoaded)
+
+	_, err := Select(context.Background(), newFakeSource(), strategy, Request{}, []int64{1, 2})
+	if !errors.Is(err, ErrNoCandidate) {
+		t.Errorf("没有候选面板时应返回 ErrNoCandidate，实际 %v", err)
+	}
+
+	sourceErr := errors.New("查询失败")
+	_, err = Select(context.Background(), &fakeSource{err: sourceErr}, strategy, Request{}, []int64{1})
+	if !errors.Is(err, sourceErr) {
+		t.Errorf("应返回面板来源的错误，实际 %v", err)
+	}
+}
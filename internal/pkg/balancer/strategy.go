@@ -0,0 +1,106 @@
+package balancer
+
+import (
+	"hash/fnv"
+	"strconv"
+	"sync"
+)
+
+// leastLoaded 选择变量数量最少的面板，数量相同时选择ID最小的面板
+type leastLoaded struct{}
+
+func (leastLoaded) Name() string { return LeastLoaded }
+
+func (leastLoaded) Pick(_ Request, candidates []Candidate) Candidate {
+	best := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.Used < best.Used {
+			best = c
+		}
+	}
+	return best
+}
+
+// weighted 按权重分配，选择放入后“数量/权重”比值最小的面板，使各面板的数量与权重成正比
+type weighted struct{}
+
+func (weighted) Name() string { return Weighted }
+
+func (weighted) Pick(_ Request, candidates []Candidate) Candidate {
+	best := candidates[0]
+	for _, c := range candidates[1:] {
+		// (c.Used+1)/c.Weight < (best.Used+1)/best.Weight，交叉相乘避免浮点误差
+		if int64(c.Used+1)*int64(weightOf(best)) < int64(best.Used+1)*int64(weightOf(c)) {
+			best = c
+		}
+	}
+	return best
+}
+
+// roundRobin 按变量轮流分配到各面板，计数仅保存在内存中
+type roundRobin struct {
+	mu       sync.Mutex
+	counters map[int64]uint64
+}
+
+func newRoundRobin() *roundRobin {
+	return &roundRobin{counters: make(map[int64]uint64)}
+}
+
+func (*roundRobin) Name() string { return RoundRobin }
+
+func (r *roundRobin) Pick(req Request, candidates []Candidate) Candidate {
+	r.mu.Lock()
+	n := r.counters[req.EnvID]
+	r.counters[req.EnvID] = n + 1
+	r.mu.Unlock()
+
+	return candidates[n%uint64(len(candidates))]
+}
+
+// fillFirst 按面板ID顺序填充，当前面板达到容量后再使用下一个面板；未设置容量的面板会一直被使用
+type fillFirst struct{}
+
+func (fillFirst) Name() string { return FillFirst }
+
+func (fillFirst) Pick(_ Request, candidates []Candidate) Candidate {
+	return candidates[0]
+}
+
+// random 在未满的面板中随机选择
+type random struct {
+	intN func(n int) int // 随机数来源，返回 [0, n) 内的整数
+}
+
+func (random) Name() string { return Random }
+
+func (r random) Pick(_ Request, candidates []Candidate) Candidate {
+	return candidates[r.intN(len(candidates))]
+}
+
+// sticky 同一提交者固定分配到同一面板
+// 使用最高随机权重哈希（rendezvous hashing），面板增减时只有少量提交者会被重新分配
+type sticky struct{}
+
+func (sticky) Name() string { return Sticky }
+
+func (sticky) Pick(req Request, candidates []Candidate) Candidate {
+	if req.Submitter == "" {
+		return leastLoaded{}.Pick(req, candidates)
+	}
+
+	var (
+		best      Candidate
+		bestScore uint64
+	)
+	for i, c := range candidates {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(req.Submitter))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(strconv.FormatInt(c.PanelID, 10)))
+		if score := h.Sum64(); i == 0 || score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,105 @@
+// Package extract 基于正则命名分组提取变量字段，并按模板重新组装变量值
+package extract
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// placeholderRe 模板占位符，例如 {{pt_key}}
+var placeholderRe = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// Extractor 正则提取器
+type Extractor struct {
+	re       *regexp.Regexp
+	template string
+}
+
+// Match 提取结果
+type Match struct {
+	Text   string            // 完整匹配内容
+	Fields map[string]string // 命名分组提取的字段，未参与匹配的分组为空字符串
+}
+
+// New 创建提取器，模板为空时使用完整匹配内容
+// 模板中的占位符必须是正则中定义的命名分组
+func New(pattern, template string) (*Extractor, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("正则表达式不能为空")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("正则表达式错误: %w", err)
+	}
+
+	groups := make(map[string]bool)
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			groups[name] = true
+		}
+	}
+	for _, name := range Placeholders(template) {
+		if !groups[name] {
+			return nil, fmt.Errorf("模板引用了正则中不存在的命名分组: %s", name)
+		}
+	}
+
+	return &Extractor{re: re, template: template}, nil
+}
+
+// Extract 从变量值中提取字段，未匹配时返回 false
+func (x *Extractor) Extract(value string) (Match, bool) {
+	loc := x.re.FindStringSubmatchIndex(value)
+	if loc == nil {
+		return Match{}, false
+	}
+
+	m := Match{Text: value[loc[0]:loc[1]], Fields: make(map[string]string)}
+	for i, name := range x.re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		if start, end := loc[2*i], loc[2*i+1]; start >= 0 {
+			m.Fields[name] = value[start:end]
+		} else if _, ok := m.Fields[name]; !ok {
+			m.Fields[name] = ""
+		}
+	}
+	return m, true
+}
+
+// Render 提取字段并按模板组装结果，模板为空时返回完整匹配内容，未匹配时返回 false
+func (x *Extractor) Render(value string) (string, bool) {
+	m, ok := x.Extract(value)
+	if !ok {
+		return "", false
+	}
+	if x.template == "" {
+		return m.Text, true
+	}
+	return Execute(x.template, m.Fields), true
+}
+
+// Placeholders 返回模板中引用的字段名（按出现顺序去重）
+func Placeholders(template string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, sub := range placeholderRe.FindAllStringSubmatch(template, -1) {
+		if !seen[sub[1]] {
+			seen[sub[1]] = true
+			names = append(names, sub[1])
+		}
+	}
+	return names
+}
+
+// Execute 使用字段替换模板中的占位符，不存在的字段替换为空字符串
+func Execute(template string, fields map[string]string) string {
+	if !strings.Contains(template, "{{") {
+		return template
+	}
+	return placeholderRe.ReplaceAllStringFunc(template, func(s string) string {
+		return fields[placeholderRe.FindStringSubmatch(s)[1]]
+	})
+}
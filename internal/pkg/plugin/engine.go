@@ -21,6 +21,7 @@ type ExecutionContext struct {
 	EnvValue  string `json:"env_value"` // 环境变量值
 	Config    []byte `json:"config"`    // 插件配置
 	Timestamp int64  `json:"timestamp"` // 时间戳
+	DryRun    bool   `json:"dry_run"`   // 试运行或测试执行，不计入执行指标
 }
 
 // ExecutionResult 插件执行结果
@@ -30,6 +31,26 @@ type ExecutionResult struct {
 	ErrorMessage  string `json:"error_message"`  // 错误信息
 	ExecutionTime int    `json:"execution_time"` // 执行耗时(毫秒)
 	StackTrace    string `json:"stack_trace"`    // 错误堆栈
+	TimedOut      bool   `json:"timed_out"`      // 是否执行超时
+}
+
+// 插件执行状态，与执行日志表 execution_status 字段取值一致
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+	StatusTimeout = "timeout"
+)
+
+// Status 返回执行状态
+func (r *ExecutionResult) Status() string {
+	switch {
+	case r.TimedOut:
+		return StatusTimeout
+	case r.Success:
+		return StatusSuccess
+	default:
+		return StatusError
+	}
 }
 
 // Engine 插件执行引擎
@@ -57,21 +78,20 @@ func (e *Engine) Execute(ctx context.Context, script string, execCtx *ExecutionC
 	execContext, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// 创建执行结果
-	result := &ExecutionResult{
-		Success: false,
-	}
-
-	// 在goroutine中执行脚本
-	done := make(chan struct{})
+	// 在goroutine中执行脚本，执行结果只由该goroutine写入并通过通道发布
+	// 超时后脚本可能仍在运行，通道带缓冲保证goroutine不会阻塞
+	done := make(chan *ExecutionResult, 1)
 	go func() {
+		result := &ExecutionResult{
+			Success: false,
+		}
 		defer func() {
 			if r := recover(); r != nil {
 				result.ErrorMessage = fmt.Sprintf("插件执行发生panic: %v", r)
 				result.StackTrace = fmt.Sprintf("%+v", r)
 			}
 			result.ExecutionTime = int(time.Since(startTime).Milliseconds())
-			close(done)
+			done <- result
 		}()
 
 		// 执行脚本
@@ -79,16 +99,23 @@ func (e *Engine) Execute(ctx context.Context, script string, execCtx *ExecutionC
 	}()
 
 	// 等待执行完成或超时
+	var result *ExecutionResult
 	select {
-	case <-done:
+	case result = <-done:
 		// 执行完成
-		return result
 	case <-execContext.Done():
-		// 超时
-		result.ErrorMessage = "插件执行超时"
-		result.ExecutionTime = int(timeout.Milliseconds())
-		return result
+		// 超时，不再读取仍在执行的脚本结果
+		result = &ExecutionResult{
+			ErrorMessage:  "插件执行超时",
+			ExecutionTime: int(timeout.Milliseconds()),
+			TimedOut:      true,
+		}
+	}
+
+	if !execCtx.DryRun {
+		observeExecution(execCtx.PluginID, result)
 	}
+	return result
 }
 
 // executeScript 执行JavaScript脚本
@@ -411,15 +438,25 @@ func (e *Engine) ValidateScript(script string) error {
 
 // TestScript 测试脚本执行
 func (e *Engine) TestScript(script string, envValue string) *ExecutionResult {
+	return e.TestScriptWithConfig(script, envValue, []byte(`{}`), 10*time.Second)
+}
+
+// TestScriptWithConfig 使用指定配置和超时时间测试脚本执行
+func (e *Engine) TestScriptWithConfig(script string, envValue string, configData []byte, timeout time.Duration) *ExecutionResult {
+	if len(configData) == 0 {
+		configData = []byte(`{}`)
+	}
+
 	execCtx := &ExecutionContext{
 		PluginID:  0,
 		EnvID:     0,
 		EnvValue:  envValue,
-		Config:    []byte(`{}`),
+		Config:    configData,
 		Timestamp: time.Now().Unix(),
+		DryRun:    true,
 	}
 
-	return e.Execute(context.Background(), script, execCtx, 10*time.Second)
+	return e.Execute(context.Background(), script, execCtx, timeout)
 }
 
 // makeHTTPRequest 执行HTTP请求
@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ginprometheus "github.com/zsais/go-gin-prometheus"
+)
+
+// Metrics 插件执行指标，需要在创建 gin-prometheus 中间件时作为自定义指标传入完成注册
+var Metrics = []*ginprometheus.Metric{
+	executionCounter,
+	executionDuration,
+}
+
+var (
+	// executionCounter 按插件与状态统计的执行次数
+	executionCounter = &ginprometheus.Metric{
+		ID:          "pluginExecCnt",
+		Name:        "plugin_executions_total",
+		Description: "插件执行次数（按插件与执行状态区分）",
+		Type:        "counter_vec",
+		Args:        []string{"plugin_id", "status"},
+	}
+	// executionDuration 按插件与状态统计的执行耗时
+	executionDuration = &ginprometheus.Metric{
+		ID:          "pluginExecDur",
+		Name:        "plugin_execution_duration_seconds",
+		Description: "插件执行耗时（秒）",
+		Type:        "histogram_vec",
+		Args:        []string{"plugin_id", "status"},
+	}
+)
+
+// observeExecution 记录一次插件执行的指标
+// 测试执行（插件ID为0）不计入指标，试运行由调用方跳过；指标未注册时跳过
+func observeExecution(pluginID int64, result *ExecutionResult) {
+	if pluginID == 0 {
+		return
+	}
+
+	id := strconv.FormatInt(pluginID, 10)
+	status := result.Status()
+
+	if counter, ok := executionCounter.MetricCollector.(*prometheus.CounterVec); ok {
+		counter.WithLabelValues(id, status).Inc()
+	}
+	if histogram, ok := executionDuration.MetricCollector.(*prometheus.HistogramVec); ok {
+		histogram.WithLabelValues(id, status).Observe(float64(result.ExecutionTime) / 1000)
+	}
+}
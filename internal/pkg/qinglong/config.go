@@ -1,9 +1,9 @@
 package qinglong
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
 	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
 	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
 )
@@ -30,7 +30,8 @@ func NewConfig(url, clientId, clientSecret string) *QlConfig {
 	}
 }
 
-func (cfg *QlConfig) GetConfig() (schema.TokenResponse, error) {
+// GetConfig 使用 ClientID 与 ClientSecret 获取Token
+func (cfg *QlConfig) GetConfig(ctx context.Context) (schema.TokenResponse, error) {
 	var res schema.TokenResponse
 
 	ads := fmt.Sprintf("%s/open/auth/token", cfg.URL)
@@ -41,14 +42,11 @@ func (cfg *QlConfig) GetConfig() (schema.TokenResponse, error) {
 	}
 
 	// 发送请求
-	response, err := cfg.client.Get(ads, params)
+	response, err := cfg.client.Get(ctx, ads, params)
 	if err != nil {
 		return res, err
 	}
 
-	if err = config.JSON.Unmarshal(response.Body(), &res); err != nil {
-		return res, err
-	}
-
-	return res, nil
+	err = decodeResponse(ads, response, &res)
+	return res, err
 }
@@ -0,0 +1,60 @@
+package qinglong
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// 青龙未提供删除配置文件的接口，配置文件只支持列表、读取与保存
+
+// GetConfigFiles 获取配置文件列表
+func (api *QlAPI) GetConfigFiles(ctx context.Context) (schema.ConfigFileResponse, error) {
+	var res schema.ConfigFileResponse
+
+	// http://127.0.0.1:5700/open/configs/files
+	ads := fmt.Sprintf("%s/open/configs/files", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, nil)
+	})
+	return res, err
+}
+
+// GetConfigDetail 获取配置文件内容，name 如 config.sh
+func (api *QlAPI) GetConfigDetail(ctx context.Context, name string) (schema.ConfigDetailResponse, error) {
+	var res schema.ConfigDetailResponse
+
+	// http://127.0.0.1:5700/open/configs/detail?path=config.sh
+	ads := fmt.Sprintf("%s/open/configs/detail", api.URL)
+	params := map[string]string{
+		"path": name,
+	}
+	// 旧版本: http://127.0.0.1:5700/open/configs/config.sh
+	if !api.atLeast(versionFileDetail) {
+		ads = fmt.Sprintf("%s/open/configs/%s", api.URL, url.PathEscape(name))
+		params = nil
+	}
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, params)
+	})
+	return res, err
+}
+
+// SaveConfig 保存配置文件内容
+func (api *QlAPI) SaveConfig(ctx context.Context, cfg schema.SaveConfigRequest) (schema.SaveConfigResponse, error) {
+	var res schema.SaveConfigResponse
+
+	// http://127.0.0.1:5700/open/configs/save
+	ads := fmt.Sprintf("%s/open/configs/save", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Post(ctx, ads, cfg)
+	})
+	return res, err
+}
@@ -0,0 +1,128 @@
+package qinglong
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetCrons 获取定时任务列表
+func (api *QlAPI) GetCrons(ctx context.Context, searchValue string, page, size int) (schema.CronResponse, error) {
+	var res schema.CronResponse
+
+	// http://127.0.0.1:5700/open/crons?searchValue=&page=1&size=20
+	ads := fmt.Sprintf("%s/open/crons", api.URL)
+	params := map[string]string{
+		"searchValue": searchValue,
+	}
+	if page > 0 && size > 0 {
+		params["page"] = strconv.Itoa(page)
+		params["size"] = strconv.Itoa(size)
+	}
+
+	request := func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, params)
+	}
+
+	// 旧版本直接返回定时任务数组，不支持分页
+	if !api.atLeast(versionPagedCrons) {
+		var legacy struct {
+			Code int             `json:"code"`
+			Data []schema.QlCron `json:"data"`
+		}
+		err := api.executeWithRetry(ctx, ads, &legacy, request)
+		res.Code = legacy.Code
+		res.Data.Data = legacy.Data
+		res.Data.Total = len(legacy.Data)
+		return res, err
+	}
+
+	err := api.executeWithRetry(ctx, ads, &res, request)
+	return res, err
+}
+
+// PostCrons 创建定时任务
+func (api *QlAPI) PostCrons(ctx context.Context, cron schema.PostCronRequest) (schema.PostCronResponse, error) {
+	var res schema.PostCronResponse
+
+	ads := fmt.Sprintf("%s/open/crons", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Post(ctx, ads, cron)
+	})
+	return res, err
+}
+
+// PutCrons 更新定时任务
+func (api *QlAPI) PutCrons(ctx context.Context, cron schema.PutCronRequest) (schema.PutCronResponse, error) {
+	var res schema.PutCronResponse
+
+	ads := fmt.Sprintf("%s/open/crons", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Put(ctx, ads, cron)
+	})
+	return res, err
+}
+
+// DeleteCrons 删除定时任务
+func (api *QlAPI) DeleteCrons(ctx context.Context, ids schema.CronIDsRequest) (schema.CronActionResponse, error) {
+	var res schema.CronActionResponse
+
+	ads := fmt.Sprintf("%s/open/crons", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Delete(ctx, ads, ids)
+	})
+	return res, err
+}
+
+// PutEnableCrons 启用定时任务
+func (api *QlAPI) PutEnableCrons(ctx context.Context, ids schema.CronIDsRequest) (schema.CronActionResponse, error) {
+	return api.putCronAction(ctx, "enable", ids)
+}
+
+// PutDisableCrons 禁用定时任务
+func (api *QlAPI) PutDisableCrons(ctx context.Context, ids schema.CronIDsRequest) (schema.CronActionResponse, error) {
+	return api.putCronAction(ctx, "disable", ids)
+}
+
+// PutRunCrons 立即运行定时任务
+func (api *QlAPI) PutRunCrons(ctx context.Context, ids schema.CronIDsRequest) (schema.CronActionResponse, error) {
+	return api.putCronAction(ctx, "run", ids)
+}
+
+// PutStopCrons 停止运行中的定时任务
+func (api *QlAPI) PutStopCrons(ctx context.Context, ids schema.CronIDsRequest) (schema.CronActionResponse, error) {
+	return api.putCronAction(ctx, "stop", ids)
+}
+
+// GetCronLog 获取定时任务最近一次运行的日志
+func (api *QlAPI) GetCronLog(ctx context.Context, id int) (schema.CronLogResponse, error) {
+	var res schema.CronLogResponse
+
+	// http://127.0.0.1:5700/open/crons/1/log
+	ads := fmt.Sprintf("%s/open/crons/%d/log", api.URL, id)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, nil)
+	})
+	return res, err
+}
+
+// putCronAction 按ID批量执行定时任务操作
+func (api *QlAPI) putCronAction(ctx context.Context, action string, ids schema.CronIDsRequest) (schema.CronActionResponse, error) {
+	var res schema.CronActionResponse
+
+	// http://127.0.0.1:5700/open/crons/run
+	ads := fmt.Sprintf("%s/open/crons/%s", api.URL, action)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Put(ctx, ads, ids)
+	})
+	return res, err
+}
@@ -0,0 +1,126 @@
+package qinglong
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// 依赖类型
+const (
+	DependencyNodeJS  = 0
+	DependencyPython3 = 1
+	DependencyLinux   = 2
+)
+
+// 依赖状态
+const (
+	DependencyInstalling    = 0
+	DependencyInstalled     = 1
+	DependencyInstallFailed = 2
+	DependencyRemoving      = 3
+	DependencyRemoved       = 4
+	DependencyRemoveFailed  = 5
+	DependencyQueued        = 6
+)
+
+// dependencyTypeNames 依赖类型在查询参数中的名称
+var dependencyTypeNames = map[int]string{
+	DependencyNodeJS:  "nodejs",
+	DependencyPython3: "python3",
+	DependencyLinux:   "linux",
+}
+
+// dependencyStatusTexts 依赖状态说明
+var dependencyStatusTexts = map[int]string{
+	DependencyInstalling:    "安装中",
+	DependencyInstalled:     "已安装",
+	DependencyInstallFailed: "安装失败",
+	DependencyRemoving:      "删除中",
+	DependencyRemoved:       "已删除",
+	DependencyRemoveFailed:  "删除失败",
+	DependencyQueued:        "队列中",
+}
+
+// DependencyTypeName 获取依赖类型名称，未知类型返回空字符串
+func DependencyTypeName(t int) string {
+	return dependencyTypeNames[t]
+}
+
+// DependencyStatusText 获取依赖状态说明
+func DependencyStatusText(status int) string {
+	if text, ok := dependencyStatusTexts[status]; ok {
+		return text
+	}
+	return fmt.Sprintf("未知状态(%d)", status)
+}
+
+// GetDependencies 获取依赖列表
+// depType: 依赖类型（DependencyNodeJS/DependencyPython3/DependencyLinux）
+func (api *QlAPI) GetDependencies(ctx context.Context, searchValue string, depType int) (schema.DependencyResponse, error) {
+	var res schema.DependencyResponse
+
+	// http://127.0.0.1:5700/open/dependencies?searchValue=&type=nodejs
+	ads := fmt.Sprintf("%s/open/dependencies", api.URL)
+	params := map[string]string{
+		"searchValue": searchValue,
+		"type":        DependencyTypeName(depType),
+	}
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, params)
+	})
+	return res, err
+}
+
+// GetDependency 获取依赖详情（含安装日志）
+func (api *QlAPI) GetDependency(ctx context.Context, id int) (schema.DependencyDetailResponse, error) {
+	var res schema.DependencyDetailResponse
+
+	// http://127.0.0.1:5700/open/dependencies/1
+	ads := fmt.Sprintf("%s/open/dependencies/%d", api.URL, id)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, nil)
+	})
+	return res, err
+}
+
+// PostDependencies 安装依赖
+func (api *QlAPI) PostDependencies(ctx context.Context, deps schema.PostDependencyRequest) (schema.PostDependencyResponse, error) {
+	var res schema.PostDependencyResponse
+
+	ads := fmt.Sprintf("%s/open/dependencies", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Post(ctx, ads, deps)
+	})
+	return res, err
+}
+
+// PutReinstallDependencies 重新安装依赖
+func (api *QlAPI) PutReinstallDependencies(ctx context.Context, ids schema.DependencyIDsRequest) (schema.DependencyActionResponse, error) {
+	var res schema.DependencyActionResponse
+
+	ads := fmt.Sprintf("%s/open/dependencies/reinstall", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Put(ctx, ads, ids)
+	})
+	return res, err
+}
+
+// DeleteDependencies 删除（卸载）依赖
+func (api *QlAPI) DeleteDependencies(ctx context.Context, ids schema.DependencyIDsRequest) (schema.DependencyActionResponse, error) {
+	var res schema.DependencyActionResponse
+
+	ads := fmt.Sprintf("%s/open/dependencies", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Delete(ctx, ads, ids)
+	})
+	return res, err
+}
@@ -0,0 +1,74 @@
+package qinglong
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+)
+
+// APIError 青龙接口返回的错误（HTTP状态码非2xx或业务码非200）
+type APIError struct {
+	Endpoint   string // 请求地址
+	StatusCode int    // HTTP状态码
+	Code       int    // 业务响应码
+	Message    string // 错误信息
+}
+
+// Error 实现error接口
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("青龙接口请求失败 [%s] HTTP %d，响应码: %d", e.Endpoint, e.StatusCode, e.Code)
+	}
+	return fmt.Sprintf("青龙接口请求失败 [%s] HTTP %d，响应码: %d，错误信息: %s", e.Endpoint, e.StatusCode, e.Code, e.Message)
+}
+
+// IsUnauthorized 判断是否为认证失败（Token无效或已过期）
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.Code == http.StatusUnauthorized
+}
+
+// IsNotFound 判断是否为资源不存在
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.Code == http.StatusNotFound
+}
+
+// IsAPIError 判断是否为青龙接口返回的错误（区别于网络错误）
+func IsAPIError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr)
+}
+
+// decodeResponse 检查响应状态并解析响应体，非成功响应返回 *APIError
+func decodeResponse(endpoint string, response *resty.Response, out interface{}) error {
+	// 青龙统一响应格式: {code: 200, message: "", data: ...}
+	var envelope struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	_ = config.JSON.Unmarshal(response.Body(), &envelope)
+
+	if response.IsError() || envelope.Code != http.StatusOK {
+		return &APIError{
+			Endpoint:   endpoint,
+			StatusCode: response.StatusCode(),
+			Code:       envelope.Code,
+			Message:    envelope.Message,
+		}
+	}
+
+	if err := config.JSON.Unmarshal(response.Body(), out); err != nil {
+		return fmt.Errorf("解析青龙接口响应失败 [%s]: %w", endpoint, err)
+	}
+	return nil
+}
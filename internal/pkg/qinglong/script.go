@@ -0,0 +1,83 @@
+package qinglong
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetScripts 获取脚本文件列表
+func (api *QlAPI) GetScripts(ctx context.Context) (schema.ScriptResponse, error) {
+	var res schema.ScriptResponse
+
+	// http://127.0.0.1:5700/open/scripts
+	ads := fmt.Sprintf("%s/open/scripts", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, nil)
+	})
+	return res, err
+}
+
+// GetScriptDetail 获取脚本内容
+// filename: 文件名；path: 所在目录（相对脚本根目录，根目录为空）
+func (api *QlAPI) GetScriptDetail(ctx context.Context, filename, path string) (schema.ScriptDetailResponse, error) {
+	var res schema.ScriptDetailResponse
+
+	// http://127.0.0.1:5700/open/scripts/detail?file=test.js&path=
+	ads := fmt.Sprintf("%s/open/scripts/detail", api.URL)
+	params := map[string]string{
+		"file": filename,
+		"path": path,
+	}
+	// 旧版本: http://127.0.0.1:5700/open/scripts/test.js?path=
+	if !api.atLeast(versionFileDetail) {
+		ads = fmt.Sprintf("%s/open/scripts/%s", api.URL, url.PathEscape(filename))
+		delete(params, "file")
+	}
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, params)
+	})
+	return res, err
+}
+
+// PostScripts 创建脚本
+func (api *QlAPI) PostScripts(ctx context.Context, script schema.PostScriptRequest) (schema.ScriptActionResponse, error) {
+	var res schema.ScriptActionResponse
+
+	ads := fmt.Sprintf("%s/open/scripts", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Post(ctx, ads, script)
+	})
+	return res, err
+}
+
+// PutScripts 保存脚本内容，文件不存在时创建
+func (api *QlAPI) PutScripts(ctx context.Context, script schema.PutScriptRequest) (schema.ScriptActionResponse, error) {
+	var res schema.ScriptActionResponse
+
+	ads := fmt.Sprintf("%s/open/scripts", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Put(ctx, ads, script)
+	})
+	return res, err
+}
+
+// DeleteScripts 删除脚本
+func (api *QlAPI) DeleteScripts(ctx context.Context, script schema.DeleteScriptRequest) (schema.ScriptActionResponse, error) {
+	var res schema.ScriptActionResponse
+
+	ads := fmt.Sprintf("%s/open/scripts", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Delete(ctx, ads, script)
+	})
+	return res, err
+}
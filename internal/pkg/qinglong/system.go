@@ -0,0 +1,100 @@
+package qinglong
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// 支持的青龙版本
+const (
+	MinSupportedVersion = "2.11.3" // 最低支持版本，低于此版本的面板接口差异过大
+	RecommendedVersion  = "2.19.2" // 推荐版本
+)
+
+// 已知的接口差异（从该版本起使用新接口）
+const (
+	versionPagedCrons = "2.12.0" // 定时任务列表返回 {data, total}，之前直接返回数组
+	versionFileDetail = "2.16.0" // 脚本与配置文件内容通过 /detail 接口读取，之前为 /:file
+)
+
+// GetSystemInfo 获取青龙系统信息
+func (api *QlAPI) GetSystemInfo(ctx context.Context) (schema.SystemResponse, error) {
+	var res schema.SystemResponse
+
+	// http://127.0.0.1:5700/open/system
+	ads := fmt.Sprintf("%s/open/system", api.URL)
+
+	err := api.executeWithRetry(ctx, ads, &res, func(client *requests.Request) (*resty.Response, error) {
+		return client.Get(ctx, ads, nil)
+	})
+	return res, err
+}
+
+// SetVersion 设置面板版本，用于适配不同版本的接口差异
+func (api *QlAPI) SetVersion(version string) {
+	api.Version = version
+}
+
+// atLeast 判断面板版本是否不低于指定版本，版本未知时按最新版本处理
+func (api *QlAPI) atLeast(version string) bool {
+	if api.Version == "" {
+		return true
+	}
+	return CompareVersion(api.Version, version) >= 0
+}
+
+// CompareVersion 比较两个版本号（忽略前缀v与预发布后缀），a<b 返回-1，a==b 返回0，a>b 返回1
+func CompareVersion(a, b string) int {
+	pa, pb := parseVersion(a), parseVersion(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion 解析版本号为数字段，如 v2.19.2-beta -> [2 19 2]
+func parseVersion(version string) []int {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if idx := strings.IndexAny(version, "-+ "); idx >= 0 {
+		version = version[:idx]
+	}
+
+	parts := strings.Split(version, ".")
+	result := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		result = append(result, n)
+	}
+	return result
+}
+
+// IsSupportedVersion 判断面板版本是否受支持
+func IsSupportedVersion(version string) bool {
+	return CompareVersion(version, MinSupportedVersion) >= 0
+}
+
+// IsRecommendedVersion 判断面板版本是否不低于推荐版本
+func IsRecommendedVersion(version string) bool {
+	return CompareVersion(version, RecommendedVersion) >= 0
+}
@@ -0,0 +1,148 @@
+package qinglong
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	tokenRenewBefore    = 10 * time.Minute // Token到期前多久主动续期
+	tokenRefreshTimeout = 10 * time.Second // 单次刷新Token的超时时长
+)
+
+// TokenRefreshCallback token刷新回调函数类型，返回新Token及其到期时间（Unix秒）
+type TokenRefreshCallback func(ctx context.Context, panelID int64) (newToken string, expiration int, err error)
+
+// TokenHolder 面板Token持有者
+// 同一面板的所有API实例共享一个持有者，刷新通过 singleflight 合并，等待中的请求直接复用新Token
+type TokenHolder struct {
+	PanelID int64 // 面板ID
+
+	mu         sync.RWMutex
+	token      string               // 当前Token
+	expiration int                  // 到期时间（Unix秒），0表示未知
+	refresh    TokenRefreshCallback // 刷新回调函数
+	group      singleflight.Group
+}
+
+// tokenHolders 按面板ID注册的Token持有者
+var (
+	tokenHoldersMu sync.Mutex
+	tokenHolders   = make(map[int64]*TokenHolder)
+)
+
+// NewTokenHolder 创建不参与共享的Token持有者
+func NewTokenHolder(panelID int64, token string, expiration int, refresh TokenRefreshCallback) *TokenHolder {
+	return &TokenHolder{
+		PanelID:    panelID,
+		token:      token,
+		expiration: expiration,
+		refresh:    refresh,
+	}
+}
+
+// AcquireTokenHolder 获取面板共享的Token持有者，不存在时使用传入的Token创建
+// 已存在时忽略传入的Token（持有者中的Token不会比数据库中的旧）
+func AcquireTokenHolder(panelID int64, token string, expiration int, refresh TokenRefreshCallback) *TokenHolder {
+	tokenHoldersMu.Lock()
+	defer tokenHoldersMu.Unlock()
+
+	if holder, ok := tokenHolders[panelID]; ok {
+		return holder
+	}
+	holder := NewTokenHolder(panelID, token, expiration, refresh)
+	tokenHolders[panelID] = holder
+	return holder
+}
+
+// ForgetTokenHolder 移除面板共享的Token持有者，面板连接信息或Token被修改、面板被删除后调用
+func ForgetTokenHolder(panelID int64) {
+	tokenHoldersMu.Lock()
+	defer tokenHoldersMu.Unlock()
+
+	delete(tokenHolders, panelID)
+}
+
+// Token 获取可用的Token，临近到期时主动续期
+// 续期失败但Token尚未过期时继续使用旧Token
+func (h *TokenHolder) Token(ctx context.Context) (string, error) {
+	h.mu.RLock()
+	token, expiration := h.token, h.expiration
+	h.mu.RUnlock()
+
+	if expiration <= 0 || time.Until(time.Unix(int64(expiration), 0)) > tokenRenewBefore {
+		return token, nil
+	}
+
+	newToken, err := h.Refresh(ctx, token)
+	if err != nil {
+		if time.Now().Before(time.Unix(int64(expiration), 0)) {
+			config.Log.Warn(fmt.Sprintf("面板ID %d 的token即将到期，主动续期失败: %v", h.PanelID, err))
+			return token, nil
+		}
+		return "", err
+	}
+	return newToken, nil
+}
+
+// Refresh 刷新Token，staleToken 为调用方认为已失效的Token
+// 若Token已被其他请求刷新则直接返回新Token；并发刷新合并为一次，等待方按自身 ctx 超时返回
+func (h *TokenHolder) Refresh(ctx context.Context, staleToken string) (string, error) {
+	h.mu.RLock()
+	current, refresh := h.token, h.refresh
+	h.mu.RUnlock()
+
+	if current != staleToken {
+		return current, nil
+	}
+
+	// 如果没有设置回调函数或面板ID，无法刷新token
+	if refresh == nil || h.PanelID == 0 {
+		return "", errors.New("token已过期，但未设置刷新回调函数或面板ID")
+	}
+
+	ch := h.group.DoChan("refresh", func() (interface{}, error) {
+		// 使用独立的超时上下文，避免发起刷新的请求被取消后影响其他等待方
+		refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tokenRefreshTimeout)
+		defer cancel()
+
+		config.Log.Info(fmt.Sprintf("开始刷新面板ID %d 的token", h.PanelID))
+		newToken, expiration, err := refresh(refreshCtx, h.PanelID)
+		if err != nil {
+			config.Log.Error(fmt.Sprintf("刷新面板ID %d 的token失败: %v", h.PanelID, err))
+			return nil, err
+		}
+
+		h.mu.Lock()
+		h.token = newToken
+		h.expiration = expiration
+		h.mu.Unlock()
+
+		config.Log.Info(fmt.Sprintf("面板ID %d 的token刷新成功", h.PanelID))
+		return newToken, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return "", fmt.Errorf("刷新token失败: %w", res.Err)
+		}
+		return res.Val.(string), nil
+	case <-ctx.Done():
+		return "", fmt.Errorf("等待token刷新失败: %w", ctx.Err())
+	}
+}
+
+// SetRefreshCallback 设置刷新回调函数
+func (h *TokenHolder) SetRefreshCallback(refresh TokenRefreshCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.refresh = refresh
+}
@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,7 +12,8 @@ import (
 
 // Request HTTP请求客户端结构体
 type Request struct {
-	client *resty.Client // resty客户端实例
+	client  *resty.Client     // resty客户端实例
+	headers map[string]string // 单次请求附加的请求头
 }
 
 // New 创建一个新的HTTP请求客户端
@@ -38,57 +40,78 @@ func (r *Request) SetHeader(header, value string) {
 	r.client.SetHeader(header, value)
 }
 
+// WithHeaders 返回附加请求头的客户端副本（共享底层连接）
+// 与 SetHeader 不同，不会修改共享客户端，适合并发请求按次携带不同的认证信息
+func (r *Request) WithHeaders(headers map[string]string) *Request {
+	return &Request{
+		client:  r.client,
+		headers: headers,
+	}
+}
+
 // Get 发送GET请求
+// ctx: 请求上下文，用于取消与超时（同时中断重试等待）
 // url: 请求地址
 // params: URL查询参数
-func (r *Request) Get(url string, params map[string]string) (*resty.Response, error) {
+func (r *Request) Get(ctx context.Context, url string, params map[string]string) (*resty.Response, error) {
 	// 记录请求日志
 	config.Log.Debug("GET: " + url)
 	config.Log.Debug(fmt.Sprintf("params: %v", params))
 
 	// 发送GET请求
 	return r.client.R().
+		SetContext(ctx).
+		SetHeaders(r.headers).
 		SetQueryParams(params).
 		Get(url)
 }
 
 // Post 发送POST请求
+// ctx: 请求上下文，用于取消与超时（同时中断重试等待）
 // url: 请求地址
 // body: 请求体数据
-func (r *Request) Post(url string, body any) (*resty.Response, error) {
+func (r *Request) Post(ctx context.Context, url string, body any) (*resty.Response, error) {
 	// 记录请求日志
 	config.Log.Debug("POST: " + url)
 	config.Log.Debug(fmt.Sprintf("body: %v", body))
 
 	// 发送POST请求
 	return r.client.R().
+		SetContext(ctx).
+		SetHeaders(r.headers).
 		SetBody(body).
 		Post(url)
 }
 
 // Put 发送PUT请求
+// ctx: 请求上下文，用于取消与超时（同时中断重试等待）
 // url: 请求地址
 // body: 请求体数据
-func (r *Request) Put(url string, body any) (*resty.Response, error) {
+func (r *Request) Put(ctx context.Context, url string, body any) (*resty.Response, error) {
 	// 记录请求日志
 	config.Log.Debug("PUT: " + url)
 	config.Log.Debug(fmt.Sprintf("body: %v", body))
 
 	return r.client.R().
+		SetContext(ctx).
+		SetHeaders(r.headers).
 		SetBody(body).
 		Put(url)
 }
 
 // Delete 发送DELETE请求
+// ctx: 请求上下文，用于取消与超时（同时中断重试等待）
 // url: 请求地址
 // body: 请求体数据
-func (r *Request) Delete(url string, body any) (*resty.Response, error) {
+func (r *Request) Delete(ctx context.Context, url string, body any) (*resty.Response, error) {
 	// 记录请求日志
 	config.Log.Debug("DELETE: " + url)
 	config.Log.Debug(fmt.Sprintf("body: %v", body))
 
 	// 发送DELETE请求
 	return r.client.R().
+		SetContext(ctx).
+		SetHeaders(r.headers).
 		SetBody(body).
 		Delete(url)
 }
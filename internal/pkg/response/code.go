@@ -13,6 +13,7 @@ const (
 	CodeNeedLogin
 	CodeGenericError
 	CodeTooManyRequests
+	CodeRequestInFlight
 )
 
 var codeMsgMap = map[ResCode]string{
@@ -26,6 +27,7 @@ var codeMsgMap = map[ResCode]string{
 	CodeNeedLogin:       "未登录",
 	CodeGenericError:    "Error",
 	CodeTooManyRequests: "请求过于频繁，请稍后再试",
+	CodeRequestInFlight: "请求正在处理中，请稍后重试",
 }
 
 func (c ResCode) Msg() string {
@@ -40,6 +40,16 @@ func ResErrorWithMsg(c *gin.Context, code ResCode, msg any, data ...any) {
 		})
 }
 
+// ResErrorWithStatus 自定义HTTP状态码的错误返回
+func ResErrorWithStatus(c *gin.Context, status int, code ResCode, msg any) {
+	c.JSON(status,
+		&Data{
+			Code: code,
+			Msg:  msg,
+			Data: nil,
+		})
+}
+
 // ResSuccess 返回成功信息
 func ResSuccess(c *gin.Context, data any) {
 	c.JSON(http.StatusOK,
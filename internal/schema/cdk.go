@@ -82,3 +82,28 @@ type ToggleCDKStatusRequest struct {
 type ToggleCDKStatusResponse struct {
 	Message string `json:"message"` // 消息
 }
+
+// GetCDKLedgerRequest 获取卡密额度流水请求结构
+type GetCDKLedgerRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`              // 页码
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"` // 每页数量
+}
+
+// CDKLedgerRecord 卡密额度流水
+type CDKLedgerRecord struct {
+	ID            int64   `json:"id"`             // 流水ID
+	EnvID         int64   `json:"env_id"`         // 环境变量ID
+	Type          string  `json:"type"`           // 流水类型（reserve预扣、commit结算、release退回）
+	Delta         int32   `json:"delta"`          // 额度变动
+	Balance       int32   `json:"balance"`        // 变动后余额
+	ReservationID *int64  `json:"reservation_id"` // 关联的预扣流水ID
+	Settled       bool    `json:"settled"`        // 预扣是否已结算
+	Message       *string `json:"message"`        // 说明
+	CreatedAt     string  `json:"created_at"`     // 创建时间
+}
+
+// GetCDKLedgerResponse 获取卡密额度流水响应结构
+type GetCDKLedgerResponse struct {
+	Total int64             `json:"total"` // 总数
+	List  []CDKLedgerRecord `json:"list"`  // 流水列表
+}
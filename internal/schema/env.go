@@ -2,18 +2,26 @@ package schema
 
 // AddEnvRequest 添加环境变量请求结构
 type AddEnvRequest struct {
-	Name            string  `json:"name" binding:"required"`      // 变量名称
-	Remarks         *string `json:"remarks"`                      // 备注
-	Quantity        int32   `json:"quantity" binding:"required"`  // 负载数量
-	Regex           *string `json:"regex"`                        // 匹配正则
-	Mode            int32   `json:"mode" binding:"required"`      // 模式
-	RegexUpdate     *string `json:"regex_update"`                 // 匹配正则[更新]
-	IsAutoEnvEnable bool    `json:"is_auto_env_enable"`           // 是否自动启用提交的变量
-	EnableKey       bool    `json:"enable_key"`                   // 是否启用KEY
-	CdkLimit        int32   `json:"cdk_limit" binding:"required"` // 单次消耗卡密额度
-	IsPrompt        bool    `json:"is_prompt"`                    // 是否提示
-	PromptLevel     *string `json:"prompt_level"`                 // 提示等级
-	PromptContent   *string `json:"prompt_content"`               // 提示内容
+	Name             string  `json:"name" binding:"required"`      // 变量名称
+	Remarks          *string `json:"remarks"`                      // 备注
+	Quantity         int32   `json:"quantity" binding:"required"`  // 负载数量
+	Regex            *string `json:"regex"`                        // 匹配正则
+	Mode             int32   `json:"mode" binding:"required"`      // 模式
+	RegexUpdate      *string `json:"regex_update"`                 // 匹配正则[更新]
+	IsAutoEnvEnable  bool    `json:"is_auto_env_enable"`           // 是否自动启用提交的变量
+	EnableKey        bool    `json:"enable_key"`                   // 是否启用KEY
+	CdkLimit         int32   `json:"cdk_limit" binding:"required"` // 单次消耗卡密额度
+	IsPrompt         bool    `json:"is_prompt"`                    // 是否提示
+	PromptLevel      *string `json:"prompt_level"`                 // 提示等级
+	PromptContent    *string `json:"prompt_content"`               // 提示内容
+	SelectStrategy   string  `json:"select_strategy"`              // 新建模式面板选择策略（为空时使用least_loaded）
+	Replicas         int32   `json:"replicas"`                     // 副本模式写入的面板数量（0表示所有绑定面板）
+	UpdateScope      string  `json:"update_scope"`                 // 更新模式的更新范围（first、all、dedupe，为空时使用first）
+	ValueTemplate    *string `json:"value_template"`               // 变量值模板，如 pt_key={{pt_key}};pt_pin={{pt_pin}};（为空时使用完整匹配内容）
+	MatchKeyTemplate *string `json:"match_key_template"`           // 更新模式的匹配键模板，引用更新正则的命名分组（为空时使用完整匹配内容）
+	SplitBy          string  `json:"split_by"`                     // 批量提交的分隔符，按整个字符串拆分，如 @@（为空时按换行分隔）
+	DedupBy          string  `json:"dedup_by"`                     // 新建模式重复检测方式：none 不检测，value 按完整变量值，key 按更新正则提取的匹配键（为空时使用none）
+	DedupAction      string  `json:"dedup_action"`                 // 检测到重复时的处理方式：reject 拒绝，update 更新已有变量，allow 继续新建（为空时使用reject）
 }
 
 // AddEnvResponse 添加环境变量响应结构
@@ -24,20 +32,28 @@ type AddEnvResponse struct {
 
 // UpdateEnvRequest 更新环境变量请求结构
 type UpdateEnvRequest struct {
-	ID              int64   `json:"id" binding:"required"`        // 环境变量ID
-	Name            string  `json:"name" binding:"required"`      // 变量名称
-	Remarks         *string `json:"remarks"`                      // 备注
-	Quantity        int32   `json:"quantity" binding:"required"`  // 负载数量
-	Regex           *string `json:"regex"`                        // 匹配正则
-	Mode            int32   `json:"mode" binding:"required"`      // 模式
-	RegexUpdate     *string `json:"regex_update"`                 // 匹配正则[更新]
-	IsAutoEnvEnable bool    `json:"is_auto_env_enable"`           // 是否自动启用提交的变量
-	EnableKey       bool    `json:"enable_key"`                   // 是否启用KEY
-	CdkLimit        int32   `json:"cdk_limit" binding:"required"` // 单次消耗卡密额度
-	IsPrompt        bool    `json:"is_prompt"`                    // 是否提示
-	PromptLevel     *string `json:"prompt_level"`                 // 提示等级
-	PromptContent   *string `json:"prompt_content"`               // 提示内容
-	IsEnable        *bool   `json:"is_enable"`                    // 是否启用（可选）
+	ID               int64   `json:"id" binding:"required"`        // 环境变量ID
+	Name             string  `json:"name" binding:"required"`      // 变量名称
+	Remarks          *string `json:"remarks"`                      // 备注
+	Quantity         int32   `json:"quantity" binding:"required"`  // 负载数量
+	Regex            *string `json:"regex"`                        // 匹配正则
+	Mode             int32   `json:"mode" binding:"required"`      // 模式
+	RegexUpdate      *string `json:"regex_update"`                 // 匹配正则[更新]
+	IsAutoEnvEnable  bool    `json:"is_auto_env_enable"`           // 是否自动启用提交的变量
+	EnableKey        bool    `json:"enable_key"`                   // 是否启用KEY
+	CdkLimit         int32   `json:"cdk_limit" binding:"required"` // 单次消耗卡密额度
+	IsPrompt         bool    `json:"is_prompt"`                    // 是否提示
+	PromptLevel      *string `json:"prompt_level"`                 // 提示等级
+	PromptContent    *string `json:"prompt_content"`               // 提示内容
+	IsEnable         *bool   `json:"is_enable"`                    // 是否启用（可选）
+	SelectStrategy   string  `json:"select_strategy"`              // 新建模式面板选择策略（为空时保持不变）
+	Replicas         *int32  `json:"replicas"`                     // 副本模式写入的面板数量（0表示所有绑定面板，为空时保持不变）
+	UpdateScope      string  `json:"update_scope"`                 // 更新模式的更新范围（first、all、dedupe，为空时保持不变）
+	ValueTemplate    *string `json:"value_template"`               // 变量值模板，如 pt_key={{pt_key}};pt_pin={{pt_pin}};（为空时使用完整匹配内容）
+	MatchKeyTemplate *string `json:"match_key_template"`           // 更新模式的匹配键模板，引用更新正则的命名分组（为空时使用完整匹配内容）
+	SplitBy          *string `json:"split_by"`                     // 批量提交的分隔符，按整个字符串拆分（为空字符串时按换行分隔，不传时保持不变）
+	DedupBy          string  `json:"dedup_by"`                     // 新建模式重复检测方式（none、value、key，为空时保持不变）
+	DedupAction      string  `json:"dedup_action"`                 // 检测到重复时的处理方式（reject、update、allow，为空时保持不变）
 }
 
 // UpdateEnvResponse 更新环境变量响应结构
@@ -47,22 +63,30 @@ type UpdateEnvResponse struct {
 
 // GetEnvResponse 获取环境变量响应结构
 type GetEnvResponse struct {
-	ID              int64   `json:"id"`                 // 环境变量ID
-	Name            string  `json:"name"`               // 变量名称
-	Remarks         *string `json:"remarks"`            // 备注
-	Quantity        int32   `json:"quantity"`           // 负载数量
-	Regex           *string `json:"regex"`              // 匹配正则
-	Mode            int32   `json:"mode"`               // 模式
-	RegexUpdate     *string `json:"regex_update"`       // 匹配正则[更新]
-	IsAutoEnvEnable bool    `json:"is_auto_env_enable"` // 是否自动启用提交的变量
-	EnableKey       bool    `json:"enable_key"`         // 是否启用KEY
-	CdkLimit        int32   `json:"cdk_limit"`          // 单次消耗卡密额度
-	IsPrompt        bool    `json:"is_prompt"`          // 是否提示
-	PromptLevel     *string `json:"prompt_level"`       // 提示等级
-	PromptContent   *string `json:"prompt_content"`     // 提示内容
-	IsEnable        bool    `json:"is_enable"`          // 是否启用
-	CreatedAt       string  `json:"created_at"`         // 创建时间
-	UpdatedAt       string  `json:"updated_at"`         // 更新时间
+	ID               int64   `json:"id"`                 // 环境变量ID
+	Name             string  `json:"name"`               // 变量名称
+	Remarks          *string `json:"remarks"`            // 备注
+	Quantity         int32   `json:"quantity"`           // 负载数量
+	Regex            *string `json:"regex"`              // 匹配正则
+	Mode             int32   `json:"mode"`               // 模式
+	RegexUpdate      *string `json:"regex_update"`       // 匹配正则[更新]
+	IsAutoEnvEnable  bool    `json:"is_auto_env_enable"` // 是否自动启用提交的变量
+	EnableKey        bool    `json:"enable_key"`         // 是否启用KEY
+	CdkLimit         int32   `json:"cdk_limit"`          // 单次消耗卡密额度
+	IsPrompt         bool    `json:"is_prompt"`          // 是否提示
+	PromptLevel      *string `json:"prompt_level"`       // 提示等级
+	PromptContent    *string `json:"prompt_content"`     // 提示内容
+	IsEnable         bool    `json:"is_enable"`          // 是否启用
+	SelectStrategy   string  `json:"select_strategy"`    // 新建模式面板选择策略
+	Replicas         int32   `json:"replicas"`           // 副本模式写入的面板数量（0表示所有绑定面板）
+	UpdateScope      string  `json:"update_scope"`       // 更新模式的更新范围
+	ValueTemplate    *string `json:"value_template"`     // 变量值模板
+	MatchKeyTemplate *string `json:"match_key_template"` // 更新模式的匹配键模板
+	SplitBy          string  `json:"split_by"`           // 批量提交的分隔符
+	DedupBy          string  `json:"dedup_by"`           // 新建模式重复检测方式
+	DedupAction      string  `json:"dedup_action"`       // 检测到重复时的处理方式
+	CreatedAt        string  `json:"created_at"`         // 创建时间
+	UpdatedAt        string  `json:"updated_at"`         // 更新时间
 }
 
 // GetEnvListRequest 获取环境变量列表请求结构
@@ -119,8 +143,32 @@ type GetEnvPanelsRequest struct {
 
 // GetEnvPanelsResponse 获取环境变量关联面板响应结构
 type GetEnvPanelsResponse struct {
-	EnvID    int64   `json:"env_id"`    // 环境变量ID
-	PanelIDs []int64 `json:"panel_ids"` // 关联的面板ID列表
+	EnvID    int64                 `json:"env_id"`    // 环境变量ID
+	PanelIDs []int64               `json:"panel_ids"` // 关联的面板ID列表
+	Bindings []EnvPanelBindingInfo `json:"bindings"`  // 各面板的绑定配置
+}
+
+// EnvPanelBindingInfo 环境变量面板绑定配置
+type EnvPanelBindingInfo struct {
+	PanelID   int64  `json:"panel_id"`   // 面板ID
+	PanelName string `json:"panel_name"` // 面板名称
+	MaxCount  int32  `json:"max_count"`  // 该面板上的最大数量（0表示不限制）
+	Weight    *int   `json:"weight"`     // 绑定权重（为空时按1处理）
+	IsEnable  bool   `json:"is_enable"`  // 是否启用
+}
+
+// UpdateEnvPanelBindingRequest 更新环境变量面板绑定配置请求结构
+type UpdateEnvPanelBindingRequest struct {
+	EnvID    int64  `json:"env_id" binding:"required"`           // 环境变量ID
+	PanelID  int64  `json:"panel_id" binding:"required"`         // 面板ID
+	MaxCount *int32 `json:"max_count" binding:"omitempty,min=0"` // 该面板上的最大数量（可选，0表示不限制）
+	Weight   *int   `json:"weight" binding:"omitempty,min=0"`    // 绑定权重（可选，0表示恢复默认权重1）
+	IsEnable *bool  `json:"is_enable"`                           // 是否启用（可选）
+}
+
+// UpdateEnvPanelBindingResponse 更新环境变量面板绑定配置响应结构
+type UpdateEnvPanelBindingResponse struct {
+	Message string `json:"message"` // 消息
 }
 
 // GetEnvPluginsRequest 获取环境变量关联插件请求结构
@@ -142,4 +190,5 @@ type EnvPluginRelationInfo struct {
 	ExecutionOrder int32  `json:"execution_order"` // 执行顺序
 	Config         string `json:"config"`          // 插件配置参数
 	CreatedAt      string `json:"created_at"`      // 创建时间
+	PluginFailureState
 }
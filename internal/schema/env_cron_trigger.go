@@ -0,0 +1,91 @@
+package schema
+
+// EnvCronTriggerInfo 变量定时任务触发配置信息
+type EnvCronTriggerInfo struct {
+	ID          int64   `json:"id"`           // 触发配置ID
+	EnvID       int64   `json:"env_id"`       // 环境变量ID
+	PanelID     int64   `json:"panel_id"`     // 面板ID（0表示变量绑定的所有面板）
+	CronID      *int    `json:"cron_id"`      // 定时任务ID
+	NamePattern *string `json:"name_pattern"` // 定时任务名称匹配正则
+	IsEnable    bool    `json:"is_enable"`    // 是否启用
+	CreatedAt   string  `json:"created_at"`   // 创建时间
+	UpdatedAt   string  `json:"updated_at"`   // 更新时间
+}
+
+// GetEnvCronTriggersRequest 获取变量定时任务触发配置请求结构
+type GetEnvCronTriggersRequest struct {
+	EnvID int64 `json:"env_id"` // 环境变量ID
+}
+
+// GetEnvCronTriggersResponse 获取变量定时任务触发配置响应结构
+type GetEnvCronTriggersResponse struct {
+	EnvID    int64                `json:"env_id"`   // 环境变量ID
+	Triggers []EnvCronTriggerInfo `json:"triggers"` // 触发配置列表
+}
+
+// AddEnvCronTriggerRequest 添加变量定时任务触发配置请求结构
+// CronID 与 NamePattern 二选一：按ID精确触发，或按名称正则匹配面板中的定时任务
+type AddEnvCronTriggerRequest struct {
+	EnvID       int64   `json:"env_id" binding:"required"` // 环境变量ID
+	PanelID     int64   `json:"panel_id"`                  // 面板ID（0表示变量绑定的所有面板）
+	CronID      *int    `json:"cron_id"`                   // 定时任务ID
+	NamePattern *string `json:"name_pattern"`              // 定时任务名称匹配正则
+	IsEnable    *bool   `json:"is_enable"`                 // 是否启用（默认启用）
+}
+
+// AddEnvCronTriggerResponse 添加变量定时任务触发配置响应结构
+type AddEnvCronTriggerResponse struct {
+	ID      int64  `json:"id"`      // 触发配置ID
+	Message string `json:"message"` // 消息
+}
+
+// UpdateEnvCronTriggerRequest 更新变量定时任务触发配置请求结构
+type UpdateEnvCronTriggerRequest struct {
+	ID          int64   `json:"id" binding:"required"` // 触发配置ID
+	PanelID     int64   `json:"panel_id"`              // 面板ID（0表示变量绑定的所有面板）
+	CronID      *int    `json:"cron_id"`               // 定时任务ID
+	NamePattern *string `json:"name_pattern"`          // 定时任务名称匹配正则
+	IsEnable    bool    `json:"is_enable"`             // 是否启用
+}
+
+// UpdateEnvCronTriggerResponse 更新变量定时任务触发配置响应结构
+type UpdateEnvCronTriggerResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// DeleteEnvCronTriggerRequest 删除变量定时任务触发配置请求结构
+type DeleteEnvCronTriggerRequest struct {
+	ID int64 `json:"id"` // 触发配置ID
+}
+
+// DeleteEnvCronTriggerResponse 删除变量定时任务触发配置响应结构
+type DeleteEnvCronTriggerResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// GetCronTriggerLogsRequest 获取定时任务触发日志请求结构
+type GetCronTriggerLogsRequest struct {
+	Page     int    `form:"page" binding:"min=1"`              // 页码
+	PageSize int    `form:"page_size" binding:"min=1,max=100"` // 每页数量
+	EnvID    *int64 `form:"env_id"`                            // 环境变量ID
+	PanelID  *int64 `form:"panel_id"`                          // 面板ID
+	Status   string `form:"status"`                            // 触发状态(success,error,skipped)
+}
+
+// CronTriggerLogInfo 定时任务触发日志信息
+type CronTriggerLogInfo struct {
+	ID        int64   `json:"id"`         // 日志ID
+	EnvID     int64   `json:"env_id"`     // 环境变量ID
+	PanelID   int64   `json:"panel_id"`   // 面板ID
+	TriggerID int64   `json:"trigger_id"` // 触发配置ID
+	CronIDs   *string `json:"cron_ids"`   // 已触发的定时任务ID
+	Status    string  `json:"status"`     // 触发状态
+	Message   *string `json:"message"`    // 结果说明
+	CreatedAt string  `json:"created_at"` // 触发时间
+}
+
+// GetCronTriggerLogsResponse 获取定时任务触发日志响应结构
+type GetCronTriggerLogsResponse struct {
+	Total int64                `json:"total"` // 总数
+	List  []CronTriggerLogInfo `json:"list"`  // 日志列表
+}
@@ -0,0 +1,20 @@
+package schema
+
+// TestEnvExtractRequest 测试变量提取规则请求结构
+type TestEnvExtractRequest struct {
+	Regex            *string `json:"regex"`                    // 匹配正则
+	ValueTemplate    *string `json:"value_template"`           // 变量值模板
+	RegexUpdate      *string `json:"regex_update"`             // 匹配正则[更新]
+	MatchKeyTemplate *string `json:"match_key_template"`       // 匹配键模板
+	Value            string  `json:"value" binding:"required"` // 示例变量值
+}
+
+// TestEnvExtractResponse 测试变量提取规则响应结构
+type TestEnvExtractResponse struct {
+	Matched    bool              `json:"matched"`     // 是否满足匹配正则（未设置匹配正则时为true）
+	Fields     map[string]string `json:"fields"`      // 匹配正则命名分组提取的字段
+	FinalValue string            `json:"final_value"` // 最终提交的变量值
+	KeyMatched bool              `json:"key_matched"` // 最终变量值是否满足更新正则（未设置更新正则时为false）
+	KeyFields  map[string]string `json:"key_fields"`  // 更新正则命名分组提取的字段
+	MatchKey   string            `json:"match_key"`   // 更新模式下用于识别同一变量的匹配键
+}
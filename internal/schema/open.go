@@ -14,16 +14,17 @@ type CheckCDKResponse struct {
 
 // OnlineServiceInfo 在线服务信息
 type OnlineServiceInfo struct {
-	ID             int64   `json:"id"`              // 环境变量ID
-	Name           string  `json:"name"`            // 变量名称
-	Remarks        *string `json:"remarks"`         // 备注
-	Quantity       int32   `json:"quantity"`        // 负载数量
-	EnableKey      bool    `json:"enable_key"`      // 是否启用KEY
-	CdkLimit       int32   `json:"cdk_limit"`       // 单次消耗卡密额度
-	IsPrompt       bool    `json:"is_prompt"`       // 是否提示
-	PromptLevel    *string `json:"prompt_level"`    // 提示等级
-	PromptContent  *string `json:"prompt_content"`  // 提示内容
-	AvailableSlots int32   `json:"available_slots"` // 可用位置数
+	ID                int64   `json:"id"`                 // 环境变量ID
+	Name              string  `json:"name"`               // 变量名称
+	Remarks           *string `json:"remarks"`            // 备注
+	Quantity          int32   `json:"quantity"`           // 负载数量
+	EnableKey         bool    `json:"enable_key"`         // 是否启用KEY
+	CdkLimit          int32   `json:"cdk_limit"`          // 单次消耗卡密额度
+	IsPrompt          bool    `json:"is_prompt"`          // 是否提示
+	PromptLevel       *string `json:"prompt_level"`       // 提示等级
+	PromptContent     *string `json:"prompt_content"`     // 提示内容
+	AvailableSlots    int32   `json:"available_slots"`    // 可用位置数
+	UnreachablePanels []int64 `json:"unreachable_panels"` // 本次未能获取数据的面板ID（其已用位置未计入）
 }
 
 // GetOnlineServicesResponse 获取在线服务响应结构
@@ -39,10 +40,11 @@ type CalculateAvailableSlotsRequest struct {
 
 // CalculateAvailableSlotsResponse 计算剩余位置响应结构
 type CalculateAvailableSlotsResponse struct {
-	EnvID          int64 `json:"env_id"`          // 环境变量ID
-	TotalSlots     int32 `json:"total_slots"`     // 总位置数
-	UsedSlots      int32 `json:"used_slots"`      // 已使用位置数
-	AvailableSlots int32 `json:"available_slots"` // 可用位置数
+	EnvID             int64   `json:"env_id"`             // 环境变量ID
+	TotalSlots        int32   `json:"total_slots"`        // 总位置数
+	UsedSlots         int32   `json:"used_slots"`         // 已使用位置数
+	AvailableSlots    int32   `json:"available_slots"`    // 可用位置数
+	UnreachablePanels []int64 `json:"unreachable_panels"` // 本次未能获取数据的面板ID（其已用位置未计入）
 }
 
 // SubmitVariableRequest 提交变量请求结构
@@ -51,12 +53,82 @@ type SubmitVariableRequest struct {
 	Value   string `json:"value" binding:"required"`  // 变量值
 	Key     string `json:"key"`                       // CDK密钥（如果启用KEY验证）
 	Remarks string `json:"remarks"`                   // 备注
+
+	ClientIP string `json:"-"` // 提交者IP（由控制器填充，用于粘性面板选择）
 }
 
 // SubmitVariableResponse 提交变量响应结构
 type SubmitVariableResponse struct {
-	Success      bool   `json:"success"`       // 是否成功
-	Message      string `json:"message"`       // 消息
-	SubmittedTo  int32  `json:"submitted_to"`  // 提交到的面板数量
-	RemainingCDK int32  `json:"remaining_cdk"` // 剩余CDK次数（如果使用了CDK）
+	Success      bool                `json:"success"`       // 是否成功
+	Message      string              `json:"message"`       // 消息
+	SubmittedTo  int32               `json:"submitted_to"`  // 提交到的面板数量
+	RemainingCDK int32               `json:"remaining_cdk"` // 剩余CDK次数（如果使用了CDK）
+	Duplicate    bool                `json:"duplicate"`     // 是否检测到重复变量（拒绝提交或已更新已有变量）
+	Results      []SubmitPanelResult `json:"results"`       // 各面板的处理结果
+
+	Exhausted bool `json:"-"` // 位置已满或卡密次数不足，相同变量的后续提交也会失败（用于批量提交提前结束）
+}
+
+// SubmitBatchRequest 批量提交变量请求结构
+type SubmitBatchRequest struct {
+	EnvID   int64    `json:"env_id" binding:"required"` // 环境变量ID
+	Value   string   `json:"value"`                     // 包含多个变量值的文本，按变量配置的分隔符拆分
+	Values  []string `json:"values"`                    // 已拆分的变量值列表（传入时忽略 value）
+	Key     string   `json:"key"`                       // CDK密钥（如果启用KEY验证）
+	Remarks string   `json:"remarks"`                   // 备注
+
+	ClientIP string `json:"-"` // 提交者IP（由控制器填充，用于粘性面板选择）
+}
+
+// SubmitBatchResponse 批量提交变量响应结构
+type SubmitBatchResponse struct {
+	Total        int32             `json:"total"`         // 变量值数量
+	Succeeded    int32             `json:"succeeded"`     // 成功数量
+	Failed       int32             `json:"failed"`        // 失败数量
+	Skipped      int32             `json:"skipped"`       // 因位置已满或卡密次数不足而跳过的数量
+	RemainingCDK int32             `json:"remaining_cdk"` // 剩余CDK次数（如果使用了CDK）
+	Items        []SubmitBatchItem `json:"items"`         // 每个变量值的提交结果
+}
+
+// SubmitBatchItem 批量提交中单个变量值的结果
+type SubmitBatchItem struct {
+	Index       int                 `json:"index"`        // 变量值在拆分结果中的序号（从0开始）
+	Success     bool                `json:"success"`      // 是否成功
+	Skipped     bool                `json:"skipped"`      // 是否因前面的变量值位置已满或卡密次数不足而跳过
+	Message     string              `json:"message"`      // 消息
+	SubmittedTo int32               `json:"submitted_to"` // 提交到的面板数量
+	Results     []SubmitPanelResult `json:"results"`      // 各面板的处理结果
+}
+
+// SubmitPanelResult 提交在单个面板上的处理结果
+type SubmitPanelResult struct {
+	PanelID int64 `json:"panel_id"`          // 面板ID
+	Created []int `json:"created,omitempty"` // 新建的青龙变量ID
+	Updated []int `json:"updated,omitempty"` // 更新的青龙变量ID
+	Deleted []int `json:"deleted,omitempty"` // 删除的重复青龙变量ID
+	Failed  []int `json:"failed,omitempty"`  // 处理失败的青龙变量ID
+}
+
+// DryRunSubmitRequest 试运行提交请求结构
+type DryRunSubmitRequest struct {
+	EnvID   int64  `json:"env_id" binding:"required"` // 环境变量ID
+	Value   string `json:"value" binding:"required"`  // 变量值
+	Key     string `json:"key"`                       // CDK密钥（只校验不扣减）
+	Remarks string `json:"remarks"`                   // 备注
+}
+
+// DryRunStep 试运行步骤
+type DryRunStep struct {
+	Step    string      `json:"step"`             // 步骤名称
+	Status  string      `json:"status"`           // 状态：ok/fail/skip
+	Message string      `json:"message"`          // 说明
+	Detail  interface{} `json:"detail,omitempty"` // 步骤详情
+}
+
+// DryRunSubmitResponse 试运行提交响应结构
+type DryRunSubmitResponse struct {
+	WouldSucceed bool         `json:"would_succeed"` // 真实提交是否会成功
+	Message      string       `json:"message"`       // 消息
+	FinalValue   string       `json:"final_value"`   // 经过正则与插件处理后的最终值
+	Steps        []DryRunStep `json:"steps"`         // 步骤追踪
 }
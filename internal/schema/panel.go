@@ -11,8 +11,9 @@ type AddPanelRequest struct {
 
 // AddPanelResponse 添加面板响应结构
 type AddPanelResponse struct {
-	ID      int64  `json:"id"`      // 面板ID
-	Message string `json:"message"` // 消息
+	ID      int64  `json:"id"`                // 面板ID
+	Message string `json:"message"`           // 消息
+	Warning string `json:"warning,omitempty"` // 版本警告
 }
 
 // UpdatePanelRequest 更新面板请求结构
@@ -27,7 +28,8 @@ type UpdatePanelRequest struct {
 
 // UpdatePanelResponse 更新面板响应结构
 type UpdatePanelResponse struct {
-	Message string `json:"message"` // 消息
+	Message string `json:"message"`           // 消息
+	Warning string `json:"warning,omitempty"` // 版本警告
 }
 
 // GetPanelResponse 获取面板响应结构
@@ -42,6 +44,16 @@ type GetPanelResponse struct {
 	Params       int32  `json:"params"`        // Params
 	CreatedAt    string `json:"created_at"`    // 创建时间
 	UpdatedAt    string `json:"updated_at"`    // 更新时间
+
+	QlVersion       *string `json:"ql_version"`        // 青龙版本
+	NodeVersion     *string `json:"node_version"`      // Node版本
+	Uptime          *int64  `json:"uptime"`            // 运行时长（秒）
+	SystemCheckedAt *string `json:"system_checked_at"` // 系统信息更新时间
+
+	HealthStatus        string  `json:"health_status"`        // 健康状态（unknown、healthy、degraded、down）
+	ConsecutiveFailures int     `json:"consecutive_failures"` // 连续检查失败次数
+	HealthCheckedAt     *string `json:"health_checked_at"`    // 最近一次健康检查时间
+	AutoDisabled        bool    `json:"auto_disabled"`        // 是否因持续不可用被自动禁用
 }
 
 // GetPanelListRequest 获取面板列表请求结构
@@ -104,4 +116,7 @@ type TestPanelConnectionResponse struct {
 	Token       string `json:"token"`        // Token（连接成功时返回）
 	Expiration  int    `json:"expiration"`   // Token过期时间（连接成功时返回）
 	ResponseMsg string `json:"response_msg"` // API响应消息（连接失败时的详细信息）
+	Version     string `json:"version"`      // 青龙版本
+	NodeVersion string `json:"node_version"` // Node版本
+	Warning     string `json:"warning"`      // 版本警告
 }
@@ -0,0 +1,53 @@
+package schema
+
+// GetPanelCronsRequest 获取面板定时任务列表请求结构
+type GetPanelCronsRequest struct {
+	SearchValue string `form:"search_value"`                                // 搜索关键字（名称或命令）
+	Page        int    `form:"page" binding:"omitempty,min=1"`              // 页码（为空时返回全部）
+	PageSize    int    `form:"page_size" binding:"omitempty,min=1,max=100"` // 每页数量
+}
+
+// GetPanelCronsResponse 获取面板定时任务列表响应结构
+type GetPanelCronsResponse struct {
+	Total int      `json:"total"` // 总数
+	List  []QlCron `json:"list"`  // 定时任务列表
+}
+
+// CreatePanelCronRequest 创建面板定时任务请求结构
+type CreatePanelCronRequest struct {
+	Name     string   `json:"name"`                        // 任务名称
+	Command  string   `json:"command" binding:"required"`  // 执行命令，如 task xxx.js
+	Schedule string   `json:"schedule" binding:"required"` // 定时规则（cron表达式）
+	Labels   []string `json:"labels"`                      // 标签
+}
+
+// UpdatePanelCronRequest 更新面板定时任务请求结构
+type UpdatePanelCronRequest struct {
+	ID       int      `json:"id" binding:"required"`       // 定时任务ID
+	Name     string   `json:"name"`                        // 任务名称
+	Command  string   `json:"command" binding:"required"`  // 执行命令
+	Schedule string   `json:"schedule" binding:"required"` // 定时规则（cron表达式）
+	Labels   []string `json:"labels"`                      // 标签
+}
+
+// PanelCronResponse 创建/更新面板定时任务响应结构
+type PanelCronResponse struct {
+	Message string `json:"message"` // 消息
+	Cron    QlCron `json:"cron"`    // 定时任务
+}
+
+// PanelCronIDsRequest 批量操作面板定时任务请求结构（删除、启用、禁用、运行、停止）
+type PanelCronIDsRequest struct {
+	IDs []int `json:"ids" binding:"required,min=1"` // 定时任务ID列表
+}
+
+// PanelCronActionResponse 批量操作面板定时任务响应结构
+type PanelCronActionResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// GetPanelCronLogResponse 获取面板定时任务日志响应结构
+type GetPanelCronLogResponse struct {
+	ID  int    `json:"id"`  // 定时任务ID
+	Log string `json:"log"` // 最近一次运行日志
+}
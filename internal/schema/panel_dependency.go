@@ -0,0 +1,84 @@
+package schema
+
+// GetPanelDependenciesRequest 获取面板依赖列表请求结构
+type GetPanelDependenciesRequest struct {
+	Type        *int   `form:"type" binding:"required,min=0,max=2"` // 依赖类型：0 nodejs，1 python3，2 linux
+	SearchValue string `form:"search_value"`                        // 搜索关键字
+}
+
+// GetPanelDependenciesResponse 获取面板依赖列表响应结构
+type GetPanelDependenciesResponse struct {
+	List []QlDependency `json:"list"` // 依赖列表
+}
+
+// PanelDependencyItem 待安装的依赖
+type PanelDependencyItem struct {
+	Name   string `json:"name" binding:"required"`             // 依赖名称（可带版本号）
+	Type   *int   `json:"type" binding:"required,min=0,max=2"` // 依赖类型：0 nodejs，1 python3，2 linux
+	Remark string `json:"remark"`                              // 备注
+}
+
+// InstallPanelDependenciesRequest 安装面板依赖请求结构
+type InstallPanelDependenciesRequest struct {
+	Dependencies []PanelDependencyItem `json:"dependencies" binding:"required,min=1,dive"` // 依赖列表
+}
+
+// InstallPanelDependenciesResponse 安装面板依赖响应结构
+type InstallPanelDependenciesResponse struct {
+	Message string         `json:"message"` // 消息
+	List    []QlDependency `json:"list"`    // 已创建的依赖
+}
+
+// PanelDependencyIDsRequest 批量操作面板依赖请求结构（重新安装、删除）
+type PanelDependencyIDsRequest struct {
+	IDs []int `json:"ids" binding:"required,min=1"` // 依赖ID列表
+}
+
+// PanelDependencyActionResponse 批量操作面板依赖响应结构
+type PanelDependencyActionResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// GetPanelDependencyLogResponse 获取面板依赖安装日志响应结构
+type GetPanelDependencyLogResponse struct {
+	ID         int      `json:"id"`          // 依赖ID
+	Name       string   `json:"name"`        // 依赖名称
+	Status     int      `json:"status"`      // 状态
+	StatusText string   `json:"status_text"` // 状态说明
+	Log        []string `json:"log"`         // 安装日志
+}
+
+// GetDependencyFleetRequest 获取依赖在各面板安装情况请求结构
+type GetDependencyFleetRequest struct {
+	Name string `form:"name" binding:"required"`             // 依赖名称（忽略版本号比较）
+	Type *int   `form:"type" binding:"required,min=0,max=2"` // 依赖类型：0 nodejs，1 python3，2 linux
+}
+
+// DependencyFleetItem 单个面板的依赖安装情况
+type DependencyFleetItem struct {
+	PanelID      int64  `json:"panel_id"`      // 面板ID
+	PanelName    string `json:"panel_name"`    // 面板名称
+	Reachable    bool   `json:"reachable"`     // 面板是否可达
+	Installed    bool   `json:"installed"`     // 是否已安装
+	DependencyID int    `json:"dependency_id"` // 面板中的依赖ID（未找到时为0）
+	Status       *int   `json:"status"`        // 依赖状态（未找到时为空）
+	StatusText   string `json:"status_text"`   // 状态说明
+	Message      string `json:"message"`       // 错误信息
+}
+
+// GetDependencyFleetResponse 获取依赖在各面板安装情况响应结构
+type GetDependencyFleetResponse struct {
+	Name           string                `json:"name"`            // 依赖名称
+	Type           int                   `json:"type"`            // 依赖类型
+	InstalledCount int                   `json:"installed_count"` // 已安装面板数
+	MissingCount   int                   `json:"missing_count"`   // 未安装面板数（不含不可达面板）
+	Panels         []DependencyFleetItem `json:"panels"`          // 各面板情况
+}
+
+// InstallMissingDependencyRequest 在缺少依赖的面板上批量安装请求结构
+type InstallMissingDependencyRequest struct {
+	Name     string  `json:"name" binding:"required"`             // 依赖名称（可带版本号）
+	Type     *int    `json:"type" binding:"required,min=0,max=2"` // 依赖类型：0 nodejs，1 python3，2 linux
+	Remark   string  `json:"remark"`                              // 备注
+	PanelIDs []int64 `json:"panel_ids"`                           // 限定的面板ID（为空时为所有启用的面板）
+}
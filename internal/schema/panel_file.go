@@ -0,0 +1,93 @@
+package schema
+
+// GetPanelScriptsResponse 获取面板脚本列表响应结构
+type GetPanelScriptsResponse struct {
+	List []QlScriptFile `json:"list"` // 脚本文件树
+}
+
+// GetPanelScriptDetailRequest 获取面板脚本内容请求结构
+type GetPanelScriptDetailRequest struct {
+	Filename string `form:"filename" binding:"required"` // 文件名
+	Path     string `form:"path"`                        // 所在目录（根目录为空）
+}
+
+// PanelScriptDetailResponse 获取面板脚本内容响应结构
+type PanelScriptDetailResponse struct {
+	Filename string `json:"filename"` // 文件名
+	Path     string `json:"path"`     // 所在目录
+	Content  string `json:"content"`  // 文件内容
+}
+
+// SavePanelScriptRequest 保存面板脚本请求结构
+type SavePanelScriptRequest struct {
+	Filename string `json:"filename" binding:"required"` // 文件名
+	Path     string `json:"path"`                        // 所在目录（根目录为空）
+	Content  string `json:"content"`                     // 文件内容
+}
+
+// DeletePanelScriptRequest 删除面板脚本请求结构
+type DeletePanelScriptRequest struct {
+	Filename string `json:"filename" binding:"required"` // 文件名或目录名
+	Path     string `json:"path"`                        // 所在目录（根目录为空）
+	Type     string `json:"type"`                        // 删除目录时为 directory
+}
+
+// PanelFileActionResponse 保存/删除面板文件响应结构
+type PanelFileActionResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// GetPanelConfigsResponse 获取面板配置文件列表响应结构
+type GetPanelConfigsResponse struct {
+	List []QlConfigFile `json:"list"` // 配置文件列表
+}
+
+// GetPanelConfigDetailRequest 获取面板配置文件内容请求结构
+type GetPanelConfigDetailRequest struct {
+	Name string `form:"name" binding:"required"` // 配置文件名，如 config.sh
+}
+
+// PanelConfigDetailResponse 获取面板配置文件内容响应结构
+type PanelConfigDetailResponse struct {
+	Name    string `json:"name"`    // 配置文件名
+	Content string `json:"content"` // 文件内容
+}
+
+// SavePanelConfigRequest 保存面板配置文件请求结构
+type SavePanelConfigRequest struct {
+	Name    string `json:"name" binding:"required"` // 配置文件名，如 config.sh
+	Content string `json:"content"`                 // 文件内容
+}
+
+// PushScriptRequest 批量推送脚本请求结构
+type PushScriptRequest struct {
+	PanelIDs []int64 `json:"panel_ids" binding:"required,min=1"` // 目标面板ID列表
+	Filename string  `json:"filename" binding:"required"`        // 文件名
+	Path     string  `json:"path"`                               // 所在目录（根目录为空）
+	Content  string  `json:"content" binding:"required"`         // 文件内容
+}
+
+// PushConfigRequest 批量推送配置请求结构
+type PushConfigRequest struct {
+	PanelIDs []int64 `json:"panel_ids" binding:"required,min=1"`            // 目标面板ID列表
+	Name     string  `json:"name" binding:"required"`                       // 配置文件名，如 config.sh
+	Content  string  `json:"content" binding:"required"`                    // 配置内容或片段
+	Mode     string  `json:"mode" binding:"omitempty,oneof=append replace"` // 推送方式：append 追加片段（默认，已包含时跳过），replace 覆盖整个文件
+}
+
+// PanelPushResult 单个面板的推送结果
+type PanelPushResult struct {
+	PanelID   int64  `json:"panel_id"`   // 面板ID
+	PanelName string `json:"panel_name"` // 面板名称
+	Success   bool   `json:"success"`    // 是否成功
+	Skipped   bool   `json:"skipped"`    // 是否跳过（内容已存在）
+	Message   string `json:"message"`    // 结果说明
+}
+
+// PushFileResponse 批量推送响应结构
+type PushFileResponse struct {
+	Message      string            `json:"message"`       // 消息
+	SuccessCount int               `json:"success_count"` // 成功面板数（含跳过）
+	FailedCount  int               `json:"failed_count"`  // 失败面板数
+	Results      []PanelPushResult `json:"results"`       // 各面板结果
+}
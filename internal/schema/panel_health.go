@@ -0,0 +1,47 @@
+package schema
+
+// GetPanelHealthHistoryRequest 获取面板健康检查记录请求结构
+type GetPanelHealthHistoryRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`              // 页码
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"` // 每页数量
+}
+
+// PanelHealthRecord 面板健康检查记录
+type PanelHealthRecord struct {
+	ID         int64   `json:"id"`          // 记录ID
+	PanelID    int64   `json:"panel_id"`    // 面板ID
+	Status     string  `json:"status"`      // 健康状态（healthy、degraded、down）
+	TokenValid bool    `json:"token_valid"` // Token是否有效
+	Latency    int64   `json:"latency"`     // 响应耗时（毫秒）
+	EnvCount   *int32  `json:"env_count"`   // 环境变量数量
+	Message    *string `json:"message"`     // 检查结果说明
+	CreatedAt  string  `json:"created_at"`  // 检查时间
+}
+
+// GetPanelHealthHistoryResponse 获取面板健康检查记录响应结构
+type GetPanelHealthHistoryResponse struct {
+	Total int64               `json:"total"` // 总数
+	List  []PanelHealthRecord `json:"list"`  // 检查记录
+}
+
+// PanelHealthOverviewItem 面板健康概览
+type PanelHealthOverviewItem struct {
+	PanelID             int64   `json:"panel_id"`             // 面板ID
+	PanelName           string  `json:"panel_name"`           // 面板名称
+	IsEnable            bool    `json:"is_enable"`            // 是否启用
+	AutoDisabled        bool    `json:"auto_disabled"`        // 是否被自动禁用
+	HealthStatus        string  `json:"health_status"`        // 健康状态
+	ConsecutiveFailures int     `json:"consecutive_failures"` // 连续失败次数
+	HealthCheckedAt     *string `json:"health_checked_at"`    // 最近一次检查时间
+}
+
+// GetPanelHealthOverviewResponse 获取面板健康概览响应结构
+type GetPanelHealthOverviewResponse struct {
+	List []PanelHealthOverviewItem `json:"list"` // 面板健康概览
+}
+
+// CheckPanelHealthResponse 立即检查面板健康响应结构
+type CheckPanelHealthResponse struct {
+	Message string            `json:"message"` // 响应消息
+	Record  PanelHealthRecord `json:"record"`  // 本次检查记录
+}
@@ -10,6 +10,7 @@ type CreatePluginRequest struct {
 	TriggerEvent     string `json:"trigger_event" binding:"required"`              // 触发事件
 	ExecutionTimeout int    `json:"execution_timeout" binding:"min=100,max=30000"` // 执行超时时间(毫秒)
 	Priority         int    `json:"priority" binding:"min=1,max=1000"`             // 执行优先级
+	RequireTestsPass bool   `json:"require_tests_pass"`                            // 保存前要求测试用例全部通过
 }
 
 // CreatePluginResponse 创建插件响应结构
@@ -30,6 +31,7 @@ type UpdatePluginRequest struct {
 	ExecutionTimeout int    `json:"execution_timeout" binding:"min=100,max=30000"` // 执行超时时间(毫秒)
 	Priority         int    `json:"priority" binding:"min=1,max=1000"`             // 执行优先级
 	IsEnable         *bool  `json:"is_enable"`                                     // 是否启用（可选）
+	RequireTestsPass *bool  `json:"require_tests_pass"`                            // 保存前要求测试用例全部通过（可选）
 }
 
 // UpdatePluginResponse 更新插件响应结构
@@ -39,18 +41,19 @@ type UpdatePluginResponse struct {
 
 // GetPluginResponse 获取插件响应结构
 type GetPluginResponse struct {
-	ID               int64   `json:"id"`                // 插件ID
-	Name             string  `json:"name"`              // 插件名称
-	Description      *string `json:"description"`       // 插件描述
-	Version          string  `json:"version"`           // 插件版本
-	Author           *string `json:"author"`            // 插件作者
-	ScriptContent    string  `json:"script_content"`    // JavaScript脚本内容
-	TriggerEvent     string  `json:"trigger_event"`     // 触发事件
-	IsEnable         bool    `json:"is_enable"`         // 是否启用
-	ExecutionTimeout int     `json:"execution_timeout"` // 执行超时时间(毫秒)
-	Priority         int     `json:"priority"`          // 执行优先级
-	CreatedAt        string  `json:"created_at"`        // 创建时间
-	UpdatedAt        string  `json:"updated_at"`        // 更新时间
+	ID               int64   `json:"id"`                 // 插件ID
+	Name             string  `json:"name"`               // 插件名称
+	Description      *string `json:"description"`        // 插件描述
+	Version          string  `json:"version"`            // 插件版本
+	Author           *string `json:"author"`             // 插件作者
+	ScriptContent    string  `json:"script_content"`     // JavaScript脚本内容
+	TriggerEvent     string  `json:"trigger_event"`      // 触发事件
+	IsEnable         bool    `json:"is_enable"`          // 是否启用
+	ExecutionTimeout int     `json:"execution_timeout"`  // 执行超时时间(毫秒)
+	Priority         int     `json:"priority"`           // 执行优先级
+	RequireTestsPass bool    `json:"require_tests_pass"` // 保存前要求测试用例全部通过
+	CreatedAt        string  `json:"created_at"`         // 创建时间
+	UpdatedAt        string  `json:"updated_at"`         // 更新时间
 }
 
 // GetPluginListRequest 获取插件列表请求结构
@@ -105,10 +108,13 @@ type TestPluginResponse struct {
 
 // BindPluginToEnvRequest 绑定插件到环境变量请求结构
 type BindPluginToEnvRequest struct {
-	PluginID       int64  `json:"plugin_id" binding:"required"` // 插件ID
-	EnvID          int64  `json:"env_id" binding:"required"`    // 环境变量ID
-	ExecutionOrder int32  `json:"execution_order"`              // 执行顺序
-	Config         string `json:"config"`                       // 插件配置参数
+	PluginID         int64  `json:"plugin_id" binding:"required"`                                                // 插件ID
+	EnvID            int64  `json:"env_id" binding:"required"`                                                   // 环境变量ID
+	ExecutionOrder   int32  `json:"execution_order"`                                                             // 执行顺序
+	Config           string `json:"config"`                                                                      // 插件配置参数
+	FailurePolicy    string `json:"failure_policy" binding:"omitempty,oneof=fail_closed fail_open auto_disable"` // 执行失败处理策略，默认fail_closed
+	FailureThreshold int32  `json:"failure_threshold" binding:"omitempty,min=1"`                                 // 连续失败熔断阈值，默认5
+	CooldownSeconds  int32  `json:"cooldown_seconds" binding:"omitempty,min=1"`                                  // 熔断冷却时长(秒)，默认300
 }
 
 // BindPluginToEnvResponse 绑定插件到环境变量响应结构
@@ -146,6 +152,29 @@ type PluginEnvRelationInfo struct {
 	ExecutionOrder int32   `json:"execution_order"` // 执行顺序
 	Config         *string `json:"config"`          // 插件配置参数
 	CreatedAt      string  `json:"created_at"`      // 创建时间
+	PluginFailureState
+}
+
+// PluginFailureState 插件绑定的失败处理策略与熔断状态
+type PluginFailureState struct {
+	FailurePolicy       string  `json:"failure_policy"`       // 执行失败处理策略(fail_closed,fail_open,auto_disable)
+	FailureThreshold    int32   `json:"failure_threshold"`    // 连续失败熔断阈值
+	CooldownSeconds     int32   `json:"cooldown_seconds"`     // 熔断冷却时长(秒)
+	ConsecutiveFailures int32   `json:"consecutive_failures"` // 连续失败次数
+	CircuitOpen         bool    `json:"circuit_open"`         // 当前是否处于熔断状态
+	DisabledUntil       *string `json:"disabled_until"`       // 熔断截止时间
+	DisabledReason      *string `json:"disabled_reason"`      // 熔断原因
+}
+
+// ResetPluginBreakerRequest 重置插件熔断状态请求结构
+type ResetPluginBreakerRequest struct {
+	PluginID int64 `json:"plugin_id" binding:"required"` // 插件ID
+	EnvID    int64 `json:"env_id" binding:"required"`    // 环境变量ID
+}
+
+// ResetPluginBreakerResponse 重置插件熔断状态响应结构
+type ResetPluginBreakerResponse struct {
+	Message string `json:"message"` // 消息
 }
 
 // GetPluginExecutionLogsRequest 获取插件执行日志请求结构
@@ -179,3 +208,151 @@ type PluginExecutionLogInfo struct {
 	ErrorMessage    *string `json:"error_message"`    // 错误信息
 	CreatedAt       string  `json:"created_at"`       // 创建时间
 }
+
+// PluginTestCaseInfo 插件测试用例信息
+type PluginTestCaseInfo struct {
+	ID            int64   `json:"id"`             // 用例ID
+	PluginID      int64   `json:"plugin_id"`      // 插件ID
+	Name          string  `json:"name"`           // 用例名称
+	InputValue    string  `json:"input_value"`    // 输入的变量值
+	Config        *string `json:"config"`         // 插件配置参数
+	ExpectedBool  *bool   `json:"expected_bool"`  // 期望返回的bool
+	ExpectedEnv   *string `json:"expected_env"`   // 期望返回的env
+	ExpectedError *string `json:"expected_error"` // 期望的错误信息（包含匹配）
+	IsEnable      bool    `json:"is_enable"`      // 是否启用
+	CreatedAt     string  `json:"created_at"`     // 创建时间
+	UpdatedAt     string  `json:"updated_at"`     // 更新时间
+}
+
+// GetPluginTestCasesResponse 获取插件测试用例响应结构
+type GetPluginTestCasesResponse struct {
+	PluginID int64                `json:"plugin_id"` // 插件ID
+	List     []PluginTestCaseInfo `json:"list"`      // 测试用例列表
+}
+
+// CreatePluginTestCaseRequest 创建插件测试用例请求结构
+type CreatePluginTestCaseRequest struct {
+	PluginID      int64   `json:"plugin_id" binding:"required"` // 插件ID
+	Name          string  `json:"name" binding:"required"`      // 用例名称
+	InputValue    string  `json:"input_value"`                  // 输入的变量值
+	Config        *string `json:"config"`                       // 插件配置参数
+	ExpectedBool  *bool   `json:"expected_bool"`                // 期望返回的bool
+	ExpectedEnv   *string `json:"expected_env"`                 // 期望返回的env
+	ExpectedError *string `json:"expected_error"`               // 期望的错误信息（包含匹配）
+}
+
+// CreatePluginTestCaseResponse 创建插件测试用例响应结构
+type CreatePluginTestCaseResponse struct {
+	ID      int64  `json:"id"`      // 用例ID
+	Message string `json:"message"` // 消息
+}
+
+// UpdatePluginTestCaseRequest 更新插件测试用例请求结构
+type UpdatePluginTestCaseRequest struct {
+	ID            int64   `json:"id" binding:"required"`   // 用例ID
+	Name          string  `json:"name" binding:"required"` // 用例名称
+	InputValue    string  `json:"input_value"`             // 输入的变量值
+	Config        *string `json:"config"`                  // 插件配置参数
+	ExpectedBool  *bool   `json:"expected_bool"`           // 期望返回的bool
+	ExpectedEnv   *string `json:"expected_env"`            // 期望返回的env
+	ExpectedError *string `json:"expected_error"`          // 期望的错误信息（包含匹配）
+	IsEnable      *bool   `json:"is_enable"`               // 是否启用（可选）
+}
+
+// UpdatePluginTestCaseResponse 更新插件测试用例响应结构
+type UpdatePluginTestCaseResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// DeletePluginTestCaseResponse 删除插件测试用例响应结构
+type DeletePluginTestCaseResponse struct {
+	Message string `json:"message"` // 消息
+}
+
+// RunPluginTestsRequest 运行插件测试用例请求结构
+type RunPluginTestsRequest struct {
+	PluginID      int64  `json:"plugin_id" binding:"required"` // 插件ID
+	ScriptContent string `json:"script_content"`               // 待测试的脚本内容（为空则使用已保存的脚本）
+}
+
+// RunPluginTestsResponse 运行插件测试用例响应结构
+type RunPluginTestsResponse struct {
+	PluginID  int64                  `json:"plugin_id"`  // 插件ID
+	Total     int                    `json:"total"`      // 用例总数
+	Passed    int                    `json:"passed"`     // 通过数量
+	Failed    int                    `json:"failed"`     // 失败数量
+	AllPassed bool                   `json:"all_passed"` // 是否全部通过
+	Results   []PluginTestCaseResult `json:"results"`    // 用例执行结果
+}
+
+// PluginTestCaseResult 插件测试用例执行结果
+type PluginTestCaseResult struct {
+	CaseID        int64                `json:"case_id"`        // 用例ID
+	Name          string               `json:"name"`           // 用例名称
+	Passed        bool                 `json:"passed"`         // 是否通过
+	ExecutionTime int                  `json:"execution_time"` // 执行耗时(毫秒)
+	OutputData    string               `json:"output_data"`    // 实际输出数据
+	ErrorMessage  string               `json:"error_message"`  // 实际错误信息
+	Diffs         []PluginTestCaseDiff `json:"diffs"`          // 与期望值的差异
+}
+
+// PluginTestCaseDiff 测试用例期望值与实际值的差异
+type PluginTestCaseDiff struct {
+	Field    string `json:"field"`    // 字段(bool,env,error)
+	Expected string `json:"expected"` // 期望值
+	Actual   string `json:"actual"`   // 实际值
+}
+
+// GetPluginStatsRequest 获取插件执行统计请求结构
+type GetPluginStatsRequest struct {
+	PluginID  *int64 `form:"plugin_id"`                                 // 插件ID
+	EnvID     *int64 `form:"env_id"`                                    // 环境变量ID
+	Bucket    string `form:"bucket" binding:"omitempty,oneof=hour day"` // 时间分桶粒度：hour/day，默认hour
+	StartTime string `form:"start_time"`                                // 开始时间，默认24小时前
+	EndTime   string `form:"end_time"`                                  // 结束时间，默认当前时间
+}
+
+// PluginStatsSummary 插件执行统计汇总
+type PluginStatsSummary struct {
+	Total       int64   `json:"total"`        // 执行次数
+	Success     int64   `json:"success"`      // 成功次数
+	Error       int64   `json:"error"`        // 失败次数
+	Timeout     int64   `json:"timeout"`      // 超时次数
+	SuccessRate float64 `json:"success_rate"` // 成功率(0-1)
+	AvgTime     float64 `json:"avg_time"`     // 平均耗时(毫秒)
+	P95Time     int32   `json:"p95_time"`     // P95耗时(毫秒)
+	MaxTime     int32   `json:"max_time"`     // 最大耗时(毫秒)
+}
+
+// PluginStatsItem 单个插件的执行统计
+type PluginStatsItem struct {
+	PluginID   int64  `json:"plugin_id"`   // 插件ID
+	PluginName string `json:"plugin_name"` // 插件名称
+	PluginStatsSummary
+}
+
+// PluginEnvStatsItem 插件在单个环境变量下的执行统计
+type PluginEnvStatsItem struct {
+	PluginID   int64  `json:"plugin_id"`   // 插件ID
+	PluginName string `json:"plugin_name"` // 插件名称
+	EnvID      int64  `json:"env_id"`      // 环境变量ID
+	EnvName    string `json:"env_name"`    // 环境变量名称
+	PluginStatsSummary
+}
+
+// PluginStatsBucket 时间分桶统计
+type PluginStatsBucket struct {
+	Time string `json:"time"` // 分桶起始时间
+	PluginStatsSummary
+}
+
+// GetPluginStatsResponse 获取插件执行统计响应结构
+type GetPluginStatsResponse struct {
+	StartTime string               `json:"start_time"` // 统计开始时间
+	EndTime   string               `json:"end_time"`   // 统计结束时间
+	Bucket    string               `json:"bucket"`     // 分桶粒度
+	Overall   PluginStatsSummary   `json:"overall"`    // 总体统计
+	Plugins   []PluginStatsItem    `json:"plugins"`    // 按插件统计
+	Envs      []PluginEnvStatsItem `json:"envs"`       // 按插件与环境变量统计
+	Buckets   []PluginStatsBucket  `json:"buckets"`    // 按时间分桶统计
+}
@@ -13,20 +13,23 @@ type TokenResponse struct {
 	Message string
 }
 
+// QlEnv 青龙环境变量
+type QlEnv struct {
+	Id        int       `json:"id"`
+	Value     string    `json:"value"`
+	Timestamp string    `json:"timestamp"`
+	Status    int       `json:"status"`
+	Position  int64     `json:"position"`
+	Name      string    `json:"name"`
+	Remarks   string    `json:"remarks"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // EnvResponse 获取环境变量【返回】
 type EnvResponse struct {
-	Code int `json:"code"`
-	Data []struct {
-		Id        int       `json:"id"`
-		Value     string    `json:"value"`
-		Timestamp string    `json:"timestamp"`
-		Status    int       `json:"status"`
-		Position  int64     `json:"position"`
-		Name      string    `json:"name"`
-		Remarks   string    `json:"remarks"`
-		CreatedAt time.Time `json:"createdAt"`
-		UpdatedAt time.Time `json:"updatedAt"`
-	} `json:"data"`
+	Code int     `json:"code"`
+	Data []QlEnv `json:"data"`
 }
 
 // PostEnvRequest 创建环境变量
@@ -99,3 +102,220 @@ type DeleteEnvRequest []int
 type DeleteEnvResponse struct {
 	Code int `json:"code"`
 }
+
+// QlCron 青龙定时任务
+type QlCron struct {
+	Id                int       `json:"id"`
+	Name              string    `json:"name"`
+	Command           string    `json:"command"`
+	Schedule          string    `json:"schedule"`
+	Timestamp         string    `json:"timestamp"`
+	Saved             bool      `json:"saved"`
+	Status            int       `json:"status"`     // 0: 运行中，1: 空闲
+	IsSystem          int       `json:"isSystem"`   // 是否系统任务
+	Pid               *int      `json:"pid"`        // 运行中的进程ID
+	IsDisabled        int       `json:"isDisabled"` // 0: 启用，1: 禁用
+	IsPinned          int       `json:"isPinned"`   // 是否置顶
+	LogPath           string    `json:"log_path"`
+	Labels            []string  `json:"labels"`
+	LastRunningTime   int64     `json:"last_running_time"`
+	LastExecutionTime int64     `json:"last_execution_time"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// CronResponse 获取定时任务列表【返回】
+type CronResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Data  []QlCron `json:"data"`
+		Total int      `json:"total"`
+	} `json:"data"`
+}
+
+// PostCronRequest 创建定时任务
+type PostCronRequest struct {
+	Name     string   `json:"name,omitempty"`
+	Command  string   `json:"command"`
+	Schedule string   `json:"schedule"`
+	Labels   []string `json:"labels,omitempty"`
+}
+
+// PostCronResponse 创建定时任务【返回】
+type PostCronResponse struct {
+	Code int    `json:"code"`
+	Data QlCron `json:"data"`
+}
+
+// PutCronRequest 更新定时任务
+type PutCronRequest struct {
+	Name     string   `json:"name,omitempty"`
+	Command  string   `json:"command"`
+	Schedule string   `json:"schedule"`
+	Labels   []string `json:"labels,omitempty"`
+	Id       int      `json:"id"`
+}
+
+// PutCronResponse 更新定时任务【返回】
+type PutCronResponse struct {
+	Code int    `json:"code"`
+	Data QlCron `json:"data"`
+}
+
+// CronIDsRequest 按ID批量操作定时任务（删除、启用、禁用、运行、停止）
+type CronIDsRequest []int
+
+// CronActionResponse 批量操作定时任务【返回】
+type CronActionResponse struct {
+	Code int `json:"code"`
+}
+
+// CronLogResponse 获取定时任务日志【返回】
+type CronLogResponse struct {
+	Code int    `json:"code"`
+	Data string `json:"data"`
+}
+
+// QlScriptFile 青龙脚本文件（目录时包含子节点）
+type QlScriptFile struct {
+	Title    string         `json:"title"`
+	Value    string         `json:"value"`
+	Key      string         `json:"key"`
+	Type     string         `json:"type"` // file 或 directory
+	Parent   string         `json:"parent"`
+	Mtime    float64        `json:"mtime"`
+	Children []QlScriptFile `json:"children,omitempty"`
+}
+
+// ScriptResponse 获取脚本列表【返回】
+type ScriptResponse struct {
+	Code int            `json:"code"`
+	Data []QlScriptFile `json:"data"`
+}
+
+// ScriptDetailResponse 获取脚本内容【返回】
+type ScriptDetailResponse struct {
+	Code int    `json:"code"`
+	Data string `json:"data"`
+}
+
+// PostScriptRequest 创建脚本
+type PostScriptRequest struct {
+	Filename string `json:"filename"`
+	Path     string `json:"path"`
+	Content  string `json:"content"`
+}
+
+// PutScriptRequest 保存脚本（文件不存在时创建）
+type PutScriptRequest struct {
+	Filename string `json:"filename"`
+	Path     string `json:"path"`
+	Content  string `json:"content"`
+}
+
+// DeleteScriptRequest 删除脚本
+type DeleteScriptRequest struct {
+	Filename string `json:"filename"`
+	Path     string `json:"path"`
+	Type     string `json:"type,omitempty"` // 删除目录时为 directory
+}
+
+// ScriptActionResponse 创建/保存/删除脚本【返回】
+type ScriptActionResponse struct {
+	Code int `json:"code"`
+}
+
+// QlConfigFile 青龙配置文件
+type QlConfigFile struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// ConfigFileResponse 获取配置文件列表【返回】
+type ConfigFileResponse struct {
+	Code int            `json:"code"`
+	Data []QlConfigFile `json:"data"`
+}
+
+// ConfigDetailResponse 获取配置文件内容【返回】
+type ConfigDetailResponse struct {
+	Code int    `json:"code"`
+	Data string `json:"data"`
+}
+
+// SaveConfigRequest 保存配置文件
+type SaveConfigRequest struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// SaveConfigResponse 保存配置文件【返回】
+type SaveConfigResponse struct {
+	Code int `json:"code"`
+}
+
+// QlDependency 青龙依赖
+type QlDependency struct {
+	Id        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`   // 0: nodejs，1: python3，2: linux
+	Status    int       `json:"status"` // 0: 安装中，1: 已安装，2: 安装失败，3: 删除中，4: 已删除，5: 删除失败，6: 队列中
+	Timestamp string    `json:"timestamp"`
+	Log       []string  `json:"log"`
+	Remark    string    `json:"remark"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DependencyResponse 获取依赖列表【返回】
+type DependencyResponse struct {
+	Code int            `json:"code"`
+	Data []QlDependency `json:"data"`
+}
+
+// DependencyDetailResponse 获取依赖详情（含安装日志）【返回】
+type DependencyDetailResponse struct {
+	Code int          `json:"code"`
+	Data QlDependency `json:"data"`
+}
+
+// PostDependency 安装依赖
+type PostDependency struct {
+	Name   string `json:"name"`
+	Type   int    `json:"type"`
+	Remark string `json:"remark,omitempty"`
+}
+
+// PostDependencyRequest 批量安装依赖
+type PostDependencyRequest []PostDependency
+
+// PostDependencyResponse 安装依赖【返回】
+type PostDependencyResponse struct {
+	Code int            `json:"code"`
+	Data []QlDependency `json:"data"`
+}
+
+// DependencyIDsRequest 按ID批量操作依赖（重新安装、删除）
+type DependencyIDsRequest []int
+
+// DependencyActionResponse 批量操作依赖【返回】
+type DependencyActionResponse struct {
+	Code int `json:"code"`
+}
+
+// QlSystemInfo 青龙系统信息
+type QlSystemInfo struct {
+	IsInitialized  bool   `json:"isInitialized"`
+	Version        string `json:"version"`
+	Branch         string `json:"branch"`
+	PublishTime    int64  `json:"publishTime"`
+	LastCommitTime int64  `json:"lastCommitTime"`
+	NodeVersion    string `json:"nodeVersion"` // 部分版本不返回
+	Uptime         int64  `json:"uptime"`      // 运行时长（秒），部分版本不返回
+}
+
+// SystemResponse 获取系统信息【返回】
+type SystemResponse struct {
+	Code int          `json:"code"`
+	Data QlSystemInfo `json:"data"`
+}
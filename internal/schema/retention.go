@@ -0,0 +1,38 @@
+package schema
+
+// RetentionPolicyInfo 数据保留策略信息
+type RetentionPolicyInfo struct {
+	Target      string `json:"target"`        // 数据表标识
+	Description string `json:"description"`   // 说明
+	MaxAge      int    `json:"max_age"`       // 最长保留天数(0为不限制)
+	ErrorMaxAge int    `json:"error_max_age"` // 错误记录最长保留天数(0为与max_age一致)
+	MaxRows     int    `json:"max_rows"`      // 最多保留条数(0为不限制)
+}
+
+// GetRetentionPoliciesResponse 获取数据保留策略响应结构
+type GetRetentionPoliciesResponse struct {
+	Enable         bool                  `json:"enable"`           // 是否启用后台清理
+	Interval       int                   `json:"interval"`         // 清理间隔(分钟)
+	StoreInputData bool                  `json:"store_input_data"` // 执行日志是否保存输入数据
+	Policies       []RetentionPolicyInfo `json:"policies"`         // 生效的保留策略
+}
+
+// PurgeRequest 手动清理请求结构
+type PurgeRequest struct {
+	Target string `json:"target"`                            // 数据表标识，为空时清理全部
+	MaxAge *int   `json:"max_age" binding:"omitempty,min=1"` // 临时覆盖最长保留天数
+}
+
+// PurgeResult 单个数据表的清理结果
+type PurgeResult struct {
+	Target  string `json:"target"`  // 数据表标识
+	Deleted int    `json:"deleted"` // 删除条数
+	Error   string `json:"error"`   // 错误信息
+}
+
+// PurgeResponse 手动清理响应结构
+type PurgeResponse struct {
+	Total   int           `json:"total"`   // 删除总条数
+	Results []PurgeResult `json:"results"` // 各数据表清理结果
+	Message string        `json:"message"` // 消息
+}
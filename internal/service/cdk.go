@@ -144,12 +144,7 @@ func (s *CDKService) UpdateCDK(req schema.UpdateCDKRequest) (*schema.UpdateCDKRe
 
 // GetCDKList 获取CDK列表
 func (s *CDKService) GetCDKList(req schema.GetCDKListRequest) (*schema.GetCDKListResponse, error) {
-	if req.Page <= 0 {
-		req.Page = 1
-	}
-	if req.PageSize <= 0 {
-		req.PageSize = 10
-	}
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 10)
 
 	ctx := context.Background()
 	query := config.Ent.CdKey.Query()
@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config/autoload"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkey"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkledger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// 卡密流水类型
+const (
+	cdkLedgerReserve = "reserve" // 预扣
+	cdkLedgerCommit  = "commit"  // 结算
+	cdkLedgerRelease = "release" // 退回
+)
+
+// errCDKInsufficient 卡密不可用或剩余次数不足
+var errCDKInsufficient = errors.New("卡密不可用或剩余次数不足")
+
+func init() {
+	registerRetentionTarget("cdk-ledger", "卡密额度流水",
+		autoload.RetentionPolicy{MaxAge: 90}, purgeCDKLedger)
+}
+
+// cdkReservation 卡密预扣记录
+type cdkReservation struct {
+	ID      int64 // 预扣流水ID
+	CdkID   int64 // 卡密ID
+	EnvID   int64 // 环境变量ID
+	Amount  int32 // 预扣额度
+	Balance int32 // 预扣后余额
+}
+
+// cdkReservationTimeout 预扣超时时间，超时未结算的预扣会被自动退回
+func cdkReservationTimeout() time.Duration {
+	if timeout := config.Config.Submit.ReservationTimeout; timeout > 0 {
+		return time.Duration(timeout) * time.Second
+	}
+	return 10 * time.Minute
+}
+
+// StartCDKReservationTask 启动超时预扣退回任务
+func StartCDKReservationTask() {
+	go func() {
+		// 启动后先处理一次，退回上次异常退出时遗留的预扣
+		releaseStaleCDKReservations()
+
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			releaseStaleCDKReservations()
+		}
+	}()
+}
+
+// reserveCDK 预扣卡密额度
+// 扣减与余额校验在同一条条件 UPDATE 中完成，并发提交（包括多个实例）时余额不会出现负数
+func reserveCDK(ctx context.Context, key string, amount int32, envID int64) (*cdkReservation, error) {
+	tx, err := config.Ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+
+	affected, err := tx.CdKey.Update().
+		Where(
+			cdkey.KeyEQ(key),
+			cdkey.IsEnableEQ(true),
+			cdkey.CountGTE(amount),
+		).
+		AddCount(-amount).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("预扣卡密额度失败: %w", err)
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return nil, errCDKInsufficient
+	}
+
+	cdk, err := tx.CdKey.Query().Where(cdkey.KeyEQ(key)).Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("查询卡密失败: %w", err)
+	}
+
+	entry, err := tx.CdkLedger.Create().
+		SetCdkID(cdk.ID).
+		SetEnvID(envID).
+		SetType(cdkLedgerReserve).
+		SetDelta(-amount).
+		SetBalance(cdk.Count).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("记录卡密流水失败: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	return &cdkReservation{
+		ID:      entry.ID,
+		CdkID:   cdk.ID,
+		EnvID:   envID,
+		Amount:  amount,
+		Balance: cdk.Count,
+	}, nil
+}
+
+// commitCDKReservation 结算预扣，额度在预扣时已扣减，结算只记录流水
+// 提交锁失效后预扣可能已被超时任务退回，此时重新扣减，余额不足则只记录告警
+func commitCDKReservation(ctx context.Context, r *cdkReservation) error {
+	tx, err := config.Ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+
+	settled, err := tx.CdkLedger.Update().
+		Where(cdkledger.IDEQ(r.ID), cdkledger.SettledEQ(false)).
+		SetSettled(true).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("结算卡密预扣失败: %w", err)
+	}
+
+	delta := int32(0)
+	message := fmt.Sprintf("结算预扣#%d", r.ID)
+	if settled == 0 {
+		// 提交锁续约失败且耗时超过预扣超时时间，额度已被退回，需要重新扣减
+		affected, err := tx.CdKey.Update().
+			Where(cdkey.IDEQ(r.CdkID), cdkey.CountGTE(r.Amount)).
+			AddCount(-r.Amount).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("扣减卡密额度失败: %w", err)
+		}
+		if affected == 0 {
+			config.Log.Warn(fmt.Sprintf("卡密%d预扣#%d已被退回且余额不足，本次提交未扣减额度", r.CdkID, r.ID))
+			message = fmt.Sprintf("预扣#%d已退回，余额不足未扣减", r.ID)
+		} else {
+			delta = -r.Amount
+			message = fmt.Sprintf("预扣#%d已退回，重新扣减", r.ID)
+		}
+	}
+
+	balance, err := cdkBalance(ctx, tx, r.CdkID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.CdkLedger.Create().
+		SetCdkID(r.CdkID).
+		SetEnvID(r.EnvID).
+		SetType(cdkLedgerCommit).
+		SetDelta(delta).
+		SetBalance(balance).
+		SetReservationID(r.ID).
+		SetMessage(message).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("记录卡密流水失败: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	r.Balance = balance
+	return nil
+}
+
+// releaseCDKReservation 退回预扣额度，预扣已结算或已退回时不做处理
+func releaseCDKReservation(ctx context.Context, r *cdkReservation, reason string) error {
+	tx, err := config.Ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+
+	settled, err := tx.CdkLedger.Update().
+		Where(cdkledger.IDEQ(r.ID), cdkledger.SettledEQ(false)).
+		SetSettled(true).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("退回卡密预扣失败: %w", err)
+	}
+	if settled == 0 {
+		_ = tx.Rollback()
+		return nil
+	}
+
+	// 卡密可能已被删除，此时只记录流水
+	if _, err := tx.CdKey.Update().
+		Where(cdkey.IDEQ(r.CdkID)).
+		AddCount(r.Amount).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("退回卡密额度失败: %w", err)
+	}
+
+	balance, err := cdkBalance(ctx, tx, r.CdkID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.CdkLedger.Create().
+		SetCdkID(r.CdkID).
+		SetEnvID(r.EnvID).
+		SetType(cdkLedgerRelease).
+		SetDelta(r.Amount).
+		SetBalance(balance).
+		SetReservationID(r.ID).
+		SetMessage(reason).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("记录卡密流水失败: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	r.Balance = balance
+	return nil
+}
+
+// cdkBalance 查询卡密当前余额，卡密已删除时返回0
+func cdkBalance(ctx context.Context, tx *ent.Tx, cdkID int64) (int32, error) {
+	cdk, err := tx.CdKey.Get(ctx, cdkID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("查询卡密失败: %w", err)
+	}
+	return cdk.Count, nil
+}
+
+// releaseStaleCDKReservations 退回超时未结算的预扣
+// 预扣在持有变量提交锁期间创建与结算，锁仍被持有说明提交尚未结束，此时不退回，避免提交成功后额度被白白退回
+func releaseStaleCDKReservations() {
+	ctx := context.Background()
+	entries, err := config.Ent.CdkLedger.Query().
+		Where(
+			cdkledger.TypeEQ(cdkLedgerReserve),
+			cdkledger.SettledEQ(false),
+			cdkledger.CreatedAtLT(time.Now().Add(-cdkReservationTimeout())),
+		).
+		All(ctx)
+	if err != nil {
+		config.Log.Error(fmt.Sprintf("查询超时卡密预扣失败: %v", err))
+		return
+	}
+
+	for _, e := range entries {
+		held, err := resourceLockHeld(ctx, envSubmitLockName(e.EnvID))
+		if err != nil {
+			config.Log.Error(fmt.Sprintf("检查卡密%d预扣#%d的提交锁失败: %v", e.CdkID, e.ID, err))
+			continue
+		}
+		if held {
+			continue
+		}
+
+		r := &cdkReservation{ID: e.ID, CdkID: e.CdkID, EnvID: e.EnvID, Amount: -e.Delta}
+		if err := releaseCDKReservation(ctx, r, "预扣超时自动退回"); err != nil {
+			config.Log.Error(fmt.Sprintf("退回卡密%d预扣#%d失败: %v", e.CdkID, e.ID, err))
+			continue
+		}
+		config.Log.Info(fmt.Sprintf("卡密%d预扣#%d超时未结算，已退回%d次", e.CdkID, e.ID, r.Amount))
+	}
+}
+
+// GetCDKLedger 获取卡密额度流水
+func (s *CDKService) GetCDKLedger(cdkID int64, req schema.GetCDKLedgerRequest) (*schema.GetCDKLedgerResponse, error) {
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 20)
+
+	ctx := context.Background()
+	query := config.Ent.CdkLedger.Query().Where(cdkledger.CdkIDEQ(cdkID))
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询卡密流水总数失败: %w", err)
+	}
+
+	entries, err := query.Order(ent.Desc(cdkledger.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询卡密流水失败: %w", err)
+	}
+
+	list := make([]schema.CDKLedgerRecord, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, schema.CDKLedgerRecord{
+			ID:            e.ID,
+			EnvID:         e.EnvID,
+			Type:          e.Type,
+			Delta:         e.Delta,
+			Balance:       e.Balance,
+			ReservationID: e.ReservationID,
+			Settled:       e.Settled,
+			Message:       e.Message,
+			CreatedAt:     e.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &schema.GetCDKLedgerResponse{
+		Total: int64(total),
+		List:  list,
+	}, nil
+}
+
+// purgeCDKLedger 清理卡密额度流水，未结算的预留记录不清理
+var purgeCDKLedger = retentionRecords[predicate.CdkLedger]{
+	label: "卡密流水",
+	delete: func(ctx context.Context, ps ...predicate.CdkLedger) (int, error) {
+		return config.Ent.CdkLedger.Delete().Where(ps...).Exec(ctx)
+	},
+	nthNewestID: func(ctx context.Context, offset int) (int64, error) {
+		return config.Ent.CdkLedger.Query().Order(ent.Desc(cdkledger.FieldID)).Offset(offset).FirstID(ctx)
+	},
+	keep: func(time.Time) predicate.CdkLedger {
+		return cdkledger.And(cdkledger.TypeEQ(cdkLedgerReserve), cdkledger.SettledEQ(false))
+	},
+}.purge
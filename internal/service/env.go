@@ -7,10 +7,15 @@ import (
 	"time"
 
 	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	_const "github.com/nuanxinqing123/QLToolsV2/internal/const"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/balancer"
 	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
 )
 
@@ -34,9 +39,21 @@ func (s *EnvService) AddEnv(req schema.AddEnvRequest) (*schema.AddEnvResponse, e
 	if exists {
 		return nil, errors.New("环境变量名称已存在")
 	}
+	if _, err := balancer.Get(req.SelectStrategy); err != nil {
+		return nil, err
+	}
+	if err := validateEnvMode(req.Mode, req.Replicas, req.UpdateScope); err != nil {
+		return nil, err
+	}
+	if err := validateEnvExtract(req.Regex, req.ValueTemplate, req.RegexUpdate, req.MatchKeyTemplate); err != nil {
+		return nil, err
+	}
+	if err := validateEnvDedup(req.DedupBy, req.DedupAction, req.RegexUpdate); err != nil {
+		return nil, err
+	}
 
 	// 创建环境变量记录
-	e, err := config.Ent.Env.Create().
+	builder := config.Ent.Env.Create().
 		SetName(req.Name).
 		SetNillableRemarks(req.Remarks).
 		SetQuantity(req.Quantity).
@@ -49,10 +66,26 @@ func (s *EnvService) AddEnv(req schema.AddEnvRequest) (*schema.AddEnvResponse, e
 		SetIsPrompt(req.IsPrompt).
 		SetNillablePromptLevel(req.PromptLevel).
 		SetNillablePromptContent(req.PromptContent).
+		SetNillableValueTemplate(req.ValueTemplate).
+		SetNillableMatchKeyTemplate(req.MatchKeyTemplate).
+		SetSplitBy(req.SplitBy).
 		SetIsEnable(true).
+		SetReplicas(req.Replicas).
 		SetCreatedAt(time.Now()).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
+		SetUpdatedAt(time.Now())
+	if req.SelectStrategy != "" {
+		builder.SetSelectStrategy(req.SelectStrategy)
+	}
+	if req.UpdateScope != "" {
+		builder.SetUpdateScope(req.UpdateScope)
+	}
+	if req.DedupBy != "" {
+		builder.SetDedupBy(req.DedupBy)
+	}
+	if req.DedupAction != "" {
+		builder.SetDedupAction(req.DedupAction)
+	}
+	e, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("创建环境变量失败: %w", err)
 	}
@@ -91,6 +124,33 @@ func (s *EnvService) UpdateEnv(req schema.UpdateEnvRequest) (*schema.UpdateEnvRe
 		}
 	}
 
+	if _, err := balancer.Get(req.SelectStrategy); err != nil {
+		return nil, err
+	}
+	replicas := e.Replicas
+	if req.Replicas != nil {
+		replicas = *req.Replicas
+	}
+	if err := validateEnvMode(req.Mode, replicas, req.UpdateScope); err != nil {
+		return nil, err
+	}
+	// 未传入的字段保持不变，按更新后的值校验
+	if err := validateEnvExtract(
+		coalesceString(req.Regex, e.Regex),
+		coalesceString(req.ValueTemplate, e.ValueTemplate),
+		coalesceString(req.RegexUpdate, e.RegexUpdate),
+		coalesceString(req.MatchKeyTemplate, e.MatchKeyTemplate),
+	); err != nil {
+		return nil, err
+	}
+	dedupBy := e.DedupBy
+	if req.DedupBy != "" {
+		dedupBy = req.DedupBy
+	}
+	if err := validateEnvDedup(dedupBy, req.DedupAction, coalesceString(req.RegexUpdate, e.RegexUpdate)); err != nil {
+		return nil, err
+	}
+
 	// 执行更新
 	updater := config.Ent.Env.UpdateOneID(req.ID).
 		SetName(req.Name).
@@ -105,11 +165,29 @@ func (s *EnvService) UpdateEnv(req schema.UpdateEnvRequest) (*schema.UpdateEnvRe
 		SetIsPrompt(req.IsPrompt).
 		SetNillablePromptLevel(req.PromptLevel).
 		SetNillablePromptContent(req.PromptContent).
+		SetNillableValueTemplate(req.ValueTemplate).
+		SetNillableMatchKeyTemplate(req.MatchKeyTemplate).
+		SetNillableSplitBy(req.SplitBy).
 		SetUpdatedAt(time.Now())
 
 	if req.IsEnable != nil {
 		updater.SetIsEnable(*req.IsEnable)
 	}
+	if req.SelectStrategy != "" {
+		updater.SetSelectStrategy(req.SelectStrategy)
+	}
+	if req.Replicas != nil {
+		updater.SetReplicas(*req.Replicas)
+	}
+	if req.UpdateScope != "" {
+		updater.SetUpdateScope(req.UpdateScope)
+	}
+	if req.DedupBy != "" {
+		updater.SetDedupBy(req.DedupBy)
+	}
+	if req.DedupAction != "" {
+		updater.SetDedupAction(req.DedupAction)
+	}
 
 	if err := updater.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("更新环境变量失败: %w", err)
@@ -132,33 +210,36 @@ func (s *EnvService) GetEnv(id int64) (*schema.GetEnvResponse, error) {
 	}
 
 	return &schema.GetEnvResponse{
-		ID:              e.ID,
-		Name:            e.Name,
-		Remarks:         e.Remarks,
-		Quantity:        e.Quantity,
-		Regex:           e.Regex,
-		Mode:            e.Mode,
-		RegexUpdate:     e.RegexUpdate,
-		IsAutoEnvEnable: e.IsAutoEnvEnable,
-		EnableKey:       e.EnableKey,
-		CdkLimit:        e.CdkLimit,
-		IsPrompt:        e.IsPrompt,
-		PromptLevel:     e.PromptLevel,
-		PromptContent:   e.PromptContent,
-		IsEnable:        e.IsEnable,
-		CreatedAt:       e.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:       e.UpdatedAt.Format("2006-01-02 15:04:05"),
+		ID:               e.ID,
+		Name:             e.Name,
+		Remarks:          e.Remarks,
+		Quantity:         e.Quantity,
+		Regex:            e.Regex,
+		Mode:             e.Mode,
+		RegexUpdate:      e.RegexUpdate,
+		IsAutoEnvEnable:  e.IsAutoEnvEnable,
+		EnableKey:        e.EnableKey,
+		CdkLimit:         e.CdkLimit,
+		IsPrompt:         e.IsPrompt,
+		PromptLevel:      e.PromptLevel,
+		PromptContent:    e.PromptContent,
+		IsEnable:         e.IsEnable,
+		SelectStrategy:   e.SelectStrategy,
+		Replicas:         e.Replicas,
+		UpdateScope:      e.UpdateScope,
+		ValueTemplate:    e.ValueTemplate,
+		MatchKeyTemplate: e.MatchKeyTemplate,
+		SplitBy:          e.SplitBy,
+		DedupBy:          e.DedupBy,
+		DedupAction:      e.DedupAction,
+		CreatedAt:        e.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        e.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}, nil
 }
 
 // GetEnvList 获取环境变量列表
 func (s *EnvService) GetEnvList(req schema.GetEnvListRequest) (*schema.GetEnvListResponse, error) {
-	if req.Page <= 0 {
-		req.Page = 1
-	}
-	if req.PageSize <= 0 {
-		req.PageSize = 10
-	}
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 10)
 
 	ctx := context.Background()
 	query := config.Ent.Env.Query()
@@ -190,22 +271,30 @@ func (s *EnvService) GetEnvList(req schema.GetEnvListRequest) (*schema.GetEnvLis
 	list := make([]schema.GetEnvResponse, 0, len(envs))
 	for _, e := range envs {
 		list = append(list, schema.GetEnvResponse{
-			ID:              e.ID,
-			Name:            e.Name,
-			Remarks:         e.Remarks,
-			Quantity:        e.Quantity,
-			Regex:           e.Regex,
-			Mode:            e.Mode,
-			RegexUpdate:     e.RegexUpdate,
-			IsAutoEnvEnable: e.IsAutoEnvEnable,
-			EnableKey:       e.EnableKey,
-			CdkLimit:        e.CdkLimit,
-			IsPrompt:        e.IsPrompt,
-			PromptLevel:     e.PromptLevel,
-			PromptContent:   e.PromptContent,
-			IsEnable:        e.IsEnable,
-			CreatedAt:       e.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:       e.UpdatedAt.Format("2006-01-02 15:04:05"),
+			ID:               e.ID,
+			Name:             e.Name,
+			Remarks:          e.Remarks,
+			Quantity:         e.Quantity,
+			Regex:            e.Regex,
+			Mode:             e.Mode,
+			RegexUpdate:      e.RegexUpdate,
+			IsAutoEnvEnable:  e.IsAutoEnvEnable,
+			EnableKey:        e.EnableKey,
+			CdkLimit:         e.CdkLimit,
+			IsPrompt:         e.IsPrompt,
+			PromptLevel:      e.PromptLevel,
+			PromptContent:    e.PromptContent,
+			IsEnable:         e.IsEnable,
+			SelectStrategy:   e.SelectStrategy,
+			Replicas:         e.Replicas,
+			UpdateScope:      e.UpdateScope,
+			ValueTemplate:    e.ValueTemplate,
+			MatchKeyTemplate: e.MatchKeyTemplate,
+			SplitBy:          e.SplitBy,
+			DedupBy:          e.DedupBy,
+			DedupAction:      e.DedupAction,
+			CreatedAt:        e.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:        e.UpdatedAt.Format("2006-01-02 15:04:05"),
 		})
 	}
 
@@ -227,8 +316,27 @@ func (s *EnvService) DeleteEnv(req schema.DeleteEnvConfigRequest) (*schema.Delet
 		return nil, fmt.Errorf("查询环境变量失败: %w", err)
 	}
 
-	// Ent 删除会自动处理多对多关联表的清除 (如果定义了正确的外键/级联)
-	// 在我们的 Schema 中，env_panels 是通过 StorageKey 配置的
+	// 删除变量的定时任务触发配置
+	if _, err := config.Ent.EnvCronTrigger.Delete().
+		Where(envcrontrigger.EnvIDEQ(req.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("删除定时任务触发配置失败: %w", err)
+	}
+
+	// 删除变量的面板绑定关系
+	if _, err := config.Ent.EnvPanel.Delete().
+		Where(envpanel.EnvIDEQ(req.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("删除面板绑定关系失败: %w", err)
+	}
+
+	// 删除变量的副本记录
+	if _, err := config.Ent.EnvReplica.Delete().
+		Where(envreplica.EnvIDEQ(req.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("删除副本记录失败: %w", err)
+	}
+
 	if err := config.Ent.Env.DeleteOneID(req.ID).Exec(ctx); err != nil {
 		return nil, fmt.Errorf("删除环境变量失败: %w", err)
 	}
@@ -289,14 +397,41 @@ func (s *EnvService) UpdateEnvPanels(req schema.UpdateEnvPanelsRequest) (*schema
 		}
 	}
 
-	// 更新关联关系 (Ent 会自动处理解绑和新绑定)
-	err = config.Ent.Env.UpdateOneID(req.EnvID).
-		ClearPanels().
-		AddPanelIDs(req.PanelIDs...).
-		Exec(ctx)
-	if err != nil {
+	// 更新关联关系，仍然绑定的面板保留已有的绑定配置
+	if _, err := config.Ent.EnvPanel.Delete().
+		Where(envpanel.EnvIDEQ(req.EnvID), envpanel.PanelIDNotIn(req.PanelIDs...)).
+		Exec(ctx); err != nil {
 		return nil, fmt.Errorf("更新绑定关系失败: %w", err)
 	}
+	// 解绑面板上的副本不再参与同步
+	if _, err := config.Ent.EnvReplica.Delete().
+		Where(envreplica.EnvIDEQ(req.EnvID), envreplica.PanelIDNotIn(req.PanelIDs...)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("删除副本记录失败: %w", err)
+	}
+	existing, err := config.Ent.EnvPanel.Query().
+		Where(envpanel.EnvIDEQ(req.EnvID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询绑定关系失败: %w", err)
+	}
+	bound := make(map[int64]bool, len(existing))
+	for _, b := range existing {
+		bound[b.PanelID] = true
+	}
+	builders := make([]*ent.EnvPanelCreate, 0, len(req.PanelIDs))
+	for _, panelID := range req.PanelIDs {
+		if bound[panelID] {
+			continue
+		}
+		bound[panelID] = true
+		builders = append(builders, config.Ent.EnvPanel.Create().SetEnvID(req.EnvID).SetPanelID(panelID))
+	}
+	if len(builders) > 0 {
+		if err := config.Ent.EnvPanel.CreateBulk(builders...).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("更新绑定关系失败: %w", err)
+		}
+	}
 
 	message := "环境变量面板绑定关系更新成功"
 	if len(req.PanelIDs) == 0 {
@@ -311,25 +446,43 @@ func (s *EnvService) UpdateEnvPanels(req schema.UpdateEnvPanelsRequest) (*schema
 // GetEnvPanels 获取环境变量关联的面板
 func (s *EnvService) GetEnvPanels(req schema.GetEnvPanelsRequest) (*schema.GetEnvPanelsResponse, error) {
 	ctx := context.Background()
-	e, err := config.Ent.Env.Query().
-		Where(env.IDEQ(req.EnvID)).
-		WithPanels().
-		Only(ctx)
+	exists, err := config.Ent.Env.Query().Where(env.IDEQ(req.EnvID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询环境变量失败: %w", err)
+	}
+	if !exists {
+		return nil, errors.New("环境变量不存在")
+	}
+
+	bindings, err := config.Ent.EnvPanel.Query().
+		Where(envpanel.EnvIDEQ(req.EnvID)).
+		WithPanel().
+		Order(ent.Asc(envpanel.FieldPanelID)).
+		All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, errors.New("环境变量不存在")
-		}
 		return nil, fmt.Errorf("查询环境变量关联面板失败: %w", err)
 	}
 
-	panelIDs := make([]int64, 0, len(e.Edges.Panels))
-	for _, p := range e.Edges.Panels {
-		panelIDs = append(panelIDs, p.ID)
+	panelIDs := make([]int64, 0, len(bindings))
+	list := make([]schema.EnvPanelBindingInfo, 0, len(bindings))
+	for _, b := range bindings {
+		panelIDs = append(panelIDs, b.PanelID)
+		info := schema.EnvPanelBindingInfo{
+			PanelID:  b.PanelID,
+			MaxCount: b.MaxCount,
+			Weight:   b.Weight,
+			IsEnable: b.IsEnable,
+		}
+		if b.Edges.Panel != nil {
+			info.PanelName = b.Edges.Panel.Name
+		}
+		list = append(list, info)
 	}
 
 	return &schema.GetEnvPanelsResponse{
 		EnvID:    req.EnvID,
 		PanelIDs: panelIDs,
+		Bindings: list,
 	}, nil
 }
 
@@ -360,6 +513,8 @@ func (s *EnvService) GetEnvPlugins(req schema.GetEnvPluginsRequest) (*schema.Get
 			ExecutionOrder: ep.ExecutionOrder,
 			Config:         configStr,
 			CreatedAt:      ep.CreatedAt.Format("2006-01-02 15:04:05"),
+
+			PluginFailureState: buildPluginFailureState(ep),
 		})
 	}
 
@@ -368,3 +523,21 @@ func (s *EnvService) GetEnvPlugins(req schema.GetEnvPluginsRequest) (*schema.Get
 		Plugins: plugins,
 	}, nil
 }
+
+// validateEnvMode 校验提交模式、副本数量与更新范围，更新范围为空时表示使用默认值或保持不变
+func validateEnvMode(mode, replicas int32, updateScope string) error {
+	switch mode {
+	case _const.CreateMode, _const.UpdateMode, _const.ReplicateMode:
+	default:
+		return fmt.Errorf("不支持的模式: %d", mode)
+	}
+	if replicas < 0 {
+		return errors.New("副本数量不能小于0")
+	}
+	switch updateScope {
+	case "", _const.UpdateScopeFirst, _const.UpdateScopeAll, _const.UpdateScopeDedupe:
+	default:
+		return fmt.Errorf("不支持的更新范围: %s", updateScope)
+	}
+	return nil
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config/autoload"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/crontriggerlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envcrontrigger"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// 定时任务触发状态
+const (
+	cronTriggerSuccess = "success"
+	cronTriggerError   = "error"
+	cronTriggerSkipped = "skipped"
+)
+
+func init() {
+	registerRetentionTarget("cron-trigger-log", "定时任务触发日志",
+		autoload.RetentionPolicy{MaxAge: 30}, purgeCronTriggerLogs)
+}
+
+// GetEnvCronTriggers 获取变量的定时任务触发配置
+func (s *EnvService) GetEnvCronTriggers(req schema.GetEnvCronTriggersRequest) (*schema.GetEnvCronTriggersResponse, error) {
+	ctx := context.Background()
+	triggers, err := config.Ent.EnvCronTrigger.Query().
+		Where(envcrontrigger.EnvIDEQ(req.EnvID)).
+		Order(ent.Asc(envcrontrigger.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询定时任务触发配置失败: %w", err)
+	}
+
+	list := make([]schema.EnvCronTriggerInfo, 0, len(triggers))
+	for _, t := range triggers {
+		list = append(list, schema.EnvCronTriggerInfo{
+			ID:          t.ID,
+			EnvID:       t.EnvID,
+			PanelID:     t.PanelID,
+			CronID:      t.CronID,
+			NamePattern: t.NamePattern,
+			IsEnable:    t.IsEnable,
+			CreatedAt:   t.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:   t.UpdatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &schema.GetEnvCronTriggersResponse{
+		EnvID:    req.EnvID,
+		Triggers: list,
+	}, nil
+}
+
+// AddEnvCronTrigger 添加变量的定时任务触发配置
+func (s *EnvService) AddEnvCronTrigger(req schema.AddEnvCronTriggerRequest) (*schema.AddEnvCronTriggerResponse, error) {
+	ctx := context.Background()
+	exists, err := config.Ent.Env.Query().Where(env.IDEQ(req.EnvID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询环境变量失败: %w", err)
+	}
+	if !exists {
+		return nil, errors.New("环境变量不存在")
+	}
+
+	if err := validateCronTrigger(ctx, req.PanelID, req.CronID, req.NamePattern); err != nil {
+		return nil, err
+	}
+
+	isEnable := true
+	if req.IsEnable != nil {
+		isEnable = *req.IsEnable
+	}
+
+	t, err := config.Ent.EnvCronTrigger.Create().
+		SetEnvID(req.EnvID).
+		SetPanelID(req.PanelID).
+		SetNillableCronID(req.CronID).
+		SetNillableNamePattern(req.NamePattern).
+		SetIsEnable(isEnable).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建定时任务触发配置失败: %w", err)
+	}
+
+	return &schema.AddEnvCronTriggerResponse{
+		ID:      t.ID,
+		Message: "定时任务触发配置添加成功",
+	}, nil
+}
+
+// UpdateEnvCronTrigger 更新变量的定时任务触发配置
+func (s *EnvService) UpdateEnvCronTrigger(req schema.UpdateEnvCronTriggerRequest) (*schema.UpdateEnvCronTriggerResponse, error) {
+	ctx := context.Background()
+	if err := validateCronTrigger(ctx, req.PanelID, req.CronID, req.NamePattern); err != nil {
+		return nil, err
+	}
+
+	updater := config.Ent.EnvCronTrigger.UpdateOneID(req.ID).
+		SetPanelID(req.PanelID).
+		SetIsEnable(req.IsEnable).
+		SetUpdatedAt(time.Now())
+	if req.CronID != nil {
+		updater.SetCronID(*req.CronID).ClearNamePattern()
+	} else {
+		updater.SetNamePattern(*req.NamePattern).ClearCronID()
+	}
+	if err := updater.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("定时任务触发配置不存在")
+		}
+		return nil, fmt.Errorf("更新定时任务触发配置失败: %w", err)
+	}
+
+	return &schema.UpdateEnvCronTriggerResponse{
+		Message: "定时任务触发配置更新成功",
+	}, nil
+}
+
+// DeleteEnvCronTrigger 删除变量的定时任务触发配置
+func (s *EnvService) DeleteEnvCronTrigger(req schema.DeleteEnvCronTriggerRequest) (*schema.DeleteEnvCronTriggerResponse, error) {
+	ctx := context.Background()
+	if err := config.Ent.EnvCronTrigger.DeleteOneID(req.ID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("定时任务触发配置不存在")
+		}
+		return nil, fmt.Errorf("删除定时任务触发配置失败: %w", err)
+	}
+
+	return &schema.DeleteEnvCronTriggerResponse{
+		Message: "定时任务触发配置删除成功",
+	}, nil
+}
+
+// GetCronTriggerLogs 获取定时任务触发日志
+func (s *EnvService) GetCronTriggerLogs(req schema.GetCronTriggerLogsRequest) (*schema.GetCronTriggerLogsResponse, error) {
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 10)
+
+	ctx := context.Background()
+	query := config.Ent.CronTriggerLog.Query()
+
+	if req.EnvID != nil {
+		query.Where(crontriggerlog.EnvIDEQ(*req.EnvID))
+	}
+	if req.PanelID != nil {
+		query.Where(crontriggerlog.PanelIDEQ(*req.PanelID))
+	}
+	if req.Status != "" {
+		query.Where(crontriggerlog.StatusEQ(req.Status))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询日志总数失败: %w", err)
+	}
+
+	logs, err := query.Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		Order(ent.Desc(crontriggerlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询触发日志失败: %w", err)
+	}
+
+	list := make([]schema.CronTriggerLogInfo, 0, len(logs))
+	for _, l := range logs {
+		list = append(list, schema.CronTriggerLogInfo{
+			ID:        l.ID,
+			EnvID:     l.EnvID,
+			PanelID:   l.PanelID,
+			TriggerID: l.TriggerID,
+			CronIDs:   l.CronIds,
+			Status:    l.Status,
+			Message:   l.Message,
+			CreatedAt: l.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &schema.GetCronTriggerLogsResponse{
+		Total: int64(total),
+		List:  list,
+	}, nil
+}
+
+// validateCronTrigger 校验触发配置：定时任务ID与名称正则二选一，指定的面板必须存在
+func validateCronTrigger(ctx context.Context, panelID int64, cronID *int, namePattern *string) error {
+	hasPattern := namePattern != nil && *namePattern != ""
+	if (cronID == nil) == !hasPattern {
+		return errors.New("定时任务ID与名称正则必须且只能设置一个")
+	}
+	if hasPattern {
+		if _, err := regexp.Compile(*namePattern); err != nil {
+			return fmt.Errorf("名称正则格式错误: %w", err)
+		}
+	}
+
+	if panelID != 0 {
+		exists, err := config.Ent.Panel.Query().Where(panel.IDEQ(panelID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("查询面板失败: %w", err)
+		}
+		if !exists {
+			return errors.New("面板不存在")
+		}
+	}
+	return nil
+}
+
+// triggerEnvCrons 变量提交成功后在接收变量的面板上运行关联的定时任务
+// 实际触发异步执行，不影响提交结果；结果记录到定时任务触发日志
+func (s *OpenService) triggerEnvCrons(envID int64, panelIDs []int64, trace *submitTrace) {
+	if len(panelIDs) == 0 {
+		return
+	}
+
+	ctx := context.Background()
+	triggers, err := config.Ent.EnvCronTrigger.Query().
+		Where(
+			envcrontrigger.EnvIDEQ(envID),
+			envcrontrigger.IsEnableEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		config.Log.Warn(fmt.Sprintf("查询变量%d的定时任务触发配置失败: %v", envID, err))
+		return
+	}
+	if len(triggers) == 0 {
+		return
+	}
+
+	// 试运行只记录将触发的配置
+	if trace.enabled() {
+		trace.add("cron_trigger", stepStatusSkip, fmt.Sprintf("试运行：将在面板%v上触发%d个定时任务配置", panelIDs, len(triggers)),
+			map[string]interface{}{"panel_ids": panelIDs, "trigger_count": len(triggers)})
+		return
+	}
+
+	go func() {
+		for _, panelID := range panelIDs {
+			s.runPanelCronTriggers(envID, panelID, triggers)
+		}
+	}()
+}
+
+// runPanelCronTriggers 在指定面板上运行适用的定时任务触发配置
+func (s *OpenService) runPanelCronTriggers(envID, panelID int64, triggers []*ent.EnvCronTrigger) {
+	applicable := make([]*ent.EnvCronTrigger, 0, len(triggers))
+	needList := false
+	for _, t := range triggers {
+		if t.PanelID == 0 || t.PanelID == panelID {
+			applicable = append(applicable, t)
+			needList = needList || t.NamePattern != nil
+		}
+	}
+	if len(applicable) == 0 {
+		return
+	}
+
+	qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		for _, t := range applicable {
+			recordCronTrigger(envID, panelID, t.ID, nil, cronTriggerError, fmt.Sprintf("创建青龙API实例失败: %v", err))
+		}
+		return
+	}
+
+	// 按名称匹配时需要面板中的定时任务列表，同一面板只获取一次
+	var crons []schema.QlCron
+	if needList {
+		ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+		res, err := qlAPI.GetCrons(ctx, "", 0, 0)
+		cancel()
+		if err != nil {
+			for _, t := range applicable {
+				if t.NamePattern != nil {
+					recordCronTrigger(envID, panelID, t.ID, nil, cronTriggerError, fmt.Sprintf("获取定时任务列表失败: %v", err))
+				}
+			}
+			applicable = filterCronIDTriggers(applicable)
+		} else {
+			crons = res.Data.Data
+		}
+	}
+
+	for _, t := range applicable {
+		cronIDs := resolveTriggerCronIDs(t, crons)
+		if len(cronIDs) == 0 {
+			recordCronTrigger(envID, panelID, t.ID, nil, cronTriggerSkipped, "未匹配到可运行的定时任务")
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+		_, err := qlAPI.PutRunCrons(ctx, cronIDs)
+		cancel()
+		if err != nil {
+			recordCronTrigger(envID, panelID, t.ID, cronIDs, cronTriggerError, fmt.Sprintf("运行定时任务失败: %v", err))
+			continue
+		}
+		config.Log.Info(fmt.Sprintf("变量%d提交后已在面板%d运行定时任务%v", envID, panelID, cronIDs))
+		recordCronTrigger(envID, panelID, t.ID, cronIDs, cronTriggerSuccess, "定时任务已开始运行")
+	}
+}
+
+// filterCronIDTriggers 过滤出按定时任务ID配置的触发项
+func filterCronIDTriggers(triggers []*ent.EnvCronTrigger) []*ent.EnvCronTrigger {
+	result := make([]*ent.EnvCronTrigger, 0, len(triggers))
+	for _, t := range triggers {
+		if t.CronID != nil {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// resolveTriggerCronIDs 解析触发配置对应的定时任务ID，按名称匹配时跳过已禁用的定时任务
+func resolveTriggerCronIDs(t *ent.EnvCronTrigger, crons []schema.QlCron) schema.CronIDsRequest {
+	if t.CronID != nil {
+		return schema.CronIDsRequest{*t.CronID}
+	}
+
+	regex, err := regexp.Compile(*t.NamePattern)
+	if err != nil {
+		return nil
+	}
+	var ids schema.CronIDsRequest
+	for _, c := range crons {
+		if c.IsDisabled == 0 && regex.MatchString(c.Name) {
+			ids = append(ids, c.Id)
+		}
+	}
+	return ids
+}
+
+// recordCronTrigger 记录定时任务触发结果
+func recordCronTrigger(envID, panelID, triggerID int64, cronIDs []int, status, message string) {
+	builder := config.Ent.CronTriggerLog.Create().
+		SetEnvID(envID).
+		SetPanelID(panelID).
+		SetTriggerID(triggerID).
+		SetStatus(status).
+		SetMessage(message)
+	if len(cronIDs) > 0 {
+		ids := make([]string, 0, len(cronIDs))
+		for _, id := range cronIDs {
+			ids = append(ids, strconv.Itoa(id))
+		}
+		builder.SetCronIds(strings.Join(ids, ","))
+	}
+	if err := builder.Exec(context.Background()); err != nil {
+		config.Log.Warn(fmt.Sprintf("记录定时任务触发日志失败: %v", err))
+	}
+	if status == cronTriggerError {
+		config.Log.Warn(fmt.Sprintf("变量%d在面板%d的定时任务触发失败: %s", envID, panelID, message))
+	}
+}
+
+// purgeCronTriggerLogs 清理定时任务触发日志
+var purgeCronTriggerLogs = retentionRecords[predicate.CronTriggerLog]{
+	label: "触发日志",
+	delete: func(ctx context.Context, ps ...predicate.CronTriggerLog) (int, error) {
+		return config.Ent.CronTriggerLog.Delete().Where(ps...).Exec(ctx)
+	},
+	nthNewestID: func(ctx context.Context, offset int) (int64, error) {
+		return config.Ent.CronTriggerLog.Query().Order(ent.Desc(crontriggerlog.FieldID)).Offset(offset).FirstID(ctx)
+	},
+}.purge
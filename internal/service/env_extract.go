@@ -0,0 +1,101 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	_const "github.com/nuanxinqing123/QLToolsV2/internal/const"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/extract"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// TestEnvExtract 使用提取规则处理示例值，规则无效时返回错误
+// 与提交流程一致：先用匹配正则与变量值模板得到最终变量值，再从最终变量值中提取匹配键
+func (s *EnvService) TestEnvExtract(req schema.TestEnvExtractRequest) (*schema.TestEnvExtractResponse, error) {
+	if err := validateEnvExtract(req.Regex, req.ValueTemplate, req.RegexUpdate, req.MatchKeyTemplate); err != nil {
+		return nil, err
+	}
+
+	resp := &schema.TestEnvExtractResponse{
+		Matched:    true,
+		Fields:     map[string]string{},
+		FinalValue: req.Value,
+		KeyFields:  map[string]string{},
+	}
+
+	extractor, _ := newEnvExtractor(req.Regex, req.ValueTemplate)
+	if extractor != nil {
+		m, ok := extractor.Extract(req.Value)
+		if !ok {
+			resp.Matched = false
+			resp.FinalValue = ""
+			return resp, nil
+		}
+		resp.Fields = m.Fields
+		resp.FinalValue, _ = extractor.Render(req.Value)
+	}
+
+	matchKey, _ := newEnvExtractor(req.RegexUpdate, req.MatchKeyTemplate)
+	if matchKey != nil {
+		if m, ok := matchKey.Extract(resp.FinalValue); ok {
+			resp.KeyMatched = true
+			resp.KeyFields = m.Fields
+			resp.MatchKey, _ = matchKey.Render(resp.FinalValue)
+		}
+	}
+
+	return resp, nil
+}
+
+// validateEnvExtract 校验匹配正则与变量值模板、更新正则与匹配键模板
+func validateEnvExtract(regex, valueTemplate, regexUpdate, matchKeyTemplate *string) error {
+	if _, err := newEnvExtractor(regex, valueTemplate); err != nil {
+		return fmt.Errorf("匹配正则: %w", err)
+	}
+	if _, err := newEnvExtractor(regexUpdate, matchKeyTemplate); err != nil {
+		return fmt.Errorf("更新正则: %w", err)
+	}
+	return nil
+}
+
+// newEnvExtractor 根据正则与模板创建提取器，未设置正则时返回 nil
+func newEnvExtractor(pattern, template *string) (*extract.Extractor, error) {
+	if pattern == nil || *pattern == "" {
+		if template != nil && *template != "" {
+			return nil, errors.New("设置模板时必须设置正则表达式")
+		}
+		return nil, nil
+	}
+	tmpl := ""
+	if template != nil {
+		tmpl = *template
+	}
+	return extract.New(*pattern, tmpl)
+}
+
+// coalesceString 请求中传入了值时使用请求的值，否则使用已有的值
+func coalesceString(value, current *string) *string {
+	if value != nil {
+		return value
+	}
+	return current
+}
+
+// validateEnvDedup 校验重复检测配置，按匹配键检测时必须设置更新正则
+func validateEnvDedup(dedupBy, dedupAction string, regexUpdate *string) error {
+	switch dedupBy {
+	case "", _const.DedupByNone, _const.DedupByValue:
+	case _const.DedupByKey:
+		if regexUpdate == nil || *regexUpdate == "" {
+			return errors.New("按匹配键检测重复时必须设置更新正则")
+		}
+	default:
+		return fmt.Errorf("不支持的重复检测方式: %s", dedupBy)
+	}
+	switch dedupAction {
+	case "", _const.DedupReject, _const.DedupUpdate, _const.DedupAllow:
+	default:
+		return fmt.Errorf("不支持的重复处理方式: %s", dedupAction)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	_const "github.com/nuanxinqing123/QLToolsV2/internal/const"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// UpdateEnvPanelBinding 更新环境变量在某个面板上的绑定配置
+func (s *EnvService) UpdateEnvPanelBinding(req schema.UpdateEnvPanelBindingRequest) (*schema.UpdateEnvPanelBindingResponse, error) {
+	ctx := context.Background()
+	exists, err := config.Ent.EnvPanel.Query().
+		Where(envpanel.EnvIDEQ(req.EnvID), envpanel.PanelIDEQ(req.PanelID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询绑定关系失败: %w", err)
+	}
+	if !exists {
+		return nil, errors.New("环境变量未绑定该面板")
+	}
+
+	updater := config.Ent.EnvPanel.Update().
+		Where(envpanel.EnvIDEQ(req.EnvID), envpanel.PanelIDEQ(req.PanelID))
+	if req.MaxCount != nil {
+		updater.SetMaxCount(*req.MaxCount)
+	}
+	if req.Weight != nil {
+		if *req.Weight == 0 {
+			updater.ClearWeight()
+		} else {
+			updater.SetWeight(*req.Weight)
+		}
+	}
+	if req.IsEnable != nil {
+		updater.SetIsEnable(*req.IsEnable)
+	}
+	if _, err := updater.Save(ctx); err != nil {
+		return nil, fmt.Errorf("更新绑定配置失败: %w", err)
+	}
+
+	return &schema.UpdateEnvPanelBindingResponse{
+		Message: "绑定配置更新成功",
+	}, nil
+}
+
+// queryEnvBindings 查询变量已启用且面板也已启用的绑定关系，按面板ID排序
+func queryEnvBindings(ctx context.Context, envID int64) ([]*ent.EnvPanel, error) {
+	bindings, err := config.Ent.EnvPanel.Query().
+		Where(
+			envpanel.EnvIDEQ(envID),
+			envpanel.IsEnableEQ(true),
+			envpanel.HasPanelWith(panel.IsEnableEQ(true)),
+		).
+		Order(ent.Asc(envpanel.FieldPanelID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询绑定面板失败: %w", err)
+	}
+	return bindings, nil
+}
+
+// bindingPanelIDs 提取绑定关系中的面板ID
+func bindingPanelIDs(bindings []*ent.EnvPanel) []int64 {
+	panelIDs := make([]int64, 0, len(bindings))
+	for _, b := range bindings {
+		panelIDs = append(panelIDs, b.PanelID)
+	}
+	return panelIDs
+}
+
+// envSlots 变量位置统计结果
+type envSlots struct {
+	Total       int32
+	Used        int32
+	Available   int32
+	Unreachable []int64
+}
+
+// calculateEnvSlots 根据变量总负载数量与各面板上限计算位置
+// 没有可达的未设上限面板时，可用位置同时受总负载数量与可达面板的剩余上限约束
+// 不可达面板上的数量未知，设置了上限时按上限计为已用，否则使用最近一次拉取的数量；
+// 从未成功拉取过的未设上限面板无法估算，此时不提供可用位置，避免面板恢复后超出总负载数量
+// 副本模式下一次提交会写入多个面板，各面板的数量与上限按副本数折算为提交次数
+func calculateEnvSlots(e *ent.Env, bindings []*ent.EnvPanel, snapshots map[int64]*PanelEnvSnapshot) envSlots {
+	slots := envSlots{Total: e.Quantity, Unreachable: make([]int64, 0)}
+
+	var (
+		allCapped       = len(bindings) > 0
+		uncappedReached = false
+		unknownUsage    = false
+		capTotal        int32
+		capAvailable    int32
+		used            int32
+	)
+	for _, b := range bindings {
+		if b.MaxCount <= 0 {
+			allCapped = false
+		}
+		capTotal += b.MaxCount
+
+		snapshot, ok := snapshots[b.PanelID]
+		if !ok {
+			slots.Unreachable = append(slots.Unreachable, b.PanelID)
+			if b.MaxCount > 0 {
+				used += b.MaxCount
+			} else if count, known := lastKnownEnvCount(b.PanelID, e.Name); known {
+				used += count
+			} else {
+				unknownUsage = true
+			}
+			continue
+		}
+		count := snapshot.Count(e.Name)
+		used += count
+		if b.MaxCount <= 0 {
+			uncappedReached = true
+			continue
+		}
+		if count < b.MaxCount {
+			capAvailable += b.MaxCount - count
+		}
+	}
+
+	factor := replicaFactor(e, len(bindings))
+	slots.Used = (used + factor - 1) / factor
+	if allCapped {
+		slots.Total = min(e.Quantity, capTotal/factor)
+	}
+	slots.Available = e.Quantity - slots.Used
+	if !uncappedReached {
+		slots.Available = min(slots.Available, capAvailable/factor)
+	}
+	if unknownUsage || slots.Available < 0 {
+		slots.Available = 0
+	}
+	return slots
+}
+
+// replicaFactor 每次提交写入的面板数量，非副本模式为1
+func replicaFactor(e *ent.Env, bindingCount int) int32 {
+	if e.Mode != _const.ReplicateMode || bindingCount == 0 {
+		return 1
+	}
+	if e.Replicas > 0 && int(e.Replicas) < bindingCount {
+		return e.Replicas
+	}
+	return int32(bindingCount)
+}
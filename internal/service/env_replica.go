@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/balancer"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// replicaPlan 副本模式的写入计划
+type replicaPlan struct {
+	groupID string
+	updates map[int64]int // 需要更新的副本：面板ID -> 青龙变量ID
+	creates []int64       // 需要新建副本的面板
+	offline []int64       // 副本所在但当前不可达的面板
+}
+
+// replicateVariable 副本模式提交，将变量写入多个面板并记录每个副本的青龙变量ID
+// 设置了更新正则且匹配到已有副本时，同步更新该组所有副本，并在副本被删除或数量不足时补齐
+// 返回成功写入副本的各面板处理结果
+func (s *OpenService) replicateVariable(e *ent.Env, panelIDs []int64, value, remarks, submitter string, trace *submitTrace) ([]schema.SubmitPanelResult, error) {
+	ctx := context.Background()
+	if len(panelIDs) == 0 {
+		trace.add("replicate", stepStatusFail, "没有绑定的面板", nil)
+		return nil, errors.New("没有绑定的面板")
+	}
+
+	snapshots, _ := s.panelService.GetPanelEnvSnapshots(ctx, panelIDs)
+	plan, err := s.planReplicas(ctx, e, panelIDs, snapshots, value, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	// 副本数量不足时按选择策略补齐，不可达的副本仍计入数量，恢复后继续参与同步
+	target := int(replicaFactor(e, len(panelIDs)))
+	if missing := target - len(plan.updates) - len(plan.creates) - len(plan.offline); missing > 0 {
+		exclude := make(map[int64]bool)
+		for panelID := range plan.updates {
+			exclude[panelID] = true
+		}
+		for _, panelID := range append(plan.creates, plan.offline...) {
+			exclude[panelID] = true
+		}
+		selected, err := s.selectReplicaPanels(ctx, e, panelIDs, exclude, missing, submitter)
+		if err != nil {
+			return nil, err
+		}
+		plan.creates = append(plan.creates, selected...)
+	}
+
+	if len(plan.offline) > 0 {
+		trace.add("replicate_offline", stepStatusSkip, fmt.Sprintf("%d个副本所在面板不可达，暂不同步", len(plan.offline)),
+			map[string]interface{}{"panel_ids": plan.offline})
+		config.Log.Warn(fmt.Sprintf("变量%s副本组%s有%d个副本所在面板不可达", e.Name, plan.groupID, len(plan.offline)))
+	}
+
+	// 试运行只记录写入计划
+	if trace.enabled() {
+		var results []schema.SubmitPanelResult
+		for _, panelID := range mapKeys(plan.updates) {
+			qlEnvID := plan.updates[panelID]
+			trace.add("replicate_update", stepStatusSkip, fmt.Sprintf("试运行：将更新面板%d变量%d", panelID, qlEnvID),
+				map[string]interface{}{"panel_id": panelID, "ql_env_id": qlEnvID})
+			results = append(results, schema.SubmitPanelResult{PanelID: panelID, Updated: []int{qlEnvID}})
+		}
+		for _, panelID := range plan.creates {
+			trace.add("replicate_create", stepStatusSkip, fmt.Sprintf("试运行：将新建变量 %s 到面板%d", e.Name, panelID),
+				map[string]interface{}{"panel_id": panelID, "auto_enable": e.IsAutoEnvEnable})
+			results = append(results, schema.SubmitPanelResult{PanelID: panelID})
+		}
+		s.triggerEnvCrons(e.ID, resultPanelIDs(results), trace)
+		return results, nil
+	}
+
+	var results []schema.SubmitPanelResult
+	for _, panelID := range mapKeys(plan.updates) {
+		qlEnvID := plan.updates[panelID]
+		if err := s.updatePanelEnv(panelID, qlEnvID, e.Name, value, remarks); err != nil {
+			config.Log.Warn(fmt.Sprintf("更新面板%d副本%d失败: %v", panelID, qlEnvID, err))
+			continue
+		}
+		if err := saveEnvReplica(ctx, e.ID, plan.groupID, panelID, qlEnvID); err != nil {
+			config.Log.Warn(err.Error())
+		}
+		results = append(results, schema.SubmitPanelResult{PanelID: panelID, Updated: []int{qlEnvID}})
+	}
+	for _, panelID := range plan.creates {
+		qlEnvID, err := s.submitToPanel(panelID, e.Name, value, remarks)
+		if err != nil {
+			config.Log.Warn(fmt.Sprintf("写入面板%d副本失败: %v", panelID, err))
+			continue
+		}
+		if e.IsAutoEnvEnable && qlEnvID > 0 {
+			if err := s.enablePanelEnv(panelID, qlEnvID); err != nil {
+				config.Log.Warn(fmt.Sprintf("自动启用面板%d变量%d失败: %v", panelID, qlEnvID, err))
+			}
+		}
+		if err := saveEnvReplica(ctx, e.ID, plan.groupID, panelID, qlEnvID); err != nil {
+			config.Log.Warn(err.Error())
+		}
+		results = append(results, schema.SubmitPanelResult{PanelID: panelID, Created: []int{qlEnvID}})
+	}
+
+	if len(results) == 0 {
+		return nil, errors.New("所有副本写入失败")
+	}
+	config.Log.Info(fmt.Sprintf("变量%s副本组%s写入%d个面板", e.Name, plan.groupID, len(results)))
+
+	// 运行接收副本的面板上关联的定时任务
+	s.triggerEnvCrons(e.ID, resultPanelIDs(results), trace)
+
+	return results, nil
+}
+
+// planReplicas 根据更新正则查找已有副本并生成写入计划
+func (s *OpenService) planReplicas(ctx context.Context, e *ent.Env, panelIDs []int64, snapshots map[int64]*PanelEnvSnapshot, value string, trace *submitTrace) (*replicaPlan, error) {
+	plan := &replicaPlan{updates: make(map[int64]int)}
+
+	// 在各面板中查找与提交值匹配的变量
+	matched := make(map[int64]int)
+	matchKey, err := newEnvExtractor(e.RegexUpdate, e.MatchKeyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if matchKey != nil {
+		submittedMatch, ok := matchKey.Render(value)
+		if !ok || submittedMatch == "" {
+			trace.add("replicate_match", stepStatusFail, "用户提交的值不匹配更新正则", map[string]string{"regex_update": *e.RegexUpdate})
+			return nil, errors.New("用户提交的值不匹配正则表达式")
+		}
+		for _, panelID := range panelIDs {
+			snapshot, ok := snapshots[panelID]
+			if !ok {
+				continue
+			}
+			for _, env := range snapshot.Envs {
+				if env.Name != e.Name {
+					continue
+				}
+				if key, ok := matchKey.Render(env.Value); ok && key == submittedMatch {
+					matched[panelID] = env.Id
+					break
+				}
+			}
+		}
+	}
+
+	// 匹配到的变量属于已有副本组时，同步该组的全部副本
+	var group []*ent.EnvReplica
+	if len(matched) > 0 {
+		replicas, err := config.Ent.EnvReplica.Query().
+			Where(envreplica.EnvIDEQ(e.ID), envreplica.PanelIDIn(mapKeys(matched)...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询副本记录失败: %w", err)
+		}
+		for _, r := range replicas {
+			if matched[r.PanelID] == r.PanelEnvID {
+				plan.groupID = r.GroupID
+				break
+			}
+		}
+		if plan.groupID != "" {
+			group, err = config.Ent.EnvReplica.Query().
+				Where(envreplica.EnvIDEQ(e.ID), envreplica.GroupIDEQ(plan.groupID)).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("查询副本记录失败: %w", err)
+			}
+		}
+	}
+	if plan.groupID == "" {
+		groupID, err := randomHex(8)
+		if err != nil {
+			return nil, fmt.Errorf("生成副本组ID失败: %w", err)
+		}
+		plan.groupID = groupID
+	}
+
+	bound := make(map[int64]bool, len(panelIDs))
+	for _, panelID := range panelIDs {
+		bound[panelID] = true
+	}
+	for _, r := range group {
+		if !bound[r.PanelID] {
+			continue
+		}
+		snapshot, ok := snapshots[r.PanelID]
+		if !ok {
+			plan.offline = append(plan.offline, r.PanelID)
+			continue
+		}
+		if snapshot.hasEnv(r.PanelEnvID) {
+			plan.updates[r.PanelID] = r.PanelEnvID
+			continue
+		}
+		// 副本已在面板中被删除，重新写入
+		if _, ok := matched[r.PanelID]; !ok {
+			plan.creates = append(plan.creates, r.PanelID)
+		}
+	}
+	// 未记录在副本组中的匹配变量一并更新，并纳入该组
+	for panelID, qlEnvID := range matched {
+		plan.updates[panelID] = qlEnvID
+	}
+
+	if len(plan.updates) > 0 {
+		trace.add("replicate_match", stepStatusOK, fmt.Sprintf("匹配到副本组%s，%d个副本需要同步", plan.groupID, len(plan.updates)),
+			map[string]interface{}{"group_id": plan.groupID, "panels": plan.updates})
+	} else {
+		trace.add("replicate_match", stepStatusSkip, "未匹配到已有副本，将新建副本组", nil)
+	}
+
+	return plan, nil
+}
+
+// selectReplicaPanels 按变量配置的选择策略依次选择 n 个用于写入新副本的面板
+// 面板只受绑定上限约束，变量总负载数量已在计算位置时按副本数折算校验
+func (s *OpenService) selectReplicaPanels(ctx context.Context, e *ent.Env, panelIDs []int64, exclude map[int64]bool, n int, submitter string) ([]int64, error) {
+	strategy, err := balancer.Get(e.SelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 根据健康状态过滤面板
+	healthy, err := filterPanelsByHealth(ctx, panelIDs)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := queryEnvBindings(ctx, e.ID)
+	if err != nil {
+		return nil, err
+	}
+	source := &snapshotPanelSource{panelService: s.panelService, envName: e.Name, bindings: bindings}
+	req := balancer.Request{EnvID: e.ID, Submitter: submitter}
+
+	var selected []int64
+	for len(selected) < n {
+		candidates := make([]int64, 0, len(healthy))
+		for _, panelID := range healthy {
+			if !exclude[panelID] {
+				candidates = append(candidates, panelID)
+			}
+		}
+		best, err := balancer.Select(ctx, source, strategy, req, candidates)
+		if err != nil {
+			if errors.Is(err, balancer.ErrNoCandidate) {
+				break
+			}
+			return nil, err
+		}
+		exclude[best.PanelID] = true
+		selected = append(selected, best.PanelID)
+	}
+	return selected, nil
+}
+
+// saveEnvReplica 记录副本，替换该组在同一面板上的旧记录
+func saveEnvReplica(ctx context.Context, envID int64, groupID string, panelID int64, qlEnvID int) error {
+	if _, err := config.Ent.EnvReplica.Delete().
+		Where(
+			envreplica.PanelIDEQ(panelID),
+			envreplica.Or(
+				envreplica.PanelEnvIDEQ(qlEnvID),
+				envreplica.And(envreplica.EnvIDEQ(envID), envreplica.GroupIDEQ(groupID)),
+			),
+		).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("更新面板%d副本记录失败: %w", panelID, err)
+	}
+	if err := config.Ent.EnvReplica.Create().
+		SetEnvID(envID).
+		SetGroupID(groupID).
+		SetPanelID(panelID).
+		SetPanelEnvID(qlEnvID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("记录面板%d副本失败: %w", panelID, err)
+	}
+	return nil
+}
+
+// mapKeys 返回按升序排列的面板ID
+func mapKeys(m map[int64]int) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// resultPanelIDs 提取处理结果中的面板ID
+func resultPanelIDs(results []schema.SubmitPanelResult) []int64 {
+	panelIDs := make([]int64, 0, len(results))
+	for _, r := range results {
+		panelIDs = append(panelIDs, r.PanelID)
+	}
+	return panelIDs
+}
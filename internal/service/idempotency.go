@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config/autoload"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/idempotencyrecord"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// 幂等记录状态
+const (
+	idempotencyPending = "pending" // 首次请求处理中
+	idempotencyDone    = "done"    // 已完成，窗口内重放结果
+)
+
+// idempotencyKeyMaxLen 幂等键最大长度
+const idempotencyKeyMaxLen = 128
+
+var (
+	// errIdempotencyMismatch 幂等键已被内容不同的请求使用
+	errIdempotencyMismatch = errors.New("幂等键已被内容不同的请求使用")
+	// ErrIdempotencyInFlight 相同幂等键的请求仍在处理中
+	ErrIdempotencyInFlight = errors.New("相同幂等键的请求正在处理中，请稍后重试")
+)
+
+func init() {
+	registerRetentionTarget("idempotency-record", "幂等请求记录",
+		autoload.RetentionPolicy{MaxAge: 7}, purgeIdempotencyRecords)
+}
+
+// idempotencyWindow 完成后的结果保留时长
+func idempotencyWindow() time.Duration {
+	if window := config.Config.Submit.IdempotencyWindow; window > 0 {
+		return time.Duration(window) * time.Second
+	}
+	return 24 * time.Hour
+}
+
+// idempotencyLease 处理中记录的租约时长，与提交锁相同，处理期间定期续约
+// 持有者异常退出时租约到期后可被重试请求接管
+func idempotencyLease() time.Duration {
+	return submitLockTTL()
+}
+
+// SubmitVariableIdempotent 携带幂等键提交变量，返回值 replayed 表示结果来自首次请求
+// 幂等键按提交者（卡密，未使用卡密时为IP）隔离，不同提交者使用相同幂等键互不影响；
+// 窗口内相同幂等键与相同内容的重试直接返回首次成功的结果；首次请求仍在处理时最多等待一个租约时长，
+// 期间首次请求完成则返回其结果、租约到期则接管，仍在处理时返回 ErrIdempotencyInFlight；
+// 内容不同的请求使用相同幂等键时拒绝。未成功的提交不保存结果，重试时会重新执行
+func (s *OpenService) SubmitVariableIdempotent(ctx context.Context, key string, req schema.SubmitVariableRequest) (*schema.SubmitVariableResponse, bool, error) {
+	if len(key) > idempotencyKeyMaxLen {
+		return nil, false, fmt.Errorf("幂等键长度不能超过%d", idempotencyKeyMaxLen)
+	}
+	name := idempotencyKeyName(key, req)
+	hash, err := submitRequestHash(req)
+	if err != nil {
+		return nil, false, err
+	}
+	owner, err := newLockOwner()
+	if err != nil {
+		return nil, false, err
+	}
+
+	deadline := time.Now().Add(idempotencyLease())
+	backoff := 100 * time.Millisecond
+	for {
+		record, acquired, err := claimIdempotencyKey(ctx, name, hash, owner)
+		if err != nil {
+			return nil, false, err
+		}
+		if acquired {
+			break
+		}
+		if record != nil {
+			if record.RequestHash != hash {
+				return nil, false, errIdempotencyMismatch
+			}
+			if record.Status == idempotencyDone && record.Response != nil {
+				var resp schema.SubmitVariableResponse
+				if err := config.JSON.UnmarshalFromString(*record.Response, &resp); err != nil {
+					return nil, false, fmt.Errorf("解析幂等记录失败: %w", err)
+				}
+				return &resp, true, nil
+			}
+		}
+
+		// 首次请求处理中，等待其完成；持有者异常退出时租约不再续约，到期后由下一轮占用接管
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, false, ErrIdempotencyInFlight
+		}
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, time.Second)
+	}
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		renewIdempotencyLease(name, owner, stop)
+	}()
+	resp, err := s.SubmitVariable(req)
+	close(stop)
+	<-stopped
+	if err != nil || !resp.Success {
+		// 没有写入面板与扣减卡密，删除记录允许客户端使用相同幂等键重试
+		releaseIdempotencyKey(name, owner)
+		return resp, false, err
+	}
+	storeIdempotencyResult(name, owner, resp)
+	return resp, false, nil
+}
+
+// idempotencyKeyName 幂等记录名称，按提交者隔离，提交者取摘要避免超出字段长度
+func idempotencyKeyName(key string, req schema.SubmitVariableRequest) string {
+	submitter := req.Key
+	if submitter == "" {
+		submitter = req.ClientIP
+	}
+	sum := sha256.Sum256([]byte(submitter))
+	return "submit:" + hex.EncodeToString(sum[:8]) + ":" + key
+}
+
+// renewIdempotencyLease 处理期间每隔三分之一租约时长续约一次，直到 stop 关闭
+// 记录已被接管或删除时停止续约
+func renewIdempotencyLease(name, owner string, stop <-chan struct{}) {
+	lease := idempotencyLease()
+	ticker := time.NewTicker(lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), lease/3)
+		renewed, err := config.Ent.IdempotencyRecord.Update().
+			Where(
+				idempotencyrecord.KeyEQ(name),
+				idempotencyrecord.OwnerEQ(owner),
+				idempotencyrecord.StatusEQ(idempotencyPending),
+			).
+			SetExpiresAt(time.Now().Add(lease)).
+			Save(ctx)
+		cancel()
+		if err != nil {
+			config.Log.Warn(fmt.Sprintf("续约幂等键%s失败: %v", name, err))
+			continue
+		}
+		if renewed == 0 {
+			config.Log.Warn(fmt.Sprintf("幂等键%s的租约已被接管，停止续约", name))
+			return
+		}
+	}
+}
+
+// claimIdempotencyKey 尝试占用幂等键，未占用时返回已有记录（记录恰好被删除时为 nil）
+func claimIdempotencyKey(ctx context.Context, name, hash, owner string) (*ent.IdempotencyRecord, bool, error) {
+	now := time.Now()
+
+	// 接管已过期的记录（重放窗口已结束，或处理中的持有者异常退出）
+	taken, err := config.Ent.IdempotencyRecord.Update().
+		Where(idempotencyrecord.KeyEQ(name), idempotencyrecord.ExpiresAtLT(now)).
+		SetRequestHash(hash).
+		SetOwner(owner).
+		SetStatus(idempotencyPending).
+		ClearResponse().
+		SetExpiresAt(now.Add(idempotencyLease())).
+		Save(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("占用幂等键失败: %w", err)
+	}
+	if taken > 0 {
+		return nil, true, nil
+	}
+
+	err = config.Ent.IdempotencyRecord.Create().
+		SetKey(name).
+		SetRequestHash(hash).
+		SetOwner(owner).
+		SetStatus(idempotencyPending).
+		SetExpiresAt(now.Add(idempotencyLease())).
+		Exec(ctx)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, false, fmt.Errorf("占用幂等键失败: %w", err)
+	}
+
+	record, err := config.Ent.IdempotencyRecord.Query().
+		Where(idempotencyrecord.KeyEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("查询幂等记录失败: %w", err)
+	}
+	return record, false, nil
+}
+
+// storeIdempotencyResult 保存首次请求的结果，租约已被接管时只记录告警
+func storeIdempotencyResult(name, owner string, resp *schema.SubmitVariableResponse) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	data, err := config.JSON.MarshalToString(resp)
+	if err != nil {
+		config.Log.Warn(fmt.Sprintf("序列化幂等结果失败: %v", err))
+		return
+	}
+	saved, err := config.Ent.IdempotencyRecord.Update().
+		Where(idempotencyrecord.KeyEQ(name), idempotencyrecord.OwnerEQ(owner)).
+		SetStatus(idempotencyDone).
+		SetResponse(data).
+		SetExpiresAt(time.Now().Add(idempotencyWindow())).
+		Save(ctx)
+	if err != nil {
+		config.Log.Warn(fmt.Sprintf("保存幂等结果%s失败: %v", name, err))
+		return
+	}
+	if saved == 0 {
+		config.Log.Warn(fmt.Sprintf("幂等键%s的租约已被接管，结果未保存", name))
+	}
+}
+
+// releaseIdempotencyKey 删除自己占用的幂等记录
+func releaseIdempotencyKey(name, owner string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := config.Ent.IdempotencyRecord.Delete().
+		Where(idempotencyrecord.KeyEQ(name), idempotencyrecord.OwnerEQ(owner)).
+		Exec(ctx); err != nil {
+		config.Log.Warn(fmt.Sprintf("释放幂等键%s失败: %v", name, err))
+	}
+}
+
+// submitRequestHash 计算提交内容摘要，用于识别使用相同幂等键的不同请求
+func submitRequestHash(req schema.SubmitVariableRequest) (string, error) {
+	data, err := config.JSON.Marshal(struct {
+		EnvID   int64  `json:"env_id"`
+		Value   string `json:"value"`
+		Key     string `json:"key"`
+		Remarks string `json:"remarks"`
+	}{req.EnvID, req.Value, req.Key, req.Remarks})
+	if err != nil {
+		return "", fmt.Errorf("计算请求摘要失败: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// purgeIdempotencyRecords 清理已过期的幂等记录，未过期的记录不清理
+var purgeIdempotencyRecords = retentionRecords[predicate.IdempotencyRecord]{
+	label: "幂等记录",
+	delete: func(ctx context.Context, ps ...predicate.IdempotencyRecord) (int, error) {
+		return config.Ent.IdempotencyRecord.Delete().Where(ps...).Exec(ctx)
+	},
+	nthNewestID: func(ctx context.Context, offset int) (int64, error) {
+		return config.Ent.IdempotencyRecord.Query().Order(ent.Desc(idempotencyrecord.FieldID)).Offset(offset).FirstID(ctx)
+	},
+	keep: func(now time.Time) predicate.IdempotencyRecord {
+		return idempotencyrecord.ExpiresAtGTE(now)
+	},
+}.purge
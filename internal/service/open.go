@@ -4,9 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
-	"sort"
-	"sync"
 	"time"
 
 	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
@@ -15,14 +12,14 @@ import (
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/cdkey"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
-	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/balancer"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/extract"
 	pkgPlugin "github.com/nuanxinqing123/QLToolsV2/internal/pkg/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
 )
 
 type OpenService struct {
-	cdkMutexMap   sync.Map       // 基于卡密值的锁映射，每个卡密有独立的锁
 	pluginService *PluginService // 插件服务
 	panelService  *PanelService  // 面板服务
 }
@@ -35,12 +32,6 @@ func NewOpenService() *OpenService {
 	}
 }
 
-// getCDKMutex 获取指定卡密的互斥锁
-func (s *OpenService) getCDKMutex(cdkKey string) *sync.Mutex {
-	mutex, _ := s.cdkMutexMap.LoadOrStore(cdkKey, &sync.Mutex{})
-	return mutex.(*sync.Mutex)
-}
-
 // CheckCDK 检查卡密
 func (s *OpenService) CheckCDK(req schema.CheckCDKRequest) (*schema.CheckCDKResponse, error) {
 	ctx := context.Background()
@@ -102,73 +93,51 @@ func (s *OpenService) GetOnlineServices() (*schema.GetOnlineServicesResponse, er
 		return nil, fmt.Errorf("查询环境变量列表失败: %w", err)
 	}
 
-	// 转换为响应格式并计算可用位置数
-	var list []schema.OnlineServiceInfo
+	// 查询每个环境变量绑定的启用面板，并汇总需要拉取的面板
+	envBindings := make(map[int64][]*ent.EnvPanel, len(envs))
+	var allPanelIDs []int64
+	seen := make(map[int64]bool)
 	for _, e := range envs {
-		// 查询该环境变量绑定的启用面板ID
-		panelIDs, err := config.Ent.Env.Query().
-			Where(env.IDEQ(e.ID)).
-			QueryPanels().
-			Where(panel.IsEnableEQ(true)).
-			IDs(ctx)
+		bindings, err := queryEnvBindings(ctx, e.ID)
 		if err != nil {
-			return nil, fmt.Errorf("查询绑定面板失败: %w", err)
+			return nil, err
 		}
-
-		// 计算可用位置数：配置变量总数 - 所有面板中该变量的实际数量
-		totalSlots := e.Quantity
-		usedSlots := int32(0)
-
-		// 遍历所有绑定的面板，统计该变量在每个面板中的数量
-		for _, panelID := range panelIDs {
-			// 创建青龙API实例
-			qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
-			if err != nil {
-				config.Log.Warn(fmt.Sprintf("创建面板%d的API实例失败: %v", panelID, err))
-				continue
-			}
-
-			// 获取面板中的所有环境变量
-			envResponse, err := qlAPI.GetEnvs()
-			if err != nil {
-				config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败: %v", panelID, err))
-				continue
+		envBindings[e.ID] = bindings
+		for _, b := range bindings {
+			if !seen[b.PanelID] {
+				seen[b.PanelID] = true
+				allPanelIDs = append(allPanelIDs, b.PanelID)
 			}
+		}
+	}
 
-			if envResponse.Code != 200 {
-				config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败，响应码: %d", panelID, envResponse.Code))
-				continue
-			}
+	// 并发拉取所有面板的环境变量快照，每个面板只拉取一次
+	snapshots, _ := s.panelService.GetPanelEnvSnapshots(ctx, allPanelIDs)
 
-			// 统计该面板中匹配的变量数量
-			for _, panelEnv := range envResponse.Data {
-				if panelEnv.Name == e.Name {
-					usedSlots++
-				}
-			}
-		}
+	// 转换为响应格式并计算可用位置数
+	var list []schema.OnlineServiceInfo
+	for _, e := range envs {
+		bindings := envBindings[e.ID]
 
-		// 计算可用位置数
-		availableSlots := totalSlots - usedSlots
-		if availableSlots < 0 {
-			availableSlots = 0
-		}
+		// 计算可用位置数：配置变量总数 - 所有面板中该变量的实际数量，并受各面板上限约束
+		slots := calculateEnvSlots(e, bindings, snapshots)
 
 		// 调试日志：输出计算过程
-		config.Log.Debug(fmt.Sprintf("环境变量[%s] ID=%d: 绑定面板数=%d, 总配额=%d, 已使用=%d, 可用=%d",
-			e.Name, e.ID, len(panelIDs), totalSlots, usedSlots, availableSlots))
+		config.Log.Debug(fmt.Sprintf("环境变量[%s] ID=%d: 绑定面板数=%d, 不可达面板数=%d, 总配额=%d, 已使用=%d, 可用=%d",
+			e.Name, e.ID, len(bindings), len(slots.Unreachable), slots.Total, slots.Used, slots.Available))
 
 		list = append(list, schema.OnlineServiceInfo{
-			ID:             e.ID,
-			Name:           e.Name,
-			Remarks:        e.Remarks,
-			Quantity:       e.Quantity,
-			EnableKey:      e.EnableKey,
-			CdkLimit:       e.CdkLimit,
-			IsPrompt:       e.IsPrompt,
-			PromptLevel:    e.PromptLevel,
-			PromptContent:  e.PromptContent,
-			AvailableSlots: availableSlots,
+			ID:                e.ID,
+			Name:              e.Name,
+			Remarks:           e.Remarks,
+			Quantity:          e.Quantity,
+			EnableKey:         e.EnableKey,
+			CdkLimit:          e.CdkLimit,
+			IsPrompt:          e.IsPrompt,
+			PromptLevel:       e.PromptLevel,
+			PromptContent:     e.PromptContent,
+			AvailableSlots:    slots.Available,
+			UnreachablePanels: slots.Unreachable,
 		})
 	}
 
@@ -195,68 +164,64 @@ func (s *OpenService) CalculateAvailableSlots(req schema.CalculateAvailableSlots
 		return nil, fmt.Errorf("查询环境变量失败: %w", err)
 	}
 
-	// 查询该环境变量绑定的启用面板ID
-	panelIDs, err := config.Ent.Env.Query().
-		Where(env.IDEQ(req.EnvID)).
-		QueryPanels().
-		Where(panel.IsEnableEQ(true)).
-		IDs(ctx)
+	// 查询该环境变量绑定的启用面板
+	bindings, err := queryEnvBindings(ctx, req.EnvID)
 	if err != nil {
-		return nil, fmt.Errorf("查询绑定面板失败: %w", err)
+		return nil, err
 	}
 
-	// 计算总位置数和已使用位置数
-	totalSlots := e.Quantity
-	usedSlots := int32(0)
-
-	// 遍历所有绑定的面板，统计该变量在每个面板中的数量
-	for _, panelID := range panelIDs {
-		// 创建青龙API实例
-		qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
-		if err != nil {
-			config.Log.Warn(fmt.Sprintf("创建面板%d的API实例失败: %v", panelID, err))
-			continue
-		}
-
-		// 获取面板中的所有环境变量
-		envResponse, err := qlAPI.GetEnvs()
-		if err != nil {
-			config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败: %v", panelID, err))
-			continue
-		}
+	// 计算总位置数和已使用位置数（并发拉取面板快照，不可达的面板单独标记）
+	snapshots, _ := s.panelService.GetPanelEnvSnapshots(ctx, bindingPanelIDs(bindings))
+	slots := calculateEnvSlots(e, bindings, snapshots)
 
-		if envResponse.Code != 200 {
-			config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败，响应码: %d", panelID, envResponse.Code))
-			continue
-		}
+	return &schema.CalculateAvailableSlotsResponse{
+		EnvID:             req.EnvID,
+		TotalSlots:        slots.Total,
+		UsedSlots:         slots.Used,
+		AvailableSlots:    slots.Available,
+		UnreachablePanels: slots.Unreachable,
+	}, nil
+}
 
-		// 统计该面板中匹配的变量数量
-		for _, panelEnv := range envResponse.Data {
-			if panelEnv.Name == e.Name {
-				usedSlots++
-			}
-		}
-	}
+// SubmitVariable 提交变量
+func (s *OpenService) SubmitVariable(req schema.SubmitVariableRequest) (*schema.SubmitVariableResponse, error) {
+	return s.submitVariable(req, nil)
+}
 
-	// 计算可用位置数
-	availableSlots := totalSlots - usedSlots
-	if availableSlots < 0 {
-		availableSlots = 0
+// DryRunSubmit 试运行提交流程，按与 SubmitVariable 完全相同的步骤执行，但不扣减卡密、不写入面板
+func (s *OpenService) DryRunSubmit(req schema.DryRunSubmitRequest) (*schema.DryRunSubmitResponse, error) {
+	trace := &submitTrace{}
+	resp, err := s.submitVariable(schema.SubmitVariableRequest{
+		EnvID:   req.EnvID,
+		Value:   req.Value,
+		Key:     req.Key,
+		Remarks: req.Remarks,
+	}, trace)
+	if err != nil {
+		// 流程错误同样作为追踪结果返回，便于定位是哪一步出错
+		trace.add("error", stepStatusFail, err.Error(), nil)
+		return &schema.DryRunSubmitResponse{
+			WouldSucceed: false,
+			Message:      err.Error(),
+			FinalValue:   trace.finalValue,
+			Steps:        trace.steps,
+		}, nil
 	}
 
-	return &schema.CalculateAvailableSlotsResponse{
-		EnvID:          req.EnvID,
-		TotalSlots:     totalSlots,
-		UsedSlots:      usedSlots,
-		AvailableSlots: availableSlots,
+	return &schema.DryRunSubmitResponse{
+		WouldSucceed: resp.Success,
+		Message:      resp.Message,
+		FinalValue:   trace.finalValue,
+		Steps:        trace.steps,
 	}, nil
 }
 
-// SubmitVariable 提交变量
-func (s *OpenService) SubmitVariable(req schema.SubmitVariableRequest) (*schema.SubmitVariableResponse, error) {
+// submitVariable 提交变量流程，trace 不为空时为试运行模式
+func (s *OpenService) submitVariable(req schema.SubmitVariableRequest, trace *submitTrace) (*schema.SubmitVariableResponse, error) {
 	ctx := context.Background()
 	// 判断是否为空内容
 	if req.Value == "" {
+		trace.add("validate", stepStatusFail, "变量值不能为空", nil)
 		return &schema.SubmitVariableResponse{
 			Success: false,
 			Message: "变量值不能为空",
@@ -272,6 +237,7 @@ func (s *OpenService) SubmitVariable(req schema.SubmitVariableRequest) (*schema.
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
+			trace.add("env", stepStatusFail, "环境变量不存在或已禁用", nil)
 			return &schema.SubmitVariableResponse{
 				Success: false,
 				Message: "环境变量不存在或已禁用",
@@ -279,57 +245,63 @@ func (s *OpenService) SubmitVariable(req schema.SubmitVariableRequest) (*schema.
 		}
 		return nil, fmt.Errorf("查询环境变量失败: %w", err)
 	}
+	trace.add("env", stepStatusOK, fmt.Sprintf("环境变量 %s (模式: %d)", e.Name, e.Mode), nil)
 
 	var remainingCDK int32 = 0
 
 	// 检查是否启用KEY，并且用户提交的KEY是否有效
 	if e.EnableKey {
 		if req.Key == "" {
+			trace.add("cdk", stepStatusFail, "该服务需要提供有效的卡密", nil)
 			return &schema.SubmitVariableResponse{
 				Success: false,
 				Message: "该服务需要提供有效的卡密",
 			}, nil
 		}
 
-		// 使用基于卡密值的互斥锁防止并发问题
-		cdkMutex := s.getCDKMutex(req.Key)
-		cdkMutex.Lock()
-		defer cdkMutex.Unlock()
-
-		// 检查卡密
+		// 检查卡密，此处只用于提前给出提示，实际扣减在写入面板前通过预扣完成
 		cdkResp, err := s.CheckCDK(schema.CheckCDKRequest{Key: req.Key})
 		if err != nil {
 			return nil, fmt.Errorf("检查卡密失败: %w", err)
 		}
 
 		if !cdkResp.Valid {
+			trace.add("cdk", stepStatusFail, cdkResp.Message, nil)
 			return &schema.SubmitVariableResponse{
-				Success: false,
-				Message: cdkResp.Message,
+				Success:   false,
+				Message:   cdkResp.Message,
+				Exhausted: true,
 			}, nil
 		}
 
 		// 检查卡密次数是否足够
 		if cdkResp.RemainingUses < e.CdkLimit {
+			msg := fmt.Sprintf("卡密剩余次数不足，需要%d次，剩余%d次", e.CdkLimit, cdkResp.RemainingUses)
+			trace.add("cdk", stepStatusFail, msg, nil)
 			return &schema.SubmitVariableResponse{
-				Success: false,
-				Message: fmt.Sprintf("卡密剩余次数不足，需要%d次，剩余%d次", e.CdkLimit, cdkResp.RemainingUses),
+				Success:   false,
+				Message:   msg,
+				Exhausted: true,
 			}, nil
 		}
 
 		remainingCDK = cdkResp.RemainingUses
+		trace.add("cdk", stepStatusOK, fmt.Sprintf("卡密有效，剩余%d次，本次需要%d次", cdkResp.RemainingUses, e.CdkLimit), nil)
+	} else {
+		trace.add("cdk", stepStatusSkip, "未启用卡密验证", nil)
 	}
 
 	// 校验正则，判断是否满足提交条件，并提取匹配内容
 	if e.Regex != nil && *e.Regex != "" {
-		re, err := regexp.Compile(*e.Regex)
+		extractor, err := newEnvExtractor(e.Regex, e.ValueTemplate)
 		if err != nil {
-			return nil, fmt.Errorf("正则表达式错误: %w", err)
+			return nil, err
 		}
 
-		// 查找匹配的内容
-		matched := re.FindString(req.Value)
-		if matched == "" {
+		// 查找匹配的内容，设置了变量值模板时按命名分组重新组装
+		matched, ok := extractor.Render(req.Value)
+		if !ok || matched == "" {
+			trace.add("regex", stepStatusFail, "变量值格式不符合要求", map[string]string{"regex": *e.Regex})
 			return &schema.SubmitVariableResponse{
 				Success: false,
 				Message: "变量值格式不符合要求",
@@ -338,11 +310,14 @@ func (s *OpenService) SubmitVariable(req schema.SubmitVariableRequest) (*schema.
 
 		// 将匹配到的内容替换原始值
 		req.Value = matched
+		trace.add("regex", stepStatusOK, "正则匹配成功", map[string]string{"regex": *e.Regex, "matched": matched})
+	} else {
+		trace.add("regex", stepStatusSkip, "未设置匹配正则", nil)
 	}
 
 	// 执行插件处理
 	processedValue := req.Value
-	allowSubmit, processErr := s.executeEnvPlugins(req.EnvID, req.Value, &processedValue)
+	allowSubmit, processErr := s.executeEnvPlugins(req.EnvID, req.Value, &processedValue, trace)
 	if processErr != nil {
 		return nil, fmt.Errorf("执行插件处理失败: %w", processErr)
 	}
@@ -353,172 +328,297 @@ func (s *OpenService) SubmitVariable(req schema.SubmitVariableRequest) (*schema.
 			Message: processedValue, // processedValue此时包含禁止原因
 		}, nil
 	}
+	trace.setFinalValue(processedValue)
 
-	// 执行实时计算，判断是否还有空余提交位置
-	slotsResp, err := s.CalculateAvailableSlots(schema.CalculateAvailableSlotsRequest{EnvID: req.EnvID})
+	// 查询该环境变量绑定的启用面板ID
+	bindings, err := queryEnvBindings(ctx, req.EnvID)
 	if err != nil {
-		return nil, fmt.Errorf("计算可用位置失败: %w", err)
+		return nil, err
 	}
-	if slotsResp.AvailableSlots <= 0 {
-		return &schema.SubmitVariableResponse{
-			Success: false,
-			Message: "当前服务已满，暂无可用位置",
-		}, nil
+	panelIDs := bindingPanelIDs(bindings)
+
+	// 从计算位置到写入面板期间持有变量的提交锁，防止并发提交（包括多个实例）超出负载数量
+	// 试运行不写入面板，无需加锁
+	if !trace.enabled() {
+		release, err := acquireResourceLock(ctx, envSubmitLockName(req.EnvID), submitLockTTL(), submitLockWait())
+		if err != nil {
+			if errors.Is(err, errResourceLockTimeout) {
+				return &schema.SubmitVariableResponse{
+					Success: false,
+					Message: "当前提交人数较多，请稍后重试",
+				}, nil
+			}
+			return nil, fmt.Errorf("获取提交锁失败: %w", err)
+		}
+		defer release()
+
+		// 其他实例可能刚写入过面板，本地快照不可信，加锁后重新拉取
+		for _, panelID := range panelIDs {
+			InvalidatePanelEnvSnapshot(panelID)
+		}
 	}
 
-	// 提交数据到所有绑定的面板，并根据IsAutoEnvEnable判断是否需要启用提交变量
-	// 查询该环境变量绑定的启用面板ID
-	panelIDs, err := config.Ent.Env.Query().
-		Where(env.IDEQ(req.EnvID)).
-		QueryPanels().
-		Where(panel.IsEnableEQ(true)).
-		IDs(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("查询绑定面板失败: %w", err)
+	// 新建模式提交前检查所有绑定面板中是否已存在相同的变量
+	message := "变量提交成功"
+	var duplicates []envDuplicate
+	if e.Mode == _const.CreateMode {
+		var unchecked []int64
+		duplicates, unchecked, err = s.findDuplicates(ctx, e, panelIDs, processedValue)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(duplicates) == 0 && len(unchecked) > 0 && e.DedupAction != _const.DedupAllow:
+			// 重复变量可能位于暂时不可达的面板上，此时新建会重复占用位置与卡密
+			msg := fmt.Sprintf("面板%v暂时无法访问，无法确认变量是否重复，请稍后重试", unchecked)
+			trace.add("dedup", stepStatusFail, msg, unchecked)
+			return &schema.SubmitVariableResponse{
+				Success: false,
+				Message: msg,
+			}, nil
+		case len(duplicates) == 0:
+			if e.DedupBy != "" && e.DedupBy != _const.DedupByNone {
+				trace.add("dedup", stepStatusOK, "未检测到重复变量", nil)
+			}
+		case e.DedupAction == _const.DedupUpdate:
+			trace.add("dedup", stepStatusOK, fmt.Sprintf("检测到%d个重复变量，将更新已有变量", len(duplicates)), duplicates)
+			message = "变量已存在，已更新已有变量"
+		case e.DedupAction == _const.DedupAllow:
+			trace.add("dedup", stepStatusOK, fmt.Sprintf("检测到%d个重复变量，按配置继续新建", len(duplicates)), duplicates)
+			message = "变量提交成功（该变量已存在，已按配置重复提交）"
+			duplicates = nil
+		default:
+			msg := fmt.Sprintf("该变量已存在于面板%d，请勿重复提交", duplicates[0].PanelID)
+			trace.add("dedup", stepStatusFail, msg, duplicates)
+			return &schema.SubmitVariableResponse{
+				Success:   false,
+				Message:   msg,
+				Duplicate: true,
+			}, nil
+		}
 	}
 
+	// 执行实时计算，判断是否还有空余提交位置；更新已有的重复变量不占用新的位置
+	if len(duplicates) > 0 {
+		trace.add("slots", stepStatusSkip, "更新已有变量，不占用新的位置", nil)
+	} else {
+		slotsResp, err := s.CalculateAvailableSlots(schema.CalculateAvailableSlotsRequest{EnvID: req.EnvID})
+		if err != nil {
+			return nil, fmt.Errorf("计算可用位置失败: %w", err)
+		}
+		if slotsResp.AvailableSlots <= 0 {
+			trace.add("slots", stepStatusFail, "当前服务已满，暂无可用位置", slotsResp)
+			return &schema.SubmitVariableResponse{
+				Success:   false,
+				Message:   "当前服务已满，暂无可用位置",
+				Exhausted: true,
+			}, nil
+		}
+		trace.add("slots", stepStatusOK, fmt.Sprintf("剩余%d个位置", slotsResp.AvailableSlots), slotsResp)
+	}
+
+	// 写入面板前预扣卡密额度，提交失败时退回，成功后结算
+	var (
+		reservation *cdkReservation
+		settled     bool
+	)
+	if e.EnableKey && !trace.enabled() {
+		reservation, err = reserveCDK(ctx, req.Key, e.CdkLimit, req.EnvID)
+		if err != nil {
+			if errors.Is(err, errCDKInsufficient) {
+				msg := fmt.Sprintf("卡密不可用或剩余次数不足，需要%d次", e.CdkLimit)
+				trace.add("cdk_reserve", stepStatusFail, msg, nil)
+				return &schema.SubmitVariableResponse{
+					Success:   false,
+					Message:   msg,
+					Exhausted: true,
+				}, nil
+			}
+			return nil, err
+		}
+
+		defer func() {
+			if settled {
+				return
+			}
+			if err := releaseCDKReservation(context.Background(), reservation, "提交失败退回"); err != nil {
+				config.Log.Error(fmt.Sprintf("退回卡密预扣#%d失败: %v", reservation.ID, err))
+			}
+		}()
+	}
+
+	// 提交数据到所有绑定的面板，并根据IsAutoEnvEnable判断是否需要启用提交变量
 	// 根据模式选择提交策略
 	submittedTo := int32(0)
+	var results []schema.SubmitPanelResult
+	// 粘性策略按提交者分配面板，优先使用卡密，未使用卡密时使用IP
+	submitter := req.Key
+	if submitter == "" {
+		submitter = req.ClientIP
+	}
 
 	switch e.Mode {
 	case _const.CreateMode:
+		if len(duplicates) > 0 {
+			// 检测到重复且配置为更新：写入已存在的变量
+			results, err = s.updateDuplicates(e, duplicates, processedValue, req.Remarks, trace)
+			if err != nil {
+				return nil, err
+			}
+			updatedPanelIDs := resultPanelIDs(results)
+			submittedTo = int32(len(updatedPanelIDs))
+			s.triggerEnvCrons(req.EnvID, updatedPanelIDs, trace)
+			break
+		}
+
 		// 新建模式：使用负载均衡，选择可用位置最多的面板
-		err = s.submitAndAutoEnable(req.EnvID, panelIDs, e.Name, processedValue, req.Remarks, e.IsAutoEnvEnable)
+		result, err := s.submitAndAutoEnable(req.EnvID, panelIDs, e.Name, processedValue, req.Remarks, submitter, e.IsAutoEnvEnable, trace)
 		if err != nil {
 			return nil, err
 		}
+		results = []schema.SubmitPanelResult{result}
 		submittedTo = 1
 
 	case _const.UpdateMode:
-		// 更新模式：遍历所有面板，根据正则表达式匹配并更新
+		// 更新模式：遍历所有面板，根据正则表达式匹配并按更新范围更新
 		if e.RegexUpdate == nil || *e.RegexUpdate == "" {
 			return nil, errors.New("更新模式下必须设置更新正则表达式")
 		}
 
-		updatedCount, _, err := s.updateExistingVariables(panelIDs, e.Name, *e.RegexUpdate, processedValue, req.Remarks)
+		matchKey, err := newEnvExtractor(e.RegexUpdate, e.MatchKeyTemplate)
+		if err != nil {
+			return nil, err
+		}
+		results, err = s.updateExistingVariables(panelIDs, e.Name, matchKey, e.UpdateScope, processedValue, req.Remarks, trace)
 		if err != nil {
 			return nil, fmt.Errorf("更新现有变量失败: %w", err)
 		}
 
-		if updatedCount == 0 {
+		var updatedPanelIDs []int64
+		for _, r := range results {
+			if len(r.Updated) > 0 {
+				updatedPanelIDs = append(updatedPanelIDs, r.PanelID)
+			}
+		}
+		if len(updatedPanelIDs) == 0 {
 			// 没有匹配到任何变量，使用新建逻辑
 			config.Log.Info("更新模式下未匹配到任何变量，使用新建逻辑")
-			err = s.submitAndAutoEnable(req.EnvID, panelIDs, e.Name, processedValue, req.Remarks, e.IsAutoEnvEnable)
+			result, err := s.submitAndAutoEnable(req.EnvID, panelIDs, e.Name, processedValue, req.Remarks, submitter, e.IsAutoEnvEnable, trace)
 			if err != nil {
 				return nil, err
 			}
+			results = append(results, result)
 			submittedTo = 1
 		} else {
-			submittedTo = int32(updatedCount)
+			submittedTo = int32(len(updatedPanelIDs))
+			// 运行已更新变量的面板上关联的定时任务
+			s.triggerEnvCrons(req.EnvID, updatedPanelIDs, trace)
+		}
+
+	case _const.ReplicateMode:
+		// 副本模式：写入多个面板，匹配到已有副本时同步更新该组所有副本
+		results, err = s.replicateVariable(e, panelIDs, processedValue, req.Remarks, submitter, trace)
+		if err != nil {
+			return nil, fmt.Errorf("写入副本失败: %w", err)
 		}
+		submittedTo = int32(len(results))
 
 	default:
 		return nil, fmt.Errorf("不支持的模式: %d", e.Mode)
 	}
 
-	// 如果启用了KEY验证，扣减卡密次数
+	// 如果启用了KEY验证，结算预扣的卡密次数
 	if e.EnableKey {
-		// 扣减卡密次数
-		err = config.Ent.CdKey.Update().
-			Where(cdkey.KeyEQ(req.Key)).
-			AddCount(-e.CdkLimit).
-			Exec(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("扣减卡密次数失败: %w", err)
+		if trace.enabled() {
+			trace.add("cdk_deduct", stepStatusSkip, fmt.Sprintf("试运行：将扣减卡密%d次", e.CdkLimit), nil)
+			remainingCDK -= e.CdkLimit
+		} else {
+			// 变量已写入面板，结算失败不影响提交结果；预扣保持未结算状态，超时后会被自动退回
+			settled = true
+			if err := commitCDKReservation(ctx, reservation); err != nil {
+				config.Log.Error(fmt.Sprintf("结算卡密预扣#%d失败: %v", reservation.ID, err))
+			}
+			remainingCDK = reservation.Balance
 		}
-		remainingCDK -= e.CdkLimit
 	}
 
 	return &schema.SubmitVariableResponse{
 		Success:      true,
-		Message:      "变量提交成功",
+		Message:      message,
 		SubmittedTo:  submittedTo,
 		RemainingCDK: remainingCDK,
+		Duplicate:    len(duplicates) > 0,
+		Results:      results,
 	}, nil
 }
 
-// PanelLoadInfo 面板负载信息
-type PanelLoadInfo struct {
-	PanelID   int64
-	UsedSlots int32
-}
-
-// selectBestPanelForSubmit 选择最佳面板进行提交（负载均衡）
-// 策略：选择该环境变量数量最少的面板，即负载最轻的面板
-func (s *OpenService) selectBestPanelForSubmit(envID int64, panelIDs []int64) (int64, error) {
+// selectBestPanelForSubmit 选择最佳面板进行提交
+// 跳过健康检查判定为不可用的面板，再按变量配置的选择策略从可达面板中选择
+func (s *OpenService) selectBestPanelForSubmit(envID int64, panelIDs []int64, submitter string) (int64, string, error) {
 	ctx := context.Background()
 	// 获取环境变量信息
 	e, err := config.Ent.Env.Get(ctx, envID)
 	if err != nil {
-		return 0, fmt.Errorf("查询环境变量失败: %w", err)
+		return 0, "", fmt.Errorf("查询环境变量失败: %w", err)
 	}
 
-	var panelLoads []PanelLoadInfo
-
-	// 获取每个面板的负载情况
-	for _, panelID := range panelIDs {
-		// 创建青龙API实例
-		qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
-		if err != nil {
-			config.Log.Warn(fmt.Sprintf("创建面板%d的API实例失败: %v", panelID, err))
-			continue
-		}
-
-		// 获取面板中的所有环境变量
-		envResponse, err := qlAPI.GetEnvs()
-		if err != nil {
-			config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败: %v", panelID, err))
-			continue
-		}
-
-		if envResponse.Code != 200 {
-			config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败，响应码: %d", panelID, envResponse.Code))
-			continue
-		}
-
-		// 统计该面板中匹配的变量数量
-		envCount := int32(0)
-		for _, panelEnv := range envResponse.Data {
-			if panelEnv.Name == e.Name {
-				envCount++
-			}
-		}
+	strategy, err := balancer.Get(e.SelectStrategy)
+	if err != nil {
+		return 0, "", err
+	}
 
-		panelLoads = append(panelLoads, PanelLoadInfo{
-			PanelID:   panelID,
-			UsedSlots: envCount,
-		})
+	// 根据健康状态过滤面板
+	panelIDs, err = filterPanelsByHealth(ctx, panelIDs)
+	if err != nil {
+		return 0, "", err
 	}
 
-	if len(panelLoads) == 0 {
-		return 0, errors.New("没有可用的面板")
+	bindings, err := queryEnvBindings(ctx, envID)
+	if err != nil {
+		return 0, "", err
 	}
 
-	// 按已使用位置数升序排序，选择负载最轻的面板
-	sort.Slice(panelLoads, func(i, j int) bool {
-		return panelLoads[i].UsedSlots < panelLoads[j].UsedSlots
-	})
+	source := &snapshotPanelSource{panelService: s.panelService, envName: e.Name, bindings: bindings}
+	best, err := balancer.Select(ctx, source, strategy, balancer.Request{
+		EnvID:     envID,
+		Submitter: submitter,
+	}, panelIDs)
+	if err != nil {
+		return 0, "", err
+	}
 
-	bestPanel := panelLoads[0]
-	config.Log.Info(fmt.Sprintf("选择面板%d进行提交，当前该变量数量: %d",
-		bestPanel.PanelID, bestPanel.UsedSlots))
+	config.Log.Info(fmt.Sprintf("按%s策略选择面板%d进行提交，当前该变量数量: %d",
+		strategy.Name(), best.PanelID, best.Used))
 
-	return bestPanel.PanelID, nil
+	return best.PanelID, strategy.Name(), nil
 }
 
 // submitAndAutoEnable 提交变量到最佳面板并根据配置自动启用
-func (s *OpenService) submitAndAutoEnable(envID int64, panelIDs []int64, envName, processedValue, remarks string, isAutoEnable bool) error {
+func (s *OpenService) submitAndAutoEnable(envID int64, panelIDs []int64, envName, processedValue, remarks, submitter string, isAutoEnable bool, trace *submitTrace) (schema.SubmitPanelResult, error) {
 	// 选择最佳面板
-	bestPanelID, err := s.selectBestPanelForSubmit(envID, panelIDs)
+	bestPanelID, strategy, err := s.selectBestPanelForSubmit(envID, panelIDs, submitter)
 	if err != nil {
-		return fmt.Errorf("选择最佳面板失败: %w", err)
+		trace.add("select_panel", stepStatusFail, err.Error(), nil)
+		return schema.SubmitPanelResult{}, fmt.Errorf("选择最佳面板失败: %w", err)
+	}
+	result := schema.SubmitPanelResult{PanelID: bestPanelID}
+	trace.add("select_panel", stepStatusOK, fmt.Sprintf("按%s策略选择面板%d", strategy, bestPanelID),
+		map[string]interface{}{"panel_id": bestPanelID, "strategy": strategy})
+
+	// 试运行不写入面板
+	if trace.enabled() {
+		trace.add("submit", stepStatusSkip, fmt.Sprintf("试运行：将新建变量 %s 到面板%d", envName, bestPanelID),
+			map[string]interface{}{"panel_id": bestPanelID, "auto_enable": isAutoEnable})
+		s.triggerEnvCrons(envID, []int64{bestPanelID}, trace)
+		return result, nil
 	}
 
 	// 提交到最佳面板
 	panelEnvID, err := s.submitToPanel(bestPanelID, envName, processedValue, remarks)
 	if err != nil {
-		return fmt.Errorf("提交到面板%d失败: %w", bestPanelID, err)
+		return result, fmt.Errorf("提交到面板%d失败: %w", bestPanelID, err)
 	}
+	result.Created = []int{panelEnvID}
 
 	// 如果需要自动启用，则启用环境变量
 	if isAutoEnable && panelEnvID > 0 {
@@ -528,7 +628,10 @@ func (s *OpenService) submitAndAutoEnable(envID int64, panelIDs []int64, envName
 		}
 	}
 
-	return nil
+	// 运行接收变量的面板上关联的定时任务
+	s.triggerEnvCrons(envID, []int64{bestPanelID}, trace)
+
+	return result, nil
 }
 
 // submitToPanel 提交变量到指定面板
@@ -548,16 +651,14 @@ func (s *OpenService) submitToPanel(panelID int64, name, value, remarks string)
 	}}
 
 	// 提交环境变量
-	response, err := qlAPI.PostEnvs(envData)
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	response, err := qlAPI.PostEnvs(ctx, envData)
+	InvalidatePanelEnvSnapshot(panelID)
 	if err != nil {
 		return 0, fmt.Errorf("提交环境变量失败: %w", err)
 	}
 
-	// 检查响应状态
-	if response.Code != 200 {
-		return 0, fmt.Errorf("提交失败，响应码: %d", response.Code)
-	}
-
 	// 获取创建的变量ID
 	if len(response.Data) > 0 {
 		createdEnvID := response.Data[0].Id
@@ -578,113 +679,169 @@ func (s *OpenService) enablePanelEnv(panelID int64, envID int) error {
 
 	// 启用环境变量
 	enableRequest := schema.PutEnableEnvRequest{envID}
-	response, err := qlAPI.PutEnableEnvs(enableRequest)
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	_, err = qlAPI.PutEnableEnvs(ctx, enableRequest)
+	InvalidatePanelEnvSnapshot(panelID)
 	if err != nil {
 		return fmt.Errorf("启用环境变量失败: %w", err)
 	}
 
-	if response.Code != 200 {
-		return fmt.Errorf("启用失败，响应码: %d", response.Code)
-	}
-
 	config.Log.Info(fmt.Sprintf("成功启用面板%d中的变量%d", panelID, envID))
 	return nil
 }
 
-// updateExistingVariables 更新现有变量（更新模式）
-func (s *OpenService) updateExistingVariables(panelIDs []int64, envName, regexPattern, newValue, remarks string) (int, []int64, error) {
-	// 编译正则表达式
-	regex, err := regexp.Compile(regexPattern)
+// updatePanelEnv 更新面板中的指定变量
+func (s *OpenService) updatePanelEnv(panelID int64, qlEnvID int, name, value, remarks string) error {
+	qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
 	if err != nil {
-		return 0, nil, fmt.Errorf("编译正则表达式失败: %w", err)
+		return fmt.Errorf("创建青龙API实例失败: %w", err)
 	}
 
-	// 预先从用户提交的值中提取匹配正则的内容（所有面板共享此结果）
-	submittedMatch := regex.FindString(newValue)
-	if submittedMatch == "" {
-		return 0, nil, fmt.Errorf("用户提交的值不匹配正则表达式")
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	_, err = qlAPI.PutEnvs(ctx, schema.PutEnvRequest{
+		Id:      qlEnvID,
+		Name:    name,
+		Value:   value,
+		Remarks: remarks,
+	})
+	InvalidatePanelEnvSnapshot(panelID)
+	if err != nil {
+		return fmt.Errorf("更新环境变量失败: %w", err)
 	}
+	return nil
+}
 
-	updatedCount := 0
-	var updatedPanelIDs []int64
+// updateExistingVariables 更新现有变量（更新模式）
+// 面板中变量的匹配键与提交值的匹配键相同时视为同一变量
+// scope 决定更新范围：first 只更新第一个匹配的变量，all 更新所有面板中的全部匹配变量，
+// dedupe 更新第一个匹配的变量并删除其余重复的匹配变量
+// 返回每个面板的处理结果，未匹配到任何变量时返回空列表；匹配到变量但全部更新失败时返回错误，不退回新建逻辑
+func (s *OpenService) updateExistingVariables(panelIDs []int64, envName string, matchKey *extract.Extractor, scope, newValue, remarks string, trace *submitTrace) ([]schema.SubmitPanelResult, error) {
+	// 预先从用户提交的值中提取匹配键（所有面板共享此结果）
+	submittedMatch, ok := matchKey.Render(newValue)
+	if !ok || submittedMatch == "" {
+		trace.add("update_match", stepStatusFail, "用户提交的值不匹配更新正则", nil)
+		return nil, fmt.Errorf("用户提交的值不匹配正则表达式")
+	}
+
+	// 并发获取所有面板的环境变量快照
+	ctx := context.Background()
+	snapshots, _ := s.panelService.GetPanelEnvSnapshots(ctx, panelIDs)
 
-	// 遍历所有面板
+	var (
+		results []schema.SubmitPanelResult
+		matched int  // 匹配到的变量数量
+		kept    bool // 是否已有一个变量被更新（first、dedupe 只保留一个）
+	)
 	for _, panelID := range panelIDs {
-		// 创建青龙API实例
-		qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
-		if err != nil {
-			config.Log.Warn(fmt.Sprintf("创建面板%d的API实例失败: %v", panelID, err))
+		snapshot, ok := snapshots[panelID]
+		if !ok {
 			continue
 		}
 
-		// 获取面板中的所有环境变量
-		envResponse, err := qlAPI.GetEnvs()
-		if err != nil {
-			config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败: %v", panelID, err))
-			continue
+		// 查找该面板中匹配键与提交值相同的变量
+		var matches []schema.QlEnv
+		for _, e := range snapshot.Envs {
+			if e.Name != envName {
+				continue
+			}
+			if key, ok := matchKey.Render(e.Value); ok && key == submittedMatch {
+				matches = append(matches, e)
+			}
 		}
-
-		if envResponse.Code != 200 {
-			config.Log.Warn(fmt.Sprintf("获取面板%d环境变量失败，响应码: %d", panelID, envResponse.Code))
+		if len(matches) == 0 {
 			continue
 		}
+		matched += len(matches)
 
-		// 查找匹配的环境变量
-		panelUpdated := false
-		for _, e := range envResponse.Data {
-			// 检查变量名是否匹配
-			if e.Name == envName {
-				// 从API返回的变量值中提取匹配正则的内容
-				existingMatch := regex.FindString(e.Value)
-				if existingMatch == "" {
-					// API返回的值不匹配正则，跳过
-					continue
+		result := schema.SubmitPanelResult{PanelID: panelID}
+		var toDelete []int
+		for _, e := range matches {
+			if kept && scope != _const.UpdateScopeAll {
+				if scope == _const.UpdateScopeDedupe {
+					toDelete = append(toDelete, e.Id)
 				}
+				continue
+			}
 
-				// 只有当两者提取的内容相同时，才更新该变量
-				if submittedMatch == existingMatch {
-					// 更新变量
-					updateRequest := schema.PutEnvRequest{
-						Id:      e.Id,
-						Name:    e.Name,
-						Value:   newValue,
-						Remarks: remarks,
-					}
-
-					updateResponse, err := qlAPI.PutEnvs(updateRequest)
-					if err != nil {
-						config.Log.Warn(fmt.Sprintf("更新面板%d变量%d失败: %v", panelID, e.Id, err))
-						continue
-					}
-
-					if updateResponse.Code != 200 {
-						config.Log.Warn(fmt.Sprintf("更新面板%d变量%d失败，响应码: %d", panelID, e.Id, updateResponse.Code))
-						continue
-					}
-
-					config.Log.Info(fmt.Sprintf("成功更新面板%d变量%d: %s (匹配内容: %s)", panelID, e.Id, e.Name, submittedMatch))
-					panelUpdated = true
-					// 更新成功后立即结束，停止继续匹配该面板的其他变量
-					break
-				}
+			// 试运行只记录将被更新的变量
+			if trace.enabled() {
+				trace.add("update_match", stepStatusSkip, fmt.Sprintf("试运行：将更新面板%d变量%d", panelID, e.Id),
+					map[string]interface{}{"panel_id": panelID, "ql_env_id": e.Id, "matched": submittedMatch})
+				result.Updated = append(result.Updated, e.Id)
+				kept = true
+				continue
+			}
+
+			if err := s.updatePanelEnv(panelID, e.Id, e.Name, newValue, remarks); err != nil {
+				config.Log.Warn(fmt.Sprintf("更新面板%d变量%d失败: %v", panelID, e.Id, err))
+				result.Failed = append(result.Failed, e.Id)
+				continue
+			}
+			config.Log.Info(fmt.Sprintf("成功更新面板%d变量%d: %s (匹配内容: %s)", panelID, e.Id, e.Name, submittedMatch))
+			result.Updated = append(result.Updated, e.Id)
+			kept = true
+		}
+
+		if len(toDelete) > 0 {
+			if trace.enabled() {
+				trace.add("update_dedupe", stepStatusSkip, fmt.Sprintf("试运行：将删除面板%d中%d个重复变量", panelID, len(toDelete)),
+					map[string]interface{}{"panel_id": panelID, "ql_env_ids": toDelete})
+				result.Deleted = toDelete
+			} else if err := s.deletePanelEnvs(panelID, toDelete); err != nil {
+				config.Log.Warn(fmt.Sprintf("删除面板%d重复变量失败: %v", panelID, err))
+				result.Failed = append(result.Failed, toDelete...)
+			} else {
+				config.Log.Info(fmt.Sprintf("已删除面板%d中%d个重复变量", panelID, len(toDelete)))
+				result.Deleted = toDelete
 			}
 		}
 
-		if panelUpdated {
-			updatedCount++
-			updatedPanelIDs = append(updatedPanelIDs, panelID)
-			// 更新成功后立即返回，停止遍历其他面板
+		if len(result.Updated) > 0 || len(result.Deleted) > 0 || len(result.Failed) > 0 {
+			results = append(results, result)
+		}
+		// 只更新第一个匹配的变量时，不再遍历其他面板
+		if kept && scope == _const.UpdateScopeFirst {
 			break
 		}
 	}
 
-	return updatedCount, updatedPanelIDs, nil
+	if !kept && matched > 0 {
+		msg := fmt.Sprintf("匹配到%d个已存在的变量，但全部更新失败", matched)
+		trace.add("update_match", stepStatusFail, msg, results)
+		return nil, errors.New(msg)
+	}
+	if !kept {
+		trace.add("update_match", stepStatusOK, "未匹配到已存在的变量，将使用新建逻辑", map[string]string{"matched": submittedMatch})
+		return nil, nil
+	}
+
+	return results, nil
+}
+
+// deletePanelEnvs 删除面板中的指定变量
+func (s *OpenService) deletePanelEnvs(panelID int64, ids []int) error {
+	qlAPI, err := s.panelService.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return fmt.Errorf("创建青龙API实例失败: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	_, err = qlAPI.DeleteEnvs(ctx, ids)
+	InvalidatePanelEnvSnapshot(panelID)
+	if err != nil {
+		return fmt.Errorf("删除环境变量失败: %w", err)
+	}
+	return nil
 }
 
 // executeEnvPlugins 执行环境变量绑定的插件
 // 返回值: (是否允许继续提交, 错误)
 // processedValue: 插件处理后的值或禁止原因
-func (s *OpenService) executeEnvPlugins(envID int64, envValue string, processedValue *string) (bool, error) {
+func (s *OpenService) executeEnvPlugins(envID int64, envValue string, processedValue *string, trace *submitTrace) (bool, error) {
 	ctx := context.Background()
 	// 查询该环境变量绑定的启用插件，按执行顺序排序
 	results, err := config.Ent.EnvPlugin.Query().
@@ -704,6 +861,7 @@ func (s *OpenService) executeEnvPlugins(envID int64, envValue string, processedV
 
 	// 如果没有插件，直接返回允许提交
 	if len(results) == 0 {
+		trace.add("plugin", stepStatusSkip, "未绑定启用的插件", nil)
 		*processedValue = envValue
 		return true, nil
 	}
@@ -716,6 +874,12 @@ func (s *OpenService) executeEnvPlugins(envID int64, envValue string, processedV
 			continue
 		}
 
+		// 处于熔断冷却期的插件直接跳过
+		if isPluginCircuitOpen(item, time.Now()) {
+			trace.add("plugin", stepStatusSkip, fmt.Sprintf("插件 %s 已熔断，跳过执行", p.Name), buildPluginFailureState(item))
+			continue
+		}
+
 		// 构建执行上下文
 		var configData []byte
 		if item.Config != nil && *item.Config != "" {
@@ -730,51 +894,68 @@ func (s *OpenService) executeEnvPlugins(envID int64, envValue string, processedV
 			EnvValue:  currentValue,
 			Config:    configData,
 			Timestamp: time.Now().Unix(),
+			DryRun:    trace.enabled(),
 		}
 
 		// 执行插件
 		timeout := time.Duration(p.ExecutionTimeout) * time.Millisecond
 		pluginResult := s.pluginService.engine.Execute(context.Background(), p.ScriptContent, execCtx, timeout)
 
-		// 记录执行日志
-		s.pluginService.logPluginExecution(item.PluginID, envID, pluginResult)
-
-		// 检查执行是否成功
-		if !pluginResult.Success {
-			return false, fmt.Errorf("插件 %s 执行失败: %s", p.Name, pluginResult.ErrorMessage)
+		// 记录执行日志（试运行不记录）
+		if !trace.enabled() {
+			s.pluginService.logPluginExecution(item.PluginID, envID, currentValue, pluginResult)
 		}
 
-		// 解析插件返回结果
-		if pluginResult == nil || len(pluginResult.OutputData) == 0 {
-			// 插件没有返回数据，使用原值继续
-			continue
-		}
-
-		// 解析返回的JSON: {bool: true/false, env: "value"}
-		var result map[string]interface{}
-		if err := config.JSON.Unmarshal(pluginResult.OutputData, &result); err != nil {
-			return false, fmt.Errorf("插件 %s 返回数据格式错误: %w", p.Name, err)
+		pluginDetail := map[string]interface{}{
+			"plugin_id":       item.PluginID,
+			"plugin_name":     p.Name,
+			"execution_order": item.ExecutionOrder,
+			"failure_policy":  item.FailurePolicy,
+			"input":           currentValue,
+			"output":          string(pluginResult.OutputData),
+			"execution_time":  pluginResult.ExecutionTime,
 		}
 
-		// 检查bool字段
-		allowContinue, ok := result["bool"].(bool)
-		if !ok {
-			return false, fmt.Errorf("插件 %s 返回数据缺少bool字段或类型错误", p.Name)
+		// 检查执行结果，解析返回的JSON: {bool: true/false, env: "value"}
+		allowContinue, envResult, failReason := true, currentValue, ""
+		if !pluginResult.Success {
+			failReason = "执行失败: " + pluginResult.ErrorMessage
+		} else if len(pluginResult.OutputData) > 0 {
+			allowContinue, envResult, failReason = parsePluginOutput(pluginResult.OutputData)
+		}
+
+		// 按绑定的失败处理策略决定拒绝提交或跳过该插件
+		if failReason != "" {
+			skip, tripped := s.pluginService.recordPluginFailure(item, failReason, trace.enabled())
+			if skip {
+				msg := fmt.Sprintf("插件 %s %s，已跳过", p.Name, failReason)
+				if tripped {
+					msg = fmt.Sprintf("插件 %s %s，连续失败达到阈值，已熔断并跳过", p.Name, failReason)
+				}
+				config.Log.Warn(msg)
+				trace.add("plugin", stepStatusSkip, msg, pluginDetail)
+				continue
+			}
+			trace.add("plugin", stepStatusFail, fmt.Sprintf("插件 %s %s", p.Name, failReason), pluginDetail)
+			return false, fmt.Errorf("插件 %s %s", p.Name, failReason)
 		}
+		s.pluginService.recordPluginSuccess(item, trace.enabled())
 
-		// 获取env字段
-		envResult, ok := result["env"].(string)
-		if !ok {
-			return false, fmt.Errorf("插件 %s 返回数据缺少env字段或类型错误", p.Name)
+		if len(pluginResult.OutputData) == 0 {
+			// 插件没有返回数据，使用原值继续
+			trace.add("plugin", stepStatusOK, fmt.Sprintf("插件 %s 未返回数据，沿用原值", p.Name), pluginDetail)
+			continue
 		}
 
 		// 如果bool为false，禁止提交
 		if !allowContinue {
+			trace.add("plugin", stepStatusFail, fmt.Sprintf("插件 %s 禁止提交: %s", p.Name, envResult), pluginDetail)
 			*processedValue = envResult // 此时envResult包含禁止原因
 			return false, nil
 		}
 
 		// 更新当前值，用于下一个插件
+		trace.add("plugin", stepStatusOK, fmt.Sprintf("插件 %s 执行通过", p.Name), pluginDetail)
 		currentValue = envResult
 	}
 
@@ -782,3 +963,25 @@ func (s *OpenService) executeEnvPlugins(envID int64, envValue string, processedV
 	*processedValue = currentValue
 	return true, nil
 }
+
+// parsePluginOutput 解析插件返回的JSON: {bool: true/false, env: "value"}，格式错误时返回失败原因
+func parsePluginOutput(output []byte) (allowContinue bool, envResult string, failReason string) {
+	var result map[string]interface{}
+	if err := config.JSON.Unmarshal(output, &result); err != nil {
+		return false, "", "返回数据格式错误: " + err.Error()
+	}
+
+	// 检查bool字段
+	allowContinue, ok := result["bool"].(bool)
+	if !ok {
+		return false, "", "返回数据缺少bool字段或类型错误"
+	}
+
+	// 获取env字段
+	envResult, ok = result["env"].(string)
+	if !ok {
+		return false, "", "返回数据缺少env字段或类型错误"
+	}
+
+	return allowContinue, envResult, ""
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// maxBatchSubmitItems 单次批量提交的最大变量值数量
+const maxBatchSubmitItems = 20
+
+// SubmitBatch 批量提交变量
+// 每个变量值独立走完整的提交流程：各自计算位置、预扣并结算卡密，失败的变量值不扣减卡密
+// 位置已满或卡密次数不足时后续变量值同样会失败，直接跳过，不再逐个请求面板
+func (s *OpenService) SubmitBatch(req schema.SubmitBatchRequest) (*schema.SubmitBatchResponse, error) {
+	ctx := context.Background()
+	e, err := config.Ent.Env.Query().
+		Where(
+			env.IDEQ(req.EnvID),
+			env.IsEnableEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("环境变量不存在或已禁用")
+		}
+		return nil, fmt.Errorf("查询环境变量失败: %w", err)
+	}
+
+	values := req.Values
+	if len(values) == 0 {
+		values = splitSubmitValues(req.Value, e.SplitBy)
+	} else {
+		values = trimSubmitValues(values)
+	}
+	if len(values) == 0 {
+		return nil, errors.New("变量值不能为空")
+	}
+	if len(values) > maxBatchSubmitItems {
+		return nil, fmt.Errorf("单次最多提交%d个变量值，当前%d个", maxBatchSubmitItems, len(values))
+	}
+
+	resp := &schema.SubmitBatchResponse{
+		Total: int32(len(values)),
+		Items: make([]schema.SubmitBatchItem, 0, len(values)),
+	}
+	exhausted := ""
+	for i, value := range values {
+		item := schema.SubmitBatchItem{Index: i}
+		if exhausted != "" {
+			item.Skipped = true
+			item.Message = "已跳过：" + exhausted
+			resp.Skipped++
+			resp.Items = append(resp.Items, item)
+			continue
+		}
+
+		itemResp, err := s.SubmitVariable(schema.SubmitVariableRequest{
+			EnvID:    req.EnvID,
+			Value:    value,
+			Key:      req.Key,
+			Remarks:  req.Remarks,
+			ClientIP: req.ClientIP,
+		})
+		if err != nil {
+			config.Log.Warn(fmt.Sprintf("批量提交变量%d第%d个变量值失败: %v", req.EnvID, i+1, err))
+			item.Message = err.Error()
+		} else {
+			item.Success = itemResp.Success
+			item.Message = itemResp.Message
+			item.SubmittedTo = itemResp.SubmittedTo
+			item.Results = itemResp.Results
+			if itemResp.Exhausted {
+				exhausted = itemResp.Message
+			}
+		}
+
+		if item.Success {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	// 返回全部处理完成后的卡密余额
+	if e.EnableKey && req.Key != "" {
+		cdkResp, err := s.CheckCDK(schema.CheckCDKRequest{Key: req.Key})
+		if err == nil {
+			resp.RemainingCDK = cdkResp.RemainingUses
+		}
+	}
+
+	return resp, nil
+}
+
+// splitSubmitValues 按分隔符拆分变量值，分隔符作为整体匹配，为空时按换行拆分
+func splitSubmitValues(value, splitBy string) []string {
+	if splitBy == "" {
+		splitBy = "\n"
+	}
+	return trimSubmitValues(strings.Split(value, splitBy))
+}
+
+// trimSubmitValues 去除变量值两端的空白并丢弃空值
+func trimSubmitValues(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
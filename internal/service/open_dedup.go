@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	_const "github.com/nuanxinqing123/QLToolsV2/internal/const"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// envDuplicate 面板中已存在的重复变量
+type envDuplicate struct {
+	PanelID int64 `json:"panel_id"`  // 面板ID
+	QlEnvID int   `json:"ql_env_id"` // 青龙变量ID
+}
+
+// findDuplicates 在所有绑定面板中查找与提交值重复的变量，同时返回无法检查的不可达面板ID；未开启重复检测时返回空
+func (s *OpenService) findDuplicates(ctx context.Context, e *ent.Env, panelIDs []int64, value string) ([]envDuplicate, []int64, error) {
+	var same func(existing string) bool
+	switch e.DedupBy {
+	case _const.DedupByValue:
+		same = func(existing string) bool { return existing == value }
+	case _const.DedupByKey:
+		matchKey, err := newEnvExtractor(e.RegexUpdate, e.MatchKeyTemplate)
+		if err != nil {
+			return nil, nil, err
+		}
+		if matchKey == nil {
+			return nil, nil, nil
+		}
+		key, ok := matchKey.Render(value)
+		if !ok || key == "" {
+			// 提交值提取不到匹配键时无法判断是否重复
+			return nil, nil, nil
+		}
+		same = func(existing string) bool {
+			k, ok := matchKey.Render(existing)
+			return ok && k == key
+		}
+	default:
+		return nil, nil, nil
+	}
+
+	snapshots, unreachable := s.panelService.GetPanelEnvSnapshots(ctx, panelIDs)
+	var duplicates []envDuplicate
+	for _, panelID := range panelIDs {
+		snapshot, ok := snapshots[panelID]
+		if !ok {
+			continue
+		}
+		for _, env := range snapshot.Envs {
+			if env.Name == e.Name && same(env.Value) {
+				duplicates = append(duplicates, envDuplicate{PanelID: panelID, QlEnvID: env.Id})
+			}
+		}
+	}
+	return duplicates, unreachable, nil
+}
+
+// updateDuplicates 将提交值写入已存在的重复变量
+func (s *OpenService) updateDuplicates(e *ent.Env, duplicates []envDuplicate, value, remarks string, trace *submitTrace) ([]schema.SubmitPanelResult, error) {
+	var (
+		results []schema.SubmitPanelResult
+		updated bool
+	)
+	index := make(map[int64]int)
+	for _, d := range duplicates {
+		i, ok := index[d.PanelID]
+		if !ok {
+			i = len(results)
+			index[d.PanelID] = i
+			results = append(results, schema.SubmitPanelResult{PanelID: d.PanelID})
+		}
+
+		// 试运行只记录将被更新的变量
+		if trace.enabled() {
+			trace.add("dedup_update", stepStatusSkip, fmt.Sprintf("试运行：将更新面板%d变量%d", d.PanelID, d.QlEnvID), d)
+			results[i].Updated = append(results[i].Updated, d.QlEnvID)
+			updated = true
+			continue
+		}
+
+		if err := s.updatePanelEnv(d.PanelID, d.QlEnvID, e.Name, value, remarks); err != nil {
+			config.Log.Warn(fmt.Sprintf("更新面板%d重复变量%d失败: %v", d.PanelID, d.QlEnvID, err))
+			results[i].Failed = append(results[i].Failed, d.QlEnvID)
+			continue
+		}
+		results[i].Updated = append(results[i].Updated, d.QlEnvID)
+		updated = true
+	}
+
+	if !updated {
+		return nil, errors.New("更新已存在的变量失败")
+	}
+	return results, nil
+}
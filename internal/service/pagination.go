@@ -0,0 +1,12 @@
+package service
+
+// normalizePage 补齐分页参数，页码默认为1，每页数量默认为 defaultPageSize
+func normalizePage(page, pageSize, defaultPageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return page, pageSize
+}
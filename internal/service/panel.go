@@ -9,6 +9,8 @@ import (
 
 	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envpanel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envreplica"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
 	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/qinglong"
 	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
@@ -41,18 +43,22 @@ func (s *PanelService) AddPanel(req schema.AddPanelRequest) (*schema.AddPanelRes
 
 	// 获取面板Token
 	qlConfig := qinglong.NewConfig(req.URL, req.ClientID, req.ClientSecret)
-	tokenResp, err := qlConfig.GetConfig()
+	tokenResp, err := qlConfig.GetConfig(ctx)
 	if err != nil {
+		if qinglong.IsAPIError(err) {
+			return nil, fmt.Errorf("获取面板Token失败: %w", err)
+		}
 		return nil, fmt.Errorf("连接面板失败，无法获取Token: %w", err)
 	}
 
-	// 检查API响应状态
-	if tokenResp.Code != 200 {
-		return nil, fmt.Errorf("获取面板Token失败，错误信息: %s", tokenResp.Message)
+	// 获取面板系统信息并检查版本
+	info, warning, err := inspectPanelSystem(ctx, req.URL, tokenResp.Data.Token, tokenResp.Data.Expiration)
+	if err != nil {
+		return nil, err
 	}
 
 	// 创建面板记录
-	p, err := config.Ent.Panel.Create().
+	builder := config.Ent.Panel.Create().
 		SetName(req.Name).
 		SetURL(req.URL).
 		SetClientID(req.ClientID).
@@ -61,8 +67,9 @@ func (s *PanelService) AddPanel(req schema.AddPanelRequest) (*schema.AddPanelRes
 		SetToken(tokenResp.Data.Token).
 		SetParams(int32(tokenResp.Data.Expiration)).
 		SetCreatedAt(time.Now()).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
+		SetUpdatedAt(time.Now())
+	setPanelSystemInfo(builder, info)
+	p, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("创建面板失败: %w", err)
 	}
@@ -70,6 +77,7 @@ func (s *PanelService) AddPanel(req schema.AddPanelRequest) (*schema.AddPanelRes
 	return &schema.AddPanelResponse{
 		ID:      p.ID,
 		Message: "面板添加成功",
+		Warning: warning,
 	}, nil
 }
 
@@ -108,34 +116,56 @@ func (s *PanelService) UpdatePanel(req schema.UpdatePanelRequest) (*schema.Updat
 		SetClientID(req.ClientID).
 		SetClientSecret(req.ClientSecret).
 		SetIsEnable(req.IsEnable).
+		SetAutoDisabled(false).
 		SetUpdatedAt(time.Now())
 
 	// 如果连接信息发生变化，需要重新获取Token
 	needRefreshToken := req.URL != p.URL || req.ClientID != p.ClientID || req.ClientSecret != p.ClientSecret
+	var (
+		info    *schema.QlSystemInfo
+		warning string
+	)
 	if needRefreshToken {
 		// 使用新的连接信息获取Token
 		qlConfig := qinglong.NewConfig(req.URL, req.ClientID, req.ClientSecret)
-		tokenResp, err := qlConfig.GetConfig()
+		tokenResp, err := qlConfig.GetConfig(ctx)
 		if err != nil {
+			if qinglong.IsAPIError(err) {
+				return nil, fmt.Errorf("获取面板Token失败: %w", err)
+			}
 			return nil, fmt.Errorf("连接面板失败，无法获取新Token: %w", err)
 		}
 
-		// 检查API响应状态
-		if tokenResp.Code != 200 {
-			return nil, fmt.Errorf("获取面板Token失败，错误信息: %s", tokenResp.Message)
+		// 连接信息变更后重新检查面板版本
+		info, warning, err = inspectPanelSystem(ctx, req.URL, tokenResp.Data.Token, tokenResp.Data.Expiration)
+		if err != nil {
+			return nil, err
 		}
 
 		// 更新Token相关字段
 		updater.SetToken(tokenResp.Data.Token).
 			SetParams(int32(tokenResp.Data.Expiration))
+		updatePanelSystemInfo(updater, info)
+
+		// 连接信息变更后原有健康状态不再适用
+		updater.SetHealthStatus(panelHealthUnknown).
+			SetConsecutiveFailures(0).
+			ClearHealthCheckedAt()
 	}
 
 	if err := updater.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("更新面板失败: %w", err)
 	}
 
+	// 连接信息可能已变更，丢弃旧的环境变量快照
+	if needRefreshToken {
+		InvalidatePanelEnvSnapshot(req.ID)
+		qinglong.ForgetTokenHolder(req.ID)
+	}
+
 	return &schema.UpdatePanelResponse{
 		Message: "面板更新成功",
+		Warning: warning,
 	}, nil
 }
 
@@ -150,7 +180,7 @@ func (s *PanelService) GetPanel(id int64) (*schema.GetPanelResponse, error) {
 		return nil, fmt.Errorf("查询面板失败: %w", err)
 	}
 
-	return &schema.GetPanelResponse{
+	resp := &schema.GetPanelResponse{
 		ID:           p.ID,
 		Name:         p.Name,
 		URL:          p.URL,
@@ -161,17 +191,15 @@ func (s *PanelService) GetPanel(id int64) (*schema.GetPanelResponse, error) {
 		Params:       p.Params,
 		CreatedAt:    p.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:    p.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+	}
+	fillPanelSystemInfo(resp, p)
+	fillPanelHealth(resp, p)
+	return resp, nil
 }
 
 // GetPanelList 获取面板列表
 func (s *PanelService) GetPanelList(req schema.GetPanelListRequest) (*schema.GetPanelListResponse, error) {
-	if req.Page <= 0 {
-		req.Page = 1
-	}
-	if req.PageSize <= 0 {
-		req.PageSize = 10
-	}
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 10)
 
 	ctx := context.Background()
 	query := config.Ent.Panel.Query()
@@ -199,7 +227,7 @@ func (s *PanelService) GetPanelList(req schema.GetPanelListRequest) (*schema.Get
 
 	list := make([]schema.GetPanelResponse, 0, len(panels))
 	for _, p := range panels {
-		list = append(list, schema.GetPanelResponse{
+		item := schema.GetPanelResponse{
 			ID:           p.ID,
 			Name:         p.Name,
 			URL:          p.URL,
@@ -210,7 +238,10 @@ func (s *PanelService) GetPanelList(req schema.GetPanelListRequest) (*schema.Get
 			Params:       p.Params,
 			CreatedAt:    p.CreatedAt.Format("2006-01-02 15:04:05"),
 			UpdatedAt:    p.UpdatedAt.Format("2006-01-02 15:04:05"),
-		})
+		}
+		fillPanelSystemInfo(&item, p)
+		fillPanelHealth(&item, p)
+		list = append(list, item)
 	}
 
 	return &schema.GetPanelListResponse{
@@ -231,9 +262,25 @@ func (s *PanelService) DeletePanel(req schema.DeletePanelRequest) (*schema.Delet
 		return nil, fmt.Errorf("查询面板失败: %w", err)
 	}
 
+	// 删除面板的变量绑定关系
+	if _, err := config.Ent.EnvPanel.Delete().
+		Where(envpanel.PanelIDEQ(req.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("删除变量绑定关系失败: %w", err)
+	}
+
+	// 删除面板上的副本记录
+	if _, err := config.Ent.EnvReplica.Delete().
+		Where(envreplica.PanelIDEQ(req.ID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("删除副本记录失败: %w", err)
+	}
+
 	if err := config.Ent.Panel.DeleteOneID(req.ID).Exec(ctx); err != nil {
 		return nil, fmt.Errorf("删除面板失败: %w", err)
 	}
+	InvalidatePanelEnvSnapshot(req.ID)
+	qinglong.ForgetTokenHolder(req.ID)
 
 	return &schema.DeletePanelResponse{
 		Message: "面板删除成功",
@@ -251,8 +298,10 @@ func (s *PanelService) TogglePanelStatus(req schema.TogglePanelStatusRequest) (*
 		return nil, fmt.Errorf("查询面板失败: %w", err)
 	}
 
+	// 手动切换状态后不再由健康检查自动恢复
 	if err := config.Ent.Panel.UpdateOneID(req.ID).
 		SetIsEnable(req.IsEnable).
+		SetAutoDisabled(false).
 		SetUpdatedAt(time.Now()).
 		Exec(ctx); err != nil {
 		return nil, fmt.Errorf("更新面板状态失败: %w", err)
@@ -282,28 +331,31 @@ func (s *PanelService) RefreshPanelToken(req schema.RefreshPanelTokenRequest) (*
 
 	// 使用面板的连接信息重新获取Token
 	qlConfig := qinglong.NewConfig(p.URL, p.ClientID, p.ClientSecret)
-	tokenResp, err := qlConfig.GetConfig()
+	tokenResp, err := qlConfig.GetConfig(ctx)
 	if err != nil {
+		if qinglong.IsAPIError(err) {
+			return nil, fmt.Errorf("刷新面板Token失败: %w", err)
+		}
 		return nil, fmt.Errorf("连接面板失败，无法刷新Token: %w", err)
 	}
 
-	// 检查API响应状态
-	if tokenResp.Code != 200 {
-		return nil, fmt.Errorf("刷新面板Token失败，错误信息: %s", tokenResp.Message)
-	}
-
 	newToken := tokenResp.Data.Token
 	newParams := int32(tokenResp.Data.Expiration)
 
-	// 更新Token信息
-	err = config.Ent.Panel.UpdateOneID(req.ID).
+	// 更新Token信息，同时顺带刷新面板系统信息（获取失败不影响Token刷新）
+	updater := config.Ent.Panel.UpdateOneID(req.ID).
 		SetToken(newToken).
 		SetParams(newParams).
-		SetUpdatedAt(time.Now()).
-		Exec(ctx)
-	if err != nil {
+		SetUpdatedAt(time.Now())
+	if sysResp, err := qinglong.NewAPI(p.URL, newToken, int(newParams)).GetSystemInfo(ctx); err == nil {
+		updatePanelSystemInfo(updater, &sysResp.Data)
+	} else {
+		config.Log.Warn(fmt.Sprintf("获取面板%d系统信息失败: %v", req.ID, err))
+	}
+	if err := updater.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("更新面板Token失败: %w", err)
 	}
+	qinglong.ForgetTokenHolder(req.ID)
 
 	return &schema.RefreshPanelTokenResponse{
 		Message: "Token刷新成功",
@@ -316,9 +368,24 @@ func (s *PanelService) TestPanelConnection(req schema.TestPanelConnectionRequest
 	// 创建青龙配置实例
 	qlConfig := qinglong.NewConfig(req.URL, req.ClientID, req.ClientSecret)
 
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+
 	// 尝试获取Token来测试连接
-	tokenResp, err := qlConfig.GetConfig()
+	tokenResp, err := qlConfig.GetConfig(ctx)
 	if err != nil {
+		var apiErr *qinglong.APIError
+		if errors.As(err, &apiErr) {
+			// API返回错误状态，认证失败
+			return &schema.TestPanelConnectionResponse{
+				Success:     false,
+				Message:     "认证失败",
+				Token:       "",
+				Expiration:  0,
+				ResponseMsg: fmt.Sprintf("API响应错误 (HTTP: %d, Code: %d): %s", apiErr.StatusCode, apiErr.Code, apiErr.Message),
+			}, nil
+		}
+
 		// 连接失败，返回失败响应
 		return &schema.TestPanelConnectionResponse{
 			Success:     false,
@@ -329,26 +396,30 @@ func (s *PanelService) TestPanelConnection(req schema.TestPanelConnectionRequest
 		}, nil
 	}
 
-	// 检查API响应状态
-	if tokenResp.Code != 200 {
-		// API返回错误状态，连接失败
-		return &schema.TestPanelConnectionResponse{
-			Success:     false,
-			Message:     "认证失败",
-			Token:       "",
-			Expiration:  0,
-			ResponseMsg: fmt.Sprintf("API响应错误 (Code: %d): %s", tokenResp.Code, tokenResp.Message),
-		}, nil
-	}
-
-	// 连接成功，返回成功响应
-	return &schema.TestPanelConnectionResponse{
+	// 连接成功，继续获取面板版本信息
+	resp := &schema.TestPanelConnectionResponse{
 		Success:     true,
 		Message:     "连接成功",
 		Token:       tokenResp.Data.Token,
 		Expiration:  tokenResp.Data.Expiration,
 		ResponseMsg: "面板连接正常，认证成功",
-	}, nil
+	}
+	sysResp, err := qinglong.NewAPI(req.URL, tokenResp.Data.Token, tokenResp.Data.Expiration).GetSystemInfo(ctx)
+	if err != nil {
+		resp.Warning = "获取面板系统信息失败，无法确认版本兼容性"
+		return resp, nil
+	}
+	resp.Version = sysResp.Data.Version
+	resp.NodeVersion = sysResp.Data.NodeVersion
+	warning, err := checkPanelVersion(sysResp.Data.Version)
+	if err != nil {
+		resp.Success = false
+		resp.Message = "版本不受支持"
+		resp.ResponseMsg = err.Error()
+		return resp, nil
+	}
+	resp.Warning = warning
+	return resp, nil
 }
 
 // SubmitEnvToPanel 提交环境变量到面板（集成插件执行流程）
@@ -402,34 +473,33 @@ func (s *PanelService) SubmitEnvToPanel(panelID int64, envID int64, envValue str
 
 // CreateTokenRefreshCallback 创建token刷新回调函数
 func (s *PanelService) CreateTokenRefreshCallback() qinglong.TokenRefreshCallback {
-	return func(panelID int64) (newToken string, err error) {
-		ctx := context.Background()
+	return func(ctx context.Context, panelID int64) (newToken string, expiration int, err error) {
 		// 查询面板信息
 		p, err := config.Ent.Panel.Get(ctx, panelID)
 		if err != nil {
-			return "", fmt.Errorf("查询面板失败: %w", err)
+			return "", 0, fmt.Errorf("查询面板失败: %w", err)
 		}
 
 		// 使用ClientID和ClientSecret重新获取token
 		qlConfig := qinglong.NewConfig(p.URL, p.ClientID, p.ClientSecret)
-		tokenResp, err := qlConfig.GetConfig()
+		tokenResp, err := qlConfig.GetConfig(ctx)
 		if err != nil {
-			return "", fmt.Errorf("获取新token失败: %w", err)
-		}
-
-		if tokenResp.Code != 200 {
-			return "", fmt.Errorf("获取token失败，响应码: %d, 消息: %s", tokenResp.Code, tokenResp.Message)
+			return "", 0, fmt.Errorf("获取新token失败: %w", err)
 		}
 
 		newToken = tokenResp.Data.Token
+		expiration = tokenResp.Data.Expiration
 
-		// 更新数据库中的token
-		err = config.Ent.Panel.UpdateOneID(panelID).SetToken(newToken).Exec(ctx)
+		// 更新数据库中的token及到期时间
+		err = config.Ent.Panel.UpdateOneID(panelID).
+			SetToken(newToken).
+			SetParams(int32(expiration)).
+			Exec(ctx)
 		if err != nil {
-			return "", fmt.Errorf("更新数据库token失败: %w", err)
+			return "", 0, fmt.Errorf("更新数据库token失败: %w", err)
 		}
 
-		return newToken, nil
+		return newToken, expiration, nil
 	}
 }
 
@@ -446,9 +516,17 @@ func (s *PanelService) CreateQlAPIWithAutoRefresh(panelID int64) (*qinglong.QlAP
 		return nil, fmt.Errorf("查询面板失败: %w", err)
 	}
 
+	return s.newPanelQlAPI(p), nil
+}
+
+// newPanelQlAPI 根据面板记录创建带自动刷新Token功能的API实例，不检查面板启用状态
+func (s *PanelService) newPanelQlAPI(p *ent.Panel) *qinglong.QlAPI {
 	// 创建带面板信息和回调函数的API实例
 	callback := s.CreateTokenRefreshCallback()
-	api := qinglong.NewAPIWithPanel(p.URL, p.Token, int(p.Params), panelID, callback)
+	api := qinglong.NewAPIWithPanel(p.URL, p.Token, int(p.Params), p.ID, callback)
+	if p.QlVersion != nil {
+		api.SetVersion(*p.QlVersion)
+	}
 
-	return api, nil
+	return api
 }
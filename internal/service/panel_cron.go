@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/qinglong"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetPanelCrons 获取面板定时任务列表
+func (s *PanelService) GetPanelCrons(panelID int64, req schema.GetPanelCronsRequest) (*schema.GetPanelCronsResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetCrons(ctx, req.SearchValue, req.Page, req.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("获取定时任务列表失败: %w", err)
+	}
+
+	list := res.Data.Data
+	if list == nil {
+		list = []schema.QlCron{}
+	}
+	return &schema.GetPanelCronsResponse{
+		Total: res.Data.Total,
+		List:  list,
+	}, nil
+}
+
+// CreatePanelCron 创建面板定时任务
+func (s *PanelService) CreatePanelCron(panelID int64, req schema.CreatePanelCronRequest) (*schema.PanelCronResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.PostCrons(ctx, schema.PostCronRequest{
+		Name:     req.Name,
+		Command:  req.Command,
+		Schedule: req.Schedule,
+		Labels:   req.Labels,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建定时任务失败: %w", err)
+	}
+
+	return &schema.PanelCronResponse{
+		Message: "定时任务创建成功",
+		Cron:    res.Data,
+	}, nil
+}
+
+// UpdatePanelCron 更新面板定时任务
+func (s *PanelService) UpdatePanelCron(panelID int64, req schema.UpdatePanelCronRequest) (*schema.PanelCronResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.PutCrons(ctx, schema.PutCronRequest{
+		Id:       req.ID,
+		Name:     req.Name,
+		Command:  req.Command,
+		Schedule: req.Schedule,
+		Labels:   req.Labels,
+	})
+	if err != nil {
+		if qinglong.IsNotFound(err) {
+			return nil, fmt.Errorf("定时任务%d不存在", req.ID)
+		}
+		return nil, fmt.Errorf("更新定时任务失败: %w", err)
+	}
+
+	return &schema.PanelCronResponse{
+		Message: "定时任务更新成功",
+		Cron:    res.Data,
+	}, nil
+}
+
+// DeletePanelCrons 删除面板定时任务
+func (s *PanelService) DeletePanelCrons(panelID int64, req schema.PanelCronIDsRequest) (*schema.PanelCronActionResponse, error) {
+	return s.panelCronAction(panelID, req.IDs, "删除", (*qinglong.QlAPI).DeleteCrons)
+}
+
+// EnablePanelCrons 启用面板定时任务
+func (s *PanelService) EnablePanelCrons(panelID int64, req schema.PanelCronIDsRequest) (*schema.PanelCronActionResponse, error) {
+	return s.panelCronAction(panelID, req.IDs, "启用", (*qinglong.QlAPI).PutEnableCrons)
+}
+
+// DisablePanelCrons 禁用面板定时任务
+func (s *PanelService) DisablePanelCrons(panelID int64, req schema.PanelCronIDsRequest) (*schema.PanelCronActionResponse, error) {
+	return s.panelCronAction(panelID, req.IDs, "禁用", (*qinglong.QlAPI).PutDisableCrons)
+}
+
+// RunPanelCrons 立即运行面板定时任务
+func (s *PanelService) RunPanelCrons(panelID int64, req schema.PanelCronIDsRequest) (*schema.PanelCronActionResponse, error) {
+	return s.panelCronAction(panelID, req.IDs, "运行", (*qinglong.QlAPI).PutRunCrons)
+}
+
+// StopPanelCrons 停止面板定时任务
+func (s *PanelService) StopPanelCrons(panelID int64, req schema.PanelCronIDsRequest) (*schema.PanelCronActionResponse, error) {
+	return s.panelCronAction(panelID, req.IDs, "停止", (*qinglong.QlAPI).PutStopCrons)
+}
+
+// GetPanelCronLog 获取面板定时任务最近一次运行日志
+func (s *PanelService) GetPanelCronLog(panelID int64, cronID int) (*schema.GetPanelCronLogResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetCronLog(ctx, cronID)
+	if err != nil {
+		if qinglong.IsNotFound(err) {
+			return nil, fmt.Errorf("定时任务%d不存在", cronID)
+		}
+		return nil, fmt.Errorf("获取定时任务日志失败: %w", err)
+	}
+
+	return &schema.GetPanelCronLogResponse{
+		ID:  cronID,
+		Log: res.Data,
+	}, nil
+}
+
+// panelCronAction 按ID批量执行面板定时任务操作
+func (s *PanelService) panelCronAction(
+	panelID int64,
+	ids []int,
+	actionName string,
+	action func(*qinglong.QlAPI, context.Context, schema.CronIDsRequest) (schema.CronActionResponse, error),
+) (*schema.PanelCronActionResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	if _, err := action(qlAPI, ctx, ids); err != nil {
+		return nil, fmt.Errorf("%s定时任务失败: %w", actionName, err)
+	}
+
+	return &schema.PanelCronActionResponse{
+		Message: fmt.Sprintf("成功%s%d个定时任务", actionName, len(ids)),
+	}, nil
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/qinglong"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+	"golang.org/x/sync/errgroup"
+)
+
+// GetPanelDependencies 获取面板依赖列表
+func (s *PanelService) GetPanelDependencies(panelID int64, req schema.GetPanelDependenciesRequest) (*schema.GetPanelDependenciesResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetDependencies(ctx, req.SearchValue, *req.Type)
+	if err != nil {
+		return nil, fmt.Errorf("获取依赖列表失败: %w", err)
+	}
+
+	list := res.Data
+	if list == nil {
+		list = []schema.QlDependency{}
+	}
+	return &schema.GetPanelDependenciesResponse{List: list}, nil
+}
+
+// InstallPanelDependencies 在面板上安装依赖
+func (s *PanelService) InstallPanelDependencies(panelID int64, req schema.InstallPanelDependenciesRequest) (*schema.InstallPanelDependenciesResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make(schema.PostDependencyRequest, 0, len(req.Dependencies))
+	for _, d := range req.Dependencies {
+		deps = append(deps, schema.PostDependency{Name: d.Name, Type: *d.Type, Remark: d.Remark})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.PostDependencies(ctx, deps)
+	if err != nil {
+		return nil, fmt.Errorf("安装依赖失败: %w", err)
+	}
+
+	return &schema.InstallPanelDependenciesResponse{
+		Message: fmt.Sprintf("已提交%d个依赖的安装任务", len(deps)),
+		List:    res.Data,
+	}, nil
+}
+
+// ReinstallPanelDependencies 重新安装面板依赖
+func (s *PanelService) ReinstallPanelDependencies(panelID int64, req schema.PanelDependencyIDsRequest) (*schema.PanelDependencyActionResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	if _, err := qlAPI.PutReinstallDependencies(ctx, req.IDs); err != nil {
+		return nil, fmt.Errorf("重新安装依赖失败: %w", err)
+	}
+
+	return &schema.PanelDependencyActionResponse{
+		Message: fmt.Sprintf("已提交%d个依赖的重新安装任务", len(req.IDs)),
+	}, nil
+}
+
+// DeletePanelDependencies 删除面板依赖
+func (s *PanelService) DeletePanelDependencies(panelID int64, req schema.PanelDependencyIDsRequest) (*schema.PanelDependencyActionResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	if _, err := qlAPI.DeleteDependencies(ctx, req.IDs); err != nil {
+		return nil, fmt.Errorf("删除依赖失败: %w", err)
+	}
+
+	return &schema.PanelDependencyActionResponse{
+		Message: fmt.Sprintf("已提交%d个依赖的删除任务", len(req.IDs)),
+	}, nil
+}
+
+// GetPanelDependencyLog 获取面板依赖安装日志
+func (s *PanelService) GetPanelDependencyLog(panelID int64, dependencyID int) (*schema.GetPanelDependencyLogResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetDependency(ctx, dependencyID)
+	if err != nil {
+		if qinglong.IsNotFound(err) {
+			return nil, fmt.Errorf("依赖%d不存在", dependencyID)
+		}
+		return nil, fmt.Errorf("获取依赖日志失败: %w", err)
+	}
+
+	log := res.Data.Log
+	if log == nil {
+		log = []string{}
+	}
+	return &schema.GetPanelDependencyLogResponse{
+		ID:         res.Data.Id,
+		Name:       res.Data.Name,
+		Status:     res.Data.Status,
+		StatusText: qinglong.DependencyStatusText(res.Data.Status),
+		Log:        log,
+	}, nil
+}
+
+// GetDependencyFleet 获取依赖在所有启用面板上的安装情况
+func (s *PanelService) GetDependencyFleet(req schema.GetDependencyFleetRequest) (*schema.GetDependencyFleetResponse, error) {
+	ctx := context.Background()
+	panels, err := config.Ent.Panel.Query().
+		Where(panel.IsEnableEQ(true)).
+		Order(ent.Asc(panel.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询面板失败: %w", err)
+	}
+
+	items := s.collectDependencyFleet(panels, req.Name, *req.Type)
+
+	resp := &schema.GetDependencyFleetResponse{
+		Name:   req.Name,
+		Type:   *req.Type,
+		Panels: items,
+	}
+	for _, item := range items {
+		switch {
+		case item.Installed:
+			resp.InstalledCount++
+		case item.Reachable:
+			resp.MissingCount++
+		}
+	}
+	return resp, nil
+}
+
+// InstallMissingDependency 在缺少依赖的面板上批量安装
+// 已安装、正在安装或排队中的面板以及不可达的面板会被跳过
+func (s *PanelService) InstallMissingDependency(req schema.InstallMissingDependencyRequest) (*schema.PushFileResponse, error) {
+	ctx := context.Background()
+	query := config.Ent.Panel.Query().Where(panel.IsEnableEQ(true))
+	if len(req.PanelIDs) > 0 {
+		query.Where(panel.IDIn(req.PanelIDs...))
+	}
+	panels, err := query.Order(ent.Asc(panel.FieldID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询面板失败: %w", err)
+	}
+
+	missing := make([]int64, 0, len(panels))
+	for _, item := range s.collectDependencyFleet(panels, req.Name, *req.Type) {
+		if !item.Reachable || item.Installed {
+			continue
+		}
+		if item.Status != nil && (*item.Status == qinglong.DependencyInstalling || *item.Status == qinglong.DependencyQueued) {
+			continue
+		}
+		missing = append(missing, item.PanelID)
+	}
+	if len(missing) == 0 {
+		return &schema.PushFileResponse{
+			Message: "所有可达面板均已安装该依赖",
+			Results: []schema.PanelPushResult{},
+		}, nil
+	}
+
+	deps := schema.PostDependencyRequest{{Name: req.Name, Type: *req.Type, Remark: req.Remark}}
+	resp, err := s.pushToPanels(missing, func(ctx context.Context, qlAPI *qinglong.QlAPI) (bool, string, error) {
+		if _, err := qlAPI.PostDependencies(ctx, deps); err != nil {
+			return false, "", fmt.Errorf("安装依赖失败: %w", err)
+		}
+		return false, "已提交安装任务", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Message = fmt.Sprintf("已在%d个面板提交安装任务，失败%d个面板", resp.SuccessCount, resp.FailedCount)
+	return resp, nil
+}
+
+// collectDependencyFleet 并发查询依赖在各面板上的安装情况，结果按面板顺序返回
+func (s *PanelService) collectDependencyFleet(panels []*ent.Panel, name string, depType int) []schema.DependencyFleetItem {
+	baseName := dependencyBaseName(name, depType)
+	items := make([]schema.DependencyFleetItem, len(panels))
+
+	var g errgroup.Group
+	g.SetLimit(panelMaxConcurrency())
+	for i, p := range panels {
+		g.Go(func() error {
+			item := schema.DependencyFleetItem{PanelID: p.ID, PanelName: p.Name}
+			defer func() { items[i] = item }()
+
+			qlAPI, err := s.CreateQlAPIWithAutoRefresh(p.ID)
+			if err != nil {
+				item.Message = err.Error()
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+			defer cancel()
+			res, err := qlAPI.GetDependencies(ctx, baseName, depType)
+			if err != nil {
+				item.Message = err.Error()
+				return nil
+			}
+			item.Reachable = true
+
+			// 同名依赖可能存在多条记录（如删除后重新安装），优先取已安装的记录，否则取最新的一条
+			matches := make([]schema.QlDependency, 0)
+			for _, d := range res.Data {
+				if dependencyBaseName(d.Name, depType) == baseName {
+					matches = append(matches, d)
+				}
+			}
+			if len(matches) == 0 {
+				item.StatusText = "未安装"
+				return nil
+			}
+			sort.SliceStable(matches, func(a, b int) bool {
+				if (matches[a].Status == qinglong.DependencyInstalled) != (matches[b].Status == qinglong.DependencyInstalled) {
+					return matches[a].Status == qinglong.DependencyInstalled
+				}
+				return matches[a].Id > matches[b].Id
+			})
+
+			d := matches[0]
+			status := d.Status
+			item.DependencyID = d.Id
+			item.Status = &status
+			item.StatusText = qinglong.DependencyStatusText(status)
+			item.Installed = status == qinglong.DependencyInstalled
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return items
+}
+
+// dependencyBaseName 去掉依赖名称中的版本号，用于跨面板比较
+// nodejs: axios@1.6.0 -> axios，@scope/pkg@1.0.0 -> @scope/pkg；python3: requests==2.31.0 -> requests
+func dependencyBaseName(name string, depType int) string {
+	name = strings.TrimSpace(name)
+	switch depType {
+	case qinglong.DependencyNodeJS:
+		if idx := strings.LastIndex(name, "@"); idx > 0 {
+			name = name[:idx]
+		}
+	case qinglong.DependencyPython3:
+		if idx := strings.IndexAny(name, "=<>~![; "); idx > 0 {
+			name = name[:idx]
+		}
+		name = strings.ToLower(name)
+	}
+	return name
+}
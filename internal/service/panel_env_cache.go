@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+)
+
+// PanelEnvSnapshot 面板环境变量快照
+type PanelEnvSnapshot struct {
+	PanelID     int64            // 面板ID
+	Envs        []schema.QlEnv   // 面板中的全部环境变量
+	CountByName map[string]int32 // 按变量名统计的数量
+	FetchedAt   time.Time        // 拉取时间
+}
+
+// Count 返回指定变量名在面板中的数量
+func (s *PanelEnvSnapshot) Count(name string) int32 {
+	return s.CountByName[name]
+}
+
+// hasEnv 判断面板中是否存在指定ID的变量
+func (s *PanelEnvSnapshot) hasEnv(id int) bool {
+	for _, e := range s.Envs {
+		if e.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// panelEnvCache 面板环境变量快照缓存
+// 同一面板的并发拉取通过 singleflight 合并；写入面板后递增代数使旧快照与进行中的拉取结果失效
+type panelEnvCache struct {
+	mu          sync.Mutex
+	snapshots   map[int64]*PanelEnvSnapshot
+	generations map[int64]uint64
+	lastCounts  map[int64]map[string]int32 // 最近一次成功拉取的变量数量，不随缓存过期或失效清除
+	group       singleflight.Group
+}
+
+var envSnapshotCache = &panelEnvCache{
+	snapshots:   make(map[int64]*PanelEnvSnapshot),
+	generations: make(map[int64]uint64),
+	lastCounts:  make(map[int64]map[string]int32),
+}
+
+// envSnapshotTTL 快照缓存时长，配置为负数时关闭缓存
+func envSnapshotTTL() time.Duration {
+	ttl := config.Config.Qinglong.EnvCacheTTL
+	if ttl == 0 {
+		ttl = 10
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+// panelRequestTimeout 单个面板请求超时时长
+func panelRequestTimeout() time.Duration {
+	timeout := config.Config.Qinglong.RequestTimeout
+	if timeout <= 0 {
+		timeout = 5
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// panelMaxConcurrency 同时请求的面板数上限
+func panelMaxConcurrency() int {
+	if config.Config.Qinglong.MaxConcurrency <= 0 {
+		return 8
+	}
+	return config.Config.Qinglong.MaxConcurrency
+}
+
+// GetPanelEnvSnapshot 获取面板环境变量快照（优先使用缓存）
+// 实际拉取使用独立的超时上下文，避免某个调用方取消后影响合并到同一次拉取的其他调用方
+func (s *PanelService) GetPanelEnvSnapshot(ctx context.Context, panelID int64) (*PanelEnvSnapshot, error) {
+	ttl := envSnapshotTTL()
+
+	envSnapshotCache.mu.Lock()
+	gen := envSnapshotCache.generations[panelID]
+	if snapshot, ok := envSnapshotCache.snapshots[panelID]; ok && time.Since(snapshot.FetchedAt) < ttl {
+		envSnapshotCache.mu.Unlock()
+		return snapshot, nil
+	}
+	envSnapshotCache.mu.Unlock()
+
+	// 键中包含代数，失效后的调用不会复用失效前发起的拉取
+	key := fmt.Sprintf("%d:%d", panelID, gen)
+	ch := envSnapshotCache.group.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+		defer cancel()
+
+		snapshot, err := s.fetchPanelEnvSnapshot(fetchCtx, panelID)
+		if err != nil {
+			return nil, err
+		}
+
+		envSnapshotCache.mu.Lock()
+		if ttl > 0 && envSnapshotCache.generations[panelID] == gen {
+			envSnapshotCache.snapshots[panelID] = snapshot
+		}
+		envSnapshotCache.lastCounts[panelID] = snapshot.CountByName
+		envSnapshotCache.mu.Unlock()
+
+		return snapshot, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*PanelEnvSnapshot), nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("获取面板%d环境变量失败: %w", panelID, ctx.Err())
+	}
+}
+
+// GetPanelEnvSnapshots 并发获取多个面板的环境变量快照
+// 返回成功获取的快照与不可达的面板ID（升序）
+func (s *PanelService) GetPanelEnvSnapshots(ctx context.Context, panelIDs []int64) (map[int64]*PanelEnvSnapshot, []int64) {
+	var (
+		mu          sync.Mutex
+		snapshots   = make(map[int64]*PanelEnvSnapshot, len(panelIDs))
+		unreachable = make([]int64, 0)
+	)
+
+	var g errgroup.Group
+	g.SetLimit(panelMaxConcurrency())
+	for _, panelID := range panelIDs {
+		g.Go(func() error {
+			snapshot, err := s.GetPanelEnvSnapshot(ctx, panelID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				config.Log.Warn(err.Error())
+				unreachable = append(unreachable, panelID)
+				return nil
+			}
+			snapshots[panelID] = snapshot
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(unreachable, func(i, j int) bool { return unreachable[i] < unreachable[j] })
+	return snapshots, unreachable
+}
+
+// fetchPanelEnvSnapshot 从面板拉取环境变量并生成快照
+func (s *PanelService) fetchPanelEnvSnapshot(ctx context.Context, panelID int64) (*PanelEnvSnapshot, error) {
+	// 创建青龙API实例
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, fmt.Errorf("创建面板%d的API实例失败: %w", panelID, err)
+	}
+
+	// 获取面板中的所有环境变量
+	envResponse, err := qlAPI.GetEnvs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取面板%d环境变量失败: %w", panelID, err)
+	}
+
+	countByName := make(map[string]int32)
+	for _, e := range envResponse.Data {
+		countByName[e.Name]++
+	}
+
+	return &PanelEnvSnapshot{
+		PanelID:     panelID,
+		Envs:        envResponse.Data,
+		CountByName: countByName,
+		FetchedAt:   time.Now(),
+	}, nil
+}
+
+// lastKnownEnvCount 最近一次成功拉取时变量在面板中的数量，面板不可达时用于估算已用位置
+func lastKnownEnvCount(panelID int64, name string) (int32, bool) {
+	envSnapshotCache.mu.Lock()
+	defer envSnapshotCache.mu.Unlock()
+
+	counts, ok := envSnapshotCache.lastCounts[panelID]
+	if !ok {
+		return 0, false
+	}
+	return counts[name], true
+}
+
+// InvalidatePanelEnvSnapshot 使面板环境变量快照失效，写入面板或修改面板配置后调用
+func InvalidatePanelEnvSnapshot(panelID int64) {
+	envSnapshotCache.mu.Lock()
+	defer envSnapshotCache.mu.Unlock()
+
+	envSnapshotCache.generations[panelID]++
+	delete(envSnapshotCache.snapshots, panelID)
+}
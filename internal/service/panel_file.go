@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/qinglong"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+	"golang.org/x/sync/errgroup"
+)
+
+// 配置推送方式
+const (
+	pushConfigAppend  = "append"
+	pushConfigReplace = "replace"
+)
+
+// GetPanelScripts 获取面板脚本列表
+func (s *PanelService) GetPanelScripts(panelID int64) (*schema.GetPanelScriptsResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetScripts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取脚本列表失败: %w", err)
+	}
+
+	list := res.Data
+	if list == nil {
+		list = []schema.QlScriptFile{}
+	}
+	return &schema.GetPanelScriptsResponse{List: list}, nil
+}
+
+// GetPanelScriptDetail 获取面板脚本内容
+func (s *PanelService) GetPanelScriptDetail(panelID int64, req schema.GetPanelScriptDetailRequest) (*schema.PanelScriptDetailResponse, error) {
+	if err := validatePanelFilePath(req.Filename, req.Path); err != nil {
+		return nil, err
+	}
+
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetScriptDetail(ctx, req.Filename, req.Path)
+	if err != nil {
+		if qinglong.IsNotFound(err) {
+			return nil, errors.New("脚本不存在")
+		}
+		return nil, fmt.Errorf("获取脚本内容失败: %w", err)
+	}
+
+	return &schema.PanelScriptDetailResponse{
+		Filename: req.Filename,
+		Path:     req.Path,
+		Content:  res.Data,
+	}, nil
+}
+
+// SavePanelScript 保存面板脚本，文件不存在时创建
+func (s *PanelService) SavePanelScript(panelID int64, req schema.SavePanelScriptRequest) (*schema.PanelFileActionResponse, error) {
+	if err := validatePanelFilePath(req.Filename, req.Path); err != nil {
+		return nil, err
+	}
+
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	if err := savePanelScript(ctx, qlAPI, req.Filename, req.Path, req.Content); err != nil {
+		return nil, err
+	}
+
+	return &schema.PanelFileActionResponse{
+		Message: "脚本保存成功",
+	}, nil
+}
+
+// DeletePanelScript 删除面板脚本
+func (s *PanelService) DeletePanelScript(panelID int64, req schema.DeletePanelScriptRequest) (*schema.PanelFileActionResponse, error) {
+	if err := validatePanelFilePath(req.Filename, req.Path); err != nil {
+		return nil, err
+	}
+
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	_, err = qlAPI.DeleteScripts(ctx, schema.DeleteScriptRequest{
+		Filename: req.Filename,
+		Path:     req.Path,
+		Type:     req.Type,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("删除脚本失败: %w", err)
+	}
+
+	return &schema.PanelFileActionResponse{
+		Message: "脚本删除成功",
+	}, nil
+}
+
+// GetPanelConfigs 获取面板配置文件列表
+func (s *PanelService) GetPanelConfigs(panelID int64) (*schema.GetPanelConfigsResponse, error) {
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetConfigFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取配置文件列表失败: %w", err)
+	}
+
+	list := res.Data
+	if list == nil {
+		list = []schema.QlConfigFile{}
+	}
+	return &schema.GetPanelConfigsResponse{List: list}, nil
+}
+
+// GetPanelConfigDetail 获取面板配置文件内容
+func (s *PanelService) GetPanelConfigDetail(panelID int64, req schema.GetPanelConfigDetailRequest) (*schema.PanelConfigDetailResponse, error) {
+	if err := validatePanelFilePath(req.Name, ""); err != nil {
+		return nil, err
+	}
+
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	res, err := qlAPI.GetConfigDetail(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("获取配置文件内容失败: %w", err)
+	}
+
+	return &schema.PanelConfigDetailResponse{
+		Name:    req.Name,
+		Content: res.Data,
+	}, nil
+}
+
+// SavePanelConfig 保存面板配置文件
+func (s *PanelService) SavePanelConfig(panelID int64, req schema.SavePanelConfigRequest) (*schema.PanelFileActionResponse, error) {
+	if err := validatePanelFilePath(req.Name, ""); err != nil {
+		return nil, err
+	}
+
+	qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+	if _, err := qlAPI.SaveConfig(ctx, schema.SaveConfigRequest{Name: req.Name, Content: req.Content}); err != nil {
+		return nil, fmt.Errorf("保存配置文件失败: %w", err)
+	}
+
+	return &schema.PanelFileActionResponse{
+		Message: "配置文件保存成功",
+	}, nil
+}
+
+// PushScript 将脚本推送到多个面板
+func (s *PanelService) PushScript(req schema.PushScriptRequest) (*schema.PushFileResponse, error) {
+	if err := validatePanelFilePath(req.Filename, req.Path); err != nil {
+		return nil, err
+	}
+
+	return s.pushToPanels(req.PanelIDs, func(ctx context.Context, qlAPI *qinglong.QlAPI) (bool, string, error) {
+		if err := savePanelScript(ctx, qlAPI, req.Filename, req.Path, req.Content); err != nil {
+			return false, "", err
+		}
+		return false, "脚本已保存", nil
+	})
+}
+
+// PushConfig 将配置推送到多个面板
+// append 模式下将片段追加到文件末尾，文件已包含该片段时跳过；replace 模式覆盖整个文件
+func (s *PanelService) PushConfig(req schema.PushConfigRequest) (*schema.PushFileResponse, error) {
+	if err := validatePanelFilePath(req.Name, ""); err != nil {
+		return nil, err
+	}
+	if req.Mode == "" {
+		req.Mode = pushConfigAppend
+	}
+
+	return s.pushToPanels(req.PanelIDs, func(ctx context.Context, qlAPI *qinglong.QlAPI) (bool, string, error) {
+		content := req.Content
+		if req.Mode == pushConfigAppend {
+			detail, err := qlAPI.GetConfigDetail(ctx, req.Name)
+			if err != nil {
+				return false, "", fmt.Errorf("读取配置文件失败: %w", err)
+			}
+			if strings.Contains(detail.Data, strings.TrimSpace(req.Content)) {
+				return true, "配置已包含该内容，跳过", nil
+			}
+			content = strings.TrimRight(detail.Data, "\n") + "\n" + req.Content
+			if !strings.HasSuffix(content, "\n") {
+				content += "\n"
+			}
+		}
+
+		if _, err := qlAPI.SaveConfig(ctx, schema.SaveConfigRequest{Name: req.Name, Content: content}); err != nil {
+			return false, "", fmt.Errorf("保存配置文件失败: %w", err)
+		}
+		if req.Mode == pushConfigReplace {
+			return false, "配置文件已覆盖", nil
+		}
+		return false, "配置已追加", nil
+	})
+}
+
+// pushToPanels 并发在多个面板上执行推送操作，返回各面板结果
+func (s *PanelService) pushToPanels(panelIDs []int64, push func(ctx context.Context, qlAPI *qinglong.QlAPI) (skipped bool, message string, err error)) (*schema.PushFileResponse, error) {
+	ctx := context.Background()
+	panels, err := config.Ent.Panel.Query().
+		Where(panel.IDIn(panelIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询面板失败: %w", err)
+	}
+	names := make(map[int64]string, len(panels))
+	enabled := make(map[int64]bool, len(panels))
+	for _, p := range panels {
+		names[p.ID] = p.Name
+		enabled[p.ID] = p.IsEnable
+	}
+
+	results := make([]schema.PanelPushResult, len(panelIDs))
+	var g errgroup.Group
+	g.SetLimit(panelMaxConcurrency())
+	for i, panelID := range panelIDs {
+		g.Go(func() error {
+			result := schema.PanelPushResult{PanelID: panelID, PanelName: names[panelID]}
+			defer func() { results[i] = result }()
+
+			if _, ok := names[panelID]; !ok {
+				result.Message = "面板不存在"
+				return nil
+			}
+			if !enabled[panelID] {
+				result.Message = "面板未启用"
+				return nil
+			}
+
+			qlAPI, err := s.CreateQlAPIWithAutoRefresh(panelID)
+			if err != nil {
+				result.Message = err.Error()
+				return nil
+			}
+
+			pushCtx, cancel := context.WithTimeout(ctx, panelRequestTimeout())
+			defer cancel()
+			skipped, message, err := push(pushCtx, qlAPI)
+			if err != nil {
+				result.Message = err.Error()
+				config.Log.Warn(fmt.Sprintf("推送到面板%d失败: %v", panelID, err))
+				return nil
+			}
+			result.Success = true
+			result.Skipped = skipped
+			result.Message = message
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	resp := &schema.PushFileResponse{Results: results}
+	for _, r := range results {
+		if r.Success {
+			resp.SuccessCount++
+		} else {
+			resp.FailedCount++
+		}
+	}
+	resp.Message = fmt.Sprintf("推送完成，成功%d个面板，失败%d个面板", resp.SuccessCount, resp.FailedCount)
+
+	return resp, nil
+}
+
+// savePanelScript 保存脚本到面板
+func savePanelScript(ctx context.Context, qlAPI *qinglong.QlAPI, filename, path, content string) error {
+	_, err := qlAPI.PutScripts(ctx, schema.PutScriptRequest{
+		Filename: filename,
+		Path:     path,
+		Content:  content,
+	})
+	if err != nil {
+		return fmt.Errorf("保存脚本失败: %w", err)
+	}
+	return nil
+}
+
+// validatePanelFilePath 校验文件名与目录，禁止访问上级目录
+func validatePanelFilePath(filename, path string) error {
+	if strings.Contains(filename, "..") || strings.ContainsAny(filename, `/\`) {
+		return errors.New("文件名格式错误")
+	}
+	if strings.Contains(path, "..") {
+		return errors.New("目录格式错误")
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config/autoload"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panel"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/panelhealth"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/qinglong"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+	"golang.org/x/sync/errgroup"
+)
+
+// 面板健康状态
+const (
+	panelHealthUnknown  = "unknown"
+	panelHealthHealthy  = "healthy"
+	panelHealthDegraded = "degraded"
+	panelHealthDown     = "down"
+)
+
+func init() {
+	registerRetentionTarget("panel-health", "面板健康检查记录",
+		autoload.RetentionPolicy{MaxAge: 7}, purgePanelHealth)
+}
+
+// panelProbeResult 单次面板探测结果
+type panelProbeResult struct {
+	TokenValid bool
+	Latency    int64
+	EnvCount   *int32
+	Err        error
+}
+
+// StartPanelHealthTask 启动面板健康检查任务
+func StartPanelHealthTask() {
+	if !config.Config.PanelHealth.Enable {
+		return
+	}
+
+	interval := time.Duration(config.Config.PanelHealth.Interval) * time.Minute
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	go func() {
+		s := NewPanelService()
+		// 启动后先检查一次，避免在首个周期内使用未知状态
+		s.checkAllPanelHealth()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			s.checkAllPanelHealth()
+		}
+	}()
+}
+
+// GetPanelHealthOverview 获取所有面板的健康概览
+func (s *PanelService) GetPanelHealthOverview() (*schema.GetPanelHealthOverviewResponse, error) {
+	ctx := context.Background()
+	panels, err := config.Ent.Panel.Query().
+		Order(ent.Asc(panel.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询面板失败: %w", err)
+	}
+
+	list := make([]schema.PanelHealthOverviewItem, 0, len(panels))
+	for _, p := range panels {
+		item := schema.PanelHealthOverviewItem{
+			PanelID:             p.ID,
+			PanelName:           p.Name,
+			IsEnable:            p.IsEnable,
+			AutoDisabled:        p.AutoDisabled,
+			HealthStatus:        p.HealthStatus,
+			ConsecutiveFailures: p.ConsecutiveFailures,
+		}
+		if p.HealthCheckedAt != nil {
+			checkedAt := p.HealthCheckedAt.Format("2006-01-02 15:04:05")
+			item.HealthCheckedAt = &checkedAt
+		}
+		list = append(list, item)
+	}
+
+	return &schema.GetPanelHealthOverviewResponse{List: list}, nil
+}
+
+// GetPanelHealthHistory 获取面板健康检查记录
+func (s *PanelService) GetPanelHealthHistory(panelID int64, req schema.GetPanelHealthHistoryRequest) (*schema.GetPanelHealthHistoryResponse, error) {
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 20)
+
+	ctx := context.Background()
+	query := config.Ent.PanelHealth.Query().Where(panelhealth.PanelIDEQ(panelID))
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询健康检查记录总数失败: %w", err)
+	}
+
+	records, err := query.Order(ent.Desc(panelhealth.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询健康检查记录失败: %w", err)
+	}
+
+	list := make([]schema.PanelHealthRecord, 0, len(records))
+	for _, r := range records {
+		list = append(list, toPanelHealthRecord(r))
+	}
+
+	return &schema.GetPanelHealthHistoryResponse{
+		Total: int64(total),
+		List:  list,
+	}, nil
+}
+
+// CheckPanelHealth 立即检查面板健康状态，禁用的面板同样可以检查
+func (s *PanelService) CheckPanelHealth(panelID int64) (*schema.CheckPanelHealthResponse, error) {
+	ctx := context.Background()
+	p, err := config.Ent.Panel.Get(ctx, panelID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("面板不存在")
+		}
+		return nil, fmt.Errorf("查询面板失败: %w", err)
+	}
+
+	record, err := s.recordPanelHealth(ctx, p, s.probePanel(p))
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.CheckPanelHealthResponse{
+		Message: "健康检查完成",
+		Record:  toPanelHealthRecord(record),
+	}, nil
+}
+
+// checkAllPanelHealth 并发检查所有启用面板以及被自动禁用的面板
+func (s *PanelService) checkAllPanelHealth() {
+	ctx := context.Background()
+	panels, err := config.Ent.Panel.Query().
+		Where(panel.Or(panel.IsEnableEQ(true), panel.AutoDisabledEQ(true))).
+		All(ctx)
+	if err != nil {
+		config.Log.Error(fmt.Sprintf("面板健康检查失败: %v", err))
+		return
+	}
+
+	var g errgroup.Group
+	g.SetLimit(panelMaxConcurrency())
+	for _, p := range panels {
+		g.Go(func() error {
+			if _, err := s.recordPanelHealth(ctx, p, s.probePanel(p)); err != nil {
+				config.Log.Error(fmt.Sprintf("记录面板%d健康状态失败: %v", p.ID, err))
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// probePanel 探测面板：拉取环境变量以同时验证Token、测量耗时并统计变量数量
+func (s *PanelService) probePanel(p *ent.Panel) panelProbeResult {
+	qlAPI := s.newPanelQlAPI(p)
+
+	ctx, cancel := context.WithTimeout(context.Background(), panelRequestTimeout())
+	defer cancel()
+
+	start := time.Now()
+	res, err := qlAPI.GetEnvs(ctx)
+	result := panelProbeResult{
+		Latency: time.Since(start).Milliseconds(),
+		// 仅在面板明确拒绝认证（刷新Token后仍失败）时记为无效
+		TokenValid: !qinglong.IsUnauthorized(err),
+		Err:        err,
+	}
+	if err == nil {
+		count := int32(len(res.Data))
+		result.EnvCount = &count
+	}
+	return result
+}
+
+// recordPanelHealth 保存探测结果并更新面板健康状态，按配置自动禁用或恢复面板
+func (s *PanelService) recordPanelHealth(ctx context.Context, p *ent.Panel, result panelProbeResult) (*ent.PanelHealth, error) {
+	cfg := config.Config.PanelHealth
+	degradedLatency := int64(cfg.DegradedLatency)
+	if degradedLatency <= 0 {
+		degradedLatency = 3000
+	}
+	downThreshold := cfg.DownThreshold
+	if downThreshold <= 0 {
+		downThreshold = 3
+	}
+	autoDisableAfter := cfg.AutoDisableAfter
+	if autoDisableAfter <= 0 {
+		autoDisableAfter = 12
+	}
+
+	// 连续失败次数在数据库中原子累加或清零，避免与手动检查并发时互相覆盖
+	counter := config.Ent.Panel.UpdateOneID(p.ID)
+	if result.Err != nil {
+		counter.AddConsecutiveFailures(1)
+	} else {
+		counter.SetConsecutiveFailures(0)
+	}
+	current, err := counter.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新面板健康状态失败: %w", err)
+	}
+	failures := current.ConsecutiveFailures
+
+	var status, message string
+	switch {
+	case result.Err != nil:
+		status = panelHealthDegraded
+		if failures >= downThreshold {
+			status = panelHealthDown
+		}
+		message = result.Err.Error()
+		if !result.TokenValid {
+			message = "Token无效: " + message
+		}
+	case result.Latency > degradedLatency:
+		status = panelHealthDegraded
+		message = fmt.Sprintf("响应耗时%dms，超过阈值%dms", result.Latency, degradedLatency)
+	default:
+		status = panelHealthHealthy
+	}
+
+	builder := config.Ent.PanelHealth.Create().
+		SetPanelID(p.ID).
+		SetStatus(status).
+		SetTokenValid(result.TokenValid).
+		SetLatency(result.Latency).
+		SetNillableEnvCount(result.EnvCount)
+	if message != "" {
+		builder.SetMessage(message)
+	}
+	record, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("保存健康检查记录失败: %w", err)
+	}
+
+	if err := config.Ent.Panel.UpdateOneID(p.ID).
+		SetHealthStatus(status).
+		SetHealthCheckedAt(record.CreatedAt).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("更新面板健康状态失败: %w", err)
+	}
+
+	// 自动禁用与恢复按数据库中的最新状态条件更新：只禁用仍处于启用状态的面板，
+	// 只恢复仍标记为自动禁用的面板，期间被管理员手动启停的面板不受影响
+	switch {
+	case result.Err != nil && cfg.AutoDisable && failures >= autoDisableAfter:
+		disabled, err := config.Ent.Panel.Update().
+			Where(panel.IDEQ(p.ID), panel.IsEnableEQ(true)).
+			SetIsEnable(false).
+			SetAutoDisabled(true).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("自动禁用面板失败: %w", err)
+		}
+		if disabled > 0 {
+			config.Log.Warn(fmt.Sprintf("面板%d连续%d次检查失败，已自动禁用", p.ID, failures))
+		}
+	case result.Err == nil:
+		enabled, err := config.Ent.Panel.Update().
+			Where(panel.IDEQ(p.ID), panel.AutoDisabledEQ(true)).
+			SetIsEnable(true).
+			SetAutoDisabled(false).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("重新启用面板失败: %w", err)
+		}
+		if enabled > 0 {
+			config.Log.Info(fmt.Sprintf("面板%d已恢复，自动重新启用", p.ID))
+		}
+	}
+
+	if status != current.HealthStatus {
+		config.Log.Info(fmt.Sprintf("面板%d健康状态变更: %s -> %s", p.ID, current.HealthStatus, status))
+	}
+
+	return record, nil
+}
+
+// filterPanelsByHealth 根据健康状态筛选可提交的面板
+// 不可用的面板总是跳过；存在正常面板时同时跳过降级面板，否则退回使用降级面板
+func filterPanelsByHealth(ctx context.Context, panelIDs []int64) ([]int64, error) {
+	if !config.Config.PanelHealth.Enable || len(panelIDs) == 0 {
+		return panelIDs, nil
+	}
+
+	panels, err := config.Ent.Panel.Query().
+		Where(panel.IDIn(panelIDs...)).
+		Select(panel.FieldID, panel.FieldHealthStatus).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询面板健康状态失败: %w", err)
+	}
+	statuses := make(map[int64]string, len(panels))
+	for _, p := range panels {
+		statuses[p.ID] = p.HealthStatus
+	}
+
+	preferred := make([]int64, 0, len(panelIDs))
+	degraded := make([]int64, 0)
+	for _, panelID := range panelIDs {
+		switch statuses[panelID] {
+		case panelHealthDown:
+			continue
+		case panelHealthDegraded:
+			degraded = append(degraded, panelID)
+		default:
+			preferred = append(preferred, panelID)
+		}
+	}
+
+	if len(preferred) > 0 {
+		return preferred, nil
+	}
+	return degraded, nil
+}
+
+// fillPanelHealth 填充面板响应中的健康状态
+func fillPanelHealth(resp *schema.GetPanelResponse, p *ent.Panel) {
+	resp.HealthStatus = p.HealthStatus
+	resp.ConsecutiveFailures = p.ConsecutiveFailures
+	resp.AutoDisabled = p.AutoDisabled
+	if p.HealthCheckedAt != nil {
+		checkedAt := p.HealthCheckedAt.Format("2006-01-02 15:04:05")
+		resp.HealthCheckedAt = &checkedAt
+	}
+}
+
+// toPanelHealthRecord 转换健康检查记录
+func toPanelHealthRecord(r *ent.PanelHealth) schema.PanelHealthRecord {
+	return schema.PanelHealthRecord{
+		ID:         r.ID,
+		PanelID:    r.PanelID,
+		Status:     r.Status,
+		TokenValid: r.TokenValid,
+		Latency:    r.Latency,
+		EnvCount:   r.EnvCount,
+		Message:    r.Message,
+		CreatedAt:  r.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// purgePanelHealth 清理面板健康检查记录
+var purgePanelHealth = retentionRecords[predicate.PanelHealth]{
+	label: "健康检查记录",
+	delete: func(ctx context.Context, ps ...predicate.PanelHealth) (int, error) {
+		return config.Ent.PanelHealth.Delete().Where(ps...).Exec(ctx)
+	},
+	nthNewestID: func(ctx context.Context, offset int) (int64, error) {
+		return config.Ent.PanelHealth.Query().Order(ent.Desc(panelhealth.FieldID)).Offset(offset).FirstID(ctx)
+	},
+}.purge
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/balancer"
+)
+
+// snapshotPanelSource 基于面板环境变量快照的候选面板来源
+type snapshotPanelSource struct {
+	panelService *PanelService
+	envName      string
+	bindings     []*ent.EnvPanel // 变量的面板绑定配置
+}
+
+// Candidates 返回可达且未达到绑定上限的面板及该变量在面板上的数量
+// 面板的权重与容量均取自变量的绑定配置
+func (src *snapshotPanelSource) Candidates(ctx context.Context, panelIDs []int64) ([]balancer.Candidate, error) {
+	if len(panelIDs) == 0 {
+		return nil, nil
+	}
+
+	bindings := make(map[int64]*ent.EnvPanel, len(src.bindings))
+	for _, b := range src.bindings {
+		bindings[b.PanelID] = b
+	}
+
+	snapshots, _ := src.panelService.GetPanelEnvSnapshots(ctx, panelIDs)
+	candidates := make([]balancer.Candidate, 0, len(panelIDs))
+	for _, panelID := range panelIDs {
+		snapshot, ok := snapshots[panelID]
+		if !ok {
+			continue
+		}
+		candidate := balancer.Candidate{
+			PanelID: panelID,
+			Used:    snapshot.Count(src.envName),
+		}
+
+		// 绑定上限为硬性限制，达到上限的面板不参与选择
+		if b, ok := bindings[panelID]; ok {
+			if b.MaxCount > 0 {
+				if candidate.Used >= b.MaxCount {
+					continue
+				}
+				candidate.Capacity = b.MaxCount
+			}
+			if b.Weight != nil {
+				candidate.Weight = *b.Weight
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/qinglong"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// inspectPanelSystem 获取面板系统信息并检查版本
+// 获取失败时不阻止操作，只返回警告；版本低于最低支持版本且配置不允许时返回错误
+func inspectPanelSystem(ctx context.Context, url, token string, expiration int) (*schema.QlSystemInfo, string, error) {
+	qlAPI := qinglong.NewAPI(url, token, expiration)
+	res, err := qlAPI.GetSystemInfo(ctx)
+	if err != nil {
+		config.Log.Warn(fmt.Sprintf("获取面板%s系统信息失败: %v", url, err))
+		return nil, "获取面板系统信息失败，无法确认版本兼容性", nil
+	}
+
+	warning, err := checkPanelVersion(res.Data.Version)
+	if err != nil {
+		return nil, "", err
+	}
+	return &res.Data, warning, nil
+}
+
+// checkPanelVersion 检查面板版本，返回警告信息；不受支持且配置不允许时返回错误
+func checkPanelVersion(version string) (string, error) {
+	if version == "" {
+		return "面板未返回版本号，无法确认版本兼容性", nil
+	}
+
+	display := strings.TrimPrefix(version, "v")
+	if !qinglong.IsSupportedVersion(version) {
+		msg := fmt.Sprintf("面板版本 v%s 低于最低支持版本 v%s", display, qinglong.MinSupportedVersion)
+		if !config.Config.Qinglong.AllowUnsupportedVersion {
+			return "", errors.New(msg + "，请升级青龙面板")
+		}
+		return msg + "，部分功能可能无法使用", nil
+	}
+	if !qinglong.IsRecommendedVersion(version) {
+		return fmt.Sprintf("面板版本 v%s 低于推荐版本 v%s，部分功能可能无法使用", display, qinglong.RecommendedVersion), nil
+	}
+	return "", nil
+}
+
+// systemInfoFields 提取需要保存到面板的系统信息字段，未返回的字段为nil
+func systemInfoFields(info *schema.QlSystemInfo) (version, nodeVersion *string, uptime *int64) {
+	if info.Version != "" {
+		version = &info.Version
+	}
+	if info.NodeVersion != "" {
+		nodeVersion = &info.NodeVersion
+	}
+	if info.Uptime > 0 {
+		uptime = &info.Uptime
+	}
+	return version, nodeVersion, uptime
+}
+
+// setPanelSystemInfo 创建面板时写入系统信息
+func setPanelSystemInfo(builder *ent.PanelCreate, info *schema.QlSystemInfo) {
+	if info == nil {
+		return
+	}
+	version, nodeVersion, uptime := systemInfoFields(info)
+	builder.SetNillableQlVersion(version).
+		SetNillableNodeVersion(nodeVersion).
+		SetNillableUptime(uptime).
+		SetSystemCheckedAt(time.Now())
+}
+
+// updatePanelSystemInfo 更新面板时覆盖系统信息，info 为nil时保留原有信息
+func updatePanelSystemInfo(updater *ent.PanelUpdateOne, info *schema.QlSystemInfo) {
+	if info == nil {
+		return
+	}
+	version, nodeVersion, uptime := systemInfoFields(info)
+	if version != nil {
+		updater.SetQlVersion(*version)
+	} else {
+		updater.ClearQlVersion()
+	}
+	if nodeVersion != nil {
+		updater.SetNodeVersion(*nodeVersion)
+	} else {
+		updater.ClearNodeVersion()
+	}
+	if uptime != nil {
+		updater.SetUptime(*uptime)
+	} else {
+		updater.ClearUptime()
+	}
+	updater.SetSystemCheckedAt(time.Now())
+}
+
+// fillPanelSystemInfo 填充面板响应中的系统信息
+func fillPanelSystemInfo(resp *schema.GetPanelResponse, p *ent.Panel) {
+	resp.QlVersion = p.QlVersion
+	resp.NodeVersion = p.NodeVersion
+	resp.Uptime = p.Uptime
+	if p.SystemCheckedAt != nil {
+		checkedAt := p.SystemCheckedAt.Format("2006-01-02 15:04:05")
+		resp.SystemCheckedAt = &checkedAt
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	_const "github.com/nuanxinqing123/QLToolsV2/internal/const"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
 	pkgPlugin "github.com/nuanxinqing123/QLToolsV2/internal/pkg/plugin"
 	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
 )
@@ -54,6 +56,7 @@ func (s *PluginService) CreatePlugin(req schema.CreatePluginRequest) (*schema.Cr
 		SetExecutionTimeout(int32(req.ExecutionTimeout)).
 		SetPriority(int32(req.Priority)).
 		SetTriggerEvent(req.TriggerEvent).
+		SetRequireTestsPass(req.RequireTestsPass).
 		SetCreatedAt(time.Now()).
 		SetUpdatedAt(time.Now())
 
@@ -113,6 +116,21 @@ func (s *PluginService) UpdatePlugin(req schema.UpdatePluginRequest) (*schema.Up
 		return nil, errors.New("无效的触发事件类型")
 	}
 
+	// 要求测试通过时，使用新脚本运行测试用例，未全部通过则阻止保存
+	requireTestsPass := p.RequireTestsPass
+	if req.RequireTestsPass != nil {
+		requireTestsPass = *req.RequireTestsPass
+	}
+	if requireTestsPass {
+		suite, err := s.runTestSuite(req.ID, req.ScriptContent, time.Duration(req.ExecutionTimeout)*time.Millisecond)
+		if err != nil {
+			return nil, fmt.Errorf("运行测试用例失败: %w", err)
+		}
+		if !suite.AllPassed {
+			return nil, fmt.Errorf("测试用例未全部通过(%d/%d失败)，已阻止保存", suite.Failed, suite.Total)
+		}
+	}
+
 	// 执行更新
 	builder := config.Ent.Plugin.UpdateOneID(req.ID).
 		SetName(req.Name).
@@ -129,6 +147,9 @@ func (s *PluginService) UpdatePlugin(req schema.UpdatePluginRequest) (*schema.Up
 	if req.IsEnable != nil {
 		builder.SetIsEnable(*req.IsEnable)
 	}
+	if req.RequireTestsPass != nil {
+		builder.SetRequireTestsPass(*req.RequireTestsPass)
+	}
 
 	if err := builder.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("更新插件失败: %w", err)
@@ -159,6 +180,7 @@ func (s *PluginService) GetPlugin(id int64) (*schema.GetPluginResponse, error) {
 		ScriptContent:    p.ScriptContent,
 		IsEnable:         p.IsEnable,
 		ExecutionTimeout: int(p.ExecutionTimeout),
+		RequireTestsPass: p.RequireTestsPass,
 		CreatedAt:        p.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:        p.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}, nil
@@ -166,12 +188,7 @@ func (s *PluginService) GetPlugin(id int64) (*schema.GetPluginResponse, error) {
 
 // GetPluginList 获取插件列表
 func (s *PluginService) GetPluginList(req schema.GetPluginListRequest) (*schema.GetPluginListResponse, error) {
-	if req.Page <= 0 {
-		req.Page = 1
-	}
-	if req.PageSize <= 0 {
-		req.PageSize = 10
-	}
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 10)
 
 	ctx := context.Background()
 	query := config.Ent.Plugin.Query()
@@ -209,6 +226,7 @@ func (s *PluginService) GetPluginList(req schema.GetPluginListRequest) (*schema.
 			IsEnable:         p.IsEnable,
 			ExecutionTimeout: int(p.ExecutionTimeout),
 			TriggerEvent:     p.TriggerEvent,
+			RequireTestsPass: p.RequireTestsPass,
 			CreatedAt:        p.CreatedAt.Format("2006-01-02 15:04:05"),
 			UpdatedAt:        p.UpdatedAt.Format("2006-01-02 15:04:05"),
 		})
@@ -255,6 +273,15 @@ func (s *PluginService) DeletePlugin(req schema.DeletePluginRequest) (*schema.De
 		return nil, fmt.Errorf("删除插件环境变量关联失败: %w", err)
 	}
 
+	// 删除插件测试用例
+	_, err = tx.PluginTestCase.Delete().
+		Where(plugintestcase.PluginIDEQ(req.ID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("删除插件测试用例失败: %w", err)
+	}
+
 	// 执行删除插件
 	err = tx.Plugin.DeleteOneID(req.ID).Exec(ctx)
 	if err != nil {
@@ -381,7 +408,7 @@ func (s *PluginService) ExecutePluginsForEnv(envID int64, envValue string) (*pkg
 		result := s.engine.Execute(context.Background(), p.ScriptContent, execCtx, timeout)
 
 		// 记录执行日志
-		s.logPluginExecution(item.PluginID, envID, result)
+		s.logPluginExecution(item.PluginID, envID, envValue, result)
 
 		lastResult = result
 
@@ -405,17 +432,21 @@ func (s *PluginService) ExecutePluginsForEnv(envID int64, envValue string) (*pkg
 }
 
 // logPluginExecution 记录插件执行日志
-func (s *PluginService) logPluginExecution(pluginID, envID int64, result *pkgPlugin.ExecutionResult) {
-	status := "success"
-	if !result.Success {
-		status = "error"
-	}
+// 输入数据与输出中转换后的变量值通常包含用户Cookie，仅在配置 retention.store-input-data 开启时保存
+func (s *PluginService) logPluginExecution(pluginID, envID int64, input string, result *pkgPlugin.ExecutionResult) {
+	status := result.Status()
 
 	// 处理可选字段
 	var outputDataStr string
 	if len(result.OutputData) > 0 {
 		outputDataStr = string(result.OutputData)
 	}
+	var inputData *string
+	if config.Config.Retention.StoreInputData {
+		inputData = &input
+	} else {
+		outputDataStr = redactPluginOutput(result.OutputData)
+	}
 
 	// 异步记录日志，不影响主流程
 	go func() {
@@ -426,6 +457,7 @@ func (s *PluginService) logPluginExecution(pluginID, envID int64, result *pkgPlu
 			SetEnvID(envID).
 			SetExecutionStatus(status).
 			SetExecutionTime(int32(result.ExecutionTime)).
+			SetNillableInputData(inputData).
 			SetOutputData(outputDataStr).
 			SetErrorMessage(result.ErrorMessage).
 			SetStackTrace(result.StackTrace).
@@ -436,6 +468,26 @@ func (s *PluginService) logPluginExecution(pluginID, envID int64, result *pkgPlu
 	}()
 }
 
+// redactPluginOutput 隐藏插件输出中的变量值，保留其余字段
+// 输出不是JSON对象时无法区分变量值，整体不保存
+func redactPluginOutput(output []byte) string {
+	if len(output) == 0 {
+		return ""
+	}
+	var data map[string]interface{}
+	if err := config.JSON.Unmarshal(output, &data); err != nil {
+		return ""
+	}
+	if _, ok := data["env"]; ok {
+		data["env"] = "[已隐藏]"
+	}
+	redacted, err := config.JSON.MarshalToString(data)
+	if err != nil {
+		return ""
+	}
+	return redacted
+}
+
 // BindPluginToEnv 绑定插件到环境变量
 func (s *PluginService) BindPluginToEnv(req schema.BindPluginToEnvRequest) (*schema.BindPluginToEnvResponse, error) {
 	ctx := context.Background()
@@ -474,12 +526,32 @@ func (s *PluginService) BindPluginToEnv(req schema.BindPluginToEnvRequest) (*sch
 		executionOrder = 100
 	}
 
+	// 设置默认失败处理策略
+	failurePolicy := req.FailurePolicy
+	if failurePolicy == "" {
+		failurePolicy = _const.PluginFailClosed
+	}
+	failureThreshold := req.FailureThreshold
+	if failureThreshold == 0 {
+		failureThreshold = 5
+	}
+	cooldownSeconds := req.CooldownSeconds
+	if cooldownSeconds == 0 {
+		cooldownSeconds = 300
+	}
+
 	if existingBinding != nil {
-		// 如果已经绑定，更新配置
+		// 如果已经绑定，更新配置，并重置熔断状态
 		err = config.Ent.EnvPlugin.UpdateOne(existingBinding).
 			SetConfig(req.Config).
 			SetExecutionOrder(executionOrder).
 			SetIsEnable(true).
+			SetFailurePolicy(failurePolicy).
+			SetFailureThreshold(failureThreshold).
+			SetCooldownSeconds(cooldownSeconds).
+			SetConsecutiveFailures(0).
+			ClearDisabledUntil().
+			ClearDisabledReason().
 			SetUpdatedAt(time.Now()).
 			Exec(ctx)
 		if err != nil {
@@ -493,6 +565,9 @@ func (s *PluginService) BindPluginToEnv(req schema.BindPluginToEnvRequest) (*sch
 			SetIsEnable(true).
 			SetExecutionOrder(executionOrder).
 			SetConfig(req.Config).
+			SetFailurePolicy(failurePolicy).
+			SetFailureThreshold(failureThreshold).
+			SetCooldownSeconds(cooldownSeconds).
 			SetCreatedAt(time.Now()).
 			SetUpdatedAt(time.Now()).
 			Exec(ctx)
@@ -574,6 +649,8 @@ func (s *PluginService) GetPluginEnvs(req schema.GetPluginEnvsRequest) (*schema.
 			ExecutionOrder: res.ExecutionOrder,
 			Config:         res.Config,
 			CreatedAt:      res.CreatedAt.Format("2006-01-02 15:04:05"),
+
+			PluginFailureState: buildPluginFailureState(res),
 		})
 	}
 
@@ -585,12 +662,7 @@ func (s *PluginService) GetPluginEnvs(req schema.GetPluginEnvsRequest) (*schema.
 
 // GetPluginExecutionLogs 获取插件执行日志
 func (s *PluginService) GetPluginExecutionLogs(req schema.GetPluginExecutionLogsRequest) (*schema.GetPluginExecutionLogsResponse, error) {
-	if req.Page <= 0 {
-		req.Page = 1
-	}
-	if req.PageSize <= 0 {
-		req.PageSize = 10
-	}
+	req.Page, req.PageSize = normalizePage(req.Page, req.PageSize, 10)
 
 	ctx := context.Background()
 	query := config.Ent.PluginExecutionLog.Query()
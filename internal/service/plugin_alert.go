@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/pkg/requests"
+)
+
+// PluginAlertPayload 插件错误率告警Webhook请求体
+type PluginAlertPayload struct {
+	PluginID      int64   `json:"plugin_id"`      // 插件ID
+	PluginName    string  `json:"plugin_name"`    // 插件名称
+	Total         int64   `json:"total"`          // 窗口内执行次数
+	Errors        int64   `json:"errors"`         // 窗口内失败次数
+	Timeouts      int64   `json:"timeouts"`       // 窗口内超时次数
+	FailureRate   float64 `json:"failure_rate"`   // 失败率(0-1)
+	Threshold     float64 `json:"threshold"`      // 告警阈值
+	WindowMinutes int     `json:"window_minutes"` // 统计窗口(分钟)
+	Time          string  `json:"time"`           // 告警时间
+	Message       string  `json:"message"`        // 告警内容
+}
+
+// pluginLastAlert 插件最近一次告警时间，仅由告警任务协程访问
+var pluginLastAlert = make(map[int64]time.Time)
+
+// StartPluginAlertTask 启动插件错误率告警任务
+func StartPluginAlertTask() {
+	if !config.Config.PluginAlert.Enable {
+		return
+	}
+
+	interval := time.Duration(config.Config.PluginAlert.Interval) * time.Minute
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			checkPluginFailureRate()
+		}
+	}()
+}
+
+// checkPluginFailureRate 检查窗口内各插件的失败率并发送告警
+func checkPluginFailureRate() {
+	// 每次检查时读取配置，支持热更新阈值
+	cfg := config.Config.PluginAlert
+	if !cfg.Enable {
+		return
+	}
+	window := cfg.Window
+	if window <= 0 {
+		window = 30
+	}
+	threshold := cfg.Threshold
+	if threshold <= 0 {
+		threshold = 0.5
+	}
+	minSamples := int64(cfg.MinSamples)
+	if minSamples <= 0 {
+		minSamples = 10
+	}
+	cooldown := time.Duration(cfg.Cooldown) * time.Minute
+	if cooldown <= 0 {
+		cooldown = time.Hour
+	}
+
+	ctx := context.Background()
+	now := time.Now()
+	byPlugin := make(map[int64]*statsAccumulator)
+	err := eachPluginExecutionLog(ctx, now.Add(-time.Duration(window)*time.Minute), now, nil, nil, func(l *ent.PluginExecutionLog) {
+		if byPlugin[l.PluginID] == nil {
+			byPlugin[l.PluginID] = &statsAccumulator{}
+		}
+		byPlugin[l.PluginID].add(l.ExecutionStatus, l.ExecutionTime)
+	})
+	if err != nil {
+		config.Log.Error(fmt.Sprintf("插件告警检查失败: %v", err))
+		return
+	}
+
+	// 筛选超过阈值且不在冷却期内的插件
+	triggered := make(map[int64]*statsAccumulator)
+	for pluginID, acc := range byPlugin {
+		if acc.total < minSamples || acc.failureRate() < threshold {
+			continue
+		}
+		if last, ok := pluginLastAlert[pluginID]; ok && now.Sub(last) < cooldown {
+			continue
+		}
+		triggered[pluginID] = acc
+	}
+	if len(triggered) == 0 {
+		return
+	}
+
+	names, err := queryPluginNames(ctx, triggered)
+	if err != nil {
+		config.Log.Error(fmt.Sprintf("插件告警检查失败: %v", err))
+		return
+	}
+
+	for pluginID, acc := range triggered {
+		payload := PluginAlertPayload{
+			PluginID:      pluginID,
+			PluginName:    names[pluginID],
+			Total:         acc.total,
+			Errors:        acc.errors,
+			Timeouts:      acc.timeout,
+			FailureRate:   acc.failureRate(),
+			Threshold:     threshold,
+			WindowMinutes: window,
+			Time:          now.Format("2006-01-02 15:04:05"),
+		}
+		payload.Message = fmt.Sprintf("插件 %s(%d) 最近%d分钟失败率 %.1f%%（%d/%d，其中超时%d次），超过阈值 %.1f%%",
+			payload.PluginName, pluginID, window, payload.FailureRate*100,
+			acc.errors+acc.timeout, acc.total, acc.timeout, threshold*100)
+
+		config.Log.Warn(payload.Message)
+		pluginLastAlert[pluginID] = now
+
+		if cfg.Webhook != "" {
+			sendPluginAlert(cfg.Webhook, payload)
+		}
+	}
+}
+
+// sendPluginAlert 发送告警到Webhook
+func sendPluginAlert(webhook string, payload PluginAlertPayload) {
+	req := requests.New()
+	req.SetHeader("Content-Type", "application/json")
+	resp, err := req.Post(context.Background(), webhook, payload)
+	if err != nil {
+		config.Log.Warn(fmt.Sprintf("发送插件告警失败: %v", err))
+		return
+	}
+	if resp.IsError() {
+		config.Log.Warn(fmt.Sprintf("发送插件告警失败，响应码: %d", resp.StatusCode()))
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	_const "github.com/nuanxinqing123/QLToolsV2/internal/const"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/envplugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// ResetPluginBreaker 重置插件绑定的熔断状态
+func (s *PluginService) ResetPluginBreaker(req schema.ResetPluginBreakerRequest) (*schema.ResetPluginBreakerResponse, error) {
+	ctx := context.Background()
+	affected, err := config.Ent.EnvPlugin.Update().
+		Where(
+			envplugin.PluginIDEQ(req.PluginID),
+			envplugin.EnvIDEQ(req.EnvID),
+		).
+		SetConsecutiveFailures(0).
+		ClearDisabledUntil().
+		ClearDisabledReason().
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("重置熔断状态失败: %w", err)
+	}
+	if affected == 0 {
+		return nil, errors.New("绑定关系不存在")
+	}
+
+	return &schema.ResetPluginBreakerResponse{
+		Message: "熔断状态已重置",
+	}, nil
+}
+
+// buildPluginFailureState 构建插件绑定的失败处理策略与熔断状态
+func buildPluginFailureState(ep *ent.EnvPlugin) schema.PluginFailureState {
+	state := schema.PluginFailureState{
+		FailurePolicy:       ep.FailurePolicy,
+		FailureThreshold:    ep.FailureThreshold,
+		CooldownSeconds:     ep.CooldownSeconds,
+		ConsecutiveFailures: ep.ConsecutiveFailures,
+		CircuitOpen:         isPluginCircuitOpen(ep, time.Now()),
+		DisabledReason:      ep.DisabledReason,
+	}
+	if ep.DisabledUntil != nil {
+		until := ep.DisabledUntil.Format("2006-01-02 15:04:05")
+		state.DisabledUntil = &until
+	}
+	return state
+}
+
+// isPluginCircuitOpen 判断插件绑定当前是否处于熔断状态
+func isPluginCircuitOpen(ep *ent.EnvPlugin, now time.Time) bool {
+	return ep.FailurePolicy == _const.PluginAutoDisable &&
+		ep.DisabledUntil != nil &&
+		now.Before(*ep.DisabledUntil)
+}
+
+// recordPluginFailure 记录插件执行失败并按策略处理，返回是否跳过该插件继续提交
+// dryRun 为 true 时只计算结果，不修改熔断状态
+func (s *PluginService) recordPluginFailure(ep *ent.EnvPlugin, reason string, dryRun bool) (skip bool, tripped bool) {
+	failures := ep.ConsecutiveFailures + 1
+	tripped = ep.FailurePolicy == _const.PluginAutoDisable && failures >= ep.FailureThreshold
+
+	if !dryRun {
+		ctx := context.Background()
+		builder := config.Ent.EnvPlugin.UpdateOneID(ep.ID).
+			AddConsecutiveFailures(1).
+			SetUpdatedAt(time.Now())
+		if tripped {
+			builder.
+				SetDisabledUntil(time.Now().Add(time.Duration(ep.CooldownSeconds) * time.Second)).
+				SetDisabledReason(fmt.Sprintf("连续失败%d次: %s", failures, reason))
+		}
+		if err := builder.Exec(ctx); err != nil {
+			config.Log.Warn(fmt.Sprintf("更新插件%d熔断状态失败: %v", ep.PluginID, err))
+		}
+		if tripped {
+			config.Log.Warn(fmt.Sprintf("插件%d在环境变量%d上连续失败%d次，熔断%d秒: %s",
+				ep.PluginID, ep.EnvID, failures, ep.CooldownSeconds, reason))
+		}
+	}
+
+	switch ep.FailurePolicy {
+	case _const.PluginFailOpen:
+		return true, false
+	case _const.PluginAutoDisable:
+		return tripped, tripped
+	default:
+		return false, false
+	}
+}
+
+// recordPluginSuccess 插件执行成功后清除连续失败计数与熔断状态
+func (s *PluginService) recordPluginSuccess(ep *ent.EnvPlugin, dryRun bool) {
+	if dryRun || (ep.ConsecutiveFailures == 0 && ep.DisabledUntil == nil) {
+		return
+	}
+
+	ctx := context.Background()
+	err := config.Ent.EnvPlugin.UpdateOneID(ep.ID).
+		SetConsecutiveFailures(0).
+		ClearDisabledUntil().
+		ClearDisabledReason().
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		config.Log.Warn(fmt.Sprintf("重置插件%d熔断状态失败: %v", ep.PluginID, err))
+	}
+}
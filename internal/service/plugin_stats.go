@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/env"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	pkgPlugin "github.com/nuanxinqing123/QLToolsV2/internal/pkg/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+const (
+	// 统计查询允许的最大时间跨度
+	maxPluginStatsRange = 31 * 24 * time.Hour
+	// 分页读取执行日志的每页条数
+	pluginStatsPageSize = 1000
+	// 每个统计维度保留的耗时样本数，超过后按蓄水池抽样计算P95
+	pluginStatsSampleSize = 1000
+)
+
+// GetPluginStats 获取插件执行统计
+func (s *PluginService) GetPluginStats(req schema.GetPluginStatsRequest) (*schema.GetPluginStatsResponse, error) {
+	ctx := context.Background()
+
+	endTime := time.Now()
+	if req.EndTime != "" {
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", req.EndTime, time.Local)
+		if err != nil {
+			return nil, errors.New("结束时间格式错误")
+		}
+		endTime = t
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if req.StartTime != "" {
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", req.StartTime, time.Local)
+		if err != nil {
+			return nil, errors.New("开始时间格式错误")
+		}
+		startTime = t
+	}
+	if !startTime.Before(endTime) {
+		return nil, errors.New("开始时间必须早于结束时间")
+	}
+	if endTime.Sub(startTime) > maxPluginStatsRange {
+		return nil, errors.New("统计时间跨度不能超过31天")
+	}
+
+	bucket := req.Bucket
+	if bucket == "" {
+		bucket = "hour"
+	}
+
+	// 按插件、插件+环境变量、时间分桶聚合
+	overall := &statsAccumulator{}
+	byPlugin := make(map[int64]*statsAccumulator)
+	type pluginEnvKey struct{ pluginID, envID int64 }
+	byPluginEnv := make(map[pluginEnvKey]*statsAccumulator)
+	byBucket := make(map[time.Time]*statsAccumulator)
+
+	err := eachPluginExecutionLog(ctx, startTime, endTime, req.PluginID, req.EnvID, func(l *ent.PluginExecutionLog) {
+		overall.add(l.ExecutionStatus, l.ExecutionTime)
+
+		if byPlugin[l.PluginID] == nil {
+			byPlugin[l.PluginID] = &statsAccumulator{}
+		}
+		byPlugin[l.PluginID].add(l.ExecutionStatus, l.ExecutionTime)
+
+		key := pluginEnvKey{pluginID: l.PluginID, envID: l.EnvID}
+		if byPluginEnv[key] == nil {
+			byPluginEnv[key] = &statsAccumulator{}
+		}
+		byPluginEnv[key].add(l.ExecutionStatus, l.ExecutionTime)
+
+		b := truncateStatsBucket(l.CreatedAt, bucket)
+		if byBucket[b] == nil {
+			byBucket[b] = &statsAccumulator{}
+		}
+		byBucket[b].add(l.ExecutionStatus, l.ExecutionTime)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询插件与环境变量名称
+	pluginNames, err := queryPluginNames(ctx, byPlugin)
+	if err != nil {
+		return nil, err
+	}
+	envIDs := make([]int64, 0)
+	seenEnv := make(map[int64]bool)
+	for key := range byPluginEnv {
+		if !seenEnv[key.envID] {
+			seenEnv[key.envID] = true
+			envIDs = append(envIDs, key.envID)
+		}
+	}
+	envNames := make(map[int64]string)
+	if len(envIDs) > 0 {
+		envs, err := config.Ent.Env.Query().
+			Where(env.IDIn(envIDs...)).
+			Select(env.FieldID, env.FieldName).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询环境变量失败: %w", err)
+		}
+		for _, e := range envs {
+			envNames[e.ID] = e.Name
+		}
+	}
+
+	resp := &schema.GetPluginStatsResponse{
+		StartTime: startTime.Format("2006-01-02 15:04:05"),
+		EndTime:   endTime.Format("2006-01-02 15:04:05"),
+		Bucket:    bucket,
+		Overall:   overall.summary(),
+		Plugins:   make([]schema.PluginStatsItem, 0, len(byPlugin)),
+		Envs:      make([]schema.PluginEnvStatsItem, 0, len(byPluginEnv)),
+		Buckets:   make([]schema.PluginStatsBucket, 0),
+	}
+
+	for pluginID, acc := range byPlugin {
+		resp.Plugins = append(resp.Plugins, schema.PluginStatsItem{
+			PluginID:           pluginID,
+			PluginName:         pluginNames[pluginID],
+			PluginStatsSummary: acc.summary(),
+		})
+	}
+	sort.Slice(resp.Plugins, func(i, j int) bool {
+		return resp.Plugins[i].PluginID < resp.Plugins[j].PluginID
+	})
+
+	for key, acc := range byPluginEnv {
+		resp.Envs = append(resp.Envs, schema.PluginEnvStatsItem{
+			PluginID:           key.pluginID,
+			PluginName:         pluginNames[key.pluginID],
+			EnvID:              key.envID,
+			EnvName:            envNames[key.envID],
+			PluginStatsSummary: acc.summary(),
+		})
+	}
+	sort.Slice(resp.Envs, func(i, j int) bool {
+		if resp.Envs[i].PluginID != resp.Envs[j].PluginID {
+			return resp.Envs[i].PluginID < resp.Envs[j].PluginID
+		}
+		return resp.Envs[i].EnvID < resp.Envs[j].EnvID
+	})
+
+	// 补齐空分桶，保证时间轴连续
+	for b := truncateStatsBucket(startTime, bucket); b.Before(endTime); b = nextStatsBucket(b, bucket) {
+		acc := byBucket[b]
+		if acc == nil {
+			acc = &statsAccumulator{}
+		}
+		resp.Buckets = append(resp.Buckets, schema.PluginStatsBucket{
+			Time:               b.Format("2006-01-02 15:04:05"),
+			PluginStatsSummary: acc.summary(),
+		})
+	}
+
+	return resp, nil
+}
+
+// eachPluginExecutionLog 按ID分页遍历时间范围内的执行日志（只取统计需要的字段），避免一次加载全部日志
+func eachPluginExecutionLog(ctx context.Context, startTime, endTime time.Time, pluginID, envID *int64, fn func(*ent.PluginExecutionLog)) error {
+	var lastID int64
+	for {
+		query := config.Ent.PluginExecutionLog.Query().
+			Where(
+				pluginexecutionlog.IDGT(lastID),
+				pluginexecutionlog.CreatedAtGTE(startTime),
+				pluginexecutionlog.CreatedAtLT(endTime),
+			)
+		if pluginID != nil {
+			query = query.Where(pluginexecutionlog.PluginIDEQ(*pluginID))
+		}
+		if envID != nil {
+			query = query.Where(pluginexecutionlog.EnvIDEQ(*envID))
+		}
+
+		logs, err := query.
+			Order(ent.Asc(pluginexecutionlog.FieldID)).
+			Limit(pluginStatsPageSize).
+			Select(
+				pluginexecutionlog.FieldID,
+				pluginexecutionlog.FieldCreatedAt,
+				pluginexecutionlog.FieldPluginID,
+				pluginexecutionlog.FieldEnvID,
+				pluginexecutionlog.FieldExecutionStatus,
+				pluginexecutionlog.FieldExecutionTime,
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("查询执行日志失败: %w", err)
+		}
+		for _, l := range logs {
+			fn(l)
+		}
+		if len(logs) < pluginStatsPageSize {
+			return nil
+		}
+		lastID = logs[len(logs)-1].ID
+	}
+}
+
+// queryPluginNames 查询插件名称
+func queryPluginNames(ctx context.Context, byPlugin map[int64]*statsAccumulator) (map[int64]string, error) {
+	names := make(map[int64]string, len(byPlugin))
+	if len(byPlugin) == 0 {
+		return names, nil
+	}
+
+	ids := make([]int64, 0, len(byPlugin))
+	for id := range byPlugin {
+		ids = append(ids, id)
+	}
+	plugins, err := config.Ent.Plugin.Query().
+		Where(plugin.IDIn(ids...)).
+		Select(plugin.FieldID, plugin.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询插件失败: %w", err)
+	}
+	for _, p := range plugins {
+		names[p.ID] = p.Name
+	}
+	return names, nil
+}
+
+// truncateStatsBucket 将时间截断到分桶起点
+func truncateStatsBucket(t time.Time, bucket string) time.Time {
+	t = t.In(time.Local)
+	if bucket == "day" {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, time.Local)
+}
+
+// nextStatsBucket 返回下一个分桶起点
+func nextStatsBucket(t time.Time, bucket string) time.Time {
+	if bucket == "day" {
+		return t.AddDate(0, 0, 1)
+	}
+	return t.Add(time.Hour)
+}
+
+// statsAccumulator 执行统计累加器
+type statsAccumulator struct {
+	total   int64
+	success int64
+	errors  int64
+	timeout int64
+	sumTime int64
+	maxTime int32
+	times   []int32 // 耗时样本，最多 pluginStatsSampleSize 条
+}
+
+// add 累加一条执行记录
+func (a *statsAccumulator) add(status string, executionTime int32) {
+	a.total++
+	switch status {
+	case pkgPlugin.StatusSuccess:
+		a.success++
+	case pkgPlugin.StatusTimeout:
+		a.timeout++
+	default:
+		a.errors++
+	}
+	a.sumTime += int64(executionTime)
+	a.maxTime = max(a.maxTime, executionTime)
+
+	// 蓄水池抽样，样本数达到上限后以 样本数/总数 的概率替换已有样本
+	if len(a.times) < pluginStatsSampleSize {
+		a.times = append(a.times, executionTime)
+	} else if i := rand.Int64N(a.total); i < pluginStatsSampleSize {
+		a.times[i] = executionTime
+	}
+}
+
+// failureRate 失败率（错误与超时均计入）
+func (a *statsAccumulator) failureRate() float64 {
+	if a.total == 0 {
+		return 0
+	}
+	return float64(a.errors+a.timeout) / float64(a.total)
+}
+
+// summary 生成统计汇总
+func (a *statsAccumulator) summary() schema.PluginStatsSummary {
+	result := schema.PluginStatsSummary{
+		Total:   a.total,
+		Success: a.success,
+		Error:   a.errors,
+		Timeout: a.timeout,
+	}
+	if a.total == 0 {
+		return result
+	}
+
+	result.SuccessRate = float64(a.success) / float64(a.total)
+	result.AvgTime = float64(a.sumTime) / float64(a.total)
+
+	sorted := make([]int32, len(a.times))
+	copy(sorted, a.times)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	// 最近秩法计算P95，样本数超过上限时为抽样估计值
+	idx := (len(sorted)*95+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	result.P95Time = sorted[idx]
+	result.MaxTime = a.maxTime
+
+	return result
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/plugintestcase"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// GetPluginTestCases 获取插件测试用例列表
+func (s *PluginService) GetPluginTestCases(pluginID int64) (*schema.GetPluginTestCasesResponse, error) {
+	ctx := context.Background()
+	// 检查插件是否存在
+	if _, err := config.Ent.Plugin.Get(ctx, pluginID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("插件不存在")
+		}
+		return nil, fmt.Errorf("查询插件失败: %w", err)
+	}
+
+	cases, err := config.Ent.PluginTestCase.Query().
+		Where(plugintestcase.PluginIDEQ(pluginID)).
+		Order(ent.Asc(plugintestcase.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询测试用例失败: %w", err)
+	}
+
+	list := make([]schema.PluginTestCaseInfo, 0, len(cases))
+	for _, tc := range cases {
+		list = append(list, schema.PluginTestCaseInfo{
+			ID:            tc.ID,
+			PluginID:      tc.PluginID,
+			Name:          tc.Name,
+			InputValue:    tc.InputValue,
+			Config:        tc.Config,
+			ExpectedBool:  tc.ExpectedBool,
+			ExpectedEnv:   tc.ExpectedEnv,
+			ExpectedError: tc.ExpectedError,
+			IsEnable:      tc.IsEnable,
+			CreatedAt:     tc.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:     tc.UpdatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &schema.GetPluginTestCasesResponse{
+		PluginID: pluginID,
+		List:     list,
+	}, nil
+}
+
+// CreatePluginTestCase 创建插件测试用例
+func (s *PluginService) CreatePluginTestCase(req schema.CreatePluginTestCaseRequest) (*schema.CreatePluginTestCaseResponse, error) {
+	ctx := context.Background()
+	// 检查插件是否存在
+	if _, err := config.Ent.Plugin.Get(ctx, req.PluginID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("插件不存在")
+		}
+		return nil, fmt.Errorf("查询插件失败: %w", err)
+	}
+
+	if err := validateTestCaseConfig(req.Config); err != nil {
+		return nil, err
+	}
+
+	tc, err := config.Ent.PluginTestCase.Create().
+		SetPluginID(req.PluginID).
+		SetName(req.Name).
+		SetInputValue(req.InputValue).
+		SetNillableConfig(req.Config).
+		SetNillableExpectedBool(req.ExpectedBool).
+		SetNillableExpectedEnv(req.ExpectedEnv).
+		SetNillableExpectedError(req.ExpectedError).
+		SetIsEnable(true).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建测试用例失败: %w", err)
+	}
+
+	return &schema.CreatePluginTestCaseResponse{
+		ID:      tc.ID,
+		Message: "测试用例创建成功",
+	}, nil
+}
+
+// UpdatePluginTestCase 更新插件测试用例
+func (s *PluginService) UpdatePluginTestCase(req schema.UpdatePluginTestCaseRequest) (*schema.UpdatePluginTestCaseResponse, error) {
+	ctx := context.Background()
+	if _, err := config.Ent.PluginTestCase.Get(ctx, req.ID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("测试用例不存在")
+		}
+		return nil, fmt.Errorf("查询测试用例失败: %w", err)
+	}
+
+	if err := validateTestCaseConfig(req.Config); err != nil {
+		return nil, err
+	}
+
+	// 期望值字段允许置空，因此未提供时需要清除
+	builder := config.Ent.PluginTestCase.UpdateOneID(req.ID).
+		SetName(req.Name).
+		SetInputValue(req.InputValue).
+		ClearConfig().
+		ClearExpectedBool().
+		ClearExpectedEnv().
+		ClearExpectedError().
+		SetNillableConfig(req.Config).
+		SetNillableExpectedBool(req.ExpectedBool).
+		SetNillableExpectedEnv(req.ExpectedEnv).
+		SetNillableExpectedError(req.ExpectedError).
+		SetUpdatedAt(time.Now())
+
+	if req.IsEnable != nil {
+		builder.SetIsEnable(*req.IsEnable)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("更新测试用例失败: %w", err)
+	}
+
+	return &schema.UpdatePluginTestCaseResponse{
+		Message: "测试用例更新成功",
+	}, nil
+}
+
+// DeletePluginTestCase 删除插件测试用例
+func (s *PluginService) DeletePluginTestCase(id int64) (*schema.DeletePluginTestCaseResponse, error) {
+	ctx := context.Background()
+	if err := config.Ent.PluginTestCase.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("测试用例不存在")
+		}
+		return nil, fmt.Errorf("删除测试用例失败: %w", err)
+	}
+
+	return &schema.DeletePluginTestCaseResponse{
+		Message: "测试用例删除成功",
+	}, nil
+}
+
+// RunPluginTests 运行插件的全部测试用例
+func (s *PluginService) RunPluginTests(req schema.RunPluginTestsRequest) (*schema.RunPluginTestsResponse, error) {
+	ctx := context.Background()
+	p, err := config.Ent.Plugin.Get(ctx, req.PluginID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("插件不存在")
+		}
+		return nil, fmt.Errorf("查询插件失败: %w", err)
+	}
+
+	// 未提供脚本时使用已保存的脚本
+	script := req.ScriptContent
+	if script == "" {
+		script = p.ScriptContent
+	}
+
+	return s.runTestSuite(req.PluginID, script, time.Duration(p.ExecutionTimeout)*time.Millisecond)
+}
+
+// runTestSuite 使用指定脚本运行插件的全部启用用例
+func (s *PluginService) runTestSuite(pluginID int64, script string, timeout time.Duration) (*schema.RunPluginTestsResponse, error) {
+	ctx := context.Background()
+	cases, err := config.Ent.PluginTestCase.Query().
+		Where(
+			plugintestcase.PluginIDEQ(pluginID),
+			plugintestcase.IsEnableEQ(true),
+		).
+		Order(ent.Asc(plugintestcase.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询测试用例失败: %w", err)
+	}
+
+	resp := &schema.RunPluginTestsResponse{
+		PluginID: pluginID,
+		Total:    len(cases),
+		Results:  make([]schema.PluginTestCaseResult, 0, len(cases)),
+	}
+
+	for _, tc := range cases {
+		result := s.runTestCase(script, tc, timeout)
+		if result.Passed {
+			resp.Passed++
+		} else {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	resp.AllPassed = resp.Failed == 0
+
+	return resp, nil
+}
+
+// runTestCase 执行单个测试用例并与期望值比对
+func (s *PluginService) runTestCase(script string, tc *ent.PluginTestCase, timeout time.Duration) schema.PluginTestCaseResult {
+	var configData []byte
+	if tc.Config != nil && *tc.Config != "" {
+		configData = []byte(*tc.Config)
+	}
+
+	execResult := s.engine.TestScriptWithConfig(script, tc.InputValue, configData, timeout)
+
+	result := schema.PluginTestCaseResult{
+		CaseID:        tc.ID,
+		Name:          tc.Name,
+		ExecutionTime: execResult.ExecutionTime,
+		OutputData:    string(execResult.OutputData),
+		ErrorMessage:  execResult.ErrorMessage,
+		Diffs:         []schema.PluginTestCaseDiff{},
+	}
+
+	// 期望执行失败：只比对错误信息
+	if tc.ExpectedError != nil && *tc.ExpectedError != "" {
+		if execResult.Success || !strings.Contains(execResult.ErrorMessage, *tc.ExpectedError) {
+			result.Diffs = append(result.Diffs, schema.PluginTestCaseDiff{
+				Field:    "error",
+				Expected: *tc.ExpectedError,
+				Actual:   execResult.ErrorMessage,
+			})
+		}
+		result.Passed = len(result.Diffs) == 0
+		return result
+	}
+
+	// 期望执行成功
+	if !execResult.Success {
+		result.Diffs = append(result.Diffs, schema.PluginTestCaseDiff{
+			Field:    "error",
+			Expected: "",
+			Actual:   execResult.ErrorMessage,
+		})
+		return result
+	}
+
+	// 解析返回的JSON: {bool: true/false, env: "value"}
+	var output map[string]interface{}
+	if len(execResult.OutputData) > 0 {
+		if err := config.JSON.Unmarshal(execResult.OutputData, &output); err != nil {
+			result.Diffs = append(result.Diffs, schema.PluginTestCaseDiff{
+				Field:    "output",
+				Expected: "{bool, env}",
+				Actual:   string(execResult.OutputData),
+			})
+			return result
+		}
+	}
+
+	if tc.ExpectedBool != nil {
+		actual, ok := output["bool"].(bool)
+		if !ok || actual != *tc.ExpectedBool {
+			result.Diffs = append(result.Diffs, schema.PluginTestCaseDiff{
+				Field:    "bool",
+				Expected: strconv.FormatBool(*tc.ExpectedBool),
+				Actual:   fmt.Sprint(output["bool"]),
+			})
+		}
+	}
+
+	if tc.ExpectedEnv != nil {
+		actual, ok := output["env"].(string)
+		if !ok || actual != *tc.ExpectedEnv {
+			result.Diffs = append(result.Diffs, schema.PluginTestCaseDiff{
+				Field:    "env",
+				Expected: *tc.ExpectedEnv,
+				Actual:   fmt.Sprint(output["env"]),
+			})
+		}
+	}
+
+	result.Passed = len(result.Diffs) == 0
+	return result
+}
+
+// validateTestCaseConfig 校验用例配置是否为合法JSON
+func validateTestCaseConfig(cfg *string) error {
+	if cfg == nil || *cfg == "" {
+		return nil
+	}
+	var v interface{}
+	if err := config.JSON.Unmarshal([]byte(*cfg), &v); err != nil {
+		return fmt.Errorf("插件配置参数不是合法的JSON: %w", err)
+	}
+	return nil
+}
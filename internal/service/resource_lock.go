@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/resourcelock"
+)
+
+// errResourceLockTimeout 等待资源锁超时
+var errResourceLockTimeout = errors.New("等待资源锁超时")
+
+// submitLockTTL 提交锁租约时长
+func submitLockTTL() time.Duration {
+	if ttl := config.Config.Submit.LockTTL; ttl > 0 {
+		return time.Duration(ttl) * time.Second
+	}
+	return 30 * time.Second
+}
+
+// submitLockWait 等待提交锁的最长时间
+func submitLockWait() time.Duration {
+	if wait := config.Config.Submit.LockWait; wait > 0 {
+		return time.Duration(wait) * time.Second
+	}
+	return 10 * time.Second
+}
+
+// envSubmitLockName 变量提交锁名称
+func envSubmitLockName(envID int64) string {
+	return fmt.Sprintf("env-submit:%d", envID)
+}
+
+// acquireResourceLock 获取跨实例资源锁，返回释放函数
+// 锁记录保存在数据库中，名称唯一；持有期间后台定期续约，持有者异常退出时租约到期后可被其他实例接管
+func acquireResourceLock(ctx context.Context, name string, ttl, wait time.Duration) (func(), error) {
+	owner, err := newLockOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(wait)
+	backoff := 50 * time.Millisecond
+	for {
+		acquired, err := tryAcquireResourceLock(ctx, name, owner, ttl)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			stop := make(chan struct{})
+			stopped := make(chan struct{})
+			go func() {
+				defer close(stopped)
+				renewResourceLock(name, owner, ttl, stop)
+			}()
+			return func() {
+				close(stop)
+				<-stopped
+				releaseResourceLock(name, owner)
+			}, nil
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, errResourceLockTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 500*time.Millisecond)
+	}
+}
+
+// tryAcquireResourceLock 尝试获取一次资源锁
+func tryAcquireResourceLock(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+
+	// 接管已过期的锁，单条 UPDATE 保证只有一个实例成功
+	taken, err := config.Ent.ResourceLock.Update().
+		Where(resourcelock.NameEQ(name), resourcelock.ExpiresAtLT(now)).
+		SetOwner(owner).
+		SetExpiresAt(now.Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("获取资源锁失败: %w", err)
+	}
+	if taken > 0 {
+		return true, nil
+	}
+
+	// 锁不存在时创建，名称唯一索引保证只有一个实例成功
+	err = config.Ent.ResourceLock.Create().
+		SetName(name).
+		SetOwner(owner).
+		SetExpiresAt(now.Add(ttl)).
+		Exec(ctx)
+	if err == nil {
+		return true, nil
+	}
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	return false, fmt.Errorf("获取资源锁失败: %w", err)
+}
+
+// renewResourceLock 每隔三分之一租约时长续约一次，直到 stop 关闭
+// 租约已被其他实例接管时停止续约
+func renewResourceLock(name, owner string, ttl time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), ttl/3)
+		renewed, err := config.Ent.ResourceLock.Update().
+			Where(resourcelock.NameEQ(name), resourcelock.OwnerEQ(owner)).
+			SetExpiresAt(time.Now().Add(ttl)).
+			Save(ctx)
+		cancel()
+		if err != nil {
+			config.Log.Warn(fmt.Sprintf("续约资源锁%s失败: %v", name, err))
+			continue
+		}
+		if renewed == 0 {
+			config.Log.Warn(fmt.Sprintf("资源锁%s的租约已被接管，停止续约", name))
+			return
+		}
+	}
+}
+
+// releaseResourceLock 释放资源锁，只删除自己持有的锁，租约已被接管时不做处理
+func releaseResourceLock(name, owner string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := config.Ent.ResourceLock.Delete().
+		Where(resourcelock.NameEQ(name), resourcelock.OwnerEQ(owner)).
+		Exec(ctx); err != nil {
+		config.Log.Warn(fmt.Sprintf("释放资源锁%s失败: %v", name, err))
+	}
+}
+
+// resourceLockHeld 资源锁是否被持有且租约未到期
+func resourceLockHeld(ctx context.Context, name string) (bool, error) {
+	held, err := config.Ent.ResourceLock.Query().
+		Where(resourcelock.NameEQ(name), resourcelock.ExpiresAtGT(time.Now())).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("查询资源锁失败: %w", err)
+	}
+	return held, nil
+}
+
+// newLockOwner 生成锁持有者标识
+func newLockOwner() (string, error) {
+	owner, err := randomHex(16)
+	if err != nil {
+		return "", fmt.Errorf("生成锁标识失败: %w", err)
+	}
+	return owner, nil
+}
+
+// randomHex 生成 n 字节的随机十六进制字符串
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config"
+	"github.com/nuanxinqing123/QLToolsV2/internal/app/config/autoload"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/loginhistory"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/pluginexecutionlog"
+	"github.com/nuanxinqing123/QLToolsV2/internal/data/ent/predicate"
+	pkgPlugin "github.com/nuanxinqing123/QLToolsV2/internal/pkg/plugin"
+	"github.com/nuanxinqing123/QLToolsV2/internal/schema"
+)
+
+// RetentionPurgeFunc 按保留策略清理数据，返回删除条数
+type RetentionPurgeFunc func(ctx context.Context, policy autoload.RetentionPolicy, now time.Time) (int, error)
+
+// retentionTarget 受保留策略管理的数据表
+type retentionTarget struct {
+	name        string                   // 数据表标识，对应配置 retention.policies 下的键
+	description string                   // 说明
+	defaults    autoload.RetentionPolicy // 未配置时使用的默认策略
+	purge       RetentionPurgeFunc       // 清理函数
+}
+
+// retentionTargets 已注册的数据表
+var retentionTargets = []retentionTarget{
+	{
+		name:        "plugin-execution-log",
+		description: "插件执行日志",
+		defaults:    autoload.RetentionPolicy{MaxAge: 30, ErrorMaxAge: 90, MaxRows: 10000},
+		purge:       purgePluginExecutionLogs,
+	},
+	{
+		name:        "login-history",
+		description: "登录历史",
+		defaults:    autoload.RetentionPolicy{MaxAge: 180},
+		purge:       purgeLoginHistory,
+	},
+}
+
+// registerRetentionTarget 注册受保留策略管理的数据表，新增的记录表在各自文件的 init 中调用
+func registerRetentionTarget(name, description string, defaults autoload.RetentionPolicy, purge RetentionPurgeFunc) {
+	retentionTargets = append(retentionTargets, retentionTarget{
+		name:        name,
+		description: description,
+		defaults:    defaults,
+		purge:       purge,
+	})
+}
+
+// effectiveRetentionPolicy 获取数据表生效的保留策略（配置优先，否则使用默认值）
+func effectiveRetentionPolicy(target retentionTarget) autoload.RetentionPolicy {
+	if policy, ok := config.Config.Retention.Policies[target.name]; ok {
+		return policy
+	}
+	return target.defaults
+}
+
+type RetentionService struct{}
+
+// NewRetentionService 创建 RetentionService
+func NewRetentionService() *RetentionService {
+	return &RetentionService{}
+}
+
+// GetRetentionPolicies 获取生效的数据保留策略
+func (s *RetentionService) GetRetentionPolicies() (*schema.GetRetentionPoliciesResponse, error) {
+	policies := make([]schema.RetentionPolicyInfo, 0, len(retentionTargets))
+	for _, target := range retentionTargets {
+		policy := effectiveRetentionPolicy(target)
+		policies = append(policies, schema.RetentionPolicyInfo{
+			Target:      target.name,
+			Description: target.description,
+			MaxAge:      policy.MaxAge,
+			ErrorMaxAge: policy.ErrorMaxAge,
+			MaxRows:     policy.MaxRows,
+		})
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Target < policies[j].Target
+	})
+
+	return &schema.GetRetentionPoliciesResponse{
+		Enable:         config.Config.Retention.Enable,
+		Interval:       retentionInterval(),
+		StoreInputData: config.Config.Retention.StoreInputData,
+		Policies:       policies,
+	}, nil
+}
+
+// Purge 按保留策略手动清理数据
+func (s *RetentionService) Purge(req schema.PurgeRequest) (*schema.PurgeResponse, error) {
+	ctx := context.Background()
+
+	targets := retentionTargets
+	if req.Target != "" {
+		targets = nil
+		for _, target := range retentionTargets {
+			if target.name == req.Target {
+				targets = append(targets, target)
+				break
+			}
+		}
+		if len(targets) == 0 {
+			return nil, errors.New("不支持的数据表: " + req.Target)
+		}
+	}
+
+	resp := &schema.PurgeResponse{
+		Results: make([]schema.PurgeResult, 0, len(targets)),
+	}
+	now := time.Now()
+	for _, target := range targets {
+		policy := effectiveRetentionPolicy(target)
+		if req.MaxAge != nil {
+			policy.MaxAge = *req.MaxAge
+		}
+
+		result := schema.PurgeResult{Target: target.name}
+		deleted, err := target.purge(ctx, policy, now)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Deleted = deleted
+		resp.Total += deleted
+		resp.Results = append(resp.Results, result)
+	}
+	resp.Message = fmt.Sprintf("清理完成，共删除%d条记录", resp.Total)
+
+	return resp, nil
+}
+
+// StartRetentionTask 启动数据保留清理任务
+func StartRetentionTask() {
+	if !config.Config.Retention.Enable {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(retentionInterval()) * time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			// 每次执行时检查配置，支持热更新关闭
+			if !config.Config.Retention.Enable {
+				continue
+			}
+			ctx := context.Background()
+			now := time.Now()
+			for _, target := range retentionTargets {
+				deleted, err := target.purge(ctx, effectiveRetentionPolicy(target), now)
+				if err != nil {
+					config.Log.Warn(fmt.Sprintf("清理%s失败: %v", target.description, err))
+					continue
+				}
+				if deleted > 0 {
+					config.Log.Info(fmt.Sprintf("清理%s %d条", target.description, deleted))
+				}
+			}
+		}
+	}()
+}
+
+// retentionInterval 清理间隔（分钟）
+func retentionInterval() int {
+	if config.Config.Retention.Interval <= 0 {
+		return 60
+	}
+	return config.Config.Retention.Interval
+}
+
+// purgePluginExecutionLogs 清理插件执行日志
+// error-max-age 大于 max-age 时，失败与超时记录按 error-max-age 保留；
+// 超出 max-rows 时优先删除最早的成功记录（保留错误记录时，错误记录只按时间清理）
+func purgePluginExecutionLogs(ctx context.Context, policy autoload.RetentionPolicy, now time.Time) (int, error) {
+	keepErrorsLonger := policy.ErrorMaxAge > policy.MaxAge && policy.MaxAge > 0
+	total := 0
+
+	// 按时间清理
+	if policy.MaxAge > 0 {
+		cutoff := now.AddDate(0, 0, -policy.MaxAge)
+		query := config.Ent.PluginExecutionLog.Delete().
+			Where(pluginexecutionlog.CreatedAtLT(cutoff))
+		if keepErrorsLonger {
+			query = query.Where(pluginexecutionlog.ExecutionStatusEQ(pkgPlugin.StatusSuccess))
+		}
+		deleted, err := query.Exec(ctx)
+		if err != nil {
+			return total, fmt.Errorf("清理过期执行日志失败: %w", err)
+		}
+		total += deleted
+	}
+	if keepErrorsLonger {
+		cutoff := now.AddDate(0, 0, -policy.ErrorMaxAge)
+		deleted, err := config.Ent.PluginExecutionLog.Delete().
+			Where(
+				pluginexecutionlog.CreatedAtLT(cutoff),
+				pluginexecutionlog.ExecutionStatusNEQ(pkgPlugin.StatusSuccess),
+			).
+			Exec(ctx)
+		if err != nil {
+			return total, fmt.Errorf("清理过期错误日志失败: %w", err)
+		}
+		total += deleted
+	}
+
+	// 按每个插件的条数上限清理
+	if policy.MaxRows > 0 {
+		pluginIDs, err := config.Ent.Plugin.Query().IDs(ctx)
+		if err != nil {
+			return total, fmt.Errorf("查询插件失败: %w", err)
+		}
+		for _, pluginID := range pluginIDs {
+			count, err := config.Ent.PluginExecutionLog.Query().
+				Where(pluginexecutionlog.PluginIDEQ(pluginID)).
+				Count(ctx)
+			if err != nil {
+				return total, fmt.Errorf("统计执行日志失败: %w", err)
+			}
+			excess := count - policy.MaxRows
+			if excess <= 0 {
+				continue
+			}
+
+			scope := []predicate.PluginExecutionLog{pluginexecutionlog.PluginIDEQ(pluginID)}
+			if keepErrorsLonger {
+				scope = append(scope, pluginexecutionlog.ExecutionStatusEQ(pkgPlugin.StatusSuccess))
+			}
+
+			// 找到第 excess 条最早记录的ID，删除该ID及之前的记录
+			boundary, err := config.Ent.PluginExecutionLog.Query().
+				Where(scope...).
+				Order(ent.Asc(pluginexecutionlog.FieldID)).
+				Offset(excess - 1).
+				FirstID(ctx)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					continue
+				}
+				return total, fmt.Errorf("查询执行日志失败: %w", err)
+			}
+			deleted, err := config.Ent.PluginExecutionLog.Delete().
+				Where(append(scope, pluginexecutionlog.IDLTE(boundary))...).
+				Exec(ctx)
+			if err != nil {
+				return total, fmt.Errorf("清理超量执行日志失败: %w", err)
+			}
+			total += deleted
+		}
+	}
+
+	return total, nil
+}
+
+// purgeLoginHistory 清理登录历史
+var purgeLoginHistory = retentionRecords[predicate.LoginHistory]{
+	label: "登录历史",
+	delete: func(ctx context.Context, ps ...predicate.LoginHistory) (int, error) {
+		return config.Ent.LoginHistory.Delete().Where(ps...).Exec(ctx)
+	},
+	nthNewestID: func(ctx context.Context, offset int) (int64, error) {
+		return config.Ent.LoginHistory.Query().Order(ent.Desc(loginhistory.FieldID)).Offset(offset).FirstID(ctx)
+	},
+}.purge
+
+// retentionRecords 按 max-age 与 max-rows 清理的记录表，各表只提供删除与查询方法
+// 记录表均包含 id 与 created_at 字段
+type retentionRecords[P ~func(*sql.Selector)] struct {
+	label       string                                               // 记录名称，用于错误信息
+	delete      func(ctx context.Context, ps ...P) (int, error)      // 按条件删除
+	nthNewestID func(ctx context.Context, offset int) (int64, error) // 按ID倒序跳过 offset 条后的记录ID
+	keep        func(now time.Time) P                                // 不参与清理的记录，可为空
+}
+
+// purge 删除超过 max-age 天的记录，并只保留最新的 max-rows 条
+func (r retentionRecords[P]) purge(ctx context.Context, policy autoload.RetentionPolicy, now time.Time) (int, error) {
+	where := func(p P) []P {
+		if r.keep == nil {
+			return []P{p}
+		}
+		return []P{p, P(sql.NotPredicates(r.keep(now)))}
+	}
+	total := 0
+
+	if policy.MaxAge > 0 {
+		deleted, err := r.delete(ctx, where(P(sql.FieldLT("created_at", now.AddDate(0, 0, -policy.MaxAge))))...)
+		if err != nil {
+			return total, fmt.Errorf("清理过期%s失败: %w", r.label, err)
+		}
+		total += deleted
+	}
+
+	if policy.MaxRows > 0 {
+		boundary, err := r.nthNewestID(ctx, policy.MaxRows)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return total, nil
+			}
+			return total, fmt.Errorf("查询%s失败: %w", r.label, err)
+		}
+		deleted, err := r.delete(ctx, where(P(sql.FieldLTE("id", boundary)))...)
+		if err != nil {
+			return total, fmt.Errorf("清理超量%s失败: %w", r.label, err)
+		}
+		total += deleted
+	}
+
+	return total, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import "github.com/nuanxinqing123/QLToolsV2/internal/schema"
+
+// 试运行步骤状态
+const (
+	stepStatusOK   = "ok"
+	stepStatusFail = "fail"
+	stepStatusSkip = "skip"
+)
+
+// submitTrace 提交流程追踪，仅在试运行时创建；为 nil 时所有方法均为空操作
+type submitTrace struct {
+	steps      []schema.DryRunStep
+	finalValue string
+}
+
+// enabled 是否处于试运行模式
+func (t *submitTrace) enabled() bool {
+	return t != nil
+}
+
+// add 记录一个步骤
+func (t *submitTrace) add(step, status, message string, detail interface{}) {
+	if t == nil {
+		return
+	}
+	t.steps = append(t.steps, schema.DryRunStep{
+		Step:    step,
+		Status:  status,
+		Message: message,
+		Detail:  detail,
+	})
+}
+
+// setFinalValue 记录处理后的最终值
+func (t *submitTrace) setFinalValue(value string) {
+	if t == nil {
+		return
+	}
+	t.finalValue = value
+}